@@ -6,10 +6,16 @@ import (
 
 // CreateTodoRequest represents the HTTP request body for creating a todo
 type CreateTodoRequest struct {
-	Title       string     `json:"title" binding:"required"`
-	Description *string    `json:"description"`
-	Status      *string    `json:"status"`
-	DueDate     *time.Time `json:"due_date"`
+	Title       string          `json:"title" binding:"required"`
+	Description *string         `json:"description"`
+	Status      *string         `json:"status"`
+	Priority    *string         `json:"priority" binding:"omitempty,oneof=none low medium high urgent"`
+	DueDate     *time.Time      `json:"due_date"`
+	TagIDs      []uint          `json:"tag_ids"`
+	ParentID    *uint           `json:"parent_id"`   // create as a subtask of the todo
+	Recurrence  *RecurrenceItem `json:"recurrence"`  // repeat on a schedule, needs a due date
+	WorkflowID  *uint           `json:"workflow_id"` // defaults to the default workflow
+	ProjectID   *uint           `json:"project_id"`  // put in the project, the todo uses the project's workflow
 }
 
 // CreateTodoResponse represents the HTTP response body after creating a todo
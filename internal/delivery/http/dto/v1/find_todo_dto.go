@@ -8,13 +8,25 @@ import (
 
 // FindTodoRequest represents the HTTP request body for finding todos
 type FindTodoRequest struct {
-	Keyword     *string           `json:"keyword"`
-	Status      *string           `json:"status"`
-	CreatedFrom *time.Time        `json:"created_from"`
-	CreatedTo   *time.Time        `json:"created_to"`
-	DueFrom     *time.Time        `json:"due_from"`
-	DueTo       *time.Time        `json:"due_to"`
-	Pagination  dto.PaginationReq `json:"pagination"`
+	Keyword      *string           `json:"keyword"`
+	Status       *string           `json:"status"`
+	Statuses     []string          `json:"statuses"`
+	Priorities   []string          `json:"priorities"`
+	MinPriority  *string           `json:"min_priority"` // priority at or above, e.g. "high" matches high and urgent
+	TagsAny      []uint            `json:"tags_any"`     // tag IDs, todos having any of them
+	TagsAll      []uint            `json:"tags_all"`     // tag IDs, todos having all of them
+	ParentID     *uint             `json:"parent_id"`    // subtasks of the todo
+	SeriesID     *uint             `json:"series_id"`    // occurrences of the recurring series started by the todo
+	ProjectID    *uint             `json:"project_id"`   // todos of the project
+	CreatedFrom  *time.Time        `json:"created_from"`
+	CreatedTo    *time.Time        `json:"created_to"`
+	DueFrom      *time.Time        `json:"due_from"`
+	DueTo        *time.Time        `json:"due_to"`
+	UpdatedSince *time.Time        `json:"updated_since"`
+	Overdue      *bool             `json:"overdue"`
+	HasDueDate   *bool             `json:"has_due_date"`
+	Assignee     *string           `json:"assignee"` // user ID, "me" or "unassigned"
+	Pagination   dto.PaginationReq `json:"pagination"`
 }
 
 // FindTodoResponse represents the HTTP response body for finding todos
@@ -25,11 +37,47 @@ type FindTodoResponse struct {
 
 // TodoItem represents a single todo item in the response
 type TodoItem struct {
-	ID          uint       `json:"id"`
-	Title       string     `json:"title"`
-	Description *string    `json:"description"`
-	Status      string     `json:"status"`
-	DueDate     *time.Time `json:"due_date"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
+	ID           uint            `json:"id"`
+	Title        string          `json:"title"`
+	Description  *string         `json:"description"`
+	Status       string          `json:"status"`
+	WorkflowID   uint            `json:"workflow_id"`
+	ProjectID    *uint           `json:"project_id,omitempty"`
+	Position     string          `json:"position"` // rank key of the manual order, sort_by=position
+	Priority     string          `json:"priority"`
+	DueDate      *time.Time      `json:"due_date"`
+	ParentID     *uint           `json:"parent_id"`
+	BlockedBy    []uint          `json:"blocked_by"`   // todos that have to be done before this one starts
+	AssigneeIDs  []uint          `json:"assignee_ids"` // users working on the todo
+	CommentCount int             `json:"comment_count"`
+	Tags         []TagItem       `json:"tags"`
+	Progress     ProgressItem    `json:"progress"`
+	Recurrence   *RecurrenceItem `json:"recurrence,omitempty"`
+	SeriesID     *uint           `json:"series_id,omitempty"`
+	Occurrence   int             `json:"occurrence,omitempty"` // position in the recurring series, from 1
+	StartedAt    *time.Time      `json:"started_at,omitempty"`
+	CompletedAt  *time.Time      `json:"completed_at,omitempty"`
+	Version      uint            `json:"version"` // send it back with an update to reject concurrent edits
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+	DeletedAt    *time.Time      `json:"deleted_at,omitempty"`
+}
+
+// ProgressItem counts the done and total checklist items of a todo item
+type ProgressItem struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
+
+// RecurrenceItem represents the repeat schedule of a todo item
+type RecurrenceItem struct {
+	Rule     string `json:"rule"`     // RRULE subset, e.g. FREQ=WEEKLY;BYDAY=MO,FR
+	Timezone string `json:"timezone"` // IANA name the schedule follows, empty for UTC
+}
+
+// TagItem represents a tag attached to a todo item
+type TagItem struct {
+	ID    uint   `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
 }
@@ -0,0 +1,11 @@
+package v1
+
+// GetTodoRequest represents the HTTP path parameters for fetching a todo
+type GetTodoRequest struct {
+	ID uint `uri:"id" binding:"required"`
+}
+
+// GetTodoResponse represents the HTTP response body for fetching a todo
+type GetTodoResponse struct {
+	Todo TodoItem `json:"todo"`
+}
@@ -0,0 +1,14 @@
+package v1
+
+// MoveTodoRequest represents the HTTP request body for moving a todo in the manual order,
+// give after_id and/or before_id to place it between its new neighbours
+type MoveTodoRequest struct {
+	ID        uint    `json:"id" binding:"required"`
+	AfterID   *uint   `json:"after_id"`   // todo right above the moved one
+	BeforeID  *uint   `json:"before_id"`  // todo right below the moved one
+	Status    *string `json:"status"`     // omit to keep, status to move into, e.g. another board column
+	ProjectID *uint   `json:"project_id"` // omit to keep, id to move into the project
+	Version   *uint   `json:"version"`    // omit to skip the check, version read to reject concurrent edits with 409
+}
+
+// No MoveTodoResponse needed - using HTTP 204 No Content
@@ -0,0 +1,55 @@
+package v1
+
+import (
+	"time"
+)
+
+// CreateProjectRequest represents the HTTP request body for creating a project
+type CreateProjectRequest struct {
+	Name        string  `json:"name" binding:"required"`
+	Description *string `json:"description"`
+	WorkflowID  *uint   `json:"workflow_id"` // workflow of the todos in the project, defaults to the default workflow
+}
+
+// CreateProjectResponse represents the HTTP response body after creating a project
+type CreateProjectResponse struct {
+	ID uint `json:"id"`
+}
+
+// FindProjectResponse represents the HTTP response body for listing projects
+type FindProjectResponse struct {
+	Projects []ProjectItem `json:"projects"`
+}
+
+// GetProjectRequest represents the HTTP path parameters for fetching a project
+type GetProjectRequest struct {
+	ID uint `uri:"id" binding:"required"`
+}
+
+// GetProjectResponse represents the HTTP response body for fetching a project
+type GetProjectResponse struct {
+	Project ProjectItem `json:"project"`
+}
+
+// UpdateProjectRequest represents the HTTP request body for updating a project
+type UpdateProjectRequest struct {
+	ID          uint    `json:"id" binding:"required"`
+	Name        *string `json:"name"`        // omit to keep
+	Description *string `json:"description"` // omit to keep, "" to clear
+}
+
+// DeleteProjectRequest represents the HTTP request body for deleting a project
+type DeleteProjectRequest struct {
+	ID      uint `json:"id" binding:"required"`
+	Cascade bool `json:"cascade"` // true moves the todos of the project to the trash, false rejects a project that still has todos
+}
+
+// ProjectItem represents a single project in the response
+type ProjectItem struct {
+	ID          uint      `json:"id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description"`
+	WorkflowID  uint      `json:"workflow_id"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"itmrchow/go-todolist-service/internal/utils/dto"
+)
+
+// FindTrashRequest represents the HTTP request body for listing soft deleted todos
+type FindTrashRequest struct {
+	Pagination dto.PaginationReq `json:"pagination"`
+}
+
+// FindTrashResponse represents the HTTP response body for listing soft deleted todos
+type FindTrashResponse struct {
+	Todos      []TodoItem         `json:"todos"`
+	Pagination dto.PaginationResp `json:"pagination"`
+}
+
+// RestoreTodoRequest represents the HTTP request body for restoring a todo from the trash
+type RestoreTodoRequest struct {
+	ID uint `json:"id" binding:"required"`
+}
+
+// PurgeTodoRequest represents the HTTP request body for permanently deleting a todo in the trash
+type PurgeTodoRequest struct {
+	ID uint `json:"id" binding:"required"`
+}
+
+// EmptyTrashResponse represents the HTTP response body after emptying the trash
+type EmptyTrashResponse struct {
+	PurgedCount int64 `json:"purged_count"`
+}
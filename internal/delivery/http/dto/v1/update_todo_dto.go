@@ -6,11 +6,17 @@ import (
 
 // UpdateTodoRequest represents the HTTP request body for updating a todo
 type UpdateTodoRequest struct {
-	ID          uint       `json:"id" binding:"required"`
-	Title       string     `json:"title" binding:"required"`
-	Description *string    `json:"description"`
-	Status      *string    `json:"status" binding:"omitempty,oneof=pending doing done"`
-	DueDate     *time.Time `json:"due_date"`
+	ID          uint            `json:"id" binding:"required"`
+	Title       string          `json:"title" binding:"required"`
+	Description *string         `json:"description"`
+	Status      *string         `json:"status"`
+	Priority    *string         `json:"priority" binding:"omitempty,oneof=none low medium high urgent"`
+	DueDate     *time.Time      `json:"due_date"`
+	TagIDs      *[]uint         `json:"tag_ids"`    // omit to keep, [] to detach all
+	ParentID    *uint           `json:"parent_id"`  // omit to keep, id to move under the todo
+	Recurrence  *RecurrenceItem `json:"recurrence"` // omit to keep, rule to replace
+	ProjectID   *uint           `json:"project_id"` // omit to keep, id to move into the project
+	Version     *uint           `json:"version"`    // omit to skip the check, version read to reject concurrent edits with 409
 }
 
 // No UpdateTodoResponse needed - using HTTP 204 No Content
\ No newline at end of file
@@ -0,0 +1,38 @@
+package v2
+
+import (
+	"time"
+)
+
+// APIKeyURI represents the path parameters of a single API key resource
+type APIKeyURI struct {
+	ID uint `uri:"id" binding:"required"`
+}
+
+// CreateAPIKeyRequest represents the request body of POST /api-keys
+type CreateAPIKeyRequest struct {
+	Name   string   `json:"name" binding:"required"`
+	Scopes []string `json:"scopes" binding:"required"` // todos:read and/or todos:write
+}
+
+// CreateAPIKeyResponse represents the response body of POST /api-keys
+type CreateAPIKeyResponse struct {
+	APIKey
+	Key string `json:"key"` // shown once, send as "Authorization: Bearer <key>"
+}
+
+// ListAPIKeysResponse represents the response body of GET /api-keys
+type ListAPIKeysResponse struct {
+	APIKeys []APIKey `json:"api_keys"`
+}
+
+// APIKey represents a single API key resource, the key itself is never returned again
+type APIKey struct {
+	ID         uint       `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
@@ -0,0 +1,12 @@
+package v2
+
+// AssigneeURI represents the path parameters of a single assignee of a todo
+type AssigneeURI struct {
+	TodoID uint `uri:"id" binding:"required"`
+	UserID uint `uri:"user_id" binding:"required"`
+}
+
+// AssignTodoRequest represents the request body of POST /todos/:id/assignees
+type AssignTodoRequest struct {
+	UserID uint `json:"user_id" binding:"required"` // a member of the workspace of the todo
+}
@@ -0,0 +1,26 @@
+package v2
+
+import "time"
+
+// AttachmentURI represents the path parameters of a single attachment resource
+type AttachmentURI struct {
+	TodoID       uint `uri:"id" binding:"required"`
+	AttachmentID uint `uri:"attachment_id" binding:"required"`
+}
+
+// ListAttachmentsResponse represents the response body of GET /todos/:id/attachments
+type ListAttachmentsResponse struct {
+	Attachments []Attachment `json:"attachments"`
+}
+
+// Attachment represents the metadata of a single attachment resource,
+// the content is served by GET /todos/:id/attachments/:attachment_id
+type Attachment struct {
+	ID          uint      `json:"id"`
+	Name        string    `json:"name"`
+	Size        int64     `json:"size"`         // in bytes
+	ContentType string    `json:"content_type"` // detected from the content, not taken from the upload
+	SHA256      string    `json:"sha256"`
+	Uploader    string    `json:"uploader"` // X-Actor of the request that uploaded it, empty when unknown
+	CreatedAt   time.Time `json:"created_at"`
+}
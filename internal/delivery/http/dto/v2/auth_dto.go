@@ -0,0 +1,31 @@
+package v2
+
+// RegisterRequest represents the request body of POST /auth/register
+type RegisterRequest struct {
+	Email    string `json:"email" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// RegisterResponse represents the response body of POST /auth/register
+type RegisterResponse struct {
+	ID uint `json:"id"`
+}
+
+// LoginRequest represents the request body of POST /auth/login
+type LoginRequest struct {
+	Email    string `json:"email" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+// RefreshRequest represents the request body of POST /auth/refresh
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// TokenResponse represents the response body of POST /auth/login and POST /auth/refresh
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`  // send as "Authorization: Bearer <access_token>"
+	RefreshToken string `json:"refresh_token"` // exchange at POST /auth/refresh once the access token expires
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"` // seconds until the access token expires
+}
@@ -0,0 +1,49 @@
+package v2
+
+import (
+	"time"
+)
+
+// ChecklistItemURI represents the path parameters of a single checklist item resource
+type ChecklistItemURI struct {
+	TodoID uint `uri:"id" binding:"required"`
+	ItemID uint `uri:"item_id" binding:"required"`
+}
+
+// ListChecklistResponse represents the response body of GET /todos/:id/checklist
+type ListChecklistResponse struct {
+	Items    []ChecklistItem `json:"items"`
+	Progress ProgressItem    `json:"progress"`
+}
+
+// AddChecklistItemRequest represents the request body of POST /todos/:id/checklist
+type AddChecklistItemRequest struct {
+	Text string `json:"text" binding:"required"`
+}
+
+// AddChecklistItemResponse represents the response body of POST /todos/:id/checklist
+type AddChecklistItemResponse struct {
+	ID uint `json:"id"`
+}
+
+// PatchChecklistItemRequest represents the request body of PATCH /todos/:id/checklist/:item_id,
+// omitted fields are kept
+type PatchChecklistItemRequest struct {
+	Text *string `json:"text"`
+	Done *bool   `json:"done"`
+}
+
+// ReorderChecklistRequest represents the request body of PUT /todos/:id/checklist/order
+type ReorderChecklistRequest struct {
+	ItemIDs []uint `json:"item_ids" binding:"required"` // every item ID of the todo in the new order
+}
+
+// ChecklistItem represents a single checklist item resource
+type ChecklistItem struct {
+	ID        uint      `json:"id"`
+	Text      string    `json:"text"`
+	Done      bool      `json:"done"`
+	Position  int       `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,54 @@
+package v2
+
+import (
+	"time"
+
+	"itmrchow/go-todolist-service/internal/utils/dto"
+)
+
+// CommentURI represents the path parameters of a single comment resource
+type CommentURI struct {
+	TodoID    uint `uri:"id" binding:"required"`
+	CommentID uint `uri:"comment_id" binding:"required"`
+}
+
+// ListCommentsQuery represents the query string of GET /todos/:id/comments
+type ListCommentsQuery struct {
+	Page         int    `form:"page,default=1" binding:"min=1"`
+	PageSize     int    `form:"page_size,default=20" binding:"min=1,max=100"`
+	SortOrder    string `form:"sort_order,default=asc" binding:"oneof=asc desc"` // asc for oldest first
+	Mode         string `form:"mode" binding:"omitempty,oneof=offset cursor"`
+	Cursor       string `form:"cursor"` // next_cursor/prev_cursor of a previous response, implies mode=cursor
+	IncludeTotal bool   `form:"include_total"`
+}
+
+// ListCommentsResponse represents the response body of GET /todos/:id/comments
+type ListCommentsResponse struct {
+	Comments   []Comment          `json:"comments"`
+	Pagination dto.PaginationResp `json:"pagination"`
+}
+
+// CreateCommentRequest represents the request body of POST /todos/:id/comments
+type CreateCommentRequest struct {
+	Body string `json:"body" binding:"required"`
+}
+
+// CreateCommentResponse represents the response body of POST /todos/:id/comments
+type CreateCommentResponse struct {
+	ID uint `json:"id"`
+}
+
+// PatchCommentRequest represents the request body of PATCH /todos/:id/comments/:comment_id
+type PatchCommentRequest struct {
+	Body string `json:"body" binding:"required"`
+}
+
+// Comment represents a single comment resource
+type Comment struct {
+	ID        uint       `json:"id"`
+	Author    string     `json:"author"` // X-Actor of the request that wrote it, empty when unknown
+	Body      string     `json:"body"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	EditedAt  *time.Time `json:"edited_at"` // null when never edited
+}
@@ -0,0 +1,12 @@
+package v2
+
+// DependencyURI represents the path parameters of a single dependency of a todo
+type DependencyURI struct {
+	TodoID    uint `uri:"id" binding:"required"`
+	BlockerID uint `uri:"blocker_id" binding:"required"`
+}
+
+// AddDependencyRequest represents the request body of POST /todos/:id/dependencies
+type AddDependencyRequest struct {
+	BlockerID uint `json:"blocker_id" binding:"required"` // the todo that has to be done first
+}
@@ -0,0 +1,28 @@
+package v2
+
+import (
+	"encoding/json"
+)
+
+// Nullable distinguishes an omitted JSON field from an explicit null,
+// which PATCH needs to tell "keep current" apart from "clear"
+type Nullable[T any] struct {
+	Set   bool // field was present in the request body
+	Value *T   // nil when the field was explicitly null
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	n.Set = true
+	if string(data) == "null" {
+		n.Value = nil
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	n.Value = &value
+	return nil
+}
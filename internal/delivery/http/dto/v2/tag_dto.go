@@ -0,0 +1,42 @@
+package v2
+
+import (
+	"time"
+)
+
+// TagURI represents the path parameters of a single tag resource
+type TagURI struct {
+	ID uint `uri:"id" binding:"required"`
+}
+
+// ListTagsResponse represents the response body of GET /tags
+type ListTagsResponse struct {
+	Tags []TagItem `json:"tags"`
+}
+
+// CreateTagRequest represents the request body of POST /tags
+type CreateTagRequest struct {
+	Name  string `json:"name" binding:"required"`
+	Color string `json:"color"` // hex color like #1e90ff, defaults to grey
+}
+
+// CreateTagResponse represents the response body of POST /tags
+type CreateTagResponse struct {
+	ID uint `json:"id"`
+}
+
+// PatchTagRequest represents the request body of PATCH /tags/:id,
+// omitted fields are kept
+type PatchTagRequest struct {
+	Name  *string `json:"name"`
+	Color *string `json:"color"`
+}
+
+// TagItem represents a single tag resource
+type TagItem struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	Color     string    `json:"color"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
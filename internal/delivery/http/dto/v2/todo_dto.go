@@ -0,0 +1,163 @@
+package v2
+
+import (
+	"time"
+
+	"itmrchow/go-todolist-service/internal/utils/dto"
+)
+
+// TodoURI represents the path parameters of a single todo resource
+type TodoURI struct {
+	ID uint `uri:"id" binding:"required"`
+}
+
+// ListTodosQuery represents the query string of GET /todos
+type ListTodosQuery struct {
+	Keyword      *string    `form:"keyword"`
+	Status       *string    `form:"status"`
+	Statuses     []string   `form:"statuses"`   // repeated key, e.g. statuses=pending&statuses=doing
+	Priorities   []string   `form:"priorities"` // repeated key, e.g. priorities=high&priorities=urgent
+	MinPriority  *string    `form:"min_priority"`
+	TagsAny      []uint     `form:"tags_any"`  // repeated key of tag IDs, todos having any of them
+	TagsAll      []uint     `form:"tags_all"`  // repeated key of tag IDs, todos having all of them
+	ParentID     *uint      `form:"parent_id"` // subtasks of the todo
+	SeriesID     *uint      `form:"series_id"` // occurrences of the recurring series started by the todo
+	WorkflowID   *uint      `form:"workflow_id"`
+	ProjectID    *uint      `form:"project_id"` // todos of the project
+	CreatedFrom  *time.Time `form:"created_from" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedTo    *time.Time `form:"created_to" time_format:"2006-01-02T15:04:05Z07:00"`
+	DueFrom      *time.Time `form:"due_from" time_format:"2006-01-02T15:04:05Z07:00"`
+	DueTo        *time.Time `form:"due_to" time_format:"2006-01-02T15:04:05Z07:00"`
+	UpdatedSince *time.Time `form:"updated_since" time_format:"2006-01-02T15:04:05Z07:00"`
+	Overdue      *bool      `form:"overdue"`
+	HasDueDate   *bool      `form:"has_due_date"`
+	Assignee     *string    `form:"assignee"` // user ID, "me" for the signed-in user or "unassigned"
+	Page         int        `form:"page,default=1" binding:"min=1"`
+	PageSize     int        `form:"page_size,default=20" binding:"min=1,max=100"`
+	SortBy       string     `form:"sort_by,default=id"`      // comma separated, e.g. status,due_date
+	SortOrder    string     `form:"sort_order,default=desc"` // comma separated asc/desc
+	Mode         string     `form:"mode" binding:"omitempty,oneof=offset cursor"`
+	Cursor       string     `form:"cursor"` // next_cursor/prev_cursor of a previous response, implies mode=cursor
+	IncludeTotal bool       `form:"include_total"`
+}
+
+// ListTodosResponse represents the response body of GET /todos
+type ListTodosResponse struct {
+	Todos      []TodoItem         `json:"todos"`
+	Pagination dto.PaginationResp `json:"pagination"`
+}
+
+// CreateTodoRequest represents the request body of POST /todos
+type CreateTodoRequest struct {
+	Title       string          `json:"title" binding:"required"`
+	Description *string         `json:"description"`
+	Status      *string         `json:"status"` // status of the workflow, defaults to its first todo status
+	Priority    *string         `json:"priority" binding:"omitempty,oneof=none low medium high urgent"`
+	DueDate     *time.Time      `json:"due_date"`
+	TagIDs      []uint          `json:"tag_ids"`
+	ParentID    *uint           `json:"parent_id"`   // create as a subtask of the todo
+	Recurrence  *RecurrenceItem `json:"recurrence"`  // repeat on a schedule, needs a due date
+	WorkflowID  *uint           `json:"workflow_id"` // defaults to the default workflow
+	ProjectID   *uint           `json:"project_id"`  // put in the project, the todo uses the project's workflow
+}
+
+// CreateTodoResponse represents the response body of POST /todos
+type CreateTodoResponse struct {
+	ID uint `json:"id"`
+}
+
+// ReplaceTodoRequest represents the request body of PUT /todos/:id,
+// omitted optional fields are cleared
+type ReplaceTodoRequest struct {
+	Title       string          `json:"title" binding:"required"`
+	Description *string         `json:"description"`
+	Status      string          `json:"status" binding:"required"`
+	Priority    *string         `json:"priority" binding:"omitempty,oneof=none low medium high urgent"`
+	DueDate     *time.Time      `json:"due_date"`
+	TagIDs      []uint          `json:"tag_ids"`
+	ParentID    *uint           `json:"parent_id"`
+	Recurrence  *RecurrenceItem `json:"recurrence"`
+	ProjectID   *uint           `json:"project_id"`
+}
+
+// PatchTodoRequest represents the request body of PATCH /todos/:id,
+// omitted fields are kept and null clears nullable fields
+type PatchTodoRequest struct {
+	Title       Nullable[string]         `json:"title"`
+	Description Nullable[string]         `json:"description"`
+	Status      Nullable[string]         `json:"status"`
+	Priority    Nullable[string]         `json:"priority"`
+	DueDate     Nullable[time.Time]      `json:"due_date"`
+	TagIDs      Nullable[[]uint]         `json:"tag_ids"`    // null detaches all tags
+	ParentID    Nullable[uint]           `json:"parent_id"`  // null makes it a top-level todo
+	Recurrence  Nullable[RecurrenceItem] `json:"recurrence"` // null stops repeating
+	ProjectID   Nullable[uint]           `json:"project_id"` // null removes it from its project
+}
+
+// TransitionTodoRequest represents the request body of POST /todos/:id/transition
+type TransitionTodoRequest struct {
+	Status string `json:"status" binding:"required"` // a status of the todo's workflow
+}
+
+// MoveTodoRequest represents the request body of POST /todos/:id/move,
+// give after_id and/or before_id to place the todo between its new neighbours
+type MoveTodoRequest struct {
+	AfterID   *uint   `json:"after_id"`   // todo right above the moved one
+	BeforeID  *uint   `json:"before_id"`  // todo right below the moved one
+	Status    *string `json:"status"`     // omit to keep, status to move into, e.g. another board column
+	ProjectID *uint   `json:"project_id"` // omit to keep, id to move into the project
+}
+
+// StatusHistoryResponse represents the response body of GET /todos/:id/history
+type StatusHistoryResponse struct {
+	Changes []StatusChangeItem `json:"changes"` // oldest first
+}
+
+// StatusChangeItem represents a single recorded status transition or assignee change
+type StatusChangeItem struct {
+	Event      string    `json:"event"`                 // status_changed, assigned or unassigned
+	FromStatus string    `json:"from_status,omitempty"` // set for status_changed
+	ToStatus   string    `json:"to_status,omitempty"`
+	AssigneeID *uint     `json:"assignee_id,omitempty"` // set for assigned and unassigned
+	Actor      string    `json:"actor"`                 // X-Actor header of the request, empty when unknown
+	ChangedAt  time.Time `json:"changed_at"`
+}
+
+// TodoItem represents a single todo resource
+type TodoItem struct {
+	ID           uint            `json:"id"`
+	Title        string          `json:"title"`
+	Description  *string         `json:"description"`
+	Status       string          `json:"status"`
+	WorkflowID   uint            `json:"workflow_id"`
+	ProjectID    *uint           `json:"project_id"`
+	Position     string          `json:"position"` // rank key of the manual order, sort_by=position
+	Priority     string          `json:"priority"`
+	DueDate      *time.Time      `json:"due_date"`
+	ParentID     *uint           `json:"parent_id"`
+	BlockedBy    []uint          `json:"blocked_by"`   // todos that have to be done before this one starts
+	AssigneeIDs  []uint          `json:"assignee_ids"` // users working on the todo
+	CommentCount int             `json:"comment_count"`
+	Tags         []TagItem       `json:"tags"`
+	Progress     ProgressItem    `json:"progress"` // done/total checklist items
+	Recurrence   *RecurrenceItem `json:"recurrence"`
+	SeriesID     *uint           `json:"series_id"`    // first todo of the recurring series
+	Occurrence   int             `json:"occurrence"`   // position in the recurring series, 0 for one-off todos
+	StartedAt    *time.Time      `json:"started_at"`   // first time work started
+	CompletedAt  *time.Time      `json:"completed_at"` // set while the todo is done
+	Version      uint            `json:"version"`      // same as the ETag of GET /todos/:id, send it as If-Match
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+// RecurrenceItem represents the repeat schedule of a todo
+type RecurrenceItem struct {
+	Rule     string `json:"rule"`     // RRULE subset, e.g. FREQ=WEEKLY;BYDAY=MO,FR
+	Timezone string `json:"timezone"` // IANA name the schedule follows, empty for UTC
+}
+
+// ProgressItem counts the done and total checklist items of a todo
+type ProgressItem struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
@@ -0,0 +1,58 @@
+package v2
+
+import (
+	"time"
+)
+
+// WorkflowURI represents the path parameters of a single workflow resource
+type WorkflowURI struct {
+	ID uint `uri:"id" binding:"required"`
+}
+
+// ListWorkflowsResponse represents the response body of GET /workflows
+type ListWorkflowsResponse struct {
+	Workflows []WorkflowItem `json:"workflows"`
+}
+
+// CreateWorkflowRequest represents the request body of POST /workflows
+type CreateWorkflowRequest struct {
+	Name     string              `json:"name" binding:"required"`
+	Statuses []WorkflowStatusReq `json:"statuses" binding:"required,min=1,dive"` // in workflow order
+}
+
+// CreateWorkflowResponse represents the response body of POST /workflows
+type CreateWorkflowResponse struct {
+	ID uint `json:"id"`
+}
+
+// ReplaceWorkflowRequest represents the request body of PUT /workflows/:id,
+// statuses with an id are kept or renamed, without id added and omitted ones removed
+type ReplaceWorkflowRequest struct {
+	Name     string              `json:"name" binding:"required"`
+	Statuses []WorkflowStatusReq `json:"statuses" binding:"required,min=1,dive"`
+}
+
+// WorkflowStatusReq represents a status in workflow requests
+type WorkflowStatusReq struct {
+	ID       uint   `json:"id"` // existing status, omit for a new one
+	Name     string `json:"name" binding:"required"`
+	Category string `json:"category" binding:"required,oneof=todo in_progress done"`
+}
+
+// WorkflowItem represents a single workflow resource
+type WorkflowItem struct {
+	ID        uint                 `json:"id"`
+	Name      string               `json:"name"`
+	IsDefault bool                 `json:"is_default"`
+	Statuses  []WorkflowStatusItem `json:"statuses"` // in workflow order
+	CreatedAt time.Time            `json:"created_at"`
+	UpdatedAt time.Time            `json:"updated_at"`
+}
+
+// WorkflowStatusItem represents a status of a workflow
+type WorkflowStatusItem struct {
+	ID       uint   `json:"id"`
+	Name     string `json:"name"`
+	Category string `json:"category"` // todo, in_progress or done
+	Position int    `json:"position"`
+}
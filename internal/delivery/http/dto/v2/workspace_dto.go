@@ -0,0 +1,64 @@
+package v2
+
+import (
+	"time"
+)
+
+// WorkspaceURI represents the path parameters of a single workspace resource
+type WorkspaceURI struct {
+	ID uint `uri:"id" binding:"required"`
+}
+
+// WorkspaceMemberURI represents the path parameters of a single member of a workspace
+type WorkspaceMemberURI struct {
+	ID     uint `uri:"id" binding:"required"`
+	UserID uint `uri:"user_id" binding:"required"`
+}
+
+// ListWorkspacesResponse represents the response body of GET /workspaces
+type ListWorkspacesResponse struct {
+	Workspaces []Workspace `json:"workspaces"`
+}
+
+// CreateWorkspaceRequest represents the request body of POST /workspaces
+type CreateWorkspaceRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+// CreateWorkspaceResponse represents the response body of POST /workspaces
+type CreateWorkspaceResponse struct {
+	ID uint `json:"id"`
+}
+
+// ListWorkspaceMembersResponse represents the response body of GET /workspaces/:id/members
+type ListWorkspaceMembersResponse struct {
+	Members []WorkspaceMember `json:"members"`
+}
+
+// AddWorkspaceMemberRequest represents the request body of POST /workspaces/:id/members,
+// the user must already be registered and joins as a member unless another role is given
+type AddWorkspaceMemberRequest struct {
+	Email string `json:"email" binding:"required"`
+	Role  string `json:"role"`
+}
+
+// ChangeWorkspaceMemberRoleRequest represents the request body of PATCH /workspaces/:id/members/:user_id
+type ChangeWorkspaceMemberRoleRequest struct {
+	Role string `json:"role" binding:"required"`
+}
+
+// Workspace represents a single workspace resource
+type Workspace struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// WorkspaceMember represents a member of a workspace
+type WorkspaceMember struct {
+	UserID    uint      `json:"user_id"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
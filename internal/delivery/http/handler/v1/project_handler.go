@@ -0,0 +1,11 @@
+package v1
+
+import "github.com/gin-gonic/gin"
+
+type ProjectHandler interface {
+	CreateProject(c *gin.Context)
+	FindProject(c *gin.Context)
+	GetProject(c *gin.Context)
+	UpdateProject(c *gin.Context)
+	DeleteProject(c *gin.Context)
+}
@@ -0,0 +1,235 @@
+package v1
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	v1 "itmrchow/go-todolist-service/internal/delivery/http/dto/v1"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+)
+
+var _ ProjectHandler = &ProjectHandlerImpl{}
+
+type ProjectHandlerImpl struct {
+	logger    zerolog.Logger
+	projectUc usecase.ProjectUseCase
+}
+
+func NewProjectHandlerImpl(logger zerolog.Logger, projectUc usecase.ProjectUseCase) *ProjectHandlerImpl {
+	return &ProjectHandlerImpl{
+		logger:    logger,
+		projectUc: projectUc,
+	}
+}
+
+func (p *ProjectHandlerImpl) CreateProject(c *gin.Context) {
+	// Parse HTTP request body into HTTP DTO
+	var httpReq v1.CreateProjectRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		p.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	// Call usecase
+	ucResp, err := p.projectUc.CreateProject(c, usecase.CreateProjectRequest{
+		Name:        httpReq.Name,
+		Description: httpReq.Description,
+		WorkflowID:  httpReq.WorkflowID,
+	})
+	if err != nil {
+		// Handle different error types
+		if strings.Contains(err.Error(), "validation fail") {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		if strings.Contains(err.Error(), "conflict") {
+			c.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		// Default error handling
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, v1.CreateProjectResponse{
+		ID: ucResp.ID,
+	})
+}
+
+func (p *ProjectHandlerImpl) FindProject(c *gin.Context) {
+	// Call usecase
+	ucResp, err := p.projectUc.ListProjects(c)
+	if err != nil {
+		// Default error handling
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	// Convert UseCase response to HTTP DTO
+	projects := make([]v1.ProjectItem, len(ucResp.Projects))
+	for i, project := range ucResp.Projects {
+		projects[i] = toProjectItem(project)
+	}
+
+	c.JSON(http.StatusOK, v1.FindProjectResponse{
+		Projects: projects,
+	})
+}
+
+func (p *ProjectHandlerImpl) GetProject(c *gin.Context) {
+	// Parse HTTP path parameters into HTTP DTO
+	var httpReq v1.GetProjectRequest
+	if err := c.ShouldBindUri(&httpReq); err != nil {
+		p.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	// Call usecase
+	ucResp, err := p.projectUc.GetProject(c, httpReq.ID)
+	if err != nil {
+		// Handle different error types
+		if strings.Contains(err.Error(), "validation fail") {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		if strings.Contains(err.Error(), "not found") {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		// Default error handling
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, v1.GetProjectResponse{
+		Project: toProjectItem(*ucResp),
+	})
+}
+
+func (p *ProjectHandlerImpl) UpdateProject(c *gin.Context) {
+	// Parse HTTP request body into HTTP DTO
+	var httpReq v1.UpdateProjectRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		p.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	// Call usecase
+	err := p.projectUc.UpdateProject(c, usecase.UpdateProjectRequest{
+		ID:          httpReq.ID,
+		Name:        httpReq.Name,
+		Description: httpReq.Description,
+	})
+	if err != nil {
+		// Handle different error types
+		if strings.Contains(err.Error(), "validation fail") {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		if strings.Contains(err.Error(), "not found") {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		if strings.Contains(err.Error(), "conflict") {
+			c.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		// Default error handling
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+func (p *ProjectHandlerImpl) DeleteProject(c *gin.Context) {
+	// Parse HTTP request body into HTTP DTO
+	var httpReq v1.DeleteProjectRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		p.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	// Call usecase
+	err := p.projectUc.DeleteProject(c, usecase.DeleteProjectRequest{
+		ID:      httpReq.ID,
+		Cascade: httpReq.Cascade,
+	})
+	if err != nil {
+		// Handle different error types
+		if strings.Contains(err.Error(), "forbidden") {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		if strings.Contains(err.Error(), "not found") {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		if strings.Contains(err.Error(), "conflict") {
+			c.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		// Default error handling
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+// toProjectItem converts a usecase project response to the HTTP DTO
+func toProjectItem(project usecase.ProjectResponse) v1.ProjectItem {
+	return v1.ProjectItem{
+		ID:          project.ID,
+		Name:        project.Name,
+		Description: project.Description,
+		WorkflowID:  project.WorkflowID,
+		CreatedAt:   project.CreatedAt,
+		UpdatedAt:   project.UpdatedAt,
+	}
+}
@@ -0,0 +1,168 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	v1 "itmrchow/go-todolist-service/internal/delivery/http/dto/v1"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+)
+
+type ProjectHandlerImplTestSuite struct {
+	suite.Suite
+	ctrl          *gomock.Controller
+	mockProjectUc *usecase.MockProjectUseCase
+	handler       *ProjectHandlerImpl
+}
+
+func TestProjectHandlerImplTestSuite(t *testing.T) {
+	suite.Run(t, new(ProjectHandlerImplTestSuite))
+}
+
+func (suite *ProjectHandlerImplTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockProjectUc = usecase.NewMockProjectUseCase(suite.ctrl)
+
+	suite.handler = NewProjectHandlerImpl(zerolog.New(os.Stdout), suite.mockProjectUc)
+}
+
+func (suite *ProjectHandlerImplTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+func (suite *ProjectHandlerImplTestSuite) TestProjectHandlerImpl_CreateProject() {
+	tests := []struct {
+		name         string
+		body         interface{}
+		mockSetup    func()
+		expectedCode int
+	}{
+		{
+			name:         "Missing Name",
+			body:         map[string]interface{}{},
+			mockSetup:    func() {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "UseCase Conflict",
+			body: map[string]interface{}{"name": "release"},
+			mockSetup: func() {
+				suite.mockProjectUc.EXPECT().
+					CreateProject(gomock.Any(), usecase.CreateProjectRequest{Name: "release"}).
+					Return(nil, errors.New("conflict: project name already exists")).
+					Times(1)
+			},
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name: "Success",
+			body: map[string]interface{}{"name": "release"},
+			mockSetup: func() {
+				suite.mockProjectUc.EXPECT().
+					CreateProject(gomock.Any(), gomock.Any()).
+					Return(&usecase.CreateProjectResponse{ID: 1}, nil).
+					Times(1)
+			},
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			c, w := CreateGinContext("/create-project", tt.body)
+			suite.handler.CreateProject(c)
+
+			assert.Equal(suite.T(), tt.expectedCode, w.Code)
+			if tt.expectedCode == http.StatusOK {
+				var resp v1.CreateProjectResponse
+				assert.NoError(suite.T(), json.Unmarshal(w.Body.Bytes(), &resp))
+				assert.Equal(suite.T(), uint(1), resp.ID)
+			}
+		})
+	}
+}
+
+func (suite *ProjectHandlerImplTestSuite) TestProjectHandlerImpl_DeleteProject() {
+	tests := []struct {
+		name         string
+		body         interface{}
+		mockSetup    func()
+		expectedCode int
+	}{
+		{
+			name:         "Missing ID",
+			body:         map[string]interface{}{},
+			mockSetup:    func() {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Not Empty",
+			body: map[string]interface{}{"id": 1},
+			mockSetup: func() {
+				suite.mockProjectUc.EXPECT().
+					DeleteProject(gomock.Any(), usecase.DeleteProjectRequest{ID: 1}).
+					Return(errors.New("conflict: project still has 2 todos, delete with cascade to move them to the trash")).
+					Times(1)
+			},
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name: "UseCase Not Found Error",
+			body: map[string]interface{}{"id": 999, "cascade": true},
+			mockSetup: func() {
+				suite.mockProjectUc.EXPECT().
+					DeleteProject(gomock.Any(), usecase.DeleteProjectRequest{ID: 999, Cascade: true}).
+					Return(errors.New("not found: project not found")).
+					Times(1)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name: "UseCase Forbidden Error",
+			body: map[string]interface{}{"id": 1, "cascade": true},
+			mockSetup: func() {
+				suite.mockProjectUc.EXPECT().
+					DeleteProject(gomock.Any(), usecase.DeleteProjectRequest{ID: 1, Cascade: true}).
+					Return(errors.New("forbidden: the member role may not delete_project")).
+					Times(1)
+			},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name: "Cascade",
+			body: map[string]interface{}{"id": 1, "cascade": true},
+			mockSetup: func() {
+				suite.mockProjectUc.EXPECT().
+					DeleteProject(gomock.Any(), usecase.DeleteProjectRequest{ID: 1, Cascade: true}).
+					Return(nil).
+					Times(1)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			c, w := CreateGinContext("/delete-project", tt.body)
+			suite.handler.DeleteProject(c)
+
+			assert.Equal(suite.T(), tt.expectedCode, w.Code)
+		})
+	}
+}
@@ -5,6 +5,12 @@ import "github.com/gin-gonic/gin"
 type TodoHandler interface {
 	CreateTodo(c *gin.Context)
 	FindTodo(c *gin.Context)
+	GetTodo(c *gin.Context)
 	UpdateTodo(c *gin.Context)
 	DeleteTodo(c *gin.Context)
+	MoveTodo(c *gin.Context)
+	FindTrash(c *gin.Context)
+	RestoreTodo(c *gin.Context)
+	PurgeTodo(c *gin.Context)
+	EmptyTrash(c *gin.Context)
 }
@@ -37,9 +37,13 @@ func (t *TodoHandlerImpl) CreateTodo(c *gin.Context) {
 		return
 	}
 
+	// Without status the usecase starts the todo in the first status of its workflow
 	if httpReq.Status == nil {
 		httpReq.Status = new(string)
-		*httpReq.Status = "pending"
+	}
+	if httpReq.Priority == nil {
+		httpReq.Priority = new(string)
+		*httpReq.Priority = "none"
 	}
 
 	// Convert HTTP DTO to UseCase DTO
@@ -47,13 +51,25 @@ func (t *TodoHandlerImpl) CreateTodo(c *gin.Context) {
 		Title:       httpReq.Title,
 		Description: httpReq.Description,
 		Status:      *httpReq.Status,
+		Priority:    *httpReq.Priority,
 		DueDate:     httpReq.DueDate,
+		TagIDs:      httpReq.TagIDs,
+		ParentID:    httpReq.ParentID,
+		Recurrence:  toRecurrenceRequest(httpReq.Recurrence),
+		WorkflowID:  httpReq.WorkflowID,
+		ProjectID:   httpReq.ProjectID,
 	}
 
 	// Call usecase
 	ucResp, err := t.todoUc.CreateTodo(c, ucReq)
 	if err != nil {
 		// Handle different error types
+		if strings.Contains(err.Error(), "forbidden") {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
 		if strings.Contains(err.Error(), "validation fail") {
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error": err.Error(),
@@ -89,19 +105,38 @@ func (t *TodoHandlerImpl) FindTodo(c *gin.Context) {
 
 	// Convert HTTP DTO to UseCase DTO
 	ucReq := usecase.FindTodoRequest{
-		Keyword:     httpReq.Keyword,
-		Status:      httpReq.Status,
-		CreatedFrom: httpReq.CreatedFrom,
-		CreatedTo:   httpReq.CreatedTo,
-		DueFrom:     httpReq.DueFrom,
-		DueTo:       httpReq.DueTo,
-		Pagination:  httpReq.Pagination,
+		Keyword:      httpReq.Keyword,
+		Status:       httpReq.Status,
+		Statuses:     httpReq.Statuses,
+		Priorities:   httpReq.Priorities,
+		MinPriority:  httpReq.MinPriority,
+		TagsAny:      httpReq.TagsAny,
+		TagsAll:      httpReq.TagsAll,
+		ParentID:     httpReq.ParentID,
+		SeriesID:     httpReq.SeriesID,
+		ProjectID:    httpReq.ProjectID,
+		CreatedFrom:  httpReq.CreatedFrom,
+		CreatedTo:    httpReq.CreatedTo,
+		DueFrom:      httpReq.DueFrom,
+		DueTo:        httpReq.DueTo,
+		UpdatedSince: httpReq.UpdatedSince,
+		Overdue:      httpReq.Overdue,
+		HasDueDate:   httpReq.HasDueDate,
+		Assignee:     httpReq.Assignee,
+		Pagination:   httpReq.Pagination,
 	}
 
 	// Call Usecase
 	ucResp, err := t.todoUc.FindTodo(c, ucReq)
 
 	if err != nil {
+		// Handle different error types
+		if strings.Contains(err.Error(), "validation fail") {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
 		// Default error handling
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "internal server error",
@@ -112,15 +147,7 @@ func (t *TodoHandlerImpl) FindTodo(c *gin.Context) {
 	// Convert UseCase response to HTTP DTO
 	todos := make([]v1.TodoItem, len(ucResp.Todos))
 	for i, todo := range ucResp.Todos {
-		todos[i] = v1.TodoItem{
-			ID:          todo.ID,
-			Title:       todo.Title,
-			Description: todo.Description,
-			Status:      todo.Status,
-			DueDate:     todo.DueDate,
-			CreatedAt:   todo.CreatedAt,
-			UpdatedAt:   todo.UpdatedAt,
-		}
+		todos[i] = toTodoItem(todo)
 	}
 
 	// Return success response
@@ -132,6 +159,46 @@ func (t *TodoHandlerImpl) FindTodo(c *gin.Context) {
 	c.JSON(http.StatusOK, httpResp)
 }
 
+func (t *TodoHandlerImpl) GetTodo(c *gin.Context) {
+	// Parse HTTP path parameters into HTTP DTO
+	var httpReq v1.GetTodoRequest
+	if err := c.ShouldBindUri(&httpReq); err != nil {
+		t.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	// Call usecase
+	ucResp, err := t.todoUc.GetTodo(c, httpReq.ID)
+	if err != nil {
+		// Handle different error types
+		if strings.Contains(err.Error(), "validation fail") {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		if strings.Contains(err.Error(), "not found") {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		// Default error handling
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	// Return success response
+	c.JSON(http.StatusOK, v1.GetTodoResponse{
+		Todo: toTodoItem(ucResp.Todo),
+	})
+}
+
 func (t *TodoHandlerImpl) UpdateTodo(c *gin.Context) {
 	// Parse HTTP request body into HTTP DTO
 	var httpReq v1.UpdateTodoRequest
@@ -149,13 +216,25 @@ func (t *TodoHandlerImpl) UpdateTodo(c *gin.Context) {
 		Title:       httpReq.Title,
 		Description: httpReq.Description,
 		Status:      httpReq.Status,
+		Priority:    httpReq.Priority,
 		DueDate:     httpReq.DueDate,
+		TagIDs:      httpReq.TagIDs,
+		ParentID:    httpReq.ParentID,
+		Recurrence:  toRecurrenceRequest(httpReq.Recurrence),
+		ProjectID:   httpReq.ProjectID,
+		Version:     httpReq.Version,
 	}
 
 	// Call usecase
-	err := t.todoUc.UpdateTodo(c, ucReq)
+	_, err := t.todoUc.UpdateTodo(c, ucReq)
 	if err != nil {
 		// Handle different error types
+		if strings.Contains(err.Error(), "forbidden") {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
 		if strings.Contains(err.Error(), "validation fail") {
 			c.JSON(http.StatusBadRequest, gin.H{
 				"error": err.Error(),
@@ -168,6 +247,12 @@ func (t *TodoHandlerImpl) UpdateTodo(c *gin.Context) {
 			})
 			return
 		}
+		if strings.Contains(err.Error(), "conflict") {
+			c.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
 		// Default error handling
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": "internal server error",
@@ -194,6 +279,147 @@ func (t *TodoHandlerImpl) DeleteTodo(c *gin.Context) {
 	err := t.todoUc.DeleteTodo(c, httpReq.ID)
 	if err != nil {
 		// Handle different error types
+		if strings.Contains(err.Error(), "forbidden") {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		if strings.Contains(err.Error(), "not found") {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		// Default error handling
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+func (t *TodoHandlerImpl) MoveTodo(c *gin.Context) {
+	// Parse HTTP request body into HTTP DTO
+	var httpReq v1.MoveTodoRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		t.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	// Call usecase
+	_, err := t.todoUc.MoveTodo(c, usecase.MoveTodoRequest{
+		ID:        httpReq.ID,
+		AfterID:   httpReq.AfterID,
+		BeforeID:  httpReq.BeforeID,
+		Status:    httpReq.Status,
+		ProjectID: httpReq.ProjectID,
+		Version:   httpReq.Version,
+	})
+	if err != nil {
+		// Handle different error types
+		if strings.Contains(err.Error(), "forbidden") {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		if strings.Contains(err.Error(), "validation fail") {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		if strings.Contains(err.Error(), "not found") {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		if strings.Contains(err.Error(), "conflict") {
+			c.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		// Default error handling
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+func (t *TodoHandlerImpl) FindTrash(c *gin.Context) {
+	// Parse HTTP request body into HTTP DTO
+	var httpReq v1.FindTrashRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		t.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	// Call usecase
+	ucResp, err := t.todoUc.FindTrash(c, usecase.FindTrashRequest{
+		Pagination: httpReq.Pagination,
+	})
+	if err != nil {
+		// Handle different error types
+		if strings.Contains(err.Error(), "validation fail") {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		// Default error handling
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	// Convert UseCase response to HTTP DTO
+	todos := make([]v1.TodoItem, len(ucResp.Todos))
+	for i, todo := range ucResp.Todos {
+		todos[i] = toTodoItem(todo)
+	}
+
+	c.JSON(http.StatusOK, v1.FindTrashResponse{
+		Todos:      todos,
+		Pagination: ucResp.Pagination,
+	})
+}
+
+func (t *TodoHandlerImpl) RestoreTodo(c *gin.Context) {
+	// Parse HTTP request body into HTTP DTO
+	var httpReq v1.RestoreTodoRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		t.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	// Call usecase
+	err := t.todoUc.RestoreTodo(c, httpReq.ID)
+	if err != nil {
+		// Handle different error types
+		if strings.Contains(err.Error(), "forbidden") {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
 		if strings.Contains(err.Error(), "not found") {
 			c.JSON(http.StatusNotFound, gin.H{
 				"error": err.Error(),
@@ -209,3 +435,121 @@ func (t *TodoHandlerImpl) DeleteTodo(c *gin.Context) {
 
 	c.AbortWithStatus(http.StatusNoContent)
 }
+
+func (t *TodoHandlerImpl) PurgeTodo(c *gin.Context) {
+	// Parse HTTP request body into HTTP DTO
+	var httpReq v1.PurgeTodoRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		t.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	// Call usecase
+	err := t.todoUc.PurgeTodo(c, httpReq.ID)
+	if err != nil {
+		// Handle different error types
+		if strings.Contains(err.Error(), "forbidden") {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		if strings.Contains(err.Error(), "not found") {
+			c.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		// Default error handling
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+func (t *TodoHandlerImpl) EmptyTrash(c *gin.Context) {
+	// Call usecase
+	ucResp, err := t.todoUc.EmptyTrash(c)
+	if err != nil {
+		if strings.Contains(err.Error(), "forbidden") {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		// Default error handling
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, v1.EmptyTrashResponse{
+		PurgedCount: ucResp.PurgedCount,
+	})
+}
+
+// toTodoItem converts a usecase todo response to the HTTP DTO
+func toTodoItem(todo usecase.TodoResponse) v1.TodoItem {
+	return v1.TodoItem{
+		ID:           todo.ID,
+		Title:        todo.Title,
+		Description:  todo.Description,
+		Status:       todo.Status,
+		WorkflowID:   todo.WorkflowID,
+		ProjectID:    todo.ProjectID,
+		Position:     todo.Position,
+		Priority:     todo.Priority,
+		DueDate:      todo.DueDate,
+		ParentID:     todo.ParentID,
+		BlockedBy:    todo.BlockedBy,
+		AssigneeIDs:  todo.AssigneeIDs,
+		CommentCount: todo.CommentCount,
+		Tags:         toTagItems(todo.Tags),
+		Progress:     v1.ProgressItem{Done: todo.Progress.Done, Total: todo.Progress.Total},
+		Recurrence:   toRecurrenceItem(todo.Recurrence),
+		SeriesID:     todo.SeriesID,
+		Occurrence:   todo.Occurrence,
+		StartedAt:    todo.StartedAt,
+		CompletedAt:  todo.CompletedAt,
+		Version:      todo.Version,
+		CreatedAt:    todo.CreatedAt,
+		UpdatedAt:    todo.UpdatedAt,
+		DeletedAt:    todo.DeletedAt,
+	}
+}
+
+// toRecurrenceRequest converts a recurrence of the HTTP DTO to the usecase request
+func toRecurrenceRequest(item *v1.RecurrenceItem) *usecase.RecurrenceRequest {
+	if item == nil {
+		return nil
+	}
+	return &usecase.RecurrenceRequest{Rule: item.Rule, Timezone: item.Timezone}
+}
+
+// toRecurrenceItem converts the recurrence of a usecase todo response to the HTTP DTO
+func toRecurrenceItem(recurrence *usecase.RecurrenceResponse) *v1.RecurrenceItem {
+	if recurrence == nil {
+		return nil
+	}
+	return &v1.RecurrenceItem{Rule: recurrence.Rule, Timezone: recurrence.Timezone}
+}
+
+// toTagItems converts the tags of a usecase todo response to the HTTP DTO
+func toTagItems(tags []usecase.TagResponse) []v1.TagItem {
+	items := make([]v1.TagItem, len(tags))
+	for i, tag := range tags {
+		items[i] = v1.TagItem{
+			ID:    tag.ID,
+			Name:  tag.Name,
+			Color: tag.Color,
+		}
+	}
+	return items
+}
@@ -188,6 +188,26 @@ func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_FindTodo() {
 				"error": "invalid request format",
 			},
 		},
+		{
+			name: "UseCase FindTodo Validation Fail",
+			body: map[string]interface{}{
+				"pagination": map[string]interface{}{
+					"page":       1,
+					"page_size":  10,
+					"sort_by":    "title;DROP TABLE todos",
+					"sort_order": "asc",
+				},
+			},
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					FindTodo(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("validation fail: invalid sort field"))
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedResp: map[string]interface{}{
+				"error": "validation fail: invalid sort field",
+			},
+		},
 		{
 			name: "UseCase FindTodo Fail",
 			body: map[string]interface{}{
@@ -241,8 +261,8 @@ func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_FindTodo() {
 						Pagination: dto.PaginationResp{
 							Page:       1,
 							PageSize:   10,
-							TotalCount: 1,
-							TotalPages: 1,
+							TotalCount: intPtr(1),
+							TotalPages: intPtr(1),
 						},
 					}, nil)
 			},
@@ -257,6 +277,7 @@ func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_FindTodo() {
 							Description: stringPtr("test description"),
 							Status:      "pending",
 							DueDate:     &now,
+							Tags:        []v1.TagItem{},
 							CreatedAt:   now,
 							UpdatedAt:   now,
 						},
@@ -264,8 +285,8 @@ func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_FindTodo() {
 					Pagination: dto.PaginationResp{
 						Page:       1,
 						PageSize:   10,
-						TotalCount: 1,
-						TotalPages: 1,
+						TotalCount: intPtr(1),
+						TotalPages: intPtr(1),
 					},
 				}
 			}(),
@@ -366,11 +387,15 @@ func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_UpdateTodo() {
 				"status":      "invalid_status",
 			},
 			mockSetup: func() {
-				// no mock setup needed for validation error
+				// the workflow of the todo decides which statuses are valid
+				suite.mockTodoUc.EXPECT().
+					UpdateTodo(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("validation fail: invalid status")).
+					Times(1)
 			},
 			expectedCode: http.StatusBadRequest,
 			expectedResp: map[string]interface{}{
-				"error": "invalid request format",
+				"error": "validation fail: invalid status",
 			},
 		},
 		{
@@ -384,7 +409,7 @@ func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_UpdateTodo() {
 			mockSetup: func() {
 				suite.mockTodoUc.EXPECT().
 					UpdateTodo(gomock.Any(), gomock.Any()).
-					Return(errors.New("not found: todo not found")).
+					Return(nil, errors.New("not found: todo not found")).
 					Times(1)
 			},
 			expectedCode: http.StatusNotFound,
@@ -402,7 +427,7 @@ func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_UpdateTodo() {
 			mockSetup: func() {
 				suite.mockTodoUc.EXPECT().
 					UpdateTodo(gomock.Any(), gomock.Any()).
-					Return(errors.New("validation fail: due date must be in the future")).
+					Return(nil, errors.New("validation fail: due date must be in the future")).
 					Times(1)
 			},
 			expectedCode: http.StatusBadRequest,
@@ -420,7 +445,7 @@ func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_UpdateTodo() {
 			mockSetup: func() {
 				suite.mockTodoUc.EXPECT().
 					UpdateTodo(gomock.Any(), gomock.Any()).
-					Return(errors.New("internal fail: database connection error")).
+					Return(nil, errors.New("internal fail: database connection error")).
 					Times(1)
 			},
 			expectedCode: http.StatusInternalServerError,
@@ -440,7 +465,27 @@ func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_UpdateTodo() {
 			mockSetup: func() {
 				suite.mockTodoUc.EXPECT().
 					UpdateTodo(gomock.Any(), gomock.Any()).
-					Return(nil).
+					Return(&usecase.TodoVersionResponse{Version: 2}, nil).
+					Times(1)
+			},
+			expectedCode: http.StatusNoContent,
+			expectedResp: nil, // No response body for 204
+		},
+		{
+			name: "Success - Recurrence",
+			body: map[string]interface{}{
+				"id":         3,
+				"title":      "release checklist",
+				"status":     "done",
+				"recurrence": map[string]interface{}{"rule": "FREQ=WEEKLY;BYDAY=MO", "timezone": "Asia/Taipei"},
+			},
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					UpdateTodo(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, req usecase.UpdateTodoRequest) (*usecase.TodoVersionResponse, error) {
+						assert.Equal(suite.T(), &usecase.RecurrenceRequest{Rule: "FREQ=WEEKLY;BYDAY=MO", Timezone: "Asia/Taipei"}, req.Recurrence)
+						return &usecase.TodoVersionResponse{Version: 2}, nil
+					}).
 					Times(1)
 			},
 			expectedCode: http.StatusNoContent,
@@ -456,7 +501,7 @@ func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_UpdateTodo() {
 			mockSetup: func() {
 				suite.mockTodoUc.EXPECT().
 					UpdateTodo(gomock.Any(), gomock.Any()).
-					Return(nil).
+					Return(&usecase.TodoVersionResponse{Version: 2}, nil).
 					Times(1)
 			},
 			expectedCode: http.StatusNoContent,
@@ -634,6 +679,330 @@ func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_DeleteTodo() {
 	}
 }
 
+func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_GetTodo() {
+	gin.SetMode(gin.TestMode)
+
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		id           string
+		mockSetup    func()
+		expectedCode int
+		expectedResp interface{}
+	}{
+		{
+			name: "Invalid ID",
+			id:   "abc",
+			mockSetup: func() {
+				// no mock setup needed for path binding error
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedResp: map[string]interface{}{
+				"error": "invalid request format",
+			},
+		},
+		{
+			name: "Zero ID",
+			id:   "0",
+			mockSetup: func() {
+				// no mock setup needed for validation error
+			},
+			expectedCode: http.StatusBadRequest,
+			expectedResp: map[string]interface{}{
+				"error": "invalid request format",
+			},
+		},
+		{
+			name: "UseCase Not Found Error",
+			id:   "999",
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					GetTodo(gomock.Any(), uint(999)).
+					Return(nil, errors.New("not found: todo not found")).
+					Times(1)
+			},
+			expectedCode: http.StatusNotFound,
+			expectedResp: map[string]interface{}{
+				"error": "not found: todo not found",
+			},
+		},
+		{
+			name: "UseCase Internal Error",
+			id:   "1",
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					GetTodo(gomock.Any(), uint(1)).
+					Return(nil, errors.New("internal fail: database connection error")).
+					Times(1)
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedResp: map[string]interface{}{
+				"error": "internal server error",
+			},
+		},
+		{
+			name: "Success",
+			id:   "1",
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					GetTodo(gomock.Any(), uint(1)).
+					Return(&usecase.GetTodoResponse{
+						Todo: usecase.TodoResponse{
+							ID:          1,
+							Title:       "test todo",
+							Description: stringPtr("test description"),
+							Status:      "pending",
+							CreatedAt:   now,
+							UpdatedAt:   now,
+						},
+					}, nil).
+					Times(1)
+			},
+			expectedCode: http.StatusOK,
+			expectedResp: v1.GetTodoResponse{
+				Todo: v1.TodoItem{
+					ID:          1,
+					Title:       "test todo",
+					Description: stringPtr("test description"),
+					Status:      "pending",
+					Tags:        []v1.TagItem{},
+					CreatedAt:   now,
+					UpdatedAt:   now,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			// Setup
+			tt.mockSetup()
+
+			// Create request with path parameter
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/todos/"+tt.id, nil)
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+			c.Params = gin.Params{{Key: "id", Value: tt.id}}
+
+			// Execute
+			suite.handler.GetTodo(c)
+
+			// Assert
+			assert.Equal(suite.T(), tt.expectedCode, w.Code)
+
+			if httpResp, ok := tt.expectedResp.(v1.GetTodoResponse); ok {
+				var resp v1.GetTodoResponse
+				err := json.Unmarshal(w.Body.Bytes(), &resp)
+				assert.NoError(suite.T(), err)
+				assert.Equal(suite.T(), httpResp, resp)
+			} else {
+				var resp map[string]interface{}
+				err := json.Unmarshal(w.Body.Bytes(), &resp)
+				assert.NoError(suite.T(), err)
+				assert.Equal(suite.T(), tt.expectedResp, resp)
+			}
+		})
+	}
+}
+
+func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_MoveTodo() {
+	gin.SetMode(gin.TestMode)
+	afterID := uint(2)
+	version := uint(3)
+
+	tests := []struct {
+		name         string
+		body         interface{}
+		mockSetup    func()
+		expectedCode int
+	}{
+		{
+			name: "Missing ID",
+			body: map[string]interface{}{"after_id": 2},
+			mockSetup: func() {
+				// no mock setup needed for validation error
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "UseCase Not Found Error",
+			body: map[string]interface{}{"id": 999, "after_id": 2},
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					MoveTodo(gomock.Any(), usecase.MoveTodoRequest{ID: 999, AfterID: &afterID}).
+					Return(nil, errors.New("not found: todo not found")).
+					Times(1)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name: "UseCase Version Conflict",
+			body: map[string]interface{}{"id": 1, "after_id": 2, "version": 3},
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					MoveTodo(gomock.Any(), usecase.MoveTodoRequest{ID: 1, AfterID: &afterID, Version: &version}).
+					Return(nil, errors.New("conflict: todo was modified, the current version is 4")).
+					Times(1)
+			},
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name: "Success",
+			body: map[string]interface{}{"id": 1, "after_id": 2},
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					MoveTodo(gomock.Any(), usecase.MoveTodoRequest{ID: 1, AfterID: &afterID}).
+					Return(&usecase.TodoVersionResponse{Version: 2}, nil).
+					Times(1)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			c, w := CreateGinContext("/move-todo", tt.body)
+			suite.handler.MoveTodo(c)
+
+			assert.Equal(suite.T(), tt.expectedCode, w.Code)
+		})
+	}
+}
+
+func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_RestoreTodo() {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name         string
+		body         interface{}
+		mockSetup    func()
+		expectedCode int
+	}{
+		{
+			name: "Missing ID",
+			body: map[string]interface{}{},
+			mockSetup: func() {
+				// no mock setup needed for validation error
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "UseCase Not Found Error",
+			body: map[string]interface{}{"id": 999},
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					RestoreTodo(gomock.Any(), uint(999)).
+					Return(errors.New("not found: todo not found in trash")).
+					Times(1)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name: "UseCase Forbidden Error",
+			body: map[string]interface{}{"id": 2},
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					RestoreTodo(gomock.Any(), uint(2)).
+					Return(errors.New("forbidden: the member role may not restore_todo")).
+					Times(1)
+			},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name: "Success",
+			body: map[string]interface{}{"id": 1},
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					RestoreTodo(gomock.Any(), uint(1)).
+					Return(nil).
+					Times(1)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			c, w := CreateGinContext("/restore-todo", tt.body)
+			suite.handler.RestoreTodo(c)
+
+			assert.Equal(suite.T(), tt.expectedCode, w.Code)
+		})
+	}
+}
+
+func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_EmptyTrash() {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name         string
+		mockSetup    func()
+		expectedCode int
+		expectedResp interface{}
+	}{
+		{
+			name: "UseCase Internal Error",
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					EmptyTrash(gomock.Any()).
+					Return(nil, errors.New("internal fail: database connection error")).
+					Times(1)
+			},
+			expectedCode: http.StatusInternalServerError,
+			expectedResp: map[string]interface{}{
+				"error": "internal server error",
+			},
+		},
+		{
+			name: "UseCase Forbidden Error",
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					EmptyTrash(gomock.Any()).
+					Return(nil, errors.New("forbidden: the member role may not purge_todos")).
+					Times(1)
+			},
+			expectedCode: http.StatusForbidden,
+			expectedResp: map[string]interface{}{
+				"error": "forbidden: the member role may not purge_todos",
+			},
+		},
+		{
+			name: "Success",
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					EmptyTrash(gomock.Any()).
+					Return(&usecase.EmptyTrashResponse{PurgedCount: 2}, nil).
+					Times(1)
+			},
+			expectedCode: http.StatusOK,
+			expectedResp: map[string]interface{}{
+				"purged_count": float64(2),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			c, w := CreateGinContext("/empty-trash", nil)
+			suite.handler.EmptyTrash(c)
+
+			assert.Equal(suite.T(), tt.expectedCode, w.Code)
+			var resp map[string]interface{}
+			err := json.Unmarshal(w.Body.Bytes(), &resp)
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.expectedResp, resp)
+		})
+	}
+}
+
 func CreateGinContext(target string, body interface{}) (*gin.Context,
 	*httptest.ResponseRecorder) {
 	// Create request
@@ -659,3 +1028,7 @@ func CreateGinContext(target string, body interface{}) (*gin.Context,
 func stringPtr(s string) *string {
 	return &s
 }
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -0,0 +1,9 @@
+package v2
+
+import "github.com/gin-gonic/gin"
+
+type APIKeyHandler interface {
+	ListAPIKeys(c *gin.Context)
+	CreateAPIKey(c *gin.Context)
+	RevokeAPIKey(c *gin.Context)
+}
@@ -0,0 +1,107 @@
+package v2
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	v2 "itmrchow/go-todolist-service/internal/delivery/http/dto/v2"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+)
+
+var _ APIKeyHandler = &APIKeyHandlerImpl{}
+
+// APIKeyHandlerImpl serves the API keys of the signed-in user in the v2 API
+type APIKeyHandlerImpl struct {
+	logger   zerolog.Logger
+	apiKeyUc usecase.APIKeyUseCase
+}
+
+func NewAPIKeyHandlerImpl(logger zerolog.Logger, apiKeyUc usecase.APIKeyUseCase) *APIKeyHandlerImpl {
+	return &APIKeyHandlerImpl{
+		logger:   logger,
+		apiKeyUc: apiKeyUc,
+	}
+}
+
+// ListAPIKeys handles GET /api-keys
+func (h *APIKeyHandlerImpl) ListAPIKeys(c *gin.Context) {
+	ucResp, err := h.apiKeyUc.ListAPIKeys(c)
+	if err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	apiKeys := make([]v2.APIKey, len(ucResp.APIKeys))
+	for i, apiKey := range ucResp.APIKeys {
+		apiKeys[i] = toAPIKey(apiKey)
+	}
+
+	c.JSON(http.StatusOK, v2.ListAPIKeysResponse{
+		APIKeys: apiKeys,
+	})
+}
+
+// CreateAPIKey handles POST /api-keys, the response is the only time the key is shown
+func (h *APIKeyHandlerImpl) CreateAPIKey(c *gin.Context) {
+	var httpReq v2.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	ucResp, err := h.apiKeyUc.CreateAPIKey(c, usecase.CreateAPIKeyRequest{
+		Name:   httpReq.Name,
+		Scopes: httpReq.Scopes,
+	})
+	if err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	// Return 201 with the location of the new resource
+	c.Header("Location", fmt.Sprintf("%s/%d", strings.TrimSuffix(c.Request.URL.Path, "/"), ucResp.ID))
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusCreated, v2.CreateAPIKeyResponse{
+		APIKey: toAPIKey(ucResp.APIKeyResponse),
+		Key:    ucResp.Key,
+	})
+}
+
+// RevokeAPIKey handles DELETE /api-keys/:id, the key stays listed as revoked
+func (h *APIKeyHandlerImpl) RevokeAPIKey(c *gin.Context) {
+	var uri v2.APIKeyURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	if err := h.apiKeyUc.RevokeAPIKey(c, uri.ID); err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+// toAPIKey converts a usecase API key response to the HTTP DTO
+func toAPIKey(apiKey usecase.APIKeyResponse) v2.APIKey {
+	return v2.APIKey{
+		ID:         apiKey.ID,
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		Scopes:     apiKey.Scopes,
+		LastUsedAt: apiKey.LastUsedAt,
+		RevokedAt:  apiKey.RevokedAt,
+		CreatedAt:  apiKey.CreatedAt,
+	}
+}
@@ -0,0 +1,129 @@
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	v2 "itmrchow/go-todolist-service/internal/delivery/http/dto/v2"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+)
+
+type APIKeyHandlerImplTestSuite struct {
+	suite.Suite
+	ctrl         *gomock.Controller
+	mockAPIKeyUc *usecase.MockAPIKeyUseCase
+	handler      *APIKeyHandlerImpl
+	engine       *gin.Engine
+}
+
+func TestAPIKeyHandlerImplTestSuite(t *testing.T) {
+	suite.Run(t, new(APIKeyHandlerImplTestSuite))
+}
+
+func (suite *APIKeyHandlerImplTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockAPIKeyUc = usecase.NewMockAPIKeyUseCase(suite.ctrl)
+	suite.handler = NewAPIKeyHandlerImpl(zerolog.New(os.Stdout), suite.mockAPIKeyUc)
+
+	suite.engine = gin.New()
+	apiKeys := suite.engine.Group("/api/v2/api-keys")
+	apiKeys.GET("", suite.handler.ListAPIKeys)
+	apiKeys.POST("", suite.handler.CreateAPIKey)
+	apiKeys.DELETE("/:id", suite.handler.RevokeAPIKey)
+}
+
+func (suite *APIKeyHandlerImplTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+func (suite *APIKeyHandlerImplTestSuite) TestAPIKeyHandlerImpl_ListAPIKeys() {
+	suite.mockAPIKeyUc.EXPECT().
+		ListAPIKeys(gomock.Any()).
+		Return(&usecase.ListAPIKeysResponse{
+			APIKeys: []usecase.APIKeyResponse{{ID: 3, Name: "ci bot", Prefix: "tdk_0123456789ab", Scopes: []string{"todos:read"}}},
+		}, nil).
+		Times(1)
+
+	w := serveJSON(suite.engine, http.MethodGet, "/api/v2/api-keys", nil)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp v2.ListAPIKeysResponse
+	suite.NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Equal([]v2.APIKey{{ID: 3, Name: "ci bot", Prefix: "tdk_0123456789ab", Scopes: []string{"todos:read"}}}, resp.APIKeys)
+	suite.NotContains(w.Body.String(), "key_hash")
+}
+
+func (suite *APIKeyHandlerImplTestSuite) TestAPIKeyHandlerImpl_CreateAPIKey() {
+	suite.Run("Missing Scopes", func() {
+		w := serveJSON(suite.engine, http.MethodPost, "/api/v2/api-keys", map[string]string{"name": "ci bot"})
+
+		suite.Equal(http.StatusBadRequest, w.Code)
+	})
+
+	suite.Run("Success", func() {
+		suite.mockAPIKeyUc.EXPECT().
+			CreateAPIKey(gomock.Any(), usecase.CreateAPIKeyRequest{Name: "ci bot", Scopes: []string{"todos:read", "todos:write"}}).
+			Return(&usecase.CreateAPIKeyResponse{
+				APIKeyResponse: usecase.APIKeyResponse{ID: 3, Name: "ci bot", Prefix: "tdk_0123456789ab", Scopes: []string{"todos:read", "todos:write"}},
+				Key:            "tdk_0123456789ab_c2VjcmV0",
+			}, nil).
+			Times(1)
+
+		w := serveJSON(suite.engine, http.MethodPost, "/api/v2/api-keys", map[string]interface{}{
+			"name":   "ci bot",
+			"scopes": []string{"todos:read", "todos:write"},
+		})
+
+		suite.Equal(http.StatusCreated, w.Code)
+		suite.Equal("/api/v2/api-keys/3", w.Header().Get("Location"))
+		suite.Equal("no-store", w.Header().Get("Cache-Control"))
+		var resp v2.CreateAPIKeyResponse
+		suite.NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+		suite.Equal("tdk_0123456789ab_c2VjcmV0", resp.Key)
+		suite.Equal(uint(3), resp.ID)
+	})
+
+	suite.Run("Invalid Scope", func() {
+		suite.mockAPIKeyUc.EXPECT().
+			CreateAPIKey(gomock.Any(), gomock.Any()).
+			Return(nil, errors.Join(errors.New("validation fail"), errors.New("invalid api key scope: admin"))).
+			Times(1)
+
+		w := serveJSON(suite.engine, http.MethodPost, "/api/v2/api-keys", map[string]interface{}{
+			"name":   "ci bot",
+			"scopes": []string{"admin"},
+		})
+
+		suite.Equal(http.StatusBadRequest, w.Code)
+	})
+}
+
+func (suite *APIKeyHandlerImplTestSuite) TestAPIKeyHandlerImpl_RevokeAPIKey() {
+	suite.Run("Success", func() {
+		suite.mockAPIKeyUc.EXPECT().RevokeAPIKey(gomock.Any(), uint(3)).Return(nil).Times(1)
+
+		w := serveJSON(suite.engine, http.MethodDelete, "/api/v2/api-keys/3", nil)
+
+		suite.Equal(http.StatusNoContent, w.Code)
+	})
+
+	suite.Run("Not Found", func() {
+		suite.mockAPIKeyUc.EXPECT().RevokeAPIKey(gomock.Any(), uint(4)).Return(errors.New("not found: api key not found")).Times(1)
+
+		w := serveJSON(suite.engine, http.MethodDelete, "/api/v2/api-keys/4", nil)
+
+		suite.Equal(http.StatusNotFound, w.Code)
+	})
+}
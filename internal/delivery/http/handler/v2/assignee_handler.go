@@ -0,0 +1,8 @@
+package v2
+
+import "github.com/gin-gonic/gin"
+
+type AssigneeHandler interface {
+	AssignTodo(c *gin.Context)
+	UnassignTodo(c *gin.Context)
+}
@@ -0,0 +1,79 @@
+package v2
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	v2 "itmrchow/go-todolist-service/internal/delivery/http/dto/v2"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+)
+
+var _ AssigneeHandler = &AssigneeHandlerImpl{}
+
+// AssigneeHandlerImpl serves the assignees of a todo in the v2 API
+type AssigneeHandlerImpl struct {
+	logger     zerolog.Logger
+	assigneeUc usecase.AssigneeUseCase
+}
+
+func NewAssigneeHandlerImpl(logger zerolog.Logger, assigneeUc usecase.AssigneeUseCase) *AssigneeHandlerImpl {
+	return &AssigneeHandlerImpl{
+		logger:     logger,
+		assigneeUc: assigneeUc,
+	}
+}
+
+// AssignTodo handles POST /todos/:id/assignees, user_id starts working on the todo
+func (h *AssigneeHandlerImpl) AssignTodo(c *gin.Context) {
+	var uri v2.TodoURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	var httpReq v2.AssignTodoRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	if err := h.assigneeUc.AssignTodo(c, usecase.AssignTodoRequest{
+		TodoID: uri.ID,
+		UserID: httpReq.UserID,
+	}); err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+// UnassignTodo handles DELETE /todos/:id/assignees/:user_id
+func (h *AssigneeHandlerImpl) UnassignTodo(c *gin.Context) {
+	var uri v2.AssigneeURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	if err := h.assigneeUc.UnassignTodo(c, usecase.UnassignTodoRequest{
+		TodoID: uri.TodoID,
+		UserID: uri.UserID,
+	}); err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
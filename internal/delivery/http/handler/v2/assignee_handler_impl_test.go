@@ -0,0 +1,127 @@
+package v2
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+)
+
+type AssigneeHandlerImplTestSuite struct {
+	suite.Suite
+	ctrl           *gomock.Controller
+	mockAssigneeUc *usecase.MockAssigneeUseCase
+	handler        *AssigneeHandlerImpl
+	engine         *gin.Engine
+}
+
+func TestAssigneeHandlerImplTestSuite(t *testing.T) {
+	suite.Run(t, new(AssigneeHandlerImplTestSuite))
+}
+
+func (suite *AssigneeHandlerImplTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockAssigneeUc = usecase.NewMockAssigneeUseCase(suite.ctrl)
+	suite.handler = NewAssigneeHandlerImpl(zerolog.New(os.Stdout), suite.mockAssigneeUc)
+
+	suite.engine = gin.New()
+	assignees := suite.engine.Group("/api/v2/todos/:id/assignees")
+	assignees.POST("", suite.handler.AssignTodo)
+	assignees.DELETE("/:user_id", suite.handler.UnassignTodo)
+}
+
+func (suite *AssigneeHandlerImplTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+func (suite *AssigneeHandlerImplTestSuite) TestAssigneeHandlerImpl_AssignTodo() {
+	tests := []struct {
+		name         string
+		body         string
+		mockSetup    func()
+		expectedCode int
+	}{
+		{
+			name:         "Missing User ID",
+			body:         `{}`,
+			mockSetup:    func() {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Not A Member",
+			body: `{"user_id": 7}`,
+			mockSetup: func() {
+				suite.mockAssigneeUc.EXPECT().
+					AssignTodo(gomock.Any(), gomock.Any()).
+					Return(errors.New("validation fail: user 7 is not a member of the workspace")).
+					Times(1)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Forbidden",
+			body: `{"user_id": 7}`,
+			mockSetup: func() {
+				suite.mockAssigneeUc.EXPECT().
+					AssignTodo(gomock.Any(), gomock.Any()).
+					Return(errors.New("forbidden: the viewer role may not reassign_todo")).
+					Times(1)
+			},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name: "Already Assigned",
+			body: `{"user_id": 7}`,
+			mockSetup: func() {
+				suite.mockAssigneeUc.EXPECT().
+					AssignTodo(gomock.Any(), gomock.Any()).
+					Return(errors.New("conflict: user is already assigned")).
+					Times(1)
+			},
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name: "Success",
+			body: `{"user_id": 7}`,
+			mockSetup: func() {
+				suite.mockAssigneeUc.EXPECT().
+					AssignTodo(gomock.Any(), usecase.AssignTodoRequest{TodoID: 1, UserID: 7}).
+					Return(nil).
+					Times(1)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			w := serveJSON(suite.engine, http.MethodPost, "/api/v2/todos/1/assignees", tt.body)
+
+			suite.Equal(tt.expectedCode, w.Code)
+		})
+	}
+}
+
+func (suite *AssigneeHandlerImplTestSuite) TestAssigneeHandlerImpl_UnassignTodo() {
+	suite.mockAssigneeUc.EXPECT().
+		UnassignTodo(gomock.Any(), usecase.UnassignTodoRequest{TodoID: 1, UserID: 7}).
+		Return(errors.New("not found: assignment not found")).
+		Times(1)
+
+	w := serveJSON(suite.engine, http.MethodDelete, "/api/v2/todos/1/assignees/7", nil)
+
+	suite.Equal(http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,10 @@
+package v2
+
+import "github.com/gin-gonic/gin"
+
+type AttachmentHandler interface {
+	ListAttachments(c *gin.Context)
+	UploadAttachment(c *gin.Context)
+	DownloadAttachment(c *gin.Context)
+	DeleteAttachment(c *gin.Context)
+}
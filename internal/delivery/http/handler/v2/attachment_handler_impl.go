@@ -0,0 +1,174 @@
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	v2 "itmrchow/go-todolist-service/internal/delivery/http/dto/v2"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+)
+
+var _ AttachmentHandler = &AttachmentHandlerImpl{}
+
+// multipartOverhead is the room left in the request body for the multipart headers and boundaries,
+// the exact file size limit is enforced by the usecase
+const multipartOverhead = 1 << 20
+
+// AttachmentHandlerImpl serves the attachments of a todo in the v2 API
+type AttachmentHandlerImpl struct {
+	logger        zerolog.Logger
+	attachmentUc  usecase.AttachmentUseCase
+	maxUploadSize int64 // largest accepted file in bytes, <= 0 means no limit
+}
+
+func NewAttachmentHandlerImpl(logger zerolog.Logger, attachmentUc usecase.AttachmentUseCase, maxUploadSize int64) *AttachmentHandlerImpl {
+	return &AttachmentHandlerImpl{
+		logger:        logger,
+		attachmentUc:  attachmentUc,
+		maxUploadSize: maxUploadSize,
+	}
+}
+
+// ListAttachments handles GET /todos/:id/attachments
+func (h *AttachmentHandlerImpl) ListAttachments(c *gin.Context) {
+	var uri v2.TodoURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	ucResp, err := h.attachmentUc.ListAttachments(c, uri.ID)
+	if err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	attachments := make([]v2.Attachment, len(ucResp.Attachments))
+	for i, attachment := range ucResp.Attachments {
+		attachments[i] = toAttachmentItem(attachment)
+	}
+
+	c.JSON(http.StatusOK, v2.ListAttachmentsResponse{
+		Attachments: attachments,
+	})
+}
+
+// UploadAttachment handles POST /todos/:id/attachments, the file is sent in the multipart field "file"
+// and the uploader is taken from the X-Actor header
+func (h *AttachmentHandlerImpl) UploadAttachment(c *gin.Context) {
+	var uri v2.TodoURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	// stop reading bodies that can never fit before they are buffered
+	if h.maxUploadSize > 0 {
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, h.maxUploadSize+multipartOverhead)
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+				"error": fmt.Sprintf("too large: attachment cannot exceed %d bytes", h.maxUploadSize),
+			})
+			return
+		}
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+	defer file.Close()
+
+	ucResp, err := h.attachmentUc.UploadAttachment(c, usecase.UploadAttachmentRequest{
+		TodoID:  uri.ID,
+		Name:    fileHeader.Filename,
+		Content: file,
+	})
+	if err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	// Return 201 with the location of the new resource
+	c.Header("Location", fmt.Sprintf("%s/%d", strings.TrimSuffix(c.Request.URL.Path, "/"), ucResp.ID))
+	c.JSON(http.StatusCreated, toAttachmentItem(*ucResp))
+}
+
+// DownloadAttachment handles GET /todos/:id/attachments/:attachment_id, serving the content of the file
+func (h *AttachmentHandlerImpl) DownloadAttachment(c *gin.Context) {
+	var uri v2.AttachmentURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	ucResp, err := h.attachmentUc.DownloadAttachment(c, uri.TodoID, uri.AttachmentID)
+	if err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+	defer ucResp.Content.Close()
+
+	// always download, uploaded content is never rendered by the browser
+	c.DataFromReader(http.StatusOK, ucResp.Attachment.Size, ucResp.Attachment.ContentType, ucResp.Content, map[string]string{
+		"Content-Disposition":    mime.FormatMediaType("attachment", map[string]string{"filename": ucResp.Attachment.Name}),
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+// DeleteAttachment handles DELETE /todos/:id/attachments/:attachment_id
+func (h *AttachmentHandlerImpl) DeleteAttachment(c *gin.Context) {
+	var uri v2.AttachmentURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	if err := h.attachmentUc.DeleteAttachment(c, uri.TodoID, uri.AttachmentID); err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+func toAttachmentItem(attachment usecase.AttachmentResponse) v2.Attachment {
+	return v2.Attachment{
+		ID:          attachment.ID,
+		Name:        attachment.Name,
+		Size:        attachment.Size,
+		ContentType: attachment.ContentType,
+		SHA256:      attachment.SHA256,
+		Uploader:    attachment.Uploader,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
@@ -0,0 +1,184 @@
+package v2
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	v2 "itmrchow/go-todolist-service/internal/delivery/http/dto/v2"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+)
+
+type AttachmentHandlerImplTestSuite struct {
+	suite.Suite
+	ctrl             *gomock.Controller
+	mockAttachmentUc *usecase.MockAttachmentUseCase
+	handler          *AttachmentHandlerImpl
+	engine           *gin.Engine
+}
+
+func TestAttachmentHandlerImplTestSuite(t *testing.T) {
+	suite.Run(t, new(AttachmentHandlerImplTestSuite))
+}
+
+func (suite *AttachmentHandlerImplTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockAttachmentUc = usecase.NewMockAttachmentUseCase(suite.ctrl)
+	suite.handler = NewAttachmentHandlerImpl(zerolog.New(os.Stdout), suite.mockAttachmentUc, 16)
+
+	suite.engine = gin.New()
+	attachments := suite.engine.Group("/api/v2/todos/:id/attachments")
+	attachments.GET("", suite.handler.ListAttachments)
+	attachments.POST("", suite.handler.UploadAttachment)
+	attachments.GET("/:attachment_id", suite.handler.DownloadAttachment)
+	attachments.DELETE("/:attachment_id", suite.handler.DeleteAttachment)
+}
+
+func (suite *AttachmentHandlerImplTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+// serveUpload sends the content as the given multipart field of a POST request
+func serveUpload(engine *gin.Engine, target, field, fileName string, content []byte) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, _ := writer.CreateFormFile(field, fileName)
+	part.Write(content)
+	writer.Close()
+
+	req := httptest.NewRequest(http.MethodPost, target, &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+
+	return w
+}
+
+func (suite *AttachmentHandlerImplTestSuite) TestAttachmentHandlerImpl_UploadAttachment() {
+	suite.Run("Success", func() {
+		suite.mockAttachmentUc.EXPECT().
+			UploadAttachment(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx *gin.Context, req usecase.UploadAttachmentRequest) (*usecase.AttachmentResponse, error) {
+				suite.Equal(uint(1), req.TodoID)
+				suite.Equal("hello.txt", req.Name)
+				content, _ := io.ReadAll(req.Content)
+				suite.Equal("hello", string(content))
+				return &usecase.AttachmentResponse{ID: 3, Name: "hello.txt", Size: 5, ContentType: "text/plain"}, nil
+			}).
+			Times(1)
+
+		w := serveUpload(suite.engine, "/api/v2/todos/1/attachments", "file", "hello.txt", []byte("hello"))
+
+		suite.Equal(http.StatusCreated, w.Code)
+		suite.Equal("/api/v2/todos/1/attachments/3", w.Header().Get("Location"))
+		var resp v2.Attachment
+		suite.NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+		suite.Equal(v2.Attachment{ID: 3, Name: "hello.txt", Size: 5, ContentType: "text/plain"}, resp)
+	})
+
+	suite.Run("Missing File Field", func() {
+		w := serveUpload(suite.engine, "/api/v2/todos/1/attachments", "upload", "hello.txt", []byte("hello"))
+
+		suite.Equal(http.StatusBadRequest, w.Code)
+	})
+
+	suite.Run("Body Too Large", func() {
+		w := serveUpload(suite.engine, "/api/v2/todos/1/attachments", "file", "big.txt", bytes.Repeat([]byte("a"), multipartOverhead+32))
+
+		suite.Equal(http.StatusRequestEntityTooLarge, w.Code)
+	})
+
+	suite.Run("Usecase Errors", func() {
+		for err, code := range map[string]int{
+			"too large: attachment cannot exceed 16 bytes":                  http.StatusRequestEntityTooLarge,
+			"unsupported type: content type application/pdf is not allowed": http.StatusUnsupportedMediaType,
+			"not found: todo not found":                                     http.StatusNotFound,
+		} {
+			suite.mockAttachmentUc.EXPECT().UploadAttachment(gomock.Any(), gomock.Any()).Return(nil, errors.New(err)).Times(1)
+
+			w := serveUpload(suite.engine, "/api/v2/todos/1/attachments", "file", "hello.txt", []byte("hello"))
+
+			suite.Equal(code, w.Code, err)
+		}
+	})
+}
+
+func (suite *AttachmentHandlerImplTestSuite) TestAttachmentHandlerImpl_ListAttachments() {
+	suite.mockAttachmentUc.EXPECT().
+		ListAttachments(gomock.Any(), uint(1)).
+		Return(&usecase.ListAttachmentsResponse{
+			Attachments: []usecase.AttachmentResponse{{ID: 3, Name: "hello.txt", Size: 5, ContentType: "text/plain"}},
+		}, nil).
+		Times(1)
+
+	w := serveJSON(suite.engine, http.MethodGet, "/api/v2/todos/1/attachments", nil)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp v2.ListAttachmentsResponse
+	suite.NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Equal([]v2.Attachment{{ID: 3, Name: "hello.txt", Size: 5, ContentType: "text/plain"}}, resp.Attachments)
+}
+
+func (suite *AttachmentHandlerImplTestSuite) TestAttachmentHandlerImpl_DownloadAttachment() {
+	suite.Run("Success", func() {
+		suite.mockAttachmentUc.EXPECT().
+			DownloadAttachment(gomock.Any(), uint(1), uint(3)).
+			Return(&usecase.DownloadAttachmentResponse{
+				Attachment: usecase.AttachmentResponse{ID: 3, Name: "報價單.txt", Size: 5, ContentType: "text/plain"},
+				Content:    io.NopCloser(strings.NewReader("hello")),
+			}, nil).
+			Times(1)
+
+		w := serveJSON(suite.engine, http.MethodGet, "/api/v2/todos/1/attachments/3", nil)
+
+		suite.Equal(http.StatusOK, w.Code)
+		suite.Equal("hello", w.Body.String())
+		suite.Equal("text/plain", w.Header().Get("Content-Type"))
+		suite.Equal("5", w.Header().Get("Content-Length"))
+		suite.Equal("attachment; filename*=utf-8''%E5%A0%B1%E5%83%B9%E5%96%AE.txt", w.Header().Get("Content-Disposition"))
+		suite.Equal("nosniff", w.Header().Get("X-Content-Type-Options"))
+	})
+
+	suite.Run("Not Found", func() {
+		suite.mockAttachmentUc.EXPECT().
+			DownloadAttachment(gomock.Any(), uint(1), uint(9)).
+			Return(nil, errors.New("not found: attachment not found")).
+			Times(1)
+
+		w := serveJSON(suite.engine, http.MethodGet, "/api/v2/todos/1/attachments/9", nil)
+
+		suite.Equal(http.StatusNotFound, w.Code)
+	})
+}
+
+func (suite *AttachmentHandlerImplTestSuite) TestAttachmentHandlerImpl_DeleteAttachment() {
+	suite.Run("Invalid ID", func() {
+		w := serveJSON(suite.engine, http.MethodDelete, "/api/v2/todos/1/attachments/abc", nil)
+
+		suite.Equal(http.StatusBadRequest, w.Code)
+	})
+
+	suite.Run("Success", func() {
+		suite.mockAttachmentUc.EXPECT().DeleteAttachment(gomock.Any(), uint(1), uint(3)).Return(nil).Times(1)
+
+		w := serveJSON(suite.engine, http.MethodDelete, "/api/v2/todos/1/attachments/3", nil)
+
+		suite.Equal(http.StatusNoContent, w.Code)
+	})
+}
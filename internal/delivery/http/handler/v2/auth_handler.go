@@ -0,0 +1,9 @@
+package v2
+
+import "github.com/gin-gonic/gin"
+
+type AuthHandler interface {
+	Register(c *gin.Context)
+	Login(c *gin.Context)
+	Refresh(c *gin.Context)
+}
@@ -0,0 +1,103 @@
+package v2
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	v2 "itmrchow/go-todolist-service/internal/delivery/http/dto/v2"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+)
+
+var _ AuthHandler = &AuthHandlerImpl{}
+
+// AuthHandlerImpl serves account registration and token issuing in the v2 API
+type AuthHandlerImpl struct {
+	logger zerolog.Logger
+	authUc usecase.AuthUseCase
+}
+
+func NewAuthHandlerImpl(logger zerolog.Logger, authUc usecase.AuthUseCase) *AuthHandlerImpl {
+	return &AuthHandlerImpl{
+		logger: logger,
+		authUc: authUc,
+	}
+}
+
+// Register handles POST /auth/register
+func (h *AuthHandlerImpl) Register(c *gin.Context) {
+	var httpReq v2.RegisterRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	ucResp, err := h.authUc.Register(c, usecase.RegisterRequest{
+		Email:    httpReq.Email,
+		Password: httpReq.Password,
+	})
+	if err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, v2.RegisterResponse{
+		ID: ucResp.ID,
+	})
+}
+
+// Login handles POST /auth/login
+func (h *AuthHandlerImpl) Login(c *gin.Context) {
+	var httpReq v2.LoginRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	ucResp, err := h.authUc.Login(c, usecase.LoginRequest{
+		Email:    httpReq.Email,
+		Password: httpReq.Password,
+	})
+	if err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toTokenResponse(ucResp))
+}
+
+// Refresh handles POST /auth/refresh
+func (h *AuthHandlerImpl) Refresh(c *gin.Context) {
+	var httpReq v2.RefreshRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	ucResp, err := h.authUc.Refresh(c, httpReq.RefreshToken)
+	if err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toTokenResponse(ucResp))
+}
+
+func toTokenResponse(resp *usecase.TokenResponse) v2.TokenResponse {
+	return v2.TokenResponse{
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		TokenType:    resp.TokenType,
+		ExpiresIn:    resp.ExpiresIn,
+	}
+}
@@ -0,0 +1,150 @@
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	v2 "itmrchow/go-todolist-service/internal/delivery/http/dto/v2"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+)
+
+type AuthHandlerImplTestSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	mockAuthUc *usecase.MockAuthUseCase
+	handler    *AuthHandlerImpl
+	engine     *gin.Engine
+}
+
+func TestAuthHandlerImplTestSuite(t *testing.T) {
+	suite.Run(t, new(AuthHandlerImplTestSuite))
+}
+
+func (suite *AuthHandlerImplTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockAuthUc = usecase.NewMockAuthUseCase(suite.ctrl)
+	suite.handler = NewAuthHandlerImpl(zerolog.New(os.Stdout), suite.mockAuthUc)
+
+	suite.engine = gin.New()
+	auth := suite.engine.Group("/api/v2/auth")
+	auth.POST("/register", suite.handler.Register)
+	auth.POST("/login", suite.handler.Login)
+	auth.POST("/refresh", suite.handler.Refresh)
+}
+
+func (suite *AuthHandlerImplTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+func (suite *AuthHandlerImplTestSuite) TestAuthHandlerImpl_Register() {
+	suite.Run("Invalid Body", func() {
+		w := serveJSON(suite.engine, http.MethodPost, "/api/v2/auth/register", "{")
+
+		suite.Equal(http.StatusBadRequest, w.Code)
+	})
+
+	suite.Run("Success", func() {
+		suite.mockAuthUc.EXPECT().
+			Register(gomock.Any(), usecase.RegisterRequest{Email: "alice@example.com", Password: "correct horse"}).
+			Return(&usecase.RegisterResponse{ID: 7}, nil).
+			Times(1)
+
+		w := serveJSON(suite.engine, http.MethodPost, "/api/v2/auth/register", map[string]string{
+			"email":    "alice@example.com",
+			"password": "correct horse",
+		})
+
+		suite.Equal(http.StatusCreated, w.Code)
+		var resp v2.RegisterResponse
+		suite.NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+		suite.Equal(uint(7), resp.ID)
+	})
+
+	suite.Run("Email Taken", func() {
+		suite.mockAuthUc.EXPECT().
+			Register(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("conflict: email already registered")).
+			Times(1)
+
+		w := serveJSON(suite.engine, http.MethodPost, "/api/v2/auth/register", map[string]string{
+			"email":    "alice@example.com",
+			"password": "correct horse",
+		})
+
+		suite.Equal(http.StatusConflict, w.Code)
+	})
+
+	suite.Run("Weak Password", func() {
+		suite.mockAuthUc.EXPECT().
+			Register(gomock.Any(), gomock.Any()).
+			Return(nil, errors.Join(errors.New("validation fail"), errors.New("password must be at least 8 characters"))).
+			Times(1)
+
+		w := serveJSON(suite.engine, http.MethodPost, "/api/v2/auth/register", map[string]string{
+			"email":    "alice@example.com",
+			"password": "short",
+		})
+
+		suite.Equal(http.StatusBadRequest, w.Code)
+	})
+}
+
+func (suite *AuthHandlerImplTestSuite) TestAuthHandlerImpl_Login() {
+	suite.Run("Success", func() {
+		suite.mockAuthUc.EXPECT().
+			Login(gomock.Any(), usecase.LoginRequest{Email: "alice@example.com", Password: "correct horse"}).
+			Return(&usecase.TokenResponse{AccessToken: "access", RefreshToken: "refresh", TokenType: "Bearer", ExpiresIn: 900}, nil).
+			Times(1)
+
+		w := serveJSON(suite.engine, http.MethodPost, "/api/v2/auth/login", map[string]string{
+			"email":    "alice@example.com",
+			"password": "correct horse",
+		})
+
+		suite.Equal(http.StatusOK, w.Code)
+		var resp v2.TokenResponse
+		suite.NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+		suite.Equal(v2.TokenResponse{AccessToken: "access", RefreshToken: "refresh", TokenType: "Bearer", ExpiresIn: 900}, resp)
+	})
+
+	suite.Run("Wrong Password", func() {
+		suite.mockAuthUc.EXPECT().
+			Login(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("unauthorized: invalid email or password")).
+			Times(1)
+
+		w := serveJSON(suite.engine, http.MethodPost, "/api/v2/auth/login", map[string]string{
+			"email":    "alice@example.com",
+			"password": "wrong password",
+		})
+
+		suite.Equal(http.StatusUnauthorized, w.Code)
+	})
+}
+
+func (suite *AuthHandlerImplTestSuite) TestAuthHandlerImpl_Refresh() {
+	suite.Run("Expired Token", func() {
+		suite.mockAuthUc.EXPECT().
+			Refresh(gomock.Any(), "expired").
+			Return(nil, errors.New("unauthorized: token has expired")).
+			Times(1)
+
+		w := serveJSON(suite.engine, http.MethodPost, "/api/v2/auth/refresh", map[string]string{
+			"refresh_token": "expired",
+		})
+
+		suite.Equal(http.StatusUnauthorized, w.Code)
+	})
+}
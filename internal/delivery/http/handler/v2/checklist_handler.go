@@ -0,0 +1,11 @@
+package v2
+
+import "github.com/gin-gonic/gin"
+
+type ChecklistHandler interface {
+	ListChecklist(c *gin.Context)
+	AddChecklistItem(c *gin.Context)
+	PatchChecklistItem(c *gin.Context)
+	DeleteChecklistItem(c *gin.Context)
+	ReorderChecklist(c *gin.Context)
+}
@@ -0,0 +1,182 @@
+package v2
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	v2 "itmrchow/go-todolist-service/internal/delivery/http/dto/v2"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+)
+
+var _ ChecklistHandler = &ChecklistHandlerImpl{}
+
+// ChecklistHandlerImpl serves the checklist of a todo in the v2 API
+type ChecklistHandlerImpl struct {
+	logger      zerolog.Logger
+	checklistUc usecase.ChecklistUseCase
+}
+
+func NewChecklistHandlerImpl(logger zerolog.Logger, checklistUc usecase.ChecklistUseCase) *ChecklistHandlerImpl {
+	return &ChecklistHandlerImpl{
+		logger:      logger,
+		checklistUc: checklistUc,
+	}
+}
+
+// ListChecklist handles GET /todos/:id/checklist
+func (h *ChecklistHandlerImpl) ListChecklist(c *gin.Context) {
+	var uri v2.TodoURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	ucResp, err := h.checklistUc.ListChecklist(c, uri.ID)
+	if err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	items := make([]v2.ChecklistItem, len(ucResp.Items))
+	for i, item := range ucResp.Items {
+		items[i] = v2.ChecklistItem{
+			ID:        item.ID,
+			Text:      item.Text,
+			Done:      item.Done,
+			Position:  item.Position,
+			CreatedAt: item.CreatedAt,
+			UpdatedAt: item.UpdatedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, v2.ListChecklistResponse{
+		Items:    items,
+		Progress: v2.ProgressItem{Done: ucResp.Progress.Done, Total: ucResp.Progress.Total},
+	})
+}
+
+// AddChecklistItem handles POST /todos/:id/checklist, the item is appended at the end
+func (h *ChecklistHandlerImpl) AddChecklistItem(c *gin.Context) {
+	var uri v2.TodoURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	var httpReq v2.AddChecklistItemRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	ucResp, err := h.checklistUc.AddChecklistItem(c, usecase.AddChecklistItemRequest{
+		TodoID: uri.ID,
+		Text:   httpReq.Text,
+	})
+	if err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	// Return 201 with the location of the new resource
+	c.Header("Location", fmt.Sprintf("%s/%d", strings.TrimSuffix(c.Request.URL.Path, "/"), ucResp.ID))
+	c.JSON(http.StatusCreated, v2.AddChecklistItemResponse{
+		ID: ucResp.ID,
+	})
+}
+
+// PatchChecklistItem handles PATCH /todos/:id/checklist/:item_id, editing the text and/or toggling done
+func (h *ChecklistHandlerImpl) PatchChecklistItem(c *gin.Context) {
+	var uri v2.ChecklistItemURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	var httpReq v2.PatchChecklistItemRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	if err := h.checklistUc.UpdateChecklistItem(c, usecase.UpdateChecklistItemRequest{
+		TodoID: uri.TodoID,
+		ID:     uri.ItemID,
+		Text:   httpReq.Text,
+		Done:   httpReq.Done,
+	}); err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+// DeleteChecklistItem handles DELETE /todos/:id/checklist/:item_id
+func (h *ChecklistHandlerImpl) DeleteChecklistItem(c *gin.Context) {
+	var uri v2.ChecklistItemURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	if err := h.checklistUc.DeleteChecklistItem(c, uri.TodoID, uri.ItemID); err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+// ReorderChecklist handles PUT /todos/:id/checklist/order
+func (h *ChecklistHandlerImpl) ReorderChecklist(c *gin.Context) {
+	var uri v2.TodoURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	var httpReq v2.ReorderChecklistRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	if err := h.checklistUc.ReorderChecklist(c, usecase.ReorderChecklistRequest{
+		TodoID:  uri.ID,
+		ItemIDs: httpReq.ItemIDs,
+	}); err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
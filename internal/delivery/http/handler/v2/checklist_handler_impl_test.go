@@ -0,0 +1,191 @@
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	v2 "itmrchow/go-todolist-service/internal/delivery/http/dto/v2"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+)
+
+type ChecklistHandlerImplTestSuite struct {
+	suite.Suite
+	ctrl            *gomock.Controller
+	mockChecklistUc *usecase.MockChecklistUseCase
+	handler         *ChecklistHandlerImpl
+	engine          *gin.Engine
+}
+
+func TestChecklistHandlerImplTestSuite(t *testing.T) {
+	suite.Run(t, new(ChecklistHandlerImplTestSuite))
+}
+
+func (suite *ChecklistHandlerImplTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockChecklistUc = usecase.NewMockChecklistUseCase(suite.ctrl)
+	suite.handler = NewChecklistHandlerImpl(zerolog.New(os.Stdout), suite.mockChecklistUc)
+
+	suite.engine = gin.New()
+	checklist := suite.engine.Group("/api/v2/todos/:id/checklist")
+	checklist.GET("", suite.handler.ListChecklist)
+	checklist.POST("", suite.handler.AddChecklistItem)
+	checklist.PUT("/order", suite.handler.ReorderChecklist)
+	checklist.PATCH("/:item_id", suite.handler.PatchChecklistItem)
+	checklist.DELETE("/:item_id", suite.handler.DeleteChecklistItem)
+}
+
+func (suite *ChecklistHandlerImplTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+func (suite *ChecklistHandlerImplTestSuite) TestChecklistHandlerImpl_ListChecklist() {
+	suite.mockChecklistUc.EXPECT().
+		ListChecklist(gomock.Any(), uint(1)).
+		Return(&usecase.ListChecklistResponse{
+			Items:    []usecase.ChecklistItemResponse{{ID: 2, Text: "第一步", Done: true}},
+			Progress: usecase.TodoProgress{Done: 1, Total: 1},
+		}, nil).
+		Times(1)
+
+	w := serveJSON(suite.engine, http.MethodGet, "/api/v2/todos/1/checklist", nil)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp v2.ListChecklistResponse
+	suite.NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Equal([]v2.ChecklistItem{{ID: 2, Text: "第一步", Done: true}}, resp.Items)
+	suite.Equal(v2.ProgressItem{Done: 1, Total: 1}, resp.Progress)
+}
+
+func (suite *ChecklistHandlerImplTestSuite) TestChecklistHandlerImpl_AddChecklistItem() {
+	tests := []struct {
+		name             string
+		body             interface{}
+		mockSetup        func()
+		expectedCode     int
+		expectedLocation string
+	}{
+		{
+			name:         "Missing Text",
+			body:         map[string]interface{}{},
+			mockSetup:    func() {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Todo Not Found",
+			body: map[string]interface{}{"text": "第一步"},
+			mockSetup: func() {
+				suite.mockChecklistUc.EXPECT().
+					AddChecklistItem(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found: todo not found")).
+					Times(1)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name: "Success",
+			body: map[string]interface{}{"text": "第一步"},
+			mockSetup: func() {
+				suite.mockChecklistUc.EXPECT().
+					AddChecklistItem(gomock.Any(), usecase.AddChecklistItemRequest{TodoID: 1, Text: "第一步"}).
+					Return(&usecase.AddChecklistItemResponse{ID: 7}, nil).
+					Times(1)
+			},
+			expectedCode:     http.StatusCreated,
+			expectedLocation: "/api/v2/todos/1/checklist/7",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			w := serveJSON(suite.engine, http.MethodPost, "/api/v2/todos/1/checklist", tt.body)
+
+			suite.Equal(tt.expectedCode, w.Code)
+			suite.Equal(tt.expectedLocation, w.Header().Get("Location"))
+		})
+	}
+}
+
+func (suite *ChecklistHandlerImplTestSuite) TestChecklistHandlerImpl_PatchChecklistItem() {
+	done := true
+	suite.mockChecklistUc.EXPECT().
+		UpdateChecklistItem(gomock.Any(), usecase.UpdateChecklistItemRequest{TodoID: 1, ID: 7, Done: &done}).
+		Return(nil).
+		Times(1)
+
+	w := serveJSON(suite.engine, http.MethodPatch, "/api/v2/todos/1/checklist/7", `{"done": true}`)
+
+	suite.Equal(http.StatusNoContent, w.Code)
+}
+
+func (suite *ChecklistHandlerImplTestSuite) TestChecklistHandlerImpl_DeleteChecklistItem() {
+	suite.mockChecklistUc.EXPECT().
+		DeleteChecklistItem(gomock.Any(), uint(1), uint(7)).
+		Return(errors.New("not found: checklist item not found")).
+		Times(1)
+
+	w := serveJSON(suite.engine, http.MethodDelete, "/api/v2/todos/1/checklist/7", nil)
+
+	suite.Equal(http.StatusNotFound, w.Code)
+}
+
+func (suite *ChecklistHandlerImplTestSuite) TestChecklistHandlerImpl_ReorderChecklist() {
+	tests := []struct {
+		name         string
+		body         string
+		mockSetup    func()
+		expectedCode int
+	}{
+		{
+			name:         "Missing Item IDs",
+			body:         `{}`,
+			mockSetup:    func() {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Not A Permutation",
+			body: `{"item_ids": [3]}`,
+			mockSetup: func() {
+				suite.mockChecklistUc.EXPECT().
+					ReorderChecklist(gomock.Any(), gomock.Any()).
+					Return(errors.New("validation fail: item_ids must list every checklist item of the todo")).
+					Times(1)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Success",
+			body: `{"item_ids": [3, 1, 2]}`,
+			mockSetup: func() {
+				suite.mockChecklistUc.EXPECT().
+					ReorderChecklist(gomock.Any(), usecase.ReorderChecklistRequest{TodoID: 1, ItemIDs: []uint{3, 1, 2}}).
+					Return(nil).
+					Times(1)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			w := serveJSON(suite.engine, http.MethodPut, "/api/v2/todos/1/checklist/order", tt.body)
+
+			suite.Equal(tt.expectedCode, w.Code)
+		})
+	}
+}
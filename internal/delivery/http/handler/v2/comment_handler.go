@@ -0,0 +1,10 @@
+package v2
+
+import "github.com/gin-gonic/gin"
+
+type CommentHandler interface {
+	ListComments(c *gin.Context)
+	CreateComment(c *gin.Context)
+	PatchComment(c *gin.Context)
+	DeleteComment(c *gin.Context)
+}
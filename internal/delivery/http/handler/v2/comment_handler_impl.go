@@ -0,0 +1,170 @@
+package v2
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	v2 "itmrchow/go-todolist-service/internal/delivery/http/dto/v2"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+	"itmrchow/go-todolist-service/internal/utils/dto"
+)
+
+var _ CommentHandler = &CommentHandlerImpl{}
+
+// CommentHandlerImpl serves the comment thread of a todo in the v2 API
+type CommentHandlerImpl struct {
+	logger    zerolog.Logger
+	commentUc usecase.CommentUseCase
+}
+
+func NewCommentHandlerImpl(logger zerolog.Logger, commentUc usecase.CommentUseCase) *CommentHandlerImpl {
+	return &CommentHandlerImpl{
+		logger:    logger,
+		commentUc: commentUc,
+	}
+}
+
+// ListComments handles GET /todos/:id/comments
+func (h *CommentHandlerImpl) ListComments(c *gin.Context) {
+	var uri v2.TodoURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	var httpReq v2.ListCommentsQuery
+	if err := c.ShouldBindQuery(&httpReq); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	ucResp, err := h.commentUc.ListComments(c, usecase.ListCommentsRequest{
+		TodoID: uri.ID,
+		Pagination: dto.PaginationReq{
+			Page:         httpReq.Page,
+			PageSize:     httpReq.PageSize,
+			SortOrder:    httpReq.SortOrder,
+			Mode:         httpReq.Mode,
+			Cursor:       httpReq.Cursor,
+			IncludeTotal: httpReq.IncludeTotal,
+		},
+	})
+	if err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	comments := make([]v2.Comment, len(ucResp.Comments))
+	for i, comment := range ucResp.Comments {
+		comments[i] = v2.Comment{
+			ID:        comment.ID,
+			Author:    comment.Author,
+			Body:      comment.Body,
+			CreatedAt: comment.CreatedAt,
+			UpdatedAt: comment.UpdatedAt,
+			EditedAt:  comment.EditedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, v2.ListCommentsResponse{
+		Comments:   comments,
+		Pagination: ucResp.Pagination,
+	})
+}
+
+// CreateComment handles POST /todos/:id/comments, the author is taken from the X-Actor header
+func (h *CommentHandlerImpl) CreateComment(c *gin.Context) {
+	var uri v2.TodoURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	var httpReq v2.CreateCommentRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	ucResp, err := h.commentUc.CreateComment(c, usecase.CreateCommentRequest{
+		TodoID: uri.ID,
+		Body:   httpReq.Body,
+	})
+	if err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	// Return 201 with the location of the new resource
+	c.Header("Location", fmt.Sprintf("%s/%d", strings.TrimSuffix(c.Request.URL.Path, "/"), ucResp.ID))
+	c.JSON(http.StatusCreated, v2.CreateCommentResponse{
+		ID: ucResp.ID,
+	})
+}
+
+// PatchComment handles PATCH /todos/:id/comments/:comment_id, replacing the body
+func (h *CommentHandlerImpl) PatchComment(c *gin.Context) {
+	var uri v2.CommentURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	var httpReq v2.PatchCommentRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	if err := h.commentUc.EditComment(c, usecase.EditCommentRequest{
+		TodoID: uri.TodoID,
+		ID:     uri.CommentID,
+		Body:   httpReq.Body,
+	}); err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+// DeleteComment handles DELETE /todos/:id/comments/:comment_id
+func (h *CommentHandlerImpl) DeleteComment(c *gin.Context) {
+	var uri v2.CommentURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	if err := h.commentUc.DeleteComment(c, uri.TodoID, uri.CommentID); err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
@@ -0,0 +1,161 @@
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	v2 "itmrchow/go-todolist-service/internal/delivery/http/dto/v2"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+	"itmrchow/go-todolist-service/internal/utils/dto"
+)
+
+type CommentHandlerImplTestSuite struct {
+	suite.Suite
+	ctrl          *gomock.Controller
+	mockCommentUc *usecase.MockCommentUseCase
+	handler       *CommentHandlerImpl
+	engine        *gin.Engine
+}
+
+func TestCommentHandlerImplTestSuite(t *testing.T) {
+	suite.Run(t, new(CommentHandlerImplTestSuite))
+}
+
+func (suite *CommentHandlerImplTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockCommentUc = usecase.NewMockCommentUseCase(suite.ctrl)
+	suite.handler = NewCommentHandlerImpl(zerolog.New(os.Stdout), suite.mockCommentUc)
+
+	suite.engine = gin.New()
+	comments := suite.engine.Group("/api/v2/todos/:id/comments")
+	comments.GET("", suite.handler.ListComments)
+	comments.POST("", suite.handler.CreateComment)
+	comments.PATCH("/:comment_id", suite.handler.PatchComment)
+	comments.DELETE("/:comment_id", suite.handler.DeleteComment)
+}
+
+func (suite *CommentHandlerImplTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+func (suite *CommentHandlerImplTestSuite) TestCommentHandlerImpl_ListComments() {
+	suite.Run("Invalid Sort Order", func() {
+		w := serveJSON(suite.engine, http.MethodGet, "/api/v2/todos/1/comments?sort_order=newest", nil)
+
+		suite.Equal(http.StatusBadRequest, w.Code)
+	})
+
+	suite.Run("Success", func() {
+		suite.mockCommentUc.EXPECT().
+			ListComments(gomock.Any(), usecase.ListCommentsRequest{
+				TodoID:     1,
+				Pagination: dto.PaginationReq{Page: 2, PageSize: 20, SortOrder: "asc"},
+			}).
+			Return(&usecase.ListCommentsResponse{
+				Comments: []usecase.CommentResponse{{ID: 3, Author: "alice", Body: "明天再確認"}},
+			}, nil).
+			Times(1)
+
+		w := serveJSON(suite.engine, http.MethodGet, "/api/v2/todos/1/comments?page=2", nil)
+
+		suite.Equal(http.StatusOK, w.Code)
+		var resp v2.ListCommentsResponse
+		suite.NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+		suite.Equal([]v2.Comment{{ID: 3, Author: "alice", Body: "明天再確認"}}, resp.Comments)
+	})
+}
+
+func (suite *CommentHandlerImplTestSuite) TestCommentHandlerImpl_CreateComment() {
+	tests := []struct {
+		name             string
+		body             interface{}
+		mockSetup        func()
+		expectedCode     int
+		expectedLocation string
+	}{
+		{
+			name:         "Missing Body",
+			body:         map[string]interface{}{},
+			mockSetup:    func() {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Todo Not Found",
+			body: map[string]interface{}{"body": "明天再確認"},
+			mockSetup: func() {
+				suite.mockCommentUc.EXPECT().
+					CreateComment(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found: todo not found")).
+					Times(1)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name: "Success",
+			body: map[string]interface{}{"body": "明天再確認"},
+			mockSetup: func() {
+				suite.mockCommentUc.EXPECT().
+					CreateComment(gomock.Any(), usecase.CreateCommentRequest{TodoID: 1, Body: "明天再確認"}).
+					Return(&usecase.CreateCommentResponse{ID: 3}, nil).
+					Times(1)
+			},
+			expectedCode:     http.StatusCreated,
+			expectedLocation: "/api/v2/todos/1/comments/3",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			w := serveJSON(suite.engine, http.MethodPost, "/api/v2/todos/1/comments", tt.body)
+
+			suite.Equal(tt.expectedCode, w.Code)
+			suite.Equal(tt.expectedLocation, w.Header().Get("Location"))
+		})
+	}
+}
+
+func (suite *CommentHandlerImplTestSuite) TestCommentHandlerImpl_PatchComment() {
+	suite.mockCommentUc.EXPECT().
+		EditComment(gomock.Any(), usecase.EditCommentRequest{TodoID: 1, ID: 3, Body: "今天確認了"}).
+		Return(nil).
+		Times(1)
+
+	w := serveJSON(suite.engine, http.MethodPatch, "/api/v2/todos/1/comments/3", `{"body": "今天確認了"}`)
+
+	suite.Equal(http.StatusNoContent, w.Code)
+
+	// comments of other members are only changed by admins
+	suite.mockCommentUc.EXPECT().
+		EditComment(gomock.Any(), usecase.EditCommentRequest{TodoID: 1, ID: 4, Body: "今天確認了"}).
+		Return(errors.New("forbidden: the member role may not moderate_comments")).
+		Times(1)
+
+	w = serveJSON(suite.engine, http.MethodPatch, "/api/v2/todos/1/comments/4", `{"body": "今天確認了"}`)
+
+	suite.Equal(http.StatusForbidden, w.Code)
+}
+
+func (suite *CommentHandlerImplTestSuite) TestCommentHandlerImpl_DeleteComment() {
+	suite.mockCommentUc.EXPECT().
+		DeleteComment(gomock.Any(), uint(1), uint(3)).
+		Return(errors.New("not found: comment not found")).
+		Times(1)
+
+	w := serveJSON(suite.engine, http.MethodDelete, "/api/v2/todos/1/comments/3", nil)
+
+	suite.Equal(http.StatusNotFound, w.Code)
+}
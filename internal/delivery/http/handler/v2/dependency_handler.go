@@ -0,0 +1,8 @@
+package v2
+
+import "github.com/gin-gonic/gin"
+
+type DependencyHandler interface {
+	AddDependency(c *gin.Context)
+	RemoveDependency(c *gin.Context)
+}
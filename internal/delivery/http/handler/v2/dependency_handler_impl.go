@@ -0,0 +1,79 @@
+package v2
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	v2 "itmrchow/go-todolist-service/internal/delivery/http/dto/v2"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+)
+
+var _ DependencyHandler = &DependencyHandlerImpl{}
+
+// DependencyHandlerImpl serves the blockers of a todo in the v2 API
+type DependencyHandlerImpl struct {
+	logger       zerolog.Logger
+	dependencyUc usecase.DependencyUseCase
+}
+
+func NewDependencyHandlerImpl(logger zerolog.Logger, dependencyUc usecase.DependencyUseCase) *DependencyHandlerImpl {
+	return &DependencyHandlerImpl{
+		logger:       logger,
+		dependencyUc: dependencyUc,
+	}
+}
+
+// AddDependency handles POST /todos/:id/dependencies, the todo becomes blocked by blocker_id
+func (h *DependencyHandlerImpl) AddDependency(c *gin.Context) {
+	var uri v2.TodoURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	var httpReq v2.AddDependencyRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	if err := h.dependencyUc.AddDependency(c, usecase.AddDependencyRequest{
+		TodoID:    uri.ID,
+		BlockerID: httpReq.BlockerID,
+	}); err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+// RemoveDependency handles DELETE /todos/:id/dependencies/:blocker_id
+func (h *DependencyHandlerImpl) RemoveDependency(c *gin.Context) {
+	var uri v2.DependencyURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	if err := h.dependencyUc.RemoveDependency(c, usecase.RemoveDependencyRequest{
+		TodoID:    uri.TodoID,
+		BlockerID: uri.BlockerID,
+	}); err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
@@ -0,0 +1,116 @@
+package v2
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+)
+
+type DependencyHandlerImplTestSuite struct {
+	suite.Suite
+	ctrl             *gomock.Controller
+	mockDependencyUc *usecase.MockDependencyUseCase
+	handler          *DependencyHandlerImpl
+	engine           *gin.Engine
+}
+
+func TestDependencyHandlerImplTestSuite(t *testing.T) {
+	suite.Run(t, new(DependencyHandlerImplTestSuite))
+}
+
+func (suite *DependencyHandlerImplTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockDependencyUc = usecase.NewMockDependencyUseCase(suite.ctrl)
+	suite.handler = NewDependencyHandlerImpl(zerolog.New(os.Stdout), suite.mockDependencyUc)
+
+	suite.engine = gin.New()
+	dependencies := suite.engine.Group("/api/v2/todos/:id/dependencies")
+	dependencies.POST("", suite.handler.AddDependency)
+	dependencies.DELETE("/:blocker_id", suite.handler.RemoveDependency)
+}
+
+func (suite *DependencyHandlerImplTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+func (suite *DependencyHandlerImplTestSuite) TestDependencyHandlerImpl_AddDependency() {
+	tests := []struct {
+		name         string
+		body         string
+		mockSetup    func()
+		expectedCode int
+	}{
+		{
+			name:         "Missing Blocker ID",
+			body:         `{}`,
+			mockSetup:    func() {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Cycle",
+			body: `{"blocker_id": 2}`,
+			mockSetup: func() {
+				suite.mockDependencyUc.EXPECT().
+					AddDependency(gomock.Any(), gomock.Any()).
+					Return(errors.New("validation fail: todo 1 already blocks todo 2, the dependency would create a cycle")).
+					Times(1)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Already Linked",
+			body: `{"blocker_id": 2}`,
+			mockSetup: func() {
+				suite.mockDependencyUc.EXPECT().
+					AddDependency(gomock.Any(), gomock.Any()).
+					Return(errors.New("conflict: dependency already exists")).
+					Times(1)
+			},
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name: "Success",
+			body: `{"blocker_id": 2}`,
+			mockSetup: func() {
+				suite.mockDependencyUc.EXPECT().
+					AddDependency(gomock.Any(), usecase.AddDependencyRequest{TodoID: 1, BlockerID: 2}).
+					Return(nil).
+					Times(1)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			w := serveJSON(suite.engine, http.MethodPost, "/api/v2/todos/1/dependencies", tt.body)
+
+			suite.Equal(tt.expectedCode, w.Code)
+		})
+	}
+}
+
+func (suite *DependencyHandlerImplTestSuite) TestDependencyHandlerImpl_RemoveDependency() {
+	suite.mockDependencyUc.EXPECT().
+		RemoveDependency(gomock.Any(), usecase.RemoveDependencyRequest{TodoID: 1, BlockerID: 2}).
+		Return(errors.New("not found: dependency not found")).
+		Times(1)
+
+	w := serveJSON(suite.engine, http.MethodDelete, "/api/v2/todos/1/dependencies/2", nil)
+
+	suite.Equal(http.StatusNotFound, w.Code)
+}
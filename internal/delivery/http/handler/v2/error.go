@@ -0,0 +1,57 @@
+package v2
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+)
+
+// writeError maps usecase errors to HTTP status codes
+// The kind of an error is the prefix of its first line, errors joined after it are only causes,
+// so a cause that happens to mention "not found" never turns an internal error into a 404
+func writeError(c *gin.Context, logger zerolog.Logger, err error) {
+	kind := errorKind(err)
+	switch {
+	case strings.HasPrefix(kind, "unauthorized"):
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": err.Error(),
+		})
+	case strings.HasPrefix(kind, "forbidden"):
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": err.Error(),
+		})
+	case strings.HasPrefix(kind, "validation fail"):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+	case strings.HasPrefix(kind, "not found"):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+	case strings.HasPrefix(kind, "conflict"):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": err.Error(),
+		})
+	case strings.HasPrefix(kind, "too large"):
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{
+			"error": err.Error(),
+		})
+	case strings.HasPrefix(kind, "unsupported type"):
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{
+			"error": err.Error(),
+		})
+	default:
+		logger.Error().Err(err).Msg("internal server error")
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+	}
+}
+
+// errorKind returns the first line of an error, errors.Join puts the kind a usecase returns first
+func errorKind(err error) string {
+	kind, _, _ := strings.Cut(err.Error(), "\n")
+	return kind
+}
@@ -0,0 +1,38 @@
+package v2
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// errInvalidIfMatch is returned for an If-Match header that is not a single todo ETag
+var errInvalidIfMatch = errors.New(`invalid If-Match header, expected the ETag of the todo such as "3"`)
+
+// setETag surfaces the version of a todo as a strong entity tag, e.g. "3"
+func setETag(c *gin.Context, version uint) {
+	c.Header("ETag", strconv.Quote(strconv.FormatUint(uint64(version), 10)))
+}
+
+// ifMatchVersion reads the version the client edits from the If-Match header,
+// nil when the header is missing or "*" so the write is not checked
+func ifMatchVersion(c *gin.Context) (*uint, error) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if header == "" || header == "*" {
+		return nil, nil
+	}
+
+	// only strong tags match, weak ones (W/"3") are rejected like any other value
+	if len(header) < 2 || !strings.HasPrefix(header, `"`) || !strings.HasSuffix(header, `"`) {
+		return nil, errInvalidIfMatch
+	}
+	version, err := strconv.ParseUint(header[1:len(header)-1], 10, 0)
+	if err != nil || version == 0 {
+		return nil, errInvalidIfMatch
+	}
+
+	v := uint(version)
+	return &v, nil
+}
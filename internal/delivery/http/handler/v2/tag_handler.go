@@ -0,0 +1,10 @@
+package v2
+
+import "github.com/gin-gonic/gin"
+
+type TagHandler interface {
+	ListTags(c *gin.Context)
+	CreateTag(c *gin.Context)
+	PatchTag(c *gin.Context)
+	DeleteTag(c *gin.Context)
+}
@@ -0,0 +1,134 @@
+package v2
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	v2 "itmrchow/go-todolist-service/internal/delivery/http/dto/v2"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+)
+
+var _ TagHandler = &TagHandlerImpl{}
+
+// TagHandlerImpl serves the tag resources of the v2 API
+type TagHandlerImpl struct {
+	logger zerolog.Logger
+	tagUc  usecase.TagUseCase
+}
+
+func NewTagHandlerImpl(logger zerolog.Logger, tagUc usecase.TagUseCase) *TagHandlerImpl {
+	return &TagHandlerImpl{
+		logger: logger,
+		tagUc:  tagUc,
+	}
+}
+
+// ListTags handles GET /tags
+func (t *TagHandlerImpl) ListTags(c *gin.Context) {
+	ucResp, err := t.tagUc.ListTags(c)
+	if err != nil {
+		writeError(c, t.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, v2.ListTagsResponse{
+		Tags: toTagItems(ucResp.Tags),
+	})
+}
+
+// CreateTag handles POST /tags
+func (t *TagHandlerImpl) CreateTag(c *gin.Context) {
+	var httpReq v2.CreateTagRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		t.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	ucResp, err := t.tagUc.CreateTag(c, usecase.CreateTagRequest{
+		Name:  httpReq.Name,
+		Color: httpReq.Color,
+	})
+	if err != nil {
+		writeError(c, t.logger, err)
+		return
+	}
+
+	// Return 201 with the location of the new resource
+	c.Header("Location", fmt.Sprintf("%s/%d", strings.TrimSuffix(c.Request.URL.Path, "/"), ucResp.ID))
+	c.JSON(http.StatusCreated, v2.CreateTagResponse{
+		ID: ucResp.ID,
+	})
+}
+
+// PatchTag handles PATCH /tags/:id, renaming and/or recoloring the tag
+func (t *TagHandlerImpl) PatchTag(c *gin.Context) {
+	var uri v2.TagURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		t.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	var httpReq v2.PatchTagRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		t.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	if err := t.tagUc.UpdateTag(c, usecase.UpdateTagRequest{
+		ID:    uri.ID,
+		Name:  httpReq.Name,
+		Color: httpReq.Color,
+	}); err != nil {
+		writeError(c, t.logger, err)
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+// DeleteTag handles DELETE /tags/:id
+func (t *TagHandlerImpl) DeleteTag(c *gin.Context) {
+	var uri v2.TagURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		t.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	if err := t.tagUc.DeleteTag(c, uri.ID); err != nil {
+		writeError(c, t.logger, err)
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+// toTagItems converts usecase tag responses to the HTTP DTO
+func toTagItems(tags []usecase.TagResponse) []v2.TagItem {
+	items := make([]v2.TagItem, len(tags))
+	for i, tag := range tags {
+		items[i] = v2.TagItem{
+			ID:        tag.ID,
+			Name:      tag.Name,
+			Color:     tag.Color,
+			CreatedAt: tag.CreatedAt,
+			UpdatedAt: tag.UpdatedAt,
+		}
+	}
+	return items
+}
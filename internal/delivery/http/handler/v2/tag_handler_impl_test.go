@@ -0,0 +1,183 @@
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	v2 "itmrchow/go-todolist-service/internal/delivery/http/dto/v2"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+)
+
+type TagHandlerImplTestSuite struct {
+	suite.Suite
+	ctrl      *gomock.Controller
+	mockTagUc *usecase.MockTagUseCase
+	handler   *TagHandlerImpl
+	engine    *gin.Engine
+}
+
+func TestTagHandlerImplTestSuite(t *testing.T) {
+	suite.Run(t, new(TagHandlerImplTestSuite))
+}
+
+func (suite *TagHandlerImplTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockTagUc = usecase.NewMockTagUseCase(suite.ctrl)
+	suite.handler = NewTagHandlerImpl(zerolog.New(os.Stdout), suite.mockTagUc)
+
+	suite.engine = gin.New()
+	tags := suite.engine.Group("/api/v2/tags")
+	tags.GET("", suite.handler.ListTags)
+	tags.POST("", suite.handler.CreateTag)
+	tags.PATCH("/:id", suite.handler.PatchTag)
+	tags.DELETE("/:id", suite.handler.DeleteTag)
+}
+
+func (suite *TagHandlerImplTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+func (suite *TagHandlerImplTestSuite) TestTagHandlerImpl_ListTags() {
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	suite.mockTagUc.EXPECT().
+		ListTags(gomock.Any()).
+		Return(&usecase.ListTagsResponse{Tags: []usecase.TagResponse{
+			{ID: 1, Name: "bug", Color: "#ff0000", CreatedAt: now, UpdatedAt: now},
+		}}, nil).
+		Times(1)
+
+	w := serveJSON(suite.engine, http.MethodGet, "/api/v2/tags", nil)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp v2.ListTagsResponse
+	suite.NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Equal([]v2.TagItem{{ID: 1, Name: "bug", Color: "#ff0000", CreatedAt: now, UpdatedAt: now}}, resp.Tags)
+}
+
+func (suite *TagHandlerImplTestSuite) TestTagHandlerImpl_CreateTag() {
+	tests := []struct {
+		name             string
+		body             interface{}
+		mockSetup        func()
+		expectedCode     int
+		expectedLocation string
+	}{
+		{
+			name:         "Missing Name",
+			body:         map[string]interface{}{"color": "#ff0000"},
+			mockSetup:    func() {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Name Conflict",
+			body: map[string]interface{}{"name": "bug"},
+			mockSetup: func() {
+				suite.mockTagUc.EXPECT().
+					CreateTag(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("conflict: tag name already exists")).
+					Times(1)
+			},
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name: "Success",
+			body: map[string]interface{}{"name": "bug", "color": "#ff0000"},
+			mockSetup: func() {
+				suite.mockTagUc.EXPECT().
+					CreateTag(gomock.Any(), usecase.CreateTagRequest{Name: "bug", Color: "#ff0000"}).
+					Return(&usecase.CreateTagResponse{ID: 3}, nil).
+					Times(1)
+			},
+			expectedCode:     http.StatusCreated,
+			expectedLocation: "/api/v2/tags/3",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			w := serveJSON(suite.engine, http.MethodPost, "/api/v2/tags", tt.body)
+
+			suite.Equal(tt.expectedCode, w.Code)
+			suite.Equal(tt.expectedLocation, w.Header().Get("Location"))
+		})
+	}
+}
+
+func (suite *TagHandlerImplTestSuite) TestTagHandlerImpl_PatchTag() {
+	tests := []struct {
+		name         string
+		target       string
+		body         interface{}
+		mockSetup    func()
+		expectedCode int
+	}{
+		{
+			name:         "Invalid ID",
+			target:       "/api/v2/tags/abc",
+			body:         map[string]interface{}{"name": "bug"},
+			mockSetup:    func() {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:   "Not Found",
+			target: "/api/v2/tags/9",
+			body:   map[string]interface{}{"name": "bug"},
+			mockSetup: func() {
+				suite.mockTagUc.EXPECT().
+					UpdateTag(gomock.Any(), gomock.Any()).
+					Return(errors.New("not found: tag not found")).
+					Times(1)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:   "Success",
+			target: "/api/v2/tags/1",
+			body:   map[string]interface{}{"color": "#00ff00"},
+			mockSetup: func() {
+				color := "#00ff00"
+				suite.mockTagUc.EXPECT().
+					UpdateTag(gomock.Any(), usecase.UpdateTagRequest{ID: 1, Color: &color}).
+					Return(nil).
+					Times(1)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			w := serveJSON(suite.engine, http.MethodPatch, tt.target, tt.body)
+
+			suite.Equal(tt.expectedCode, w.Code)
+		})
+	}
+}
+
+func (suite *TagHandlerImplTestSuite) TestTagHandlerImpl_DeleteTag() {
+	suite.mockTagUc.EXPECT().
+		DeleteTag(gomock.Any(), uint(1)).
+		Return(nil).
+		Times(1)
+
+	w := serveJSON(suite.engine, http.MethodDelete, "/api/v2/tags/1", nil)
+
+	suite.Equal(http.StatusNoContent, w.Code)
+}
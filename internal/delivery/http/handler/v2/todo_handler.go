@@ -0,0 +1,15 @@
+package v2
+
+import "github.com/gin-gonic/gin"
+
+type TodoHandler interface {
+	ListTodos(c *gin.Context)
+	CreateTodo(c *gin.Context)
+	GetTodo(c *gin.Context)
+	ReplaceTodo(c *gin.Context)
+	PatchTodo(c *gin.Context)
+	DeleteTodo(c *gin.Context)
+	TransitionTodo(c *gin.Context)
+	MoveTodo(c *gin.Context)
+	ListStatusHistory(c *gin.Context)
+}
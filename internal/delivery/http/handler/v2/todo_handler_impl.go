@@ -0,0 +1,508 @@
+package v2
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	v2 "itmrchow/go-todolist-service/internal/delivery/http/dto/v2"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+	"itmrchow/go-todolist-service/internal/utils/dto"
+)
+
+var _ TodoHandler = &TodoHandlerImpl{}
+
+// TodoHandlerImpl serves the resource-oriented v2 todo API on top of the same
+// TodoUseCase as v1
+type TodoHandlerImpl struct {
+	logger zerolog.Logger
+	todoUc usecase.TodoUseCase
+}
+
+func NewTodoHandlerImpl(logger zerolog.Logger, todoUc usecase.TodoUseCase) *TodoHandlerImpl {
+	return &TodoHandlerImpl{
+		logger: logger,
+		todoUc: todoUc,
+	}
+}
+
+// ListTodos handles GET /todos
+func (t *TodoHandlerImpl) ListTodos(c *gin.Context) {
+	// Parse query string into HTTP DTO
+	var httpReq v2.ListTodosQuery
+	if err := c.ShouldBindQuery(&httpReq); err != nil {
+		t.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	// Convert HTTP DTO to UseCase DTO
+	ucReq := usecase.FindTodoRequest{
+		Keyword:      httpReq.Keyword,
+		Status:       httpReq.Status,
+		Statuses:     httpReq.Statuses,
+		Priorities:   httpReq.Priorities,
+		MinPriority:  httpReq.MinPriority,
+		TagsAny:      httpReq.TagsAny,
+		TagsAll:      httpReq.TagsAll,
+		ParentID:     httpReq.ParentID,
+		SeriesID:     httpReq.SeriesID,
+		WorkflowID:   httpReq.WorkflowID,
+		ProjectID:    httpReq.ProjectID,
+		CreatedFrom:  httpReq.CreatedFrom,
+		CreatedTo:    httpReq.CreatedTo,
+		DueFrom:      httpReq.DueFrom,
+		DueTo:        httpReq.DueTo,
+		UpdatedSince: httpReq.UpdatedSince,
+		Overdue:      httpReq.Overdue,
+		HasDueDate:   httpReq.HasDueDate,
+		Assignee:     httpReq.Assignee,
+		Pagination: dto.PaginationReq{
+			Page:         httpReq.Page,
+			PageSize:     httpReq.PageSize,
+			SortBy:       httpReq.SortBy,
+			SortOrder:    httpReq.SortOrder,
+			Mode:         httpReq.Mode,
+			Cursor:       httpReq.Cursor,
+			IncludeTotal: httpReq.IncludeTotal,
+		},
+	}
+
+	// Call usecase
+	ucResp, err := t.todoUc.FindTodo(c, ucReq)
+	if err != nil {
+		writeError(c, t.logger, err)
+		return
+	}
+
+	// Convert UseCase response to HTTP DTO
+	todos := make([]v2.TodoItem, len(ucResp.Todos))
+	for i, todo := range ucResp.Todos {
+		todos[i] = toTodoItem(todo)
+	}
+
+	c.JSON(http.StatusOK, v2.ListTodosResponse{
+		Todos:      todos,
+		Pagination: ucResp.Pagination,
+	})
+}
+
+// CreateTodo handles POST /todos
+func (t *TodoHandlerImpl) CreateTodo(c *gin.Context) {
+	// Parse HTTP request body into HTTP DTO
+	var httpReq v2.CreateTodoRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		t.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	status := "" // the usecase starts the todo in the first status of its workflow
+	if httpReq.Status != nil {
+		status = *httpReq.Status
+	}
+	priority := "none"
+	if httpReq.Priority != nil {
+		priority = *httpReq.Priority
+	}
+
+	// Call usecase
+	ucResp, err := t.todoUc.CreateTodo(c, usecase.CreateTodoRequest{
+		Title:       httpReq.Title,
+		Description: httpReq.Description,
+		Status:      status,
+		Priority:    priority,
+		DueDate:     httpReq.DueDate,
+		TagIDs:      httpReq.TagIDs,
+		ParentID:    httpReq.ParentID,
+		Recurrence:  toRecurrenceRequest(httpReq.Recurrence),
+		WorkflowID:  httpReq.WorkflowID,
+		ProjectID:   httpReq.ProjectID,
+	})
+	if err != nil {
+		writeError(c, t.logger, err)
+		return
+	}
+
+	// Return 201 with the location and the version of the new resource
+	setETag(c, ucResp.Version)
+	c.Header("Location", fmt.Sprintf("%s/%d", strings.TrimSuffix(c.Request.URL.Path, "/"), ucResp.ID))
+	c.JSON(http.StatusCreated, v2.CreateTodoResponse{
+		ID: ucResp.ID,
+	})
+}
+
+// GetTodo handles GET /todos/:id
+func (t *TodoHandlerImpl) GetTodo(c *gin.Context) {
+	var uri v2.TodoURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		t.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	// Call usecase
+	ucResp, err := t.todoUc.GetTodo(c, uri.ID)
+	if err != nil {
+		writeError(c, t.logger, err)
+		return
+	}
+
+	setETag(c, ucResp.Todo.Version)
+	c.JSON(http.StatusOK, toTodoItem(ucResp.Todo))
+}
+
+// ReplaceTodo handles PUT /todos/:id, fields omitted from the body are cleared
+func (t *TodoHandlerImpl) ReplaceTodo(c *gin.Context) {
+	var uri v2.TodoURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		t.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	var httpReq v2.ReplaceTodoRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		t.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	// Convert HTTP DTO to UseCase DTO, nil description means clear
+	description := ""
+	if httpReq.Description != nil {
+		description = *httpReq.Description
+	}
+	priority := "none"
+	if httpReq.Priority != nil {
+		priority = *httpReq.Priority
+	}
+	tagIDs := httpReq.TagIDs
+	if tagIDs == nil {
+		tagIDs = []uint{}
+	}
+	ucReq := usecase.PatchTodoRequest{
+		ID:              uri.ID,
+		Title:           &httpReq.Title,
+		Description:     &description,
+		Status:          &httpReq.Status,
+		Priority:        &priority,
+		TagIDs:          &tagIDs,
+		DueDate:         httpReq.DueDate,
+		ClearDueDate:    httpReq.DueDate == nil,
+		ParentID:        httpReq.ParentID,
+		ClearParent:     httpReq.ParentID == nil,
+		Recurrence:      toRecurrenceRequest(httpReq.Recurrence),
+		ClearRecurrence: httpReq.Recurrence == nil,
+		ProjectID:       httpReq.ProjectID,
+		ClearProject:    httpReq.ProjectID == nil,
+		Version:         version,
+	}
+
+	// Call usecase
+	ucResp, err := t.todoUc.PatchTodo(c, ucReq)
+	if err != nil {
+		writeError(c, t.logger, err)
+		return
+	}
+
+	setETag(c, ucResp.Version)
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+// PatchTodo handles PATCH /todos/:id, only fields present in the body are changed
+func (t *TodoHandlerImpl) PatchTodo(c *gin.Context) {
+	var uri v2.TodoURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		t.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	var httpReq v2.PatchTodoRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		t.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	// Title, status and priority are not nullable
+	if (httpReq.Title.Set && httpReq.Title.Value == nil) ||
+		(httpReq.Status.Set && httpReq.Status.Value == nil) ||
+		(httpReq.Priority.Set && httpReq.Priority.Value == nil) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	// Convert HTTP DTO to UseCase DTO
+	ucReq := usecase.PatchTodoRequest{
+		ID:       uri.ID,
+		Title:    httpReq.Title.Value,
+		Status:   httpReq.Status.Value,
+		Priority: httpReq.Priority.Value,
+		DueDate:  httpReq.DueDate.Value,
+		Version:  version,
+	}
+	if httpReq.Description.Set {
+		description := ""
+		if httpReq.Description.Value != nil {
+			description = *httpReq.Description.Value
+		}
+		ucReq.Description = &description
+	}
+	if httpReq.DueDate.Set && httpReq.DueDate.Value == nil {
+		ucReq.ClearDueDate = true
+	}
+	if httpReq.TagIDs.Set {
+		tagIDs := []uint{}
+		if httpReq.TagIDs.Value != nil {
+			tagIDs = *httpReq.TagIDs.Value
+		}
+		ucReq.TagIDs = &tagIDs
+	}
+	if httpReq.ParentID.Set {
+		ucReq.ParentID = httpReq.ParentID.Value
+		ucReq.ClearParent = httpReq.ParentID.Value == nil
+	}
+	if httpReq.Recurrence.Set {
+		ucReq.Recurrence = toRecurrenceRequest(httpReq.Recurrence.Value)
+		ucReq.ClearRecurrence = httpReq.Recurrence.Value == nil
+	}
+	if httpReq.ProjectID.Set {
+		ucReq.ProjectID = httpReq.ProjectID.Value
+		ucReq.ClearProject = httpReq.ProjectID.Value == nil
+	}
+
+	// Call usecase
+	ucResp, err := t.todoUc.PatchTodo(c, ucReq)
+	if err != nil {
+		writeError(c, t.logger, err)
+		return
+	}
+
+	setETag(c, ucResp.Version)
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+// DeleteTodo handles DELETE /todos/:id
+func (t *TodoHandlerImpl) DeleteTodo(c *gin.Context) {
+	var uri v2.TodoURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		t.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	// Call usecase
+	if err := t.todoUc.DeleteTodo(c, uri.ID); err != nil {
+		writeError(c, t.logger, err)
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+// TransitionTodo handles POST /todos/:id/transition
+func (t *TodoHandlerImpl) TransitionTodo(c *gin.Context) {
+	var uri v2.TodoURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		t.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	var httpReq v2.TransitionTodoRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		t.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	// Call usecase
+	ucReq := usecase.TransitionTodoRequest{
+		ID:      uri.ID,
+		Status:  httpReq.Status,
+		Version: version,
+	}
+	ucResp, err := t.todoUc.TransitionTodo(c, ucReq)
+	if err != nil {
+		writeError(c, t.logger, err)
+		return
+	}
+
+	setETag(c, ucResp.Version)
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+func (t *TodoHandlerImpl) MoveTodo(c *gin.Context) {
+	var uri v2.TodoURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		t.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	var httpReq v2.MoveTodoRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		t.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	version, err := ifMatchVersion(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	// Call usecase
+	ucReq := usecase.MoveTodoRequest{
+		ID:        uri.ID,
+		AfterID:   httpReq.AfterID,
+		BeforeID:  httpReq.BeforeID,
+		Status:    httpReq.Status,
+		ProjectID: httpReq.ProjectID,
+		Version:   version,
+	}
+	ucResp, err := t.todoUc.MoveTodo(c, ucReq)
+	if err != nil {
+		writeError(c, t.logger, err)
+		return
+	}
+
+	setETag(c, ucResp.Version)
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+// ListStatusHistory handles GET /todos/:id/history
+func (t *TodoHandlerImpl) ListStatusHistory(c *gin.Context) {
+	var uri v2.TodoURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		t.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	// Call usecase
+	ucResp, err := t.todoUc.ListStatusHistory(c, uri.ID)
+	if err != nil {
+		writeError(c, t.logger, err)
+		return
+	}
+
+	resp := v2.StatusHistoryResponse{Changes: make([]v2.StatusChangeItem, len(ucResp.Changes))}
+	for i, change := range ucResp.Changes {
+		resp.Changes[i] = v2.StatusChangeItem{
+			Event:      change.Event,
+			FromStatus: change.FromStatus,
+			ToStatus:   change.ToStatus,
+			AssigneeID: change.AssigneeID,
+			Actor:      change.Actor,
+			ChangedAt:  change.ChangedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// toTodoItem converts a usecase todo response to the HTTP DTO
+func toTodoItem(todo usecase.TodoResponse) v2.TodoItem {
+	return v2.TodoItem{
+		ID:           todo.ID,
+		Title:        todo.Title,
+		Description:  todo.Description,
+		Status:       todo.Status,
+		WorkflowID:   todo.WorkflowID,
+		ProjectID:    todo.ProjectID,
+		Position:     todo.Position,
+		Priority:     todo.Priority,
+		DueDate:      todo.DueDate,
+		ParentID:     todo.ParentID,
+		BlockedBy:    todo.BlockedBy,
+		AssigneeIDs:  todo.AssigneeIDs,
+		CommentCount: todo.CommentCount,
+		Tags:         toTagItems(todo.Tags),
+		Progress:     v2.ProgressItem{Done: todo.Progress.Done, Total: todo.Progress.Total},
+		Recurrence:   toRecurrenceItem(todo.Recurrence),
+		SeriesID:     todo.SeriesID,
+		Occurrence:   todo.Occurrence,
+		StartedAt:    todo.StartedAt,
+		CompletedAt:  todo.CompletedAt,
+		Version:      todo.Version,
+		CreatedAt:    todo.CreatedAt,
+		UpdatedAt:    todo.UpdatedAt,
+	}
+}
+
+// toRecurrenceRequest converts a recurrence of the HTTP DTO to the usecase request
+func toRecurrenceRequest(item *v2.RecurrenceItem) *usecase.RecurrenceRequest {
+	if item == nil {
+		return nil
+	}
+	return &usecase.RecurrenceRequest{Rule: item.Rule, Timezone: item.Timezone}
+}
+
+// toRecurrenceItem converts the recurrence of a usecase todo response to the HTTP DTO
+func toRecurrenceItem(recurrence *usecase.RecurrenceResponse) *v2.RecurrenceItem {
+	if recurrence == nil {
+		return nil
+	}
+	return &v2.RecurrenceItem{Rule: recurrence.Rule, Timezone: recurrence.Timezone}
+}
@@ -0,0 +1,822 @@
+package v2
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+	"itmrchow/go-todolist-service/internal/utils/dto"
+)
+
+type TodoHandlerImplTestSuite struct {
+	suite.Suite
+	ctrl       *gomock.Controller
+	mockTodoUc *usecase.MockTodoUseCase
+	handler    *TodoHandlerImpl
+	engine     *gin.Engine
+}
+
+func TestTodoHandlerImplTestSuite(t *testing.T) {
+	suite.Run(t, new(TodoHandlerImplTestSuite))
+}
+
+func (suite *TodoHandlerImplTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockTodoUc = usecase.NewMockTodoUseCase(suite.ctrl)
+	suite.handler = NewTodoHandlerImpl(zerolog.New(os.Stdout), suite.mockTodoUc)
+
+	// Register routes the same way as the router so path params are resolved
+	suite.engine = gin.New()
+	todos := suite.engine.Group("/api/v2/todos")
+	todos.GET("", suite.handler.ListTodos)
+	todos.POST("", suite.handler.CreateTodo)
+	todos.GET("/:id", suite.handler.GetTodo)
+	todos.PUT("/:id", suite.handler.ReplaceTodo)
+	todos.PATCH("/:id", suite.handler.PatchTodo)
+	todos.DELETE("/:id", suite.handler.DeleteTodo)
+	todos.POST("/:id/transition", suite.handler.TransitionTodo)
+	todos.POST("/:id/move", suite.handler.MoveTodo)
+	todos.GET("/:id/history", suite.handler.ListStatusHistory)
+}
+
+func (suite *TodoHandlerImplTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_ListTodos() {
+	tests := []struct {
+		name         string
+		query        string
+		mockSetup    func()
+		expectedCode int
+	}{
+		{
+			name:  "Invalid Sort Order",
+			query: "?sort_order=sideways",
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					FindTodo(gomock.Any(), gomock.Any()).
+					Return(nil, errors.Join(errors.New("validation fail"), errors.New("sort_order of sort_by field 1 must be asc or desc"))).
+					Times(1)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:  "Invalid Page Size",
+			query: "?page_size=1000",
+			mockSetup: func() {
+				// no mock setup needed for binding error
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:  "UseCase Internal Error",
+			query: "",
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					FindTodo(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("internal fail: database connection error")).
+					Times(1)
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+		{
+			name:  "Internal Error Mentioning Not Found",
+			query: "",
+			mockSetup: func() {
+				// only the kind on the first line decides the status, not the causes joined after it
+				suite.mockTodoUc.EXPECT().
+					FindTodo(gomock.Any(), gomock.Any()).
+					Return(nil, errors.Join(errors.New("internal fail"), errors.New("record not found"))).
+					Times(1)
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+		{
+			name:  "Success With Query Filters",
+			query: "?keyword=test&status=doing&due_from=2024-01-01T00:00:00Z&page=2&page_size=5&sort_by=title&sort_order=asc",
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					FindTodo(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, req usecase.FindTodoRequest) (*usecase.FindTodoResponse, error) {
+						// Verify query string is mapped to the usecase request
+						assert.Equal(suite.T(), "test", *req.Keyword)
+						assert.Equal(suite.T(), "doing", *req.Status)
+						assert.Equal(suite.T(), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Unix(), req.DueFrom.Unix())
+						assert.Nil(suite.T(), req.DueTo)
+						assert.Equal(suite.T(), dto.PaginationReq{
+							Page:      2,
+							PageSize:  5,
+							SortBy:    "title",
+							SortOrder: "asc",
+						}, req.Pagination)
+
+						return &usecase.FindTodoResponse{
+							Todos: []usecase.TodoResponse{{ID: 1, Title: "test", Status: "doing"}},
+							Pagination: dto.PaginationResp{
+								Page:       2,
+								PageSize:   5,
+								TotalCount: intPtr(6),
+								TotalPages: intPtr(2),
+							},
+						}, nil
+					}).
+					Times(1)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:  "Success With Assignee Me",
+			query: "?assignee=me",
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					FindTodo(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, req usecase.FindTodoRequest) (*usecase.FindTodoResponse, error) {
+						assert.Equal(suite.T(), usecase.AssigneeMe, *req.Assignee)
+						return &usecase.FindTodoResponse{
+							Todos: []usecase.TodoResponse{{ID: 1, Title: "test", Status: "doing", AssigneeIDs: []uint{7}}},
+						}, nil
+					}).
+					Times(1)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:  "Success With Defaults",
+			query: "",
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					FindTodo(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, req usecase.FindTodoRequest) (*usecase.FindTodoResponse, error) {
+						assert.Equal(suite.T(), dto.PaginationReq{
+							Page:      1,
+							PageSize:  20,
+							SortBy:    "id",
+							SortOrder: "desc",
+						}, req.Pagination)
+						return &usecase.FindTodoResponse{Todos: []usecase.TodoResponse{}}, nil
+					}).
+					Times(1)
+			},
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			w := suite.serve(http.MethodGet, "/api/v2/todos"+tt.query, nil)
+
+			assert.Equal(suite.T(), tt.expectedCode, w.Code)
+		})
+	}
+}
+
+func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_CreateTodo() {
+	tests := []struct {
+		name             string
+		body             interface{}
+		mockSetup        func()
+		expectedCode     int
+		expectedLocation string
+	}{
+		{
+			name: "Missing Title",
+			body: map[string]interface{}{
+				"description": "test description",
+			},
+			mockSetup: func() {
+				// no mock setup needed for binding error
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "UseCase Validation Fail",
+			body: map[string]interface{}{
+				"title": "test",
+			},
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					CreateTodo(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("validation fail: due date must be in the future")).
+					Times(1)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Success",
+			body: map[string]interface{}{
+				"title": "test",
+			},
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					CreateTodo(gomock.Any(), usecase.CreateTodoRequest{
+						Title:    "test",
+						Priority: "none",
+					}).
+					Return(&usecase.CreateTodoResponse{ID: 7}, nil).
+					Times(1)
+			},
+			expectedCode:     http.StatusCreated,
+			expectedLocation: "/api/v2/todos/7",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			w := suite.serve(http.MethodPost, "/api/v2/todos", tt.body)
+
+			assert.Equal(suite.T(), tt.expectedCode, w.Code)
+			assert.Equal(suite.T(), tt.expectedLocation, w.Header().Get("Location"))
+		})
+	}
+}
+
+func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_GetTodo() {
+	tests := []struct {
+		name         string
+		path         string
+		mockSetup    func()
+		expectedCode int
+	}{
+		{
+			name: "Invalid ID",
+			path: "/api/v2/todos/abc",
+			mockSetup: func() {
+				// no mock setup needed for binding error
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Not Found",
+			path: "/api/v2/todos/999",
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					GetTodo(gomock.Any(), uint(999)).
+					Return(nil, errors.New("not found: todo not found")).
+					Times(1)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name: "Success",
+			path: "/api/v2/todos/1",
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					GetTodo(gomock.Any(), uint(1)).
+					Return(&usecase.GetTodoResponse{
+						Todo: usecase.TodoResponse{ID: 1, Title: "test", Status: "pending"},
+					}, nil).
+					Times(1)
+			},
+			expectedCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			w := suite.serve(http.MethodGet, tt.path, nil)
+
+			assert.Equal(suite.T(), tt.expectedCode, w.Code)
+		})
+	}
+}
+
+func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_ReplaceTodo() {
+	tests := []struct {
+		name         string
+		body         interface{}
+		mockSetup    func()
+		expectedCode int
+	}{
+		{
+			name: "Missing Status",
+			body: map[string]interface{}{
+				"title": "test",
+			},
+			mockSetup: func() {
+				// no mock setup needed for binding error
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Success - Omitted Fields Are Cleared",
+			body: map[string]interface{}{
+				"title":  "test",
+				"status": "doing",
+			},
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					PatchTodo(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, req usecase.PatchTodoRequest) (*usecase.TodoVersionResponse, error) {
+						assert.Equal(suite.T(), uint(1), req.ID)
+						assert.Equal(suite.T(), "test", *req.Title)
+						assert.Equal(suite.T(), "doing", *req.Status)
+						assert.Equal(suite.T(), "", *req.Description)
+						assert.True(suite.T(), req.ClearDueDate)
+						assert.True(suite.T(), req.ClearRecurrence)
+						return &usecase.TodoVersionResponse{Version: 2}, nil
+					}).
+					Times(1)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name: "UseCase Not Found",
+			body: map[string]interface{}{
+				"title":  "test",
+				"status": "done",
+			},
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					PatchTodo(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("not found: todo not found")).
+					Times(1)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			w := suite.serve(http.MethodPut, "/api/v2/todos/1", tt.body)
+
+			assert.Equal(suite.T(), tt.expectedCode, w.Code)
+		})
+	}
+}
+
+func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_PatchTodo() {
+	tests := []struct {
+		name         string
+		body         string
+		mockSetup    func()
+		expectedCode int
+	}{
+		{
+			name: "Null Title",
+			body: `{"title": null}`,
+			mockSetup: func() {
+				// no mock setup needed for validation error
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Success - Only Provided Fields",
+			body: `{"status": "done"}`,
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					PatchTodo(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, req usecase.PatchTodoRequest) (*usecase.TodoVersionResponse, error) {
+						assert.Nil(suite.T(), req.Title)
+						assert.Nil(suite.T(), req.Description)
+						assert.Nil(suite.T(), req.DueDate)
+						assert.False(suite.T(), req.ClearDueDate)
+						assert.Equal(suite.T(), "done", *req.Status)
+						return &usecase.TodoVersionResponse{Version: 2}, nil
+					}).
+					Times(1)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name: "Success - Null Clears Fields",
+			body: `{"description": null, "due_date": null}`,
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					PatchTodo(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, req usecase.PatchTodoRequest) (*usecase.TodoVersionResponse, error) {
+						assert.Equal(suite.T(), "", *req.Description)
+						assert.True(suite.T(), req.ClearDueDate)
+						return &usecase.TodoVersionResponse{Version: 2}, nil
+					}).
+					Times(1)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name: "Success - Null Parent Makes Top-Level",
+			body: `{"parent_id": null}`,
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					PatchTodo(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, req usecase.PatchTodoRequest) (*usecase.TodoVersionResponse, error) {
+						assert.Nil(suite.T(), req.ParentID)
+						assert.True(suite.T(), req.ClearParent)
+						return &usecase.TodoVersionResponse{Version: 2}, nil
+					}).
+					Times(1)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name: "Success - Recurrence",
+			body: `{"recurrence": {"rule": "FREQ=WEEKLY;BYDAY=MO", "timezone": "Asia/Taipei"}}`,
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					PatchTodo(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, req usecase.PatchTodoRequest) (*usecase.TodoVersionResponse, error) {
+						assert.Equal(suite.T(), &usecase.RecurrenceRequest{Rule: "FREQ=WEEKLY;BYDAY=MO", Timezone: "Asia/Taipei"}, req.Recurrence)
+						assert.False(suite.T(), req.ClearRecurrence)
+						return &usecase.TodoVersionResponse{Version: 2}, nil
+					}).
+					Times(1)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name: "Success - Null Recurrence Stops Repeating",
+			body: `{"recurrence": null}`,
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					PatchTodo(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, req usecase.PatchTodoRequest) (*usecase.TodoVersionResponse, error) {
+						assert.Nil(suite.T(), req.Recurrence)
+						assert.True(suite.T(), req.ClearRecurrence)
+						return &usecase.TodoVersionResponse{Version: 2}, nil
+					}).
+					Times(1)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name: "Invalid Recurrence",
+			body: `{"recurrence": {"rule": "FREQ=HOURLY"}}`,
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					PatchTodo(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("validation fail\nunsupported recurrence frequency \"HOURLY\"")).
+					Times(1)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Open Subtasks Conflict",
+			body: `{"status": "done"}`,
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					PatchTodo(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("conflict: todo has 2 open subtasks")).
+					Times(1)
+			},
+			expectedCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			w := suite.serve(http.MethodPatch, "/api/v2/todos/1", tt.body)
+
+			assert.Equal(suite.T(), tt.expectedCode, w.Code)
+		})
+	}
+}
+
+func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_DeleteTodo() {
+	tests := []struct {
+		name         string
+		mockSetup    func()
+		expectedCode int
+	}{
+		{
+			name: "Not Found",
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					DeleteTodo(gomock.Any(), uint(1)).
+					Return(errors.New("not found: todo not found")).
+					Times(1)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name: "Success",
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					DeleteTodo(gomock.Any(), uint(1)).
+					Return(nil).
+					Times(1)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			w := suite.serve(http.MethodDelete, "/api/v2/todos/1", nil)
+
+			assert.Equal(suite.T(), tt.expectedCode, w.Code)
+		})
+	}
+}
+
+func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_TransitionTodo() {
+	tests := []struct {
+		name         string
+		body         interface{}
+		mockSetup    func()
+		expectedCode int
+	}{
+		{
+			name:         "Missing Status",
+			body:         map[string]interface{}{},
+			mockSetup:    func() {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Unknown Status",
+			body: map[string]interface{}{"status": "archived"},
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					TransitionTodo(gomock.Any(), usecase.TransitionTodoRequest{ID: 1, Status: "archived"}).
+					Return(nil, errors.New("validation fail: invalid status")).
+					Times(1)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Transition Not Allowed",
+			body: map[string]interface{}{"status": "pending"},
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					TransitionTodo(gomock.Any(), usecase.TransitionTodoRequest{ID: 1, Status: "pending"}).
+					Return(nil, errors.New("conflict: cannot move todo from done to pending")).
+					Times(1)
+			},
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name: "Success",
+			body: map[string]interface{}{"status": "doing"},
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					TransitionTodo(gomock.Any(), usecase.TransitionTodoRequest{ID: 1, Status: "doing"}).
+					Return(&usecase.TodoVersionResponse{Version: 2}, nil).
+					Times(1)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			w := suite.serve(http.MethodPost, "/api/v2/todos/1/transition", tt.body)
+
+			assert.Equal(suite.T(), tt.expectedCode, w.Code)
+		})
+	}
+}
+
+func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_MoveTodo() {
+	afterID, beforeID := uint(2), uint(3)
+	doing := "doing"
+
+	tests := []struct {
+		name         string
+		body         interface{}
+		mockSetup    func()
+		expectedCode int
+	}{
+		{
+			name:         "Invalid JSON",
+			body:         "invalid json",
+			mockSetup:    func() {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Neighbours Out Of Order",
+			body: map[string]interface{}{"after_id": 3, "before_id": 2},
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					MoveTodo(gomock.Any(), usecase.MoveTodoRequest{ID: 1, AfterID: &beforeID, BeforeID: &afterID}).
+					Return(nil, errors.New("validation fail: after_id must come before before_id in the manual order")).
+					Times(1)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Into Another Column",
+			body: map[string]interface{}{"after_id": 2, "before_id": 3, "status": "doing"},
+			mockSetup: func() {
+				suite.mockTodoUc.EXPECT().
+					MoveTodo(gomock.Any(), usecase.MoveTodoRequest{ID: 1, AfterID: &afterID, BeforeID: &beforeID, Status: &doing}).
+					Return(&usecase.TodoVersionResponse{Version: 2}, nil).
+					Times(1)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			w := suite.serve(http.MethodPost, "/api/v2/todos/1/move", tt.body)
+
+			assert.Equal(suite.T(), tt.expectedCode, w.Code)
+		})
+	}
+}
+
+func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_ListStatusHistory() {
+	changedAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	assigneeID := uint(7)
+
+	suite.Run("Success", func() {
+		suite.mockTodoUc.EXPECT().
+			ListStatusHistory(gomock.Any(), uint(1)).
+			Return(&usecase.ListStatusHistoryResponse{
+				Changes: []usecase.StatusChangeResponse{
+					{Event: "status_changed", FromStatus: "pending", ToStatus: "doing", Actor: "alice", ChangedAt: changedAt},
+					{Event: "assigned", AssigneeID: &assigneeID, Actor: "alice", ChangedAt: changedAt},
+				},
+			}, nil).
+			Times(1)
+
+		w := suite.serve(http.MethodGet, "/api/v2/todos/1/history", nil)
+
+		suite.Require().Equal(http.StatusOK, w.Code)
+		assert.JSONEq(suite.T(),
+			`{"changes":[`+
+				`{"event":"status_changed","from_status":"pending","to_status":"doing","actor":"alice","changed_at":"2025-03-01T09:00:00Z"},`+
+				`{"event":"assigned","assignee_id":7,"actor":"alice","changed_at":"2025-03-01T09:00:00Z"}]}`,
+			w.Body.String())
+	})
+
+	suite.Run("Not Found", func() {
+		suite.mockTodoUc.EXPECT().
+			ListStatusHistory(gomock.Any(), uint(1)).
+			Return(nil, errors.New("not found: todo not found")).
+			Times(1)
+
+		w := suite.serve(http.MethodGet, "/api/v2/todos/1/history", nil)
+
+		assert.Equal(suite.T(), http.StatusNotFound, w.Code)
+	})
+}
+
+// serve sends a request through the test engine
+func (suite *TodoHandlerImplTestSuite) TestTodoHandlerImpl_ETag() {
+	// serveIfMatch sends a JSON body with the If-Match header
+	serveIfMatch := func(method, target, body, ifMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("If-Match", ifMatch)
+		w := httptest.NewRecorder()
+		suite.engine.ServeHTTP(w, req)
+		return w
+	}
+
+	suite.Run("Get Sets ETag", func() {
+		suite.mockTodoUc.EXPECT().
+			GetTodo(gomock.Any(), uint(1)).
+			Return(&usecase.GetTodoResponse{
+				Todo: usecase.TodoResponse{ID: 1, Title: "test", Status: "pending", Version: 3},
+			}, nil).
+			Times(1)
+
+		w := suite.serve(http.MethodGet, "/api/v2/todos/1", nil)
+
+		suite.Require().Equal(http.StatusOK, w.Code)
+		assert.Equal(suite.T(), `"3"`, w.Header().Get("ETag"))
+		assert.Contains(suite.T(), w.Body.String(), `"version":3`)
+	})
+
+	suite.Run("Patch Passes Version", func() {
+		suite.mockTodoUc.EXPECT().
+			PatchTodo(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ interface{}, req usecase.PatchTodoRequest) (*usecase.TodoVersionResponse, error) {
+				suite.Require().NotNil(req.Version)
+				assert.Equal(suite.T(), uint(3), *req.Version)
+				return &usecase.TodoVersionResponse{Version: 4}, nil
+			}).
+			Times(1)
+
+		w := serveIfMatch(http.MethodPatch, "/api/v2/todos/1", `{"title": "new"}`, `"3"`)
+
+		assert.Equal(suite.T(), http.StatusNoContent, w.Code)
+		assert.Equal(suite.T(), `"4"`, w.Header().Get("ETag"))
+	})
+
+	suite.Run("Any Version", func() {
+		suite.mockTodoUc.EXPECT().
+			TransitionTodo(gomock.Any(), usecase.TransitionTodoRequest{ID: 1, Status: "doing"}).
+			Return(&usecase.TodoVersionResponse{Version: 2}, nil).
+			Times(1)
+
+		w := serveIfMatch(http.MethodPost, "/api/v2/todos/1/transition", `{"status": "doing"}`, "*")
+
+		assert.Equal(suite.T(), http.StatusNoContent, w.Code)
+		assert.Equal(suite.T(), `"2"`, w.Header().Get("ETag"))
+	})
+
+	suite.Run("Create Sets ETag", func() {
+		suite.mockTodoUc.EXPECT().
+			CreateTodo(gomock.Any(), gomock.Any()).
+			Return(&usecase.CreateTodoResponse{ID: 5, Version: 1}, nil).
+			Times(1)
+
+		w := suite.serve(http.MethodPost, "/api/v2/todos", map[string]interface{}{"title": "new"})
+
+		suite.Require().Equal(http.StatusCreated, w.Code)
+		assert.Equal(suite.T(), `"1"`, w.Header().Get("ETag"))
+	})
+
+	suite.Run("Replace Sets ETag", func() {
+		suite.mockTodoUc.EXPECT().
+			PatchTodo(gomock.Any(), gomock.Any()).
+			Return(&usecase.TodoVersionResponse{Version: 6}, nil).
+			Times(1)
+
+		w := serveIfMatch(http.MethodPut, "/api/v2/todos/1", `{"title": "new", "status": "pending"}`, `"5"`)
+
+		suite.Require().Equal(http.StatusNoContent, w.Code)
+		assert.Equal(suite.T(), `"6"`, w.Header().Get("ETag"))
+	})
+
+	suite.Run("Move Sets ETag", func() {
+		suite.mockTodoUc.EXPECT().
+			MoveTodo(gomock.Any(), gomock.Any()).
+			Return(&usecase.TodoVersionResponse{Version: 8}, nil).
+			Times(1)
+
+		w := serveIfMatch(http.MethodPost, "/api/v2/todos/1/move", `{"after_id": 2}`, `"7"`)
+
+		suite.Require().Equal(http.StatusNoContent, w.Code)
+		assert.Equal(suite.T(), `"8"`, w.Header().Get("ETag"))
+	})
+
+	suite.Run("Stale Version", func() {
+		suite.mockTodoUc.EXPECT().
+			MoveTodo(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("conflict: todo was modified, the current version is 4")).
+			Times(1)
+
+		w := serveIfMatch(http.MethodPost, "/api/v2/todos/1/move", `{"after_id": 2}`, `"3"`)
+
+		assert.Equal(suite.T(), http.StatusConflict, w.Code)
+	})
+
+	for _, ifMatch := range []string{`W/"3"`, "3", `"abc"`, `"1", "2"`} {
+		suite.Run("Invalid If-Match "+ifMatch, func() {
+			w := serveIfMatch(http.MethodPut, "/api/v2/todos/1", `{"title": "new", "status": "pending"}`, ifMatch)
+
+			assert.Equal(suite.T(), http.StatusBadRequest, w.Code)
+		})
+	}
+}
+
+func (suite *TodoHandlerImplTestSuite) serve(method, target string, body interface{}) *httptest.ResponseRecorder {
+	return serveJSON(suite.engine, method, target, body)
+}
+
+// serveJSON sends body as JSON (strings are sent verbatim) through the engine
+func serveJSON(engine *gin.Engine, method, target string, body interface{}) *httptest.ResponseRecorder {
+	var reqBody []byte
+	switch b := body.(type) {
+	case nil:
+	case string:
+		reqBody = []byte(b)
+	default:
+		reqBody, _ = json.Marshal(b)
+	}
+
+	req := httptest.NewRequest(method, target, bytes.NewBuffer(reqBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, req)
+
+	return w
+}
+
+func intPtr(i int) *int {
+	return &i
+}
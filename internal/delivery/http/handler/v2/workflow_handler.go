@@ -0,0 +1,11 @@
+package v2
+
+import "github.com/gin-gonic/gin"
+
+type WorkflowHandler interface {
+	ListWorkflows(c *gin.Context)
+	CreateWorkflow(c *gin.Context)
+	GetWorkflow(c *gin.Context)
+	ReplaceWorkflow(c *gin.Context)
+	DeleteWorkflow(c *gin.Context)
+}
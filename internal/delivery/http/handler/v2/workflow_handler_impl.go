@@ -0,0 +1,178 @@
+package v2
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	v2 "itmrchow/go-todolist-service/internal/delivery/http/dto/v2"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+)
+
+var _ WorkflowHandler = &WorkflowHandlerImpl{}
+
+// WorkflowHandlerImpl serves the workflow resources of the v2 API
+type WorkflowHandlerImpl struct {
+	logger     zerolog.Logger
+	workflowUc usecase.WorkflowUseCase
+}
+
+func NewWorkflowHandlerImpl(logger zerolog.Logger, workflowUc usecase.WorkflowUseCase) *WorkflowHandlerImpl {
+	return &WorkflowHandlerImpl{
+		logger:     logger,
+		workflowUc: workflowUc,
+	}
+}
+
+// ListWorkflows handles GET /workflows
+func (w *WorkflowHandlerImpl) ListWorkflows(c *gin.Context) {
+	ucResp, err := w.workflowUc.ListWorkflows(c)
+	if err != nil {
+		writeError(c, w.logger, err)
+		return
+	}
+
+	workflows := make([]v2.WorkflowItem, len(ucResp.Workflows))
+	for i, workflow := range ucResp.Workflows {
+		workflows[i] = toWorkflowItem(workflow)
+	}
+
+	c.JSON(http.StatusOK, v2.ListWorkflowsResponse{
+		Workflows: workflows,
+	})
+}
+
+// CreateWorkflow handles POST /workflows
+func (w *WorkflowHandlerImpl) CreateWorkflow(c *gin.Context) {
+	var httpReq v2.CreateWorkflowRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		w.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	ucResp, err := w.workflowUc.CreateWorkflow(c, usecase.CreateWorkflowRequest{
+		Name:     httpReq.Name,
+		Statuses: toWorkflowStatusRequests(httpReq.Statuses),
+	})
+	if err != nil {
+		writeError(c, w.logger, err)
+		return
+	}
+
+	// Return 201 with the location of the new resource
+	c.Header("Location", fmt.Sprintf("%s/%d", strings.TrimSuffix(c.Request.URL.Path, "/"), ucResp.ID))
+	c.JSON(http.StatusCreated, v2.CreateWorkflowResponse{
+		ID: ucResp.ID,
+	})
+}
+
+// GetWorkflow handles GET /workflows/:id
+func (w *WorkflowHandlerImpl) GetWorkflow(c *gin.Context) {
+	var uri v2.WorkflowURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		w.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	ucResp, err := w.workflowUc.GetWorkflow(c, uri.ID)
+	if err != nil {
+		writeError(c, w.logger, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toWorkflowItem(*ucResp))
+}
+
+// ReplaceWorkflow handles PUT /workflows/:id, replacing the name and statuses
+func (w *WorkflowHandlerImpl) ReplaceWorkflow(c *gin.Context) {
+	var uri v2.WorkflowURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		w.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	var httpReq v2.ReplaceWorkflowRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		w.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	if err := w.workflowUc.UpdateWorkflow(c, usecase.UpdateWorkflowRequest{
+		ID:       uri.ID,
+		Name:     httpReq.Name,
+		Statuses: toWorkflowStatusRequests(httpReq.Statuses),
+	}); err != nil {
+		writeError(c, w.logger, err)
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+// DeleteWorkflow handles DELETE /workflows/:id
+func (w *WorkflowHandlerImpl) DeleteWorkflow(c *gin.Context) {
+	var uri v2.WorkflowURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		w.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	if err := w.workflowUc.DeleteWorkflow(c, uri.ID); err != nil {
+		writeError(c, w.logger, err)
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+// toWorkflowStatusRequests converts the HTTP statuses to the usecase request
+func toWorkflowStatusRequests(statuses []v2.WorkflowStatusReq) []usecase.WorkflowStatusRequest {
+	reqs := make([]usecase.WorkflowStatusRequest, len(statuses))
+	for i, status := range statuses {
+		reqs[i] = usecase.WorkflowStatusRequest{
+			ID:       status.ID,
+			Name:     status.Name,
+			Category: status.Category,
+		}
+	}
+	return reqs
+}
+
+// toWorkflowItem converts a usecase workflow response to the HTTP DTO
+func toWorkflowItem(workflow usecase.WorkflowResponse) v2.WorkflowItem {
+	item := v2.WorkflowItem{
+		ID:        workflow.ID,
+		Name:      workflow.Name,
+		IsDefault: workflow.IsDefault,
+		Statuses:  make([]v2.WorkflowStatusItem, len(workflow.Statuses)),
+		CreatedAt: workflow.CreatedAt,
+		UpdatedAt: workflow.UpdatedAt,
+	}
+	for i, status := range workflow.Statuses {
+		item.Statuses[i] = v2.WorkflowStatusItem{
+			ID:       status.ID,
+			Name:     status.Name,
+			Category: status.Category,
+			Position: status.Position,
+		}
+	}
+	return item
+}
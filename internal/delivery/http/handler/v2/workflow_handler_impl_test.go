@@ -0,0 +1,240 @@
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	v2 "itmrchow/go-todolist-service/internal/delivery/http/dto/v2"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+)
+
+type WorkflowHandlerImplTestSuite struct {
+	suite.Suite
+	ctrl           *gomock.Controller
+	mockWorkflowUc *usecase.MockWorkflowUseCase
+	handler        *WorkflowHandlerImpl
+	engine         *gin.Engine
+}
+
+func TestWorkflowHandlerImplTestSuite(t *testing.T) {
+	suite.Run(t, new(WorkflowHandlerImplTestSuite))
+}
+
+func (suite *WorkflowHandlerImplTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockWorkflowUc = usecase.NewMockWorkflowUseCase(suite.ctrl)
+	suite.handler = NewWorkflowHandlerImpl(zerolog.New(os.Stdout), suite.mockWorkflowUc)
+
+	suite.engine = gin.New()
+	workflows := suite.engine.Group("/api/v2/workflows")
+	workflows.GET("", suite.handler.ListWorkflows)
+	workflows.POST("", suite.handler.CreateWorkflow)
+	workflows.GET("/:id", suite.handler.GetWorkflow)
+	workflows.PUT("/:id", suite.handler.ReplaceWorkflow)
+	workflows.DELETE("/:id", suite.handler.DeleteWorkflow)
+}
+
+func (suite *WorkflowHandlerImplTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+func (suite *WorkflowHandlerImplTestSuite) TestWorkflowHandlerImpl_ListWorkflows() {
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	suite.mockWorkflowUc.EXPECT().
+		ListWorkflows(gomock.Any()).
+		Return(&usecase.ListWorkflowsResponse{Workflows: []usecase.WorkflowResponse{
+			{ID: 1, Name: "default", IsDefault: true, Statuses: []usecase.WorkflowStatusResponse{
+				{ID: 1, Name: "pending", Category: "todo", Position: 0},
+			}, CreatedAt: now, UpdatedAt: now},
+		}}, nil).
+		Times(1)
+
+	w := serveJSON(suite.engine, http.MethodGet, "/api/v2/workflows", nil)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp v2.ListWorkflowsResponse
+	suite.NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Equal([]v2.WorkflowItem{{ID: 1, Name: "default", IsDefault: true, Statuses: []v2.WorkflowStatusItem{
+		{ID: 1, Name: "pending", Category: "todo", Position: 0},
+	}, CreatedAt: now, UpdatedAt: now}}, resp.Workflows)
+}
+
+func (suite *WorkflowHandlerImplTestSuite) TestWorkflowHandlerImpl_CreateWorkflow() {
+	statuses := []map[string]interface{}{
+		{"name": "backlog", "category": "todo"},
+		{"name": "shipped", "category": "done"},
+	}
+
+	tests := []struct {
+		name             string
+		body             interface{}
+		mockSetup        func()
+		expectedCode     int
+		expectedLocation string
+	}{
+		{
+			name:         "Missing Statuses",
+			body:         map[string]interface{}{"name": "release"},
+			mockSetup:    func() {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Unknown Category",
+			body: map[string]interface{}{"name": "release", "statuses": []map[string]interface{}{
+				{"name": "backlog", "category": "later"},
+			}},
+			mockSetup:    func() {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Name Conflict",
+			body: map[string]interface{}{"name": "release", "statuses": statuses},
+			mockSetup: func() {
+				suite.mockWorkflowUc.EXPECT().
+					CreateWorkflow(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("conflict: workflow name already exists")).
+					Times(1)
+			},
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name: "Success",
+			body: map[string]interface{}{"name": "release", "statuses": statuses},
+			mockSetup: func() {
+				suite.mockWorkflowUc.EXPECT().
+					CreateWorkflow(gomock.Any(), usecase.CreateWorkflowRequest{Name: "release", Statuses: []usecase.WorkflowStatusRequest{
+						{Name: "backlog", Category: "todo"},
+						{Name: "shipped", Category: "done"},
+					}}).
+					Return(&usecase.CreateWorkflowResponse{ID: 2}, nil).
+					Times(1)
+			},
+			expectedCode:     http.StatusCreated,
+			expectedLocation: "/api/v2/workflows/2",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			w := serveJSON(suite.engine, http.MethodPost, "/api/v2/workflows", tt.body)
+
+			suite.Equal(tt.expectedCode, w.Code)
+			suite.Equal(tt.expectedLocation, w.Header().Get("Location"))
+		})
+	}
+}
+
+func (suite *WorkflowHandlerImplTestSuite) TestWorkflowHandlerImpl_GetWorkflow() {
+	suite.mockWorkflowUc.EXPECT().
+		GetWorkflow(gomock.Any(), uint(9)).
+		Return(nil, errors.New("not found: workflow not found")).
+		Times(1)
+
+	w := serveJSON(suite.engine, http.MethodGet, "/api/v2/workflows/9", nil)
+	suite.Equal(http.StatusNotFound, w.Code)
+
+	suite.mockWorkflowUc.EXPECT().
+		GetWorkflow(gomock.Any(), uint(2)).
+		Return(&usecase.WorkflowResponse{ID: 2, Name: "release"}, nil).
+		Times(1)
+
+	w = serveJSON(suite.engine, http.MethodGet, "/api/v2/workflows/2", nil)
+	suite.Equal(http.StatusOK, w.Code)
+	var resp v2.WorkflowItem
+	suite.NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Equal("release", resp.Name)
+}
+
+func (suite *WorkflowHandlerImplTestSuite) TestWorkflowHandlerImpl_ReplaceWorkflow() {
+	tests := []struct {
+		name         string
+		target       string
+		body         interface{}
+		mockSetup    func()
+		expectedCode int
+	}{
+		{
+			name:         "Invalid ID",
+			target:       "/api/v2/workflows/abc",
+			body:         map[string]interface{}{"name": "release"},
+			mockSetup:    func() {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:   "Removed Status In Use",
+			target: "/api/v2/workflows/2",
+			body: map[string]interface{}{"name": "release", "statuses": []map[string]interface{}{
+				{"id": 4, "name": "backlog", "category": "todo"},
+				{"id": 6, "name": "shipped", "category": "done"},
+			}},
+			mockSetup: func() {
+				suite.mockWorkflowUc.EXPECT().
+					UpdateWorkflow(gomock.Any(), gomock.Any()).
+					Return(errors.New("conflict: removed statuses are still used by 2 todos")).
+					Times(1)
+			},
+			expectedCode: http.StatusConflict,
+		},
+		{
+			name:   "Success",
+			target: "/api/v2/workflows/2",
+			body: map[string]interface{}{"name": "release", "statuses": []map[string]interface{}{
+				{"id": 4, "name": "todo", "category": "todo"},
+				{"name": "shipped", "category": "done"},
+			}},
+			mockSetup: func() {
+				suite.mockWorkflowUc.EXPECT().
+					UpdateWorkflow(gomock.Any(), usecase.UpdateWorkflowRequest{ID: 2, Name: "release", Statuses: []usecase.WorkflowStatusRequest{
+						{ID: 4, Name: "todo", Category: "todo"},
+						{Name: "shipped", Category: "done"},
+					}}).
+					Return(nil).
+					Times(1)
+			},
+			expectedCode: http.StatusNoContent,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.mockSetup()
+
+			w := serveJSON(suite.engine, http.MethodPut, tt.target, tt.body)
+
+			suite.Equal(tt.expectedCode, w.Code)
+		})
+	}
+}
+
+func (suite *WorkflowHandlerImplTestSuite) TestWorkflowHandlerImpl_DeleteWorkflow() {
+	suite.mockWorkflowUc.EXPECT().
+		DeleteWorkflow(gomock.Any(), uint(1)).
+		Return(errors.New("conflict: default workflow cannot be deleted")).
+		Times(1)
+
+	w := serveJSON(suite.engine, http.MethodDelete, "/api/v2/workflows/1", nil)
+	suite.Equal(http.StatusConflict, w.Code)
+
+	suite.mockWorkflowUc.EXPECT().
+		DeleteWorkflow(gomock.Any(), uint(2)).
+		Return(nil).
+		Times(1)
+
+	w = serveJSON(suite.engine, http.MethodDelete, "/api/v2/workflows/2", nil)
+	suite.Equal(http.StatusNoContent, w.Code)
+}
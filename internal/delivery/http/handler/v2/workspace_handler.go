@@ -0,0 +1,12 @@
+package v2
+
+import "github.com/gin-gonic/gin"
+
+type WorkspaceHandler interface {
+	ListWorkspaces(c *gin.Context)
+	CreateWorkspace(c *gin.Context)
+	ListMembers(c *gin.Context)
+	AddMember(c *gin.Context)
+	ChangeMemberRole(c *gin.Context)
+	RemoveMember(c *gin.Context)
+}
@@ -0,0 +1,192 @@
+package v2
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	v2 "itmrchow/go-todolist-service/internal/delivery/http/dto/v2"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+)
+
+var _ WorkspaceHandler = &WorkspaceHandlerImpl{}
+
+// WorkspaceHandlerImpl serves the workspaces of the signed-in user in the v2 API
+type WorkspaceHandlerImpl struct {
+	logger      zerolog.Logger
+	workspaceUc usecase.WorkspaceUseCase
+}
+
+func NewWorkspaceHandlerImpl(logger zerolog.Logger, workspaceUc usecase.WorkspaceUseCase) *WorkspaceHandlerImpl {
+	return &WorkspaceHandlerImpl{
+		logger:      logger,
+		workspaceUc: workspaceUc,
+	}
+}
+
+// ListWorkspaces handles GET /workspaces
+func (h *WorkspaceHandlerImpl) ListWorkspaces(c *gin.Context) {
+	ucResp, err := h.workspaceUc.ListWorkspaces(c)
+	if err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	workspaces := make([]v2.Workspace, len(ucResp.Workspaces))
+	for i, workspace := range ucResp.Workspaces {
+		workspaces[i] = v2.Workspace{
+			ID:        workspace.ID,
+			Name:      workspace.Name,
+			CreatedAt: workspace.CreatedAt,
+			UpdatedAt: workspace.UpdatedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, v2.ListWorkspacesResponse{
+		Workspaces: workspaces,
+	})
+}
+
+// CreateWorkspace handles POST /workspaces
+func (h *WorkspaceHandlerImpl) CreateWorkspace(c *gin.Context) {
+	var httpReq v2.CreateWorkspaceRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	ucResp, err := h.workspaceUc.CreateWorkspace(c, usecase.CreateWorkspaceRequest{
+		Name: httpReq.Name,
+	})
+	if err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	// Return 201 with the location of the new resource
+	c.Header("Location", fmt.Sprintf("%s/%d", strings.TrimSuffix(c.Request.URL.Path, "/"), ucResp.ID))
+	c.JSON(http.StatusCreated, v2.CreateWorkspaceResponse{
+		ID: ucResp.ID,
+	})
+}
+
+// ListMembers handles GET /workspaces/:id/members
+func (h *WorkspaceHandlerImpl) ListMembers(c *gin.Context) {
+	var uri v2.WorkspaceURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	ucResp, err := h.workspaceUc.ListMembers(c, uri.ID)
+	if err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	members := make([]v2.WorkspaceMember, len(ucResp.Members))
+	for i, member := range ucResp.Members {
+		members[i] = v2.WorkspaceMember{
+			UserID:    member.UserID,
+			Email:     member.Email,
+			Role:      member.Role,
+			CreatedAt: member.CreatedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, v2.ListWorkspaceMembersResponse{
+		Members: members,
+	})
+}
+
+// AddMember handles POST /workspaces/:id/members
+func (h *WorkspaceHandlerImpl) AddMember(c *gin.Context) {
+	var uri v2.WorkspaceURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	var httpReq v2.AddWorkspaceMemberRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	if err := h.workspaceUc.AddMember(c, usecase.AddWorkspaceMemberRequest{
+		WorkspaceID: uri.ID,
+		Email:       httpReq.Email,
+		Role:        httpReq.Role,
+	}); err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+// ChangeMemberRole handles PATCH /workspaces/:id/members/:user_id
+func (h *WorkspaceHandlerImpl) ChangeMemberRole(c *gin.Context) {
+	var uri v2.WorkspaceMemberURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	var httpReq v2.ChangeWorkspaceMemberRoleRequest
+	if err := c.ShouldBindJSON(&httpReq); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	if err := h.workspaceUc.ChangeMemberRole(c, usecase.ChangeWorkspaceMemberRoleRequest{
+		WorkspaceID: uri.ID,
+		UserID:      uri.UserID,
+		Role:        httpReq.Role,
+	}); err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
+
+// RemoveMember handles DELETE /workspaces/:id/members/:user_id
+func (h *WorkspaceHandlerImpl) RemoveMember(c *gin.Context) {
+	var uri v2.WorkspaceMemberURI
+	if err := c.ShouldBindUri(&uri); err != nil {
+		h.logger.Error().Err(err).Msg("invalid request format")
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "invalid request format",
+		})
+		return
+	}
+
+	if err := h.workspaceUc.RemoveMember(c, uri.ID, uri.UserID); err != nil {
+		writeError(c, h.logger, err)
+		return
+	}
+
+	c.AbortWithStatus(http.StatusNoContent)
+}
@@ -0,0 +1,180 @@
+package v2
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	v2 "itmrchow/go-todolist-service/internal/delivery/http/dto/v2"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+)
+
+type WorkspaceHandlerImplTestSuite struct {
+	suite.Suite
+	ctrl            *gomock.Controller
+	mockWorkspaceUc *usecase.MockWorkspaceUseCase
+	handler         *WorkspaceHandlerImpl
+	engine          *gin.Engine
+}
+
+func TestWorkspaceHandlerImplTestSuite(t *testing.T) {
+	suite.Run(t, new(WorkspaceHandlerImplTestSuite))
+}
+
+func (suite *WorkspaceHandlerImplTestSuite) SetupTest() {
+	gin.SetMode(gin.TestMode)
+
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockWorkspaceUc = usecase.NewMockWorkspaceUseCase(suite.ctrl)
+	suite.handler = NewWorkspaceHandlerImpl(zerolog.New(os.Stdout), suite.mockWorkspaceUc)
+
+	suite.engine = gin.New()
+	workspaces := suite.engine.Group("/api/v2/workspaces")
+	workspaces.GET("", suite.handler.ListWorkspaces)
+	workspaces.POST("", suite.handler.CreateWorkspace)
+	workspaces.GET("/:id/members", suite.handler.ListMembers)
+	workspaces.POST("/:id/members", suite.handler.AddMember)
+	workspaces.PATCH("/:id/members/:user_id", suite.handler.ChangeMemberRole)
+	workspaces.DELETE("/:id/members/:user_id", suite.handler.RemoveMember)
+}
+
+func (suite *WorkspaceHandlerImplTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+func (suite *WorkspaceHandlerImplTestSuite) TestWorkspaceHandlerImpl_ListWorkspaces() {
+	suite.mockWorkspaceUc.EXPECT().
+		ListWorkspaces(gomock.Any()).
+		Return(&usecase.ListWorkspacesResponse{Workspaces: []usecase.WorkspaceResponse{{ID: 2, Name: "平台團隊"}}}, nil).
+		Times(1)
+
+	w := serveJSON(suite.engine, http.MethodGet, "/api/v2/workspaces", nil)
+
+	suite.Equal(http.StatusOK, w.Code)
+	var resp v2.ListWorkspacesResponse
+	suite.NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+	suite.Equal([]v2.Workspace{{ID: 2, Name: "平台團隊"}}, resp.Workspaces)
+}
+
+func (suite *WorkspaceHandlerImplTestSuite) TestWorkspaceHandlerImpl_CreateWorkspace() {
+	suite.Run("Success", func() {
+		suite.mockWorkspaceUc.EXPECT().
+			CreateWorkspace(gomock.Any(), usecase.CreateWorkspaceRequest{Name: "平台團隊"}).
+			Return(&usecase.CreateWorkspaceResponse{ID: 2}, nil).
+			Times(1)
+
+		w := serveJSON(suite.engine, http.MethodPost, "/api/v2/workspaces", map[string]string{"name": "平台團隊"})
+
+		suite.Equal(http.StatusCreated, w.Code)
+		suite.Equal("/api/v2/workspaces/2", w.Header().Get("Location"))
+	})
+
+	suite.Run("Missing Name", func() {
+		w := serveJSON(suite.engine, http.MethodPost, "/api/v2/workspaces", map[string]string{})
+
+		suite.Equal(http.StatusBadRequest, w.Code)
+	})
+}
+
+func (suite *WorkspaceHandlerImplTestSuite) TestWorkspaceHandlerImpl_Members() {
+	suite.Run("List", func() {
+		suite.mockWorkspaceUc.EXPECT().
+			ListMembers(gomock.Any(), uint(2)).
+			Return(&usecase.ListWorkspaceMembersResponse{Members: []usecase.WorkspaceMemberResponse{{UserID: 7, Email: "alice@example.com", Role: "owner"}}}, nil).
+			Times(1)
+
+		w := serveJSON(suite.engine, http.MethodGet, "/api/v2/workspaces/2/members", nil)
+
+		suite.Equal(http.StatusOK, w.Code)
+		var resp v2.ListWorkspaceMembersResponse
+		suite.NoError(json.Unmarshal(w.Body.Bytes(), &resp))
+		suite.Equal([]v2.WorkspaceMember{{UserID: 7, Email: "alice@example.com", Role: "owner"}}, resp.Members)
+	})
+
+	suite.Run("Add", func() {
+		suite.mockWorkspaceUc.EXPECT().
+			AddMember(gomock.Any(), usecase.AddWorkspaceMemberRequest{WorkspaceID: 2, Email: "bob@example.com", Role: "viewer"}).
+			Return(nil).
+			Times(1)
+
+		w := serveJSON(suite.engine, http.MethodPost, "/api/v2/workspaces/2/members", map[string]string{"email": "bob@example.com", "role": "viewer"})
+
+		suite.Equal(http.StatusNoContent, w.Code)
+	})
+
+	suite.Run("Another Team", func() {
+		suite.mockWorkspaceUc.EXPECT().
+			AddMember(gomock.Any(), gomock.Any()).
+			Return(errors.New("not found: workspace not found")).
+			Times(1)
+
+		w := serveJSON(suite.engine, http.MethodPost, "/api/v2/workspaces/3/members", map[string]string{"email": "bob@example.com"})
+
+		suite.Equal(http.StatusNotFound, w.Code)
+	})
+
+	suite.Run("Add Forbidden", func() {
+		suite.mockWorkspaceUc.EXPECT().
+			AddMember(gomock.Any(), gomock.Any()).
+			Return(errors.New("forbidden: the member role may not manage_members")).
+			Times(1)
+
+		w := serveJSON(suite.engine, http.MethodPost, "/api/v2/workspaces/2/members", map[string]string{"email": "bob@example.com"})
+
+		suite.Equal(http.StatusForbidden, w.Code)
+	})
+
+	suite.Run("Change Role", func() {
+		suite.mockWorkspaceUc.EXPECT().
+			ChangeMemberRole(gomock.Any(), usecase.ChangeWorkspaceMemberRoleRequest{WorkspaceID: 2, UserID: 8, Role: "admin"}).
+			Return(nil).
+			Times(1)
+
+		w := serveJSON(suite.engine, http.MethodPatch, "/api/v2/workspaces/2/members/8", map[string]string{"role": "admin"})
+
+		suite.Equal(http.StatusNoContent, w.Code)
+	})
+
+	suite.Run("Change Role Missing Role", func() {
+		w := serveJSON(suite.engine, http.MethodPatch, "/api/v2/workspaces/2/members/8", map[string]string{})
+
+		suite.Equal(http.StatusBadRequest, w.Code)
+	})
+
+	suite.Run("Demote Last Owner", func() {
+		suite.mockWorkspaceUc.EXPECT().
+			ChangeMemberRole(gomock.Any(), gomock.Any()).
+			Return(errors.New("conflict: the workspace needs at least one owner")).
+			Times(1)
+
+		w := serveJSON(suite.engine, http.MethodPatch, "/api/v2/workspaces/2/members/7", map[string]string{"role": "admin"})
+
+		suite.Equal(http.StatusConflict, w.Code)
+	})
+
+	suite.Run("Remove", func() {
+		suite.mockWorkspaceUc.EXPECT().
+			RemoveMember(gomock.Any(), uint(2), uint(8)).
+			Return(nil).
+			Times(1)
+
+		w := serveJSON(suite.engine, http.MethodDelete, "/api/v2/workspaces/2/members/8", nil)
+
+		suite.Equal(http.StatusNoContent, w.Code)
+	})
+
+	suite.Run("Remove Invalid User ID", func() {
+		w := serveJSON(suite.engine, http.MethodDelete, "/api/v2/workspaces/2/members/bob", nil)
+
+		suite.Equal(http.StatusBadRequest, w.Code)
+	})
+}
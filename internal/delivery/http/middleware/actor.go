@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+// ActorHeader names the request header identifying who performs the request
+const ActorHeader = "X-Actor"
+
+// maxActorLength matches the actor column of the audit tables
+const maxActorLength = 100
+
+// Actor returns a middleware that stores the X-Actor header in the request context,
+// the engine needs ContextWithFallback so handlers passing *gin.Context can read it
+func Actor() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		name := []rune(strings.TrimSpace(c.GetHeader(ActorHeader)))
+		if len(name) > maxActorLength {
+			name = name[:maxActorLength]
+		}
+		if len(name) > 0 {
+			c.Request = c.Request.WithContext(actor.WithName(c.Request.Context(), string(name)))
+		}
+
+		c.Next()
+	}
+}
@@ -2,21 +2,29 @@ package middleware
 
 import (
 	"net/http"
+	"slices"
 
 	"github.com/gin-gonic/gin"
 )
 
-// CORS returns a middleware that handles Cross-Origin Resource Sharing
-func CORS() gin.HandlerFunc {
+// CORS returns a middleware that handles Cross-Origin Resource Sharing,
+// only the configured origins are allowed and a "*" entry allows every origin.
+// Requests authenticate with a bearer token, so credentials are never allowed
+func CORS(allowedOrigins []string) gin.HandlerFunc {
+	allowAll := slices.Contains(allowedOrigins, "*")
+
 	return func(c *gin.Context) {
 		origin := c.Request.Header.Get("Origin")
+		c.Header("Vary", "Origin")
 
-		// 設定 CORS 標頭
-		c.Header("Access-Control-Allow-Origin", origin)
-		c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Requested-With")
-		c.Header("Access-Control-Allow-Credentials", "true")
-		c.Header("Access-Control-Max-Age", "86400")
+		// 設定 CORS 標頭，未允許的來源不回傳 CORS 標頭，由瀏覽器擋下
+		if origin != "" && (allowAll || slices.Contains(allowedOrigins, origin)) {
+			c.Header("Access-Control-Allow-Origin", origin)
+			c.Header("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+			c.Header("Access-Control-Allow-Headers", "Content-Type, Authorization, X-Requested-With, X-Actor, If-Match, "+IdempotencyKeyHeader+", "+WorkspaceHeader)
+			c.Header("Access-Control-Expose-Headers", "Location, ETag, "+IdempotentReplayedHeader+", "+WorkspaceHeader)
+			c.Header("Access-Control-Max-Age", "86400")
+		}
 
 		// 處理 preflight 請求
 		if c.Request.Method == "OPTIONS" {
@@ -0,0 +1,163 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog"
+
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+)
+
+// IdempotencyKeyHeader names the request header a client sets to make a retried request safe
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotentReplayedHeader is set on responses replayed from an earlier request with the same key
+const IdempotentReplayedHeader = "Idempotent-Replayed"
+
+// replayedHeaders are the response headers stored with a key and sent again on replay
+var replayedHeaders = []string{"Content-Type", "Location", "ETag"}
+
+// Idempotency returns a middleware that makes POST, PUT, PATCH and DELETE requests sent with an
+// Idempotency-Key header run once: a retry with the same key gets the stored response back,
+// a key reused for a different request is answered with 422 and a retry while the first request
+// is still running with 409. Server errors release the key so the client may retry.
+// Keyed request bodies over maxBodySize bytes are answered with 413 before they are read in full.
+// It must run after Workspace, keys are scoped to the signed-in user and the workspace is part of the request
+func Idempotency(logger zerolog.Logger, idempotencyUc usecase.IdempotencyUseCase, maxBodySize int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" || !isMutating(c.Request.Method) {
+			c.Next()
+			return
+		}
+
+		var body []byte
+		if c.Request.Body != nil {
+			var err error
+			body, err = io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxBodySize))
+			if err != nil {
+				var maxBytesErr *http.MaxBytesError
+				if errors.As(err, &maxBytesErr) {
+					c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, gin.H{
+						"error": fmt.Sprintf("too large: request body cannot exceed %d bytes", maxBodySize),
+					})
+					return
+				}
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "failed to read request body"})
+				return
+			}
+			c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		ctx := c.Request.Context()
+		replay, err := idempotencyUc.Begin(ctx, usecase.BeginIdempotencyRequest{
+			Key:    key,
+			Method: c.Request.Method,
+			Path:   c.Request.URL.RequestURI(),
+			Body:   body,
+		})
+		if err != nil {
+			switch {
+			case strings.Contains(err.Error(), "unauthorized"):
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			case strings.Contains(err.Error(), "validation fail"):
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid " + IdempotencyKeyHeader + " header"})
+			case strings.Contains(err.Error(), "unprocessable"):
+				c.AbortWithStatusJSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			case strings.Contains(err.Error(), "conflict"):
+				c.AbortWithStatusJSON(http.StatusConflict, gin.H{"error": err.Error()})
+			default:
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+			}
+			return
+		}
+
+		// 重複的請求，回傳第一次請求的回應
+		if replay != nil {
+			for name, value := range replay.Headers {
+				c.Header(name, value)
+			}
+			c.Header(IdempotentReplayedHeader, "true")
+			if len(replay.Body) == 0 {
+				c.AbortWithStatus(replay.StatusCode)
+				return
+			}
+			c.Data(replay.StatusCode, replay.Headers["Content-Type"], replay.Body)
+			c.Abort()
+			return
+		}
+
+		// The response is stored after the client got it, a cancelled request still completes its key
+		storeCtx := context.WithoutCancel(ctx)
+		writer := &recordingWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		completed := false
+		defer func() {
+			// A panicking handler releases the key, the panic goes on to the recovery middleware
+			if !completed {
+				completeKey(storeCtx, logger, idempotencyUc, usecase.CompleteIdempotencyRequest{
+					Key:        key,
+					StatusCode: http.StatusInternalServerError,
+				})
+			}
+		}()
+
+		c.Next()
+		completed = true
+
+		headers := make(map[string]string)
+		for _, name := range replayedHeaders {
+			if value := writer.Header().Get(name); value != "" {
+				headers[name] = value
+			}
+		}
+		completeKey(storeCtx, logger, idempotencyUc, usecase.CompleteIdempotencyRequest{
+			Key:        key,
+			StatusCode: writer.Status(),
+			Headers:    headers,
+			Body:       writer.body.Bytes(),
+		})
+	}
+}
+
+// completeKey stores the response of a key, the response was already sent so failures are only logged;
+// the key then stays in progress until it expires
+func completeKey(ctx context.Context, logger zerolog.Logger, idempotencyUc usecase.IdempotencyUseCase, req usecase.CompleteIdempotencyRequest) {
+	if err := idempotencyUc.Complete(ctx, req); err != nil {
+		logger.Error().Err(err).Str("key", req.Key).Msg("failed to complete idempotency key")
+	}
+}
+
+// isMutating reports whether requests with the method change data and may carry an idempotency key
+func isMutating(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// recordingWriter keeps a copy of the response body while writing it to the client
+type recordingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *recordingWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *recordingWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+// WorkspaceHeader names the request header choosing the workspace, the oldest workspace of the user when omitted
+const WorkspaceHeader = "X-Workspace-ID"
+
+// WorkspaceKey names the gin context key holding the *usecase.WorkspaceResponse the request works in
+const WorkspaceKey = "workspace"
+
+// Workspace returns a middleware that resolves the workspace of the signed-in user and stores its ID
+// and the role of the user in the request context, where the repositories pick the ID up to scope todos
+// and the usecases check the role. It must run after Auth;
+// a workspace the user is not a member of is answered with 404 like a missing one
+func Workspace(workspaceUc usecase.WorkspaceUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var workspaceID uint
+		if header := strings.TrimSpace(c.GetHeader(WorkspaceHeader)); header != "" {
+			id, err := strconv.ParseUint(header, 10, 64)
+			if err != nil || id == 0 {
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+					"error": "invalid " + WorkspaceHeader + " header",
+				})
+				return
+			}
+			workspaceID = uint(id)
+		}
+
+		workspace, err := workspaceUc.ResolveWorkspace(c.Request.Context(), workspaceID)
+		if err != nil {
+			switch {
+			case strings.Contains(err.Error(), "unauthorized"):
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			case strings.Contains(err.Error(), "not found"):
+				c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			default:
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+			}
+			return
+		}
+
+		ctx := actor.WithWorkspaceID(c.Request.Context(), workspace.ID)
+		c.Request = c.Request.WithContext(actor.WithWorkspaceRole(ctx, workspace.Role))
+		c.Set(WorkspaceKey, workspace)
+		c.Header(WorkspaceHeader, strconv.FormatUint(uint64(workspace.ID), 10))
+
+		c.Next()
+	}
+}
+
+// Authorize returns a middleware that answers 403 unless the workspace role of the request may
+// perform the action, it must run after Workspace
+func Authorize(action usecase.WorkspaceAction) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		checkRole(c, action)
+	}
+}
+
+// AuthorizeByMethod returns a middleware like Authorize where safe methods need the read action
+// and every other method the write action. The usecases still check actions that depend on the todo,
+// such as members deleting only their own todos
+func AuthorizeByMethod(read usecase.WorkspaceAction, write usecase.WorkspaceAction) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			checkRole(c, read)
+		default:
+			checkRole(c, write)
+		}
+	}
+}
+
+// checkRole aborts with 403 when the workspace role carried by the request may not perform the action
+func checkRole(c *gin.Context, action usecase.WorkspaceAction) {
+	role := entity.WorkspaceRole(actor.WorkspaceRole(c.Request.Context()))
+	if err := usecase.Authorize(role, action); err != nil {
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Next()
+}
@@ -0,0 +1,165 @@
+package entity
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// APIKeyScope grants an API key access to part of the API
+type APIKeyScope string
+
+const (
+	ScopeTodosRead  APIKeyScope = "todos:read"  // read todos, tags and workflows
+	ScopeTodosWrite APIKeyScope = "todos:write" // create, change and delete todos, tags and workflows
+)
+
+// APIKeyPrefix starts every API key, so keys are told apart from access tokens
+// and can be found by secret scanners
+const APIKeyPrefix = "tdk_"
+
+// AllAPIKeyScopes returns every scope an API key can be granted
+func AllAPIKeyScopes() []APIKeyScope {
+	return []APIKeyScope{ScopeTodosRead, ScopeTodosWrite}
+}
+
+// APIKey represents a long-lived key that signs scripts and bots in as its user.
+// The key is shaped tdk_<lookup>_<secret>, only its prefix tdk_<lookup> and a hash are stored
+type APIKey struct {
+	ID         uint          `json:"id"`
+	UserID     uint          `json:"user_id"`
+	Name       string        `json:"name"`
+	Prefix     string        `json:"prefix"` // visible start of the key, lets users tell their keys apart
+	KeyHash    string        `json:"-"`      // hex SHA-256 of the whole key
+	Scopes     []APIKeyScope `json:"scopes"`
+	LastUsedAt *time.Time    `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time    `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+// NewAPIKey creates a new APIKey with validation and returns it with the key itself,
+// which is not stored and cannot be shown again
+func NewAPIKey(userID uint, name string, scopes []APIKeyScope) (*APIKey, string, error) {
+	if userID == 0 {
+		return nil, "", errors.New("user ID cannot be 0")
+	}
+
+	name = strings.TrimSpace(name)
+	if len(name) == 0 {
+		return nil, "", errors.New("api key name cannot be empty")
+	}
+	if len([]rune(name)) > 100 {
+		return nil, "", errors.New("api key name cannot exceed 100 characters")
+	}
+
+	scopes, err := normalizeScopes(scopes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	lookup := make([]byte, 6)
+	secret := make([]byte, 32)
+	if _, err := rand.Read(lookup); err != nil {
+		return nil, "", err
+	}
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", err
+	}
+	prefix := APIKeyPrefix + hex.EncodeToString(lookup)
+	key := prefix + "_" + base64.RawURLEncoding.EncodeToString(secret)
+
+	return &APIKey{
+		UserID:    userID,
+		Name:      name,
+		Prefix:    prefix,
+		KeyHash:   HashAPIKey(key),
+		Scopes:    scopes,
+		CreatedAt: time.Now().UTC(),
+	}, key, nil
+}
+
+// ParseAPIKeyPrefix returns the stored prefix of a key, false when the key is not shaped like an API key
+func ParseAPIKeyPrefix(key string) (string, bool) {
+	if !strings.HasPrefix(key, APIKeyPrefix) {
+		return "", false
+	}
+
+	lookup, secret, found := strings.Cut(strings.TrimPrefix(key, APIKeyPrefix), "_")
+	if !found || len(lookup) != 12 || len(secret) == 0 {
+		return "", false
+	}
+	if _, err := hex.DecodeString(lookup); err != nil {
+		return "", false
+	}
+
+	return APIKeyPrefix + lookup, true
+}
+
+// HashAPIKey returns the hex SHA-256 of a key, keys are random so a slow hash is not needed
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// Matches reports whether key is this API key, in constant time
+func (k *APIKey) Matches(key string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashAPIKey(key)), []byte(k.KeyHash)) == 1
+}
+
+// HasScope reports whether the key was granted the scope
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRevoked reports whether the key was revoked
+func (k *APIKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+// Revoke marks the key as revoked, revoking twice keeps the first time
+func (k *APIKey) Revoke() {
+	if k.RevokedAt != nil {
+		return
+	}
+	now := time.Now().UTC()
+	k.RevokedAt = &now
+}
+
+// normalizeScopes validates the scopes and removes duplicates, at least one scope is required
+func normalizeScopes(scopes []APIKeyScope) ([]APIKeyScope, error) {
+	if len(scopes) == 0 {
+		return nil, errors.New("api key needs at least one scope")
+	}
+
+	normalized := make([]APIKeyScope, 0, len(scopes))
+	seen := make(map[APIKeyScope]bool, len(scopes))
+	for _, scope := range scopes {
+		valid := false
+		for _, known := range AllAPIKeyScopes() {
+			if scope == known {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf("invalid api key scope: %s", scope)
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			normalized = append(normalized, scope)
+		}
+	}
+
+	return normalized, nil
+}
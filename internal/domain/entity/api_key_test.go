@@ -0,0 +1,128 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_api_key_new_api_key(t *testing.T) {
+	tests := []struct {
+		name       string
+		userID     uint
+		keyName    string
+		scopes     []APIKeyScope
+		wantScopes []APIKeyScope
+		wantErr    bool
+		errMsg     string
+	}{
+		{
+			name:       "valid_api_key",
+			userID:     1,
+			keyName:    " ci bot ",
+			scopes:     []APIKeyScope{ScopeTodosRead, ScopeTodosWrite, ScopeTodosRead},
+			wantScopes: []APIKeyScope{ScopeTodosRead, ScopeTodosWrite},
+		},
+		{
+			name:    "missing_user_should_fail",
+			keyName: "ci bot",
+			scopes:  []APIKeyScope{ScopeTodosRead},
+			wantErr: true,
+			errMsg:  "user ID cannot be 0",
+		},
+		{
+			name:    "empty_name_should_fail",
+			userID:  1,
+			keyName: " ",
+			scopes:  []APIKeyScope{ScopeTodosRead},
+			wantErr: true,
+			errMsg:  "api key name cannot be empty",
+		},
+		{
+			name:    "long_name_should_fail",
+			userID:  1,
+			keyName: strings.Repeat("a", 101),
+			scopes:  []APIKeyScope{ScopeTodosRead},
+			wantErr: true,
+			errMsg:  "api key name cannot exceed 100 characters",
+		},
+		{
+			name:    "no_scope_should_fail",
+			userID:  1,
+			keyName: "ci bot",
+			wantErr: true,
+			errMsg:  "api key needs at least one scope",
+		},
+		{
+			name:    "unknown_scope_should_fail",
+			userID:  1,
+			keyName: "ci bot",
+			scopes:  []APIKeyScope{"todos:admin"},
+			wantErr: true,
+			errMsg:  "invalid api key scope: todos:admin",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiKey, key, err := NewAPIKey(tt.userID, tt.keyName, tt.scopes)
+
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				assert.Nil(t, apiKey)
+				assert.Empty(t, key)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "ci bot", apiKey.Name)
+			assert.Equal(t, tt.wantScopes, apiKey.Scopes)
+			assert.True(t, strings.HasPrefix(key, apiKey.Prefix+"_"))
+			assert.NotContains(t, apiKey.KeyHash, key)
+			assert.True(t, apiKey.Matches(key))
+			assert.False(t, apiKey.Matches(key+"x"))
+
+			prefix, ok := ParseAPIKeyPrefix(key)
+			assert.True(t, ok)
+			assert.Equal(t, apiKey.Prefix, prefix)
+		})
+	}
+}
+
+func Test_api_key_parse_prefix(t *testing.T) {
+	tests := []struct {
+		name string
+		key  string
+		want bool
+	}{
+		{name: "valid", key: "tdk_0123456789ab_c2VjcmV0", want: true},
+		{name: "access_token", key: "eyJhbGciOiJIUzI1NiJ9.e30.sig"},
+		{name: "missing_secret", key: "tdk_0123456789ab_"},
+		{name: "short_lookup", key: "tdk_0123_c2VjcmV0"},
+		{name: "lookup_not_hex", key: "tdk_0123456789xy_c2VjcmV0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, ok := ParseAPIKeyPrefix(tt.key)
+
+			assert.Equal(t, tt.want, ok)
+		})
+	}
+}
+
+func Test_api_key_revoke(t *testing.T) {
+	apiKey, _, err := NewAPIKey(1, "ci bot", []APIKeyScope{ScopeTodosRead})
+	assert.NoError(t, err)
+	assert.False(t, apiKey.IsRevoked())
+	assert.True(t, apiKey.HasScope(ScopeTodosRead))
+	assert.False(t, apiKey.HasScope(ScopeTodosWrite))
+
+	apiKey.Revoke()
+	revokedAt := *apiKey.RevokedAt
+	apiKey.Revoke()
+
+	assert.True(t, apiKey.IsRevoked())
+	assert.Equal(t, revokedAt, *apiKey.RevokedAt)
+}
@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// Assignment records that a user is responsible for a todo, a todo can have several assignees
+type Assignment struct {
+	TodoID    uint      `json:"todo_id"`
+	UserID    uint      `json:"user_id"` // the assignee
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewAssignment creates a new Assignment with validation
+func NewAssignment(todoID uint, userID uint) (*Assignment, error) {
+	if todoID == 0 {
+		return nil, errors.New("todo ID cannot be 0")
+	}
+	if userID == 0 {
+		return nil, errors.New("user ID cannot be 0")
+	}
+
+	return &Assignment{
+		TodoID:    todoID,
+		UserID:    userID,
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_assignment_new_assignment(t *testing.T) {
+	assignment, err := NewAssignment(1, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), assignment.TodoID)
+	assert.Equal(t, uint(7), assignment.UserID)
+	assert.False(t, assignment.CreatedAt.IsZero())
+
+	_, err = NewAssignment(0, 7)
+	assert.EqualError(t, err, "todo ID cannot be 0")
+
+	_, err = NewAssignment(1, 0)
+	assert.EqualError(t, err, "user ID cannot be 0")
+}
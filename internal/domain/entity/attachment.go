@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Attachment represents the metadata of a file uploaded to a todo
+// The content itself lives in a blob store under StorageKey
+type Attachment struct {
+	ID          uint      `json:"id"`
+	TodoID      uint      `json:"todo_id"`
+	Name        string    `json:"name"`
+	Size        int64     `json:"size"`
+	ContentType string    `json:"content_type"`
+	SHA256      string    `json:"sha256"`             // hex encoded checksum of the content
+	StorageKey  string    `json:"-"`                  // key of the content in the blob store, never exposed
+	Uploader    string    `json:"uploader,omitempty"` // actor who uploaded the file, empty when unknown
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// NewAttachment creates a new Attachment with validation
+// Directory parts of the name are dropped, only the base file name is kept
+func NewAttachment(todoID uint, name string, uploader string) (*Attachment, error) {
+	if todoID == 0 {
+		return nil, errors.New("todo ID cannot be 0")
+	}
+
+	if i := strings.LastIndexAny(name, `/\`); i >= 0 {
+		name = name[i+1:]
+	}
+	name = strings.TrimSpace(name)
+	if len(name) == 0 || name == "." || name == ".." {
+		return nil, errors.New("attachment name cannot be empty")
+	}
+	if len([]rune(name)) > 255 {
+		return nil, errors.New("attachment name cannot exceed 255 characters")
+	}
+
+	return &Attachment{
+		TodoID:    todoID,
+		Name:      name,
+		Uploader:  uploader,
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
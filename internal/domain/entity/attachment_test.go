@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_attachment_new_attachment(t *testing.T) {
+	tests := []struct {
+		name     string
+		todoID   uint
+		fileName string
+		wantName string
+		wantErr  bool
+		errMsg   string
+	}{
+		{
+			name:     "valid_attachment",
+			todoID:   1,
+			fileName: " 報價單.pdf ",
+			wantName: "報價單.pdf",
+		},
+		{
+			name:     "directory_parts_are_dropped",
+			todoID:   1,
+			fileName: `C:\Users\alice\..\report.txt`,
+			wantName: "report.txt",
+		},
+		{
+			name:     "zero_todo_id_should_fail",
+			todoID:   0,
+			fileName: "report.txt",
+			wantErr:  true,
+			errMsg:   "todo ID cannot be 0",
+		},
+		{
+			name:     "empty_name_should_fail",
+			todoID:   1,
+			fileName: "uploads/",
+			wantErr:  true,
+			errMsg:   "attachment name cannot be empty",
+		},
+		{
+			name:     "dot_dot_name_should_fail",
+			todoID:   1,
+			fileName: "../..",
+			wantErr:  true,
+			errMsg:   "attachment name cannot be empty",
+		},
+		{
+			name:     "name_too_long_should_fail",
+			todoID:   1,
+			fileName: strings.Repeat("a", 256),
+			wantErr:  true,
+			errMsg:   "attachment name cannot exceed 255 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attachment, err := NewAttachment(tt.todoID, tt.fileName, "alice")
+
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				assert.Nil(t, attachment)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.todoID, attachment.TodoID)
+			assert.Equal(t, tt.wantName, attachment.Name)
+			assert.Equal(t, "alice", attachment.Uploader)
+			assert.False(t, attachment.CreatedAt.IsZero())
+		})
+	}
+}
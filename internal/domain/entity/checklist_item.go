@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// ChecklistItem represents a single step of a todo
+type ChecklistItem struct {
+	ID        uint      `json:"id"`
+	TodoID    uint      `json:"todo_id"`
+	Text      string    `json:"text"`
+	Done      bool      `json:"done"`
+	Position  int       `json:"position"` // 0-based order within the todo
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewChecklistItem creates a new open ChecklistItem with validation
+func NewChecklistItem(todoID uint, text string) (*ChecklistItem, error) {
+	if todoID == 0 {
+		return nil, errors.New("todo ID cannot be 0")
+	}
+
+	now := time.Now().UTC()
+	item := &ChecklistItem{
+		TodoID:    todoID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := item.SetText(text); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// SetText changes the text of the item, surrounding spaces are trimmed
+func (i *ChecklistItem) SetText(text string) error {
+	text = strings.TrimSpace(text)
+	if len(text) == 0 {
+		return errors.New("checklist item text cannot be empty")
+	}
+	if len([]rune(text)) > 100 {
+		return errors.New("checklist item text cannot exceed 100 characters")
+	}
+
+	i.Text = text
+	i.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// SetDone checks or unchecks the item
+func (i *ChecklistItem) SetDone(done bool) {
+	i.Done = done
+	i.UpdatedAt = time.Now().UTC()
+}
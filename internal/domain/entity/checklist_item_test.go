@@ -0,0 +1,104 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_checklist_item_new_checklist_item(t *testing.T) {
+	tests := []struct {
+		name     string
+		todoID   uint
+		text     string
+		wantText string
+		wantErr  bool
+		errMsg   string
+	}{
+		{
+			name:     "valid_item_is_open",
+			todoID:   1,
+			text:     "  買牛奶  ",
+			wantText: "買牛奶",
+		},
+		{
+			name:    "zero_todo_id_should_fail",
+			todoID:  0,
+			text:    "買牛奶",
+			wantErr: true,
+			errMsg:  "todo ID cannot be 0",
+		},
+		{
+			name:    "empty_text_should_fail",
+			todoID:  1,
+			text:    " ",
+			wantErr: true,
+			errMsg:  "checklist item text cannot be empty",
+		},
+		{
+			name:    "text_too_long_should_fail",
+			todoID:  1,
+			text:    strings.Repeat("字", 101),
+			wantErr: true,
+			errMsg:  "checklist item text cannot exceed 100 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item, err := NewChecklistItem(tt.todoID, tt.text)
+
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				assert.Nil(t, item)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.todoID, item.TodoID)
+			assert.Equal(t, tt.wantText, item.Text)
+			assert.False(t, item.Done)
+		})
+	}
+}
+
+func Test_checklist_item_set_done(t *testing.T) {
+	item, err := NewChecklistItem(1, "買牛奶")
+	assert.NoError(t, err)
+
+	item.SetDone(true)
+	assert.True(t, item.Done)
+
+	item.SetDone(false)
+	assert.False(t, item.Done)
+}
+
+func Test_todo_progress(t *testing.T) {
+	todo := &Todo{}
+	done, total := todo.Progress()
+	assert.Equal(t, 0, done)
+	assert.Equal(t, 0, total)
+
+	todo.Checklist = []ChecklistItem{{Done: true}, {Done: false}, {Done: true}}
+	done, total = todo.Progress()
+	assert.Equal(t, 2, done)
+	assert.Equal(t, 3, total)
+}
+
+func Test_todo_set_parent(t *testing.T) {
+	todo := &Todo{ID: 5}
+	parentID := uint(3)
+	selfID := uint(5)
+	zeroID := uint(0)
+
+	assert.NoError(t, todo.SetParent(&parentID))
+	assert.Equal(t, &parentID, todo.ParentID)
+
+	assert.EqualError(t, todo.SetParent(&selfID), "invalid parent")
+	assert.EqualError(t, todo.SetParent(&zeroID), "invalid parent")
+	assert.Equal(t, &parentID, todo.ParentID) // Unchanged on error
+
+	assert.NoError(t, todo.SetParent(nil))
+	assert.Nil(t, todo.ParentID)
+}
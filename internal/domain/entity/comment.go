@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Comment represents a message in the discussion thread of a todo
+type Comment struct {
+	ID        uint       `json:"id"`
+	TodoID    uint       `json:"todo_id"`
+	Author    string     `json:"author,omitempty"`    // actor who wrote the comment, empty when unknown
+	AuthorID  uint       `json:"author_id,omitempty"` // user who wrote the comment, 0 for comments written before accounts
+	Body      string     `json:"body"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	EditedAt  *time.Time `json:"edited_at,omitempty"` // last time the body was changed, nil when never edited
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+}
+
+// NewComment creates a new Comment with validation
+func NewComment(todoID uint, author string, body string) (*Comment, error) {
+	if todoID == 0 {
+		return nil, errors.New("todo ID cannot be 0")
+	}
+
+	now := time.Now().UTC()
+	comment := &Comment{
+		TodoID:    todoID,
+		Author:    author,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := comment.setBody(body); err != nil {
+		return nil, err
+	}
+
+	return comment, nil
+}
+
+// Edit replaces the body of the comment and marks it as edited
+func (c *Comment) Edit(body string) error {
+	if err := c.setBody(body); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	c.EditedAt = &now
+	c.UpdatedAt = now
+	return nil
+}
+
+// setBody validates the body, surrounding spaces are trimmed
+func (c *Comment) setBody(body string) error {
+	body = strings.TrimSpace(body)
+	if len(body) == 0 {
+		return errors.New("comment body cannot be empty")
+	}
+	if len([]rune(body)) > 2000 {
+		return errors.New("comment body cannot exceed 2000 characters")
+	}
+
+	c.Body = body
+	return nil
+}
@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_comment_new_comment(t *testing.T) {
+	tests := []struct {
+		name     string
+		todoID   uint
+		body     string
+		wantBody string
+		wantErr  bool
+		errMsg   string
+	}{
+		{
+			name:     "valid_comment",
+			todoID:   1,
+			body:     "  明天再確認  ",
+			wantBody: "明天再確認",
+		},
+		{
+			name:    "zero_todo_id_should_fail",
+			todoID:  0,
+			body:    "明天再確認",
+			wantErr: true,
+			errMsg:  "todo ID cannot be 0",
+		},
+		{
+			name:    "empty_body_should_fail",
+			todoID:  1,
+			body:    " ",
+			wantErr: true,
+			errMsg:  "comment body cannot be empty",
+		},
+		{
+			name:    "body_too_long_should_fail",
+			todoID:  1,
+			body:    strings.Repeat("字", 2001),
+			wantErr: true,
+			errMsg:  "comment body cannot exceed 2000 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comment, err := NewComment(tt.todoID, "alice", tt.body)
+
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				assert.Nil(t, comment)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.todoID, comment.TodoID)
+			assert.Equal(t, "alice", comment.Author)
+			assert.Equal(t, tt.wantBody, comment.Body)
+			assert.Nil(t, comment.EditedAt)
+		})
+	}
+}
+
+func Test_comment_edit(t *testing.T) {
+	comment, err := NewComment(1, "alice", "明天再確認")
+	assert.NoError(t, err)
+
+	assert.EqualError(t, comment.Edit(""), "comment body cannot be empty")
+	assert.Equal(t, "明天再確認", comment.Body)
+	assert.Nil(t, comment.EditedAt)
+
+	assert.NoError(t, comment.Edit("今天確認了"))
+	assert.Equal(t, "今天確認了", comment.Body)
+	assert.NotNil(t, comment.EditedAt)
+}
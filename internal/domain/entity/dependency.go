@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// Dependency records that a todo is blocked by another todo,
+// the blocked todo cannot start or finish until its blocker is done
+type Dependency struct {
+	TodoID    uint      `json:"todo_id"`    // the blocked todo
+	BlockerID uint      `json:"blocker_id"` // the todo that has to be done first
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewDependency creates a new Dependency with validation
+func NewDependency(todoID uint, blockerID uint) (*Dependency, error) {
+	if todoID == 0 || blockerID == 0 {
+		return nil, errors.New("todo ID cannot be 0")
+	}
+	if todoID == blockerID {
+		return nil, errors.New("a todo cannot block itself")
+	}
+
+	return &Dependency{
+		TodoID:    todoID,
+		BlockerID: blockerID,
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_dependency_new_dependency(t *testing.T) {
+	tests := []struct {
+		name      string
+		todoID    uint
+		blockerID uint
+		wantErr   bool
+		errMsg    string
+	}{
+		{
+			name:      "valid_dependency",
+			todoID:    1,
+			blockerID: 2,
+		},
+		{
+			name:      "zero_blocker_id_should_fail",
+			todoID:    1,
+			blockerID: 0,
+			wantErr:   true,
+			errMsg:    "todo ID cannot be 0",
+		},
+		{
+			name:      "self_dependency_should_fail",
+			todoID:    1,
+			blockerID: 1,
+			wantErr:   true,
+			errMsg:    "a todo cannot block itself",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dep, err := NewDependency(tt.todoID, tt.blockerID)
+
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				assert.Nil(t, dep)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.todoID, dep.TodoID)
+			assert.Equal(t, tt.blockerID, dep.BlockerID)
+			assert.False(t, dep.CreatedAt.IsZero())
+		})
+	}
+}
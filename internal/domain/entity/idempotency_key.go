@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"time"
+	"unicode"
+)
+
+// IdempotencyRecord remembers a request sent with an Idempotency-Key header and the response it got,
+// so a retry with the same key replays the response instead of running the request again
+type IdempotencyRecord struct {
+	ID          uint              `json:"id"`
+	UserID      uint              `json:"user_id"`      // keys are scoped to the user who sent them
+	Key         string            `json:"key"`          // the Idempotency-Key header chosen by the client
+	RequestHash string            `json:"request_hash"` // hex SHA-256 of the request, see HashIdempotentRequest
+	StatusCode  int               `json:"status_code"`  // 0 while the first request is still running
+	Headers     map[string]string `json:"headers,omitempty"`
+	Body        []byte            `json:"-"`
+	CreatedAt   time.Time         `json:"created_at"`
+	ExpiresAt   time.Time         `json:"expires_at"`
+}
+
+// NewIdempotencyRecord creates a new in progress IdempotencyRecord with validation, it expires ttl after now
+func NewIdempotencyRecord(userID uint, key string, requestHash string, now time.Time, ttl time.Duration) (*IdempotencyRecord, error) {
+	if userID == 0 {
+		return nil, errors.New("user ID cannot be 0")
+	}
+	if err := ValidateIdempotencyKey(key); err != nil {
+		return nil, err
+	}
+	if len(requestHash) == 0 {
+		return nil, errors.New("request hash cannot be empty")
+	}
+	if ttl <= 0 {
+		return nil, errors.New("idempotency key ttl must be positive")
+	}
+
+	now = now.UTC()
+	return &IdempotencyRecord{
+		UserID:      userID,
+		Key:         key,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(ttl),
+	}, nil
+}
+
+// ValidateIdempotencyKey checks a key is between 1 and 255 printable ASCII characters
+func ValidateIdempotencyKey(key string) error {
+	if len(key) == 0 {
+		return errors.New("idempotency key cannot be empty")
+	}
+	if len(key) > 255 {
+		return errors.New("idempotency key cannot exceed 255 characters")
+	}
+	for _, r := range key {
+		if r > unicode.MaxASCII || !unicode.IsPrint(r) {
+			return errors.New("idempotency key must be printable ASCII")
+		}
+	}
+	return nil
+}
+
+// HashIdempotentRequest returns the hex SHA-256 of everything that makes two requests the same,
+// reusing a key for a request with another hash is rejected
+func HashIdempotentRequest(method string, path string, workspaceID uint, body []byte) string {
+	h := sha256.New()
+	for _, part := range []string{method, path, strconv.FormatUint(uint64(workspaceID), 10)} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Completed reports whether the response of the first request was stored
+func (r *IdempotencyRecord) Completed() bool {
+	return r.StatusCode != 0
+}
+
+// Expired reports whether the record may be forgotten at now
+func (r *IdempotencyRecord) Expired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
+
+// Complete stores the response of the first request
+func (r *IdempotencyRecord) Complete(statusCode int, headers map[string]string, body []byte) error {
+	if statusCode < 100 || statusCode > 599 {
+		return errors.New("invalid status code")
+	}
+	r.StatusCode = statusCode
+	r.Headers = headers
+	r.Body = body
+	return nil
+}
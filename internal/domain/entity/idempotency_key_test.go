@@ -0,0 +1,124 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_idempotency_key_new_record(t *testing.T) {
+	now := time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		userID  uint
+		key     string
+		hash    string
+		ttl     time.Duration
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:   "valid_record",
+			userID: 1,
+			key:    "3f1c9e1a-create-todo",
+			hash:   "abc",
+			ttl:    time.Hour,
+		},
+		{
+			name:    "missing_user_should_fail",
+			key:     "k",
+			hash:    "abc",
+			ttl:     time.Hour,
+			wantErr: true,
+			errMsg:  "user ID cannot be 0",
+		},
+		{
+			name:    "empty_key_should_fail",
+			userID:  1,
+			hash:    "abc",
+			ttl:     time.Hour,
+			wantErr: true,
+			errMsg:  "idempotency key cannot be empty",
+		},
+		{
+			name:    "long_key_should_fail",
+			userID:  1,
+			key:     strings.Repeat("k", 256),
+			hash:    "abc",
+			ttl:     time.Hour,
+			wantErr: true,
+			errMsg:  "idempotency key cannot exceed 255 characters",
+		},
+		{
+			name:    "non_ascii_key_should_fail",
+			userID:  1,
+			key:     "鍵",
+			hash:    "abc",
+			ttl:     time.Hour,
+			wantErr: true,
+			errMsg:  "idempotency key must be printable ASCII",
+		},
+		{
+			name:    "control_character_should_fail",
+			userID:  1,
+			key:     "a\nb",
+			hash:    "abc",
+			ttl:     time.Hour,
+			wantErr: true,
+			errMsg:  "idempotency key must be printable ASCII",
+		},
+		{
+			name:    "zero_ttl_should_fail",
+			userID:  1,
+			key:     "k",
+			hash:    "abc",
+			wantErr: true,
+			errMsg:  "idempotency key ttl must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record, err := NewIdempotencyRecord(tt.userID, tt.key, tt.hash, now, tt.ttl)
+
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				assert.Nil(t, record)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.key, record.Key)
+			assert.False(t, record.Completed())
+			assert.Equal(t, now.Add(tt.ttl), record.ExpiresAt)
+			assert.False(t, record.Expired(now))
+			assert.True(t, record.Expired(now.Add(tt.ttl)))
+		})
+	}
+}
+
+func Test_idempotency_key_hash_request(t *testing.T) {
+	hash := HashIdempotentRequest("POST", "/api/v1/create-todo", 1, []byte(`{"title":"a"}`))
+
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, HashIdempotentRequest("POST", "/api/v1/create-todo", 1, []byte(`{"title":"a"}`)))
+	assert.NotEqual(t, hash, HashIdempotentRequest("POST", "/api/v1/create-todo", 1, []byte(`{"title":"b"}`)))
+	assert.NotEqual(t, hash, HashIdempotentRequest("POST", "/api/v1/create-todo", 2, []byte(`{"title":"a"}`)))
+	assert.NotEqual(t, hash, HashIdempotentRequest("PUT", "/api/v1/create-todo", 1, []byte(`{"title":"a"}`)))
+}
+
+func Test_idempotency_key_complete(t *testing.T) {
+	record, err := NewIdempotencyRecord(1, "k", "abc", time.Now(), time.Hour)
+	assert.NoError(t, err)
+
+	assert.EqualError(t, record.Complete(0, nil, nil), "invalid status code")
+	assert.False(t, record.Completed())
+
+	assert.NoError(t, record.Complete(201, map[string]string{"Content-Type": "application/json"}, []byte(`{}`)))
+	assert.True(t, record.Completed())
+	assert.Equal(t, 201, record.StatusCode)
+	assert.Equal(t, []byte(`{}`), record.Body)
+}
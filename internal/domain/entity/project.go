@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Project groups todos into a list, every todo of a project follows the workflow of the project
+type Project struct {
+	ID          uint      `json:"id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description,omitempty"`
+	WorkflowID  uint      `json:"workflow_id"`
+	WorkspaceID uint      `json:"workspace_id,omitempty"` // workspace the project belongs to, 0 for projects created before workspaces
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// NewProject creates a new Project with validation in the given workflow
+func NewProject(name string, description *string, workflowID uint) (*Project, error) {
+	now := time.Now().UTC()
+	project := &Project{
+		WorkflowID: workflowID,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	if err := project.Rename(name); err != nil {
+		return nil, err
+	}
+	if err := project.SetDescription(description); err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+// Rename changes the name of the project, surrounding spaces are trimmed
+func (p *Project) Rename(name string) error {
+	name = strings.TrimSpace(name)
+	if len(name) == 0 {
+		return errors.New("project name cannot be empty")
+	}
+	if len([]rune(name)) > 50 {
+		return errors.New("project name cannot exceed 50 characters")
+	}
+
+	p.Name = name
+	p.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// SetDescription changes the description of the project, nil or empty clears it
+func (p *Project) SetDescription(description *string) error {
+	if description != nil && *description == "" {
+		description = nil
+	}
+	if description != nil && len([]rune(*description)) > 200 {
+		return errors.New("project description cannot exceed 200 characters")
+	}
+
+	p.Description = description
+	p.UpdatedAt = time.Now().UTC()
+	return nil
+}
@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_project_new_project(t *testing.T) {
+	description := "季度目標"
+	empty := ""
+	tooLong := strings.Repeat("描", 201)
+
+	tests := []struct {
+		name            string
+		projectName     string
+		description     *string
+		wantName        string
+		wantDescription *string
+		wantErr         bool
+		errMsg          string
+	}{
+		{
+			name:            "name_is_trimmed",
+			projectName:     "  Q3 規劃  ",
+			description:     &description,
+			wantName:        "Q3 規劃",
+			wantDescription: &description,
+		},
+		{
+			name:        "empty_description_is_cleared",
+			projectName: "home",
+			description: &empty,
+			wantName:    "home",
+		},
+		{
+			name:        "empty_name_should_fail",
+			projectName: "   ",
+			wantErr:     true,
+			errMsg:      "project name cannot be empty",
+		},
+		{
+			name:        "name_too_long_should_fail",
+			projectName: strings.Repeat("專", 51),
+			wantErr:     true,
+			errMsg:      "project name cannot exceed 50 characters",
+		},
+		{
+			name:        "description_too_long_should_fail",
+			projectName: "home",
+			description: &tooLong,
+			wantErr:     true,
+			errMsg:      "project description cannot exceed 200 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project, err := NewProject(tt.projectName, tt.description, 2)
+
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				assert.Nil(t, project)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantName, project.Name)
+			assert.Equal(t, tt.wantDescription, project.Description)
+			assert.Equal(t, uint(2), project.WorkflowID)
+			assert.False(t, project.CreatedAt.IsZero())
+		})
+	}
+}
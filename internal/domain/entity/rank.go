@@ -0,0 +1,229 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+)
+
+// Rank keys order todos manually with a plain string comparison, so moving a todo between two
+// others only rewrites its own key. A key is an integer part followed by an optional fraction:
+// the head character of the integer part encodes its length, 'a'..'z' for the positive integers
+// with 1 to 26 digits and '9'..'0' for the negative ones, which keeps keys short when todos are
+// appended or prepended, while the fraction grows only when inserting between two neighbours.
+// Keys use lowercase base 36 digits so they sort the same in binary and case-insensitive collations.
+
+// rankDigits are the digits of rank keys in ascending byte order
+const rankDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+
+// rankZero is the key of the first todo of an empty list
+const rankZero = "a0"
+
+// MaxRankLength is the longest rank key handed out, inserting into the same slot over and over grows
+// the fraction by about a digit every five inserts, keys past this length are spread out again long
+// before they outgrow the 255 characters the position column holds
+const MaxRankLength = 128
+
+// rankSmallestInteger is the lowest integer part, it never stands alone so keys can still be put before it
+var rankSmallestInteger = "0" + strings.Repeat("0", 10)
+
+// ErrInvalidRank is returned when a rank key is malformed or the bounds are out of order
+var ErrInvalidRank = errors.New("invalid rank")
+
+// RankBetween returns a rank key that sorts strictly between before and after, an empty before
+// means the start of the list and an empty after its end
+func RankBetween(before string, after string) (string, error) {
+	if before != "" {
+		if err := validateRank(before); err != nil {
+			return "", err
+		}
+	}
+	if after != "" {
+		if err := validateRank(after); err != nil {
+			return "", err
+		}
+	}
+	if before != "" && after != "" && before >= after {
+		return "", ErrInvalidRank
+	}
+
+	switch {
+	case before == "" && after == "":
+		return rankZero, nil
+	case before == "":
+		intAfter, _ := rankInteger(after)
+		if intAfter == rankSmallestInteger {
+			return intAfter + rankMidpoint("", after[len(intAfter):]), nil
+		}
+		if intAfter < after {
+			return intAfter, nil
+		}
+		return decrementRankInteger(intAfter)
+	case after == "":
+		intBefore, _ := rankInteger(before)
+		next, err := incrementRankInteger(intBefore)
+		if err != nil {
+			return intBefore + rankMidpoint(before[len(intBefore):], ""), nil
+		}
+		return next, nil
+	}
+
+	intBefore, _ := rankInteger(before)
+	intAfter, _ := rankInteger(after)
+	if intBefore == intAfter {
+		return intBefore + rankMidpoint(before[len(intBefore):], after[len(intAfter):]), nil
+	}
+	next, err := incrementRankInteger(intBefore)
+	if err != nil {
+		return "", err
+	}
+	if next < after {
+		return next, nil
+	}
+	return intBefore + rankMidpoint(before[len(intBefore):], ""), nil
+}
+
+// rankMidpoint returns a fraction between the fractions a and b, b empty means no upper bound
+func rankMidpoint(a string, b string) string {
+	if b != "" {
+		// keep the common prefix, a is padded with zero digits
+		n := 0
+		for n < len(b) && rankDigitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			rest := ""
+			if n < len(a) {
+				rest = a[n:]
+			}
+			return b[:n] + rankMidpoint(rest, b[n:])
+		}
+	}
+
+	digitA := 0
+	if a != "" {
+		digitA = strings.IndexByte(rankDigits, a[0])
+	}
+	digitB := len(rankDigits)
+	if b != "" {
+		digitB = strings.IndexByte(rankDigits, b[0])
+	}
+
+	if digitB-digitA > 1 {
+		return string(rankDigits[(digitA+digitB)/2])
+	}
+
+	// neighbouring first digits: cut b short if that is still above a,
+	// otherwise keep a's first digit and go one digit deeper without upper bound
+	if len(b) > 1 {
+		return b[:1]
+	}
+	rest := ""
+	if len(a) > 1 {
+		rest = a[1:]
+	}
+	return string(rankDigits[digitA]) + rankMidpoint(rest, "")
+}
+
+// rankDigitAt returns the digit of the key at i, the zero digit past its end
+func rankDigitAt(key string, i int) byte {
+	if i < len(key) {
+		return key[i]
+	}
+	return rankDigits[0]
+}
+
+// rankIntegerLength returns the length of the integer part starting with the head
+func rankIntegerLength(head byte) (int, bool) {
+	switch {
+	case head >= 'a' && head <= 'z':
+		return int(head-'a') + 2, true
+	case head >= '0' && head <= '9':
+		return int('9'-head) + 2, true
+	default:
+		return 0, false
+	}
+}
+
+// rankInteger returns the integer part of the key
+func rankInteger(key string) (string, error) {
+	length, ok := rankIntegerLength(key[0])
+	if !ok || length > len(key) {
+		return "", ErrInvalidRank
+	}
+	return key[:length], nil
+}
+
+// validateRank checks the key is made of rank digits and its fraction has no trailing zero
+func validateRank(key string) error {
+	for i := 0; i < len(key); i++ {
+		if strings.IndexByte(rankDigits, key[i]) < 0 {
+			return ErrInvalidRank
+		}
+	}
+	integer, err := rankInteger(key)
+	if err != nil {
+		return err
+	}
+	if key == rankSmallestInteger {
+		return ErrInvalidRank
+	}
+	if fraction := key[len(integer):]; strings.HasSuffix(fraction, rankDigits[:1]) {
+		return ErrInvalidRank
+	}
+	return nil
+}
+
+// incrementRankInteger returns the next integer part, growing one digit once all digits overflow
+func incrementRankInteger(integer string) (string, error) {
+	head, digits := integer[0], []byte(integer[1:])
+	for i := len(digits) - 1; i >= 0; i-- {
+		digit := strings.IndexByte(rankDigits, digits[i]) + 1
+		if digit < len(rankDigits) {
+			digits[i] = rankDigits[digit]
+			return string(head) + string(digits), nil
+		}
+		digits[i] = rankDigits[0]
+	}
+
+	switch head {
+	case '9':
+		return rankZero, nil
+	case 'z':
+		return "", ErrInvalidRank
+	}
+	head++
+	if head > 'a' {
+		digits = append(digits, rankDigits[0])
+	} else {
+		digits = digits[:len(digits)-1]
+	}
+	return string(head) + string(digits), nil
+}
+
+// decrementRankInteger returns the previous integer part, growing one digit into the negative range
+func decrementRankInteger(integer string) (string, error) {
+	last := rankDigits[len(rankDigits)-1]
+	head, digits := integer[0], []byte(integer[1:])
+	for i := len(digits) - 1; i >= 0; i-- {
+		digit := strings.IndexByte(rankDigits, digits[i]) - 1
+		if digit >= 0 {
+			digits[i] = rankDigits[digit]
+			return string(head) + string(digits), nil
+		}
+		digits[i] = last
+	}
+
+	switch head {
+	case 'a':
+		return "9" + string(last), nil
+	case '0':
+		return "", ErrInvalidRank
+	}
+	head--
+	if head < '9' {
+		digits = append(digits, last)
+	} else {
+		digits = digits[:len(digits)-1]
+	}
+	return string(head) + string(digits), nil
+}
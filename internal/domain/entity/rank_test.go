@@ -0,0 +1,110 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRankBetween(t *testing.T) {
+	tests := []struct {
+		name   string
+		before string
+		after  string
+		want   string
+	}{
+		{name: "empty_list", before: "", after: "", want: "a0"},
+		{name: "append", before: "a0", after: "", want: "a1"},
+		{name: "append_grows_integer", before: "az", after: "", want: "b00"},
+		{name: "append_drops_fraction", before: "a1i", after: "", want: "a2"},
+		{name: "prepend", before: "", after: "a1", want: "a0"},
+		{name: "prepend_into_negative", before: "", after: "a0", want: "9z"},
+		{name: "prepend_before_fraction", before: "", after: "a0i", want: "a0"},
+		{name: "between_integers", before: "a0", after: "a2", want: "a1"},
+		{name: "between_neighbours", before: "a0", after: "a1", want: "a0i"},
+		{name: "between_fractions", before: "a0i", after: "a0j", want: "a0ii"},
+		{name: "between_heads", before: "az", after: "b00", want: "azi"},
+		{name: "negative_to_positive", before: "9z", after: "", want: "a0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RankBetween(tt.before, tt.after)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Greater(t, got, tt.before)
+			if tt.after != "" {
+				assert.Less(t, got, tt.after)
+			}
+		})
+	}
+}
+
+func TestRankBetween_Invalid(t *testing.T) {
+	for name, bounds := range map[string][2]string{
+		"out_of_order":    {"a2", "a1"},
+		"equal":           {"a1", "a1"},
+		"trailing_zero":   {"a1i0", ""},
+		"uppercase_digit": {"aZ", ""},
+		"short_integer":   {"b0", ""},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := RankBetween(bounds[0], bounds[1])
+
+			assert.ErrorIs(t, err, ErrInvalidRank)
+		})
+	}
+}
+
+func TestRankBetween_KeysStayShort(t *testing.T) {
+	// appending thousands of todos only grows the integer part logarithmically
+	last := ""
+	for i := 0; i < 5000; i++ {
+		next, err := RankBetween(last, "")
+
+		assert.NoError(t, err)
+		assert.Greater(t, next, last)
+		last = next
+	}
+	assert.LessOrEqual(t, len(last), 4)
+
+	// and so does prepending
+	first := rankZero
+	for i := 0; i < 5000; i++ {
+		prev, err := RankBetween("", first)
+
+		assert.NoError(t, err)
+		assert.Less(t, prev, first)
+		first = prev
+	}
+	assert.LessOrEqual(t, len(first), 4)
+
+	// inserting again and again right after the same todo keeps the keys ordered
+	low, high := rankZero, "a1"
+	for i := 0; i < 200; i++ {
+		mid, err := RankBetween(low, high)
+
+		assert.NoError(t, err)
+		assert.Greater(t, mid, low)
+		assert.Less(t, mid, high)
+		high = mid
+	}
+}
+
+func TestRankBetween_SameSlotOutgrowsMaxLength(t *testing.T) {
+	// every insert into the same slot halves the gap, the keys grow until they have to be rebalanced
+	low, high := rankZero, "a1"
+	inserts := 0
+	for len(high) <= MaxRankLength {
+		mid, err := RankBetween(low, high)
+
+		assert.NoError(t, err)
+		assert.Greater(t, mid, low)
+		assert.Less(t, mid, high)
+		high = mid
+		inserts++
+	}
+	assert.Greater(t, inserts, 500)
+	assert.Less(t, len(high), 255)
+}
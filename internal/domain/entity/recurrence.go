@@ -0,0 +1,271 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RecurrenceFrequency is the FREQ part of a recurrence rule
+type RecurrenceFrequency string
+
+const (
+	FrequencyDaily   RecurrenceFrequency = "DAILY"
+	FrequencyWeekly  RecurrenceFrequency = "WEEKLY"
+	FrequencyMonthly RecurrenceFrequency = "MONTHLY"
+	FrequencyYearly  RecurrenceFrequency = "YEARLY"
+)
+
+// IsValid checks if the RecurrenceFrequency is one of the supported values
+func (f RecurrenceFrequency) IsValid() bool {
+	switch f {
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly:
+		return true
+	default:
+		return false
+	}
+}
+
+// maxRecurrenceSteps bounds the search for a month or year containing the due day,
+// e.g. Feb 29 every 100 years, so an unsatisfiable rule ends the series instead of looping
+const maxRecurrenceSteps = 1000
+
+// rruleTimeFormat is the UTC date-time form of UNTIL, rruleDateFormat the date-only form
+const (
+	rruleTimeFormat = "20060102T150405Z"
+	rruleDateFormat = "20060102"
+)
+
+var weekdayCodes = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// Recurrence is the schedule of a recurring todo, a subset of the RFC 5545 RRULE:
+// FREQ (DAILY, WEEKLY, MONTHLY, YEARLY), INTERVAL, BYDAY (weekly only, without ordinals),
+// UNTIL and COUNT. Occurrences keep the wall clock time of the due date in Timezone,
+// so a 09:00 chore stays at 09:00 across DST changes
+type Recurrence struct {
+	Frequency RecurrenceFrequency `json:"frequency"`
+	Interval  int                 `json:"interval"`             // 1 = every period
+	ByWeekday []time.Weekday      `json:"by_weekday,omitempty"` // weekly only
+	Until     *time.Time          `json:"until,omitempty"`      // last allowed due date, inclusive
+	Count     int                 `json:"count,omitempty"`      // occurrences in the series, 0 = unlimited
+	Timezone  string              `json:"timezone,omitempty"`   // IANA name, empty = UTC
+}
+
+// ParseRecurrence parses an RRULE like "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR" evaluated in the
+// given IANA timezone, the "RRULE:" prefix is optional
+func ParseRecurrence(rule string, timezone string) (*Recurrence, error) {
+	rule = strings.TrimSpace(rule)
+	if len(rule) >= len("RRULE:") && strings.EqualFold(rule[:len("RRULE:")], "RRULE:") {
+		rule = rule[len("RRULE:"):]
+	}
+	if rule == "" {
+		return nil, errors.New("recurrence rule cannot be empty")
+	}
+
+	r := &Recurrence{Interval: 1}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(rule, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(strings.TrimSpace(name))
+		value = strings.ToUpper(strings.TrimSpace(value))
+		if !ok || value == "" {
+			return nil, fmt.Errorf("invalid recurrence rule part %q", part)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("duplicate recurrence rule part %s", name)
+		}
+		seen[name] = true
+
+		switch name {
+		case "FREQ":
+			r.Frequency = RecurrenceFrequency(value)
+			if !r.Frequency.IsValid() {
+				return nil, fmt.Errorf("unsupported recurrence frequency %q", value)
+			}
+		case "INTERVAL":
+			interval, err := strconv.Atoi(value)
+			if err != nil || interval < 1 {
+				return nil, errors.New("recurrence interval must be a positive integer")
+			}
+			r.Interval = interval
+		case "BYDAY":
+			for _, code := range strings.Split(value, ",") {
+				weekday, err := parseWeekday(code)
+				if err != nil {
+					return nil, err
+				}
+				r.ByWeekday = append(r.ByWeekday, weekday)
+			}
+		case "UNTIL":
+			until, err := time.Parse(rruleTimeFormat, value)
+			if err != nil {
+				// a date-only UNTIL includes the whole day
+				if until, err = time.Parse(rruleDateFormat, value); err != nil {
+					return nil, fmt.Errorf("invalid recurrence until %q", value)
+				}
+				until = until.Add(24*time.Hour - time.Second)
+			}
+			r.Until = &until
+		case "COUNT":
+			count, err := strconv.Atoi(value)
+			if err != nil || count < 1 {
+				return nil, errors.New("recurrence count must be a positive integer")
+			}
+			r.Count = count
+		default:
+			return nil, fmt.Errorf("unsupported recurrence rule part %s", name)
+		}
+	}
+
+	if err := r.SetTimezone(timezone); err != nil {
+		return nil, err
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Validate checks the combination of rule parts
+func (r *Recurrence) Validate() error {
+	if !r.Frequency.IsValid() {
+		return errors.New("recurrence frequency is required")
+	}
+	if r.Interval < 1 {
+		return errors.New("recurrence interval must be a positive integer")
+	}
+	if len(r.ByWeekday) > 0 && r.Frequency != FrequencyWeekly {
+		return errors.New("recurrence by-weekday is only supported for weekly rules")
+	}
+	if r.Until != nil && r.Count > 0 {
+		return errors.New("recurrence cannot have both until and count")
+	}
+	return nil
+}
+
+// SetTimezone changes the IANA timezone the schedule follows, empty means UTC
+func (r *Recurrence) SetTimezone(timezone string) error {
+	timezone = strings.TrimSpace(timezone)
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return fmt.Errorf("unknown timezone %q", timezone)
+	}
+	if timezone == "UTC" {
+		timezone = ""
+	}
+	r.Timezone = timezone
+	return nil
+}
+
+// String formats the rule as an RRULE value without the "RRULE:" prefix
+func (r *Recurrence) String() string {
+	parts := []string{"FREQ=" + string(r.Frequency)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByWeekday) > 0 {
+		codes := make([]string, 0, len(r.ByWeekday))
+		for i, code := range weekdayCodes {
+			if r.hasWeekday(time.Weekday(i)) {
+				codes = append(codes, code)
+			}
+		}
+		parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format(rruleTimeFormat))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Next returns the first occurrence after the given one, false when the rule has no further
+// occurrence before Until. COUNT is tracked by the todo since it depends on the series position.
+// Months or years without the due day are skipped like RFC 5545 does, so a rule due on the 31st
+// only fires in months with 31 days and Feb 29 only in leap years
+func (r *Recurrence) Next(after time.Time) (time.Time, bool) {
+	loc := r.location()
+	local := after.In(loc)
+	year, month, day := local.Date()
+	hour, minute, sec := local.Clock()
+	at := func(year int, month time.Month, day int) time.Time {
+		t := time.Date(year, month, day, hour, minute, sec, local.Nanosecond(), loc)
+		if t.Hour() != hour || t.Minute() != minute {
+			// the wall clock falls into a DST gap, RFC 5545 applies the offset from before the gap
+			wall := time.Date(year, month, day, hour, minute, sec, local.Nanosecond(), time.UTC)
+			_, offset := wall.Add(-24 * time.Hour).In(loc).Zone()
+			t = wall.Add(-time.Duration(offset) * time.Second).In(loc)
+		}
+		return t
+	}
+	interval := max(r.Interval, 1)
+
+	var next time.Time
+	found := false
+	switch r.Frequency {
+	case FrequencyDaily:
+		next, found = at(year, month, day+interval), true
+	case FrequencyWeekly:
+		if len(r.ByWeekday) == 0 {
+			next, found = at(year, month, day+7*interval), true
+			break
+		}
+		// weeks start on Monday (the RFC 5545 default WKST), only every interval-th week counts
+		weekOffset := (int(local.Weekday()) + 6) % 7
+		for i := 1; i <= 7*interval+7; i++ {
+			candidate := at(year, month, day+i)
+			if (weekOffset+i)/7%interval == 0 && r.hasWeekday(candidate.Weekday()) {
+				next, found = candidate, true
+				break
+			}
+		}
+	case FrequencyMonthly:
+		for i := 1; i <= maxRecurrenceSteps && !found; i++ {
+			candidate := at(year, month+time.Month(i*interval), day)
+			next, found = candidate, candidate.Day() == day
+		}
+	case FrequencyYearly:
+		for i := 1; i <= maxRecurrenceSteps && !found; i++ {
+			candidate := at(year+i*interval, month, day)
+			next, found = candidate, candidate.Day() == day
+		}
+	}
+
+	if !found || (r.Until != nil && next.After(*r.Until)) {
+		return time.Time{}, false
+	}
+	return next.UTC(), true
+}
+
+// location returns the timezone of the schedule, UTC when unknown
+func (r *Recurrence) location() *time.Location {
+	loc, err := time.LoadLocation(r.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+func (r *Recurrence) hasWeekday(weekday time.Weekday) bool {
+	for _, w := range r.ByWeekday {
+		if w == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// parseWeekday converts a BYDAY code like "MO", ordinals like "1MO" are not supported
+func parseWeekday(code string) (time.Weekday, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	for i, c := range weekdayCodes {
+		if c == code {
+			return time.Weekday(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unsupported recurrence weekday %q", code)
+}
@@ -0,0 +1,429 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_recurrence_parse_recurrence(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     string
+		timezone string
+		want     string
+		errMsg   string
+	}{
+		{
+			name: "daily",
+			rule: "FREQ=DAILY",
+			want: "FREQ=DAILY",
+		},
+		{
+			name:     "prefix_and_lowercase_are_accepted",
+			rule:     "rrule:freq=weekly;interval=2;byday=fr,mo",
+			timezone: "Europe/Berlin",
+			want:     "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR",
+		},
+		{
+			name: "interval_1_is_omitted",
+			rule: "FREQ=MONTHLY;INTERVAL=1;COUNT=12",
+			want: "FREQ=MONTHLY;COUNT=12",
+		},
+		{
+			name: "until_date_includes_the_whole_day",
+			rule: "FREQ=YEARLY;UNTIL=20301231",
+			want: "FREQ=YEARLY;UNTIL=20301231T235959Z",
+		},
+		{
+			name: "until_date_time",
+			rule: "FREQ=DAILY;UNTIL=20300101T120000Z",
+			want: "FREQ=DAILY;UNTIL=20300101T120000Z",
+		},
+		{
+			name:   "empty_should_fail",
+			rule:   "  ",
+			errMsg: "recurrence rule cannot be empty",
+		},
+		{
+			name:   "missing_freq_should_fail",
+			rule:   "INTERVAL=2",
+			errMsg: "recurrence frequency is required",
+		},
+		{
+			name:   "unsupported_freq_should_fail",
+			rule:   "FREQ=HOURLY",
+			errMsg: `unsupported recurrence frequency "HOURLY"`,
+		},
+		{
+			name:   "unsupported_part_should_fail",
+			rule:   "FREQ=MONTHLY;BYMONTHDAY=-1",
+			errMsg: "unsupported recurrence rule part BYMONTHDAY",
+		},
+		{
+			name:   "duplicate_part_should_fail",
+			rule:   "FREQ=DAILY;FREQ=WEEKLY",
+			errMsg: "duplicate recurrence rule part FREQ",
+		},
+		{
+			name:   "zero_interval_should_fail",
+			rule:   "FREQ=DAILY;INTERVAL=0",
+			errMsg: "recurrence interval must be a positive integer",
+		},
+		{
+			name:   "ordinal_weekday_should_fail",
+			rule:   "FREQ=WEEKLY;BYDAY=1MO",
+			errMsg: `unsupported recurrence weekday "1MO"`,
+		},
+		{
+			name:   "byday_on_monthly_should_fail",
+			rule:   "FREQ=MONTHLY;BYDAY=MO",
+			errMsg: "recurrence by-weekday is only supported for weekly rules",
+		},
+		{
+			name:   "until_and_count_should_fail",
+			rule:   "FREQ=DAILY;COUNT=3;UNTIL=20300101",
+			errMsg: "recurrence cannot have both until and count",
+		},
+		{
+			name:     "unknown_timezone_should_fail",
+			rule:     "FREQ=DAILY",
+			timezone: "Mars/Olympus",
+			errMsg:   `unknown timezone "Mars/Olympus"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := ParseRecurrence(tt.rule, tt.timezone)
+
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+				assert.Nil(t, r)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, r.String())
+			assert.Equal(t, tt.timezone, r.Timezone)
+		})
+	}
+}
+
+func Test_recurrence_next(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+	berlin := mustLoadLocation(t, "Europe/Berlin")
+
+	tests := []struct {
+		name     string
+		rule     string
+		timezone string
+		after    time.Time
+		want     time.Time
+		wantNone bool
+	}{
+		// DST
+		{
+			name:     "daily_keeps_wall_clock_across_spring_forward",
+			rule:     "FREQ=DAILY",
+			timezone: "America/New_York",
+			after:    time.Date(2026, 3, 7, 9, 0, 0, 0, newYork),
+			want:     time.Date(2026, 3, 8, 13, 0, 0, 0, time.UTC), // 09:00 EDT
+		},
+		{
+			name:     "daily_keeps_wall_clock_across_fall_back",
+			rule:     "FREQ=DAILY",
+			timezone: "America/New_York",
+			after:    time.Date(2026, 10, 31, 9, 0, 0, 0, newYork),
+			want:     time.Date(2026, 11, 1, 14, 0, 0, 0, time.UTC), // 09:00 EST
+		},
+		{
+			name:     "time_skipped_by_spring_forward_moves_past_the_gap",
+			rule:     "FREQ=DAILY",
+			timezone: "America/New_York",
+			after:    time.Date(2026, 3, 7, 2, 30, 0, 0, newYork),
+			want:     time.Date(2026, 3, 8, 7, 30, 0, 0, time.UTC), // 03:30 EDT
+		},
+		{
+			name:     "weekly_keeps_wall_clock_across_eu_dst",
+			rule:     "FREQ=WEEKLY",
+			timezone: "Europe/Berlin",
+			after:    time.Date(2026, 3, 23, 10, 0, 0, 0, berlin),
+			want:     time.Date(2026, 3, 30, 8, 0, 0, 0, time.UTC), // 10:00 CEST
+		},
+		{
+			name:  "utc_schedule_ignores_dst",
+			rule:  "FREQ=DAILY",
+			after: time.Date(2026, 3, 7, 14, 0, 0, 0, time.UTC),
+			want:  time.Date(2026, 3, 8, 14, 0, 0, 0, time.UTC),
+		},
+		// month end
+		{
+			name:  "monthly_31st_skips_february",
+			rule:  "FREQ=MONTHLY",
+			after: time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC),
+			want:  time.Date(2026, 3, 31, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "monthly_31st_skips_april",
+			rule:  "FREQ=MONTHLY",
+			after: time.Date(2026, 3, 31, 9, 0, 0, 0, time.UTC),
+			want:  time.Date(2026, 5, 31, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "monthly_30th_skips_february",
+			rule:  "FREQ=MONTHLY",
+			after: time.Date(2026, 1, 30, 9, 0, 0, 0, time.UTC),
+			want:  time.Date(2026, 3, 30, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "bimonthly_31st_from_august",
+			rule:  "FREQ=MONTHLY;INTERVAL=2",
+			after: time.Date(2026, 8, 31, 9, 0, 0, 0, time.UTC),
+			want:  time.Date(2026, 10, 31, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "monthly_across_year_end",
+			rule:  "FREQ=MONTHLY",
+			after: time.Date(2026, 12, 31, 9, 0, 0, 0, time.UTC),
+			want:  time.Date(2027, 1, 31, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "month_end_follows_the_schedule_timezone",
+			rule:     "FREQ=MONTHLY",
+			timezone: "Asia/Tokyo",
+			after:    time.Date(2026, 1, 31, 23, 0, 0, 0, time.UTC), // Feb 1 08:00 in Tokyo
+			want:     time.Date(2026, 2, 28, 23, 0, 0, 0, time.UTC), // Mar 1 08:00 in Tokyo
+		},
+		{
+			name:  "yearly_feb_29_waits_for_leap_year",
+			rule:  "FREQ=YEARLY",
+			after: time.Date(2028, 2, 29, 9, 0, 0, 0, time.UTC),
+			want:  time.Date(2032, 2, 29, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "yearly_with_interval",
+			rule:  "FREQ=YEARLY;INTERVAL=2",
+			after: time.Date(2026, 6, 15, 9, 0, 0, 0, time.UTC),
+			want:  time.Date(2028, 6, 15, 9, 0, 0, 0, time.UTC),
+		},
+		// weekdays
+		{
+			name:  "weekdays_from_friday_to_monday",
+			rule:  "FREQ=WEEKLY;BYDAY=MO,WE,FR",
+			after: time.Date(2026, 3, 6, 9, 0, 0, 0, time.UTC),
+			want:  time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "weekdays_within_the_week",
+			rule:  "FREQ=WEEKLY;BYDAY=MO,WE,FR",
+			after: time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC),
+			want:  time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "biweekly_weekdays_skip_a_week",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR",
+			after: time.Date(2026, 3, 6, 9, 0, 0, 0, time.UTC),
+			want:  time.Date(2026, 3, 16, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "biweekly_weekdays_within_the_week",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR",
+			after: time.Date(2026, 3, 16, 9, 0, 0, 0, time.UTC),
+			want:  time.Date(2026, 3, 20, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "sunday_ends_the_week",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,SU",
+			after: time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC),
+			want:  time.Date(2026, 3, 15, 9, 0, 0, 0, time.UTC),
+		},
+		// until
+		{
+			name:  "until_includes_its_day",
+			rule:  "FREQ=DAILY;UNTIL=20260310",
+			after: time.Date(2026, 3, 9, 9, 0, 0, 0, time.UTC),
+			want:  time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "until_ends_the_series",
+			rule:     "FREQ=DAILY;UNTIL=20260310",
+			after:    time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC),
+			wantNone: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := ParseRecurrence(tt.rule, tt.timezone)
+			require.NoError(t, err)
+
+			next, ok := r.Next(tt.after)
+
+			if tt.wantNone {
+				assert.False(t, ok)
+				return
+			}
+			assert.True(t, ok)
+			assert.Equal(t, tt.want, next)
+		})
+	}
+}
+
+func Test_todo_set_recurrence(t *testing.T) {
+	daily, err := ParseRecurrence("FREQ=DAILY", "")
+	require.NoError(t, err)
+
+	todo := &Todo{}
+	assert.EqualError(t, todo.SetRecurrence(daily), "a recurring todo needs a due date")
+
+	todo.DueDate = timePtr(time.Now().Add(time.Hour))
+	assert.NoError(t, todo.SetRecurrence(daily))
+	assert.Equal(t, daily, todo.Recurrence)
+	assert.Equal(t, 1, todo.Occurrence)
+
+	assert.NoError(t, todo.SetRecurrence(nil))
+	assert.Nil(t, todo.Recurrence)
+}
+
+func Test_todo_next_occurrence(t *testing.T) {
+	now := time.Date(2026, 3, 9, 12, 0, 0, 0, time.UTC)
+	due := time.Date(2026, 3, 9, 18, 0, 0, 0, time.UTC)
+	workflow := DefaultWorkflow()
+	workflow.ID = 3
+	projectID := uint(5)
+
+	tests := []struct {
+		name           string
+		rule           string
+		dueDate        time.Time
+		seriesID       *uint
+		occurrence     int
+		wantNil        bool
+		wantDue        time.Time
+		wantSeriesID   uint
+		wantOccurrence int
+	}{
+		{
+			name:           "first_occurrence_starts_the_series",
+			rule:           "FREQ=DAILY",
+			dueDate:        due,
+			occurrence:     1,
+			wantDue:        due.AddDate(0, 0, 1),
+			wantSeriesID:   7,
+			wantOccurrence: 2,
+		},
+		{
+			name:           "later_occurrence_keeps_the_series",
+			rule:           "FREQ=WEEKLY",
+			dueDate:        due,
+			seriesID:       uintPtr(3),
+			occurrence:     4,
+			wantDue:        due.AddDate(0, 0, 7),
+			wantSeriesID:   3,
+			wantOccurrence: 5,
+		},
+		{
+			name:           "missed_occurrences_are_skipped",
+			rule:           "FREQ=DAILY",
+			dueDate:        due.AddDate(0, 0, -3),
+			occurrence:     1,
+			wantDue:        due,
+			wantSeriesID:   7,
+			wantOccurrence: 4,
+		},
+		{
+			name:           "count_allows_the_last_occurrence",
+			rule:           "FREQ=DAILY;COUNT=3",
+			dueDate:        due,
+			occurrence:     2,
+			wantDue:        due.AddDate(0, 0, 1),
+			wantSeriesID:   7,
+			wantOccurrence: 3,
+		},
+		{
+			name:       "count_ends_the_series",
+			rule:       "FREQ=DAILY;COUNT=3",
+			dueDate:    due,
+			occurrence: 3,
+			wantNil:    true,
+		},
+		{
+			name:       "count_reached_while_skipping",
+			rule:       "FREQ=DAILY;COUNT=3",
+			dueDate:    due.AddDate(0, 0, -3),
+			occurrence: 1,
+			wantNil:    true,
+		},
+		{
+			name:       "until_ends_the_series",
+			rule:       "FREQ=DAILY;UNTIL=20260309",
+			dueDate:    due,
+			occurrence: 1,
+			wantNil:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recurrence, err := ParseRecurrence(tt.rule, "")
+			require.NoError(t, err)
+
+			todo := &Todo{
+				ID:          7,
+				OwnerID:     5,
+				WorkspaceID: 2,
+				Title:       "on-call handoff",
+				Status:      StatusDone,
+				ProjectID:   &projectID,
+				Priority:    PriorityHigh,
+				DueDate:     timePtr(tt.dueDate),
+				Tags:        []Tag{{ID: 1, Name: "ops"}},
+				Checklist:   []ChecklistItem{{ID: 10, TodoID: 7, Text: "rotate pager", Done: true, Position: 0}},
+				Recurrence:  recurrence,
+				SeriesID:    tt.seriesID,
+				Occurrence:  tt.occurrence,
+			}
+
+			next := todo.NextOccurrence(now, workflow)
+
+			if tt.wantNil {
+				assert.Nil(t, next)
+				return
+			}
+			require.NotNil(t, next)
+			assert.Zero(t, next.ID)
+			assert.Equal(t, uint(5), next.OwnerID)
+			assert.Equal(t, uint(2), next.WorkspaceID)
+			assert.Equal(t, "on-call handoff", next.Title)
+			assert.Equal(t, StatusPending, next.Status)
+			assert.Equal(t, uint(3), next.WorkflowID)
+			assert.Equal(t, &projectID, next.ProjectID)
+			assert.Equal(t, PriorityHigh, next.Priority)
+			assert.Equal(t, tt.wantDue, *next.DueDate)
+			assert.Equal(t, tt.wantSeriesID, *next.SeriesID)
+			assert.Equal(t, tt.wantOccurrence, next.Occurrence)
+			assert.Equal(t, recurrence.String(), next.Recurrence.String())
+			assert.Equal(t, todo.Tags, next.Tags)
+			assert.Equal(t, []ChecklistItem{{Text: "rotate pager", Position: 0, CreatedAt: now, UpdatedAt: now}}, next.Checklist)
+		})
+	}
+
+	t.Run("one_off_todo_has_no_next_occurrence", func(t *testing.T) {
+		todo := &Todo{ID: 7, DueDate: timePtr(due)}
+		assert.Nil(t, todo.NextOccurrence(now, workflow))
+	})
+}
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
+
+func uintPtr(v uint) *uint {
+	return &v
+}
@@ -0,0 +1,47 @@
+package entity
+
+import "time"
+
+// HistoryEvent tells what a history record of a todo is about
+type HistoryEvent string
+
+const (
+	EventStatusChanged HistoryEvent = "status_changed" // the todo moved to another status
+	EventAssigned      HistoryEvent = "assigned"       // a user was made responsible for the todo
+	EventUnassigned    HistoryEvent = "unassigned"     // a user is no longer responsible for the todo
+)
+
+// StatusChange records a single status transition of a todo, or a change of its assignees
+type StatusChange struct {
+	ID         uint         `json:"id"`
+	TodoID     uint         `json:"todo_id"`
+	Event      HistoryEvent `json:"event"`
+	FromStatus TodoStatus   `json:"from_status,omitempty"` // empty for assignee changes
+	ToStatus   TodoStatus   `json:"to_status,omitempty"`   // empty for assignee changes
+	AssigneeID *uint        `json:"assignee_id,omitempty"` // the user assigned or unassigned
+	Actor      string       `json:"actor,omitempty"`       // who made the change, empty when unknown
+	ChangedAt  time.Time    `json:"changed_at"`
+}
+
+// NewStatusChange records the move of a todo from one status to another
+func NewStatusChange(todoID uint, from TodoStatus, to TodoStatus, actor string, at time.Time) *StatusChange {
+	return &StatusChange{
+		TodoID:     todoID,
+		Event:      EventStatusChanged,
+		FromStatus: from,
+		ToStatus:   to,
+		Actor:      actor,
+		ChangedAt:  at.UTC(),
+	}
+}
+
+// NewAssigneeChange records that a user was assigned to or unassigned from a todo
+func NewAssigneeChange(todoID uint, event HistoryEvent, assigneeID uint, actor string, at time.Time) *StatusChange {
+	return &StatusChange{
+		TodoID:     todoID,
+		Event:      event,
+		AssigneeID: &assigneeID,
+		Actor:      actor,
+		ChangedAt:  at.UTC(),
+	}
+}
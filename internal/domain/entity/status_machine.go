@@ -0,0 +1,112 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrTransitionNotAllowed is returned when the status machine forbids a status change
+var ErrTransitionNotAllowed = errors.New("status transition not allowed")
+
+// StatusMachine decides which status changes a todo may go through, any status of the workflow
+// may follow any other except that leaving a done status is a reopen and needs AllowReopen
+type StatusMachine struct {
+	Workflow    *Workflow // nil uses the built-in default workflow
+	AllowReopen bool      // done todos may move back to a todo or in progress status
+}
+
+// Transitions returns the statuses a todo in the given status may move to, in workflow order
+func (m StatusMachine) Transitions(from TodoStatus) []TodoStatus {
+	workflow := m.workflow()
+	reopen := workflow.Category(from) == CategoryDone && !m.AllowReopen
+
+	transitions := []TodoStatus{}
+	for _, status := range workflow.Statuses {
+		if status.Name == from || (reopen && status.Category != CategoryDone) {
+			continue
+		}
+		transitions = append(transitions, status.Name)
+	}
+	return transitions
+}
+
+// CanTransition reports whether a todo may move from one status to another,
+// staying in the same status is not a transition
+func (m StatusMachine) CanTransition(from TodoStatus, to TodoStatus) bool {
+	for _, status := range m.Transitions(from) {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+func (m StatusMachine) workflow() *Workflow {
+	if m.Workflow == nil {
+		return DefaultWorkflow()
+	}
+	return m.Workflow
+}
+
+// TransitionTo moves the todo to the given status if the machine allows it, StartedAt is
+// stamped the first time work starts and CompletedAt whenever the todo becomes done
+func (t *Todo) TransitionTo(to TodoStatus, machine StatusMachine, at time.Time) error {
+	workflow := machine.workflow()
+	target, ok := workflow.Status(to)
+	if !ok {
+		return errors.New("invalid status")
+	}
+	if !machine.CanTransition(t.Status, to) {
+		return fmt.Errorf("%w: %s to %s", ErrTransitionNotAllowed, t.Status, to)
+	}
+
+	at = at.UTC()
+	switch target.Category {
+	case CategoryInProgress:
+		if t.StartedAt == nil {
+			t.StartedAt = &at
+		}
+		t.CompletedAt = nil
+	case CategoryDone:
+		// moving between done statuses keeps the completion time
+		if workflow.Category(t.Status) != CategoryDone || t.CompletedAt == nil {
+			t.CompletedAt = &at
+		}
+	default:
+		t.CompletedAt = nil
+	}
+
+	t.Status = to
+	t.UpdatedAt = at
+	return nil
+}
+
+// SetWorkflow places a new todo in the workflow, its status must belong to the workflow and
+// a todo created in progress or done is stamped like a transition
+func (t *Todo) SetWorkflow(workflow *Workflow, at time.Time) error {
+	status, ok := workflow.Status(t.Status)
+	if !ok {
+		return errors.New("invalid status")
+	}
+
+	at = at.UTC()
+	switch status.Category {
+	case CategoryInProgress:
+		t.StartedAt = &at
+	case CategoryDone:
+		t.CompletedAt = &at
+	}
+
+	t.WorkflowID = workflow.ID
+	return nil
+}
+
+// CycleTime returns the time from starting work to completing the todo,
+// false when the todo was never started or is not done
+func (t *Todo) CycleTime() (time.Duration, bool) {
+	if t.StartedAt == nil || t.CompletedAt == nil {
+		return 0, false
+	}
+	return t.CompletedAt.Sub(*t.StartedAt), true
+}
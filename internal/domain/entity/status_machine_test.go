@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_status_machine_can_transition(t *testing.T) {
+	tests := []struct {
+		name        string
+		from        TodoStatus
+		to          TodoStatus
+		allowReopen bool
+		want        bool
+	}{
+		{name: "pending_to_doing", from: StatusPending, to: StatusDoing, want: true},
+		{name: "pending_to_done", from: StatusPending, to: StatusDone, want: true},
+		{name: "doing_to_pending", from: StatusDoing, to: StatusPending, want: true},
+		{name: "doing_to_done", from: StatusDoing, to: StatusDone, want: true},
+		{name: "same_status_is_not_a_transition", from: StatusDoing, to: StatusDoing, allowReopen: true, want: false},
+		{name: "reopen_disabled", from: StatusDone, to: StatusPending, want: false},
+		{name: "reopen_to_pending", from: StatusDone, to: StatusPending, allowReopen: true, want: true},
+		{name: "reopen_to_doing", from: StatusDone, to: StatusDoing, allowReopen: true, want: true},
+		{name: "unknown_status", from: StatusPending, to: TodoStatus("blocked"), allowReopen: true, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			machine := StatusMachine{AllowReopen: tt.allowReopen}
+			assert.Equal(t, tt.want, machine.CanTransition(tt.from, tt.to))
+		})
+	}
+}
+
+func Test_todo_transition_to(t *testing.T) {
+	machine := StatusMachine{AllowReopen: true}
+	started := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	completed := started.Add(26 * time.Hour)
+	reopened := completed.Add(time.Hour)
+
+	todo := &Todo{ID: 1, Status: StatusPending}
+	_, ok := todo.CycleTime()
+	assert.False(t, ok)
+
+	assert.NoError(t, todo.TransitionTo(StatusDoing, machine, started))
+	assert.Equal(t, StatusDoing, todo.Status)
+	assert.Equal(t, &started, todo.StartedAt)
+	assert.Nil(t, todo.CompletedAt)
+
+	assert.NoError(t, todo.TransitionTo(StatusDone, machine, completed))
+	assert.Equal(t, &completed, todo.CompletedAt)
+	cycleTime, ok := todo.CycleTime()
+	assert.True(t, ok)
+	assert.Equal(t, 26*time.Hour, cycleTime)
+
+	// Reopening keeps the first start and clears the completion
+	assert.NoError(t, todo.TransitionTo(StatusDoing, machine, reopened))
+	assert.Equal(t, &started, todo.StartedAt)
+	assert.Nil(t, todo.CompletedAt)
+	assert.Equal(t, reopened, todo.UpdatedAt)
+
+	err := todo.TransitionTo(StatusDoing, machine, reopened)
+	assert.ErrorIs(t, err, ErrTransitionNotAllowed)
+	assert.EqualError(t, err, "status transition not allowed: doing to doing")
+
+	assert.EqualError(t, todo.TransitionTo(TodoStatus("blocked"), machine, reopened), "invalid status")
+}
+
+func Test_todo_transition_to_without_reopen(t *testing.T) {
+	completedAt := time.Now().UTC()
+	todo := &Todo{ID: 1, Status: StatusDone, CompletedAt: &completedAt}
+
+	err := todo.TransitionTo(StatusPending, StatusMachine{}, time.Now())
+
+	assert.ErrorIs(t, err, ErrTransitionNotAllowed)
+	assert.Equal(t, StatusDone, todo.Status)
+	assert.Equal(t, &completedAt, todo.CompletedAt)
+}
+
+func Test_todo_set_workflow_stamps_status_times(t *testing.T) {
+	workflow := DefaultWorkflow()
+	workflow.ID = 2
+	now := time.Now()
+
+	pending, _ := NewTodo("待辦", nil, nil, nil)
+	assert.NoError(t, pending.SetWorkflow(workflow, now))
+	assert.Equal(t, uint(2), pending.WorkflowID)
+	assert.Nil(t, pending.StartedAt)
+	assert.Nil(t, pending.CompletedAt)
+
+	doing, _ := NewTodo("進行中", nil, statusPtr(StatusDoing), nil)
+	assert.NoError(t, doing.SetWorkflow(workflow, now))
+	assert.NotNil(t, doing.StartedAt)
+	assert.Nil(t, doing.CompletedAt)
+
+	done, _ := NewTodo("已完成", nil, statusPtr(StatusDone), nil)
+	assert.NoError(t, done.SetWorkflow(workflow, now))
+	assert.Nil(t, done.StartedAt)
+	assert.NotNil(t, done.CompletedAt)
+
+	blocked, _ := NewTodo("卡住", nil, statusPtr(TodoStatus("blocked")), nil)
+	assert.EqualError(t, blocked.SetWorkflow(workflow, now), "invalid status")
+}
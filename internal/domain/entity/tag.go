@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DefaultTagColor is used when a tag is created without a color
+const DefaultTagColor = "#808080"
+
+var tagColorPattern = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
+// Tag represents a label that can be attached to todos
+type Tag struct {
+	ID          uint      `json:"id"`
+	Name        string    `json:"name"`
+	Color       string    `json:"color"`
+	WorkspaceID uint      `json:"workspace_id,omitempty"` // workspace the tag belongs to, 0 for tags created before workspaces
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// NewTag creates a new Tag with validation, an empty color falls back to DefaultTagColor
+func NewTag(name string, color string) (*Tag, error) {
+	now := time.Now().UTC()
+	tag := &Tag{
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := tag.Rename(name); err != nil {
+		return nil, err
+	}
+
+	if color == "" {
+		color = DefaultTagColor
+	}
+	if err := tag.SetColor(color); err != nil {
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+// Rename changes the name of the tag, surrounding spaces are trimmed
+func (t *Tag) Rename(name string) error {
+	name = strings.TrimSpace(name)
+	if len(name) == 0 {
+		return errors.New("tag name cannot be empty")
+	}
+	if len([]rune(name)) > 30 {
+		return errors.New("tag name cannot exceed 30 characters")
+	}
+
+	t.Name = name
+	t.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// SetColor changes the color of the tag, colors are hex strings like "#1e90ff"
+func (t *Tag) SetColor(color string) error {
+	color = strings.ToLower(strings.TrimSpace(color))
+	if !tagColorPattern.MatchString(color) {
+		return errors.New("tag color must be a hex color like #1e90ff")
+	}
+
+	t.Color = color
+	t.UpdatedAt = time.Now().UTC()
+	return nil
+}
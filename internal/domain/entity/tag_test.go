@@ -0,0 +1,100 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_tag_new_tag(t *testing.T) {
+	tests := []struct {
+		name      string
+		tagName   string
+		color     string
+		wantName  string
+		wantColor string
+		wantErr   bool
+		errMsg    string
+	}{
+		{
+			name:      "default_color",
+			tagName:   "bug",
+			color:     "",
+			wantName:  "bug",
+			wantColor: DefaultTagColor,
+		},
+		{
+			name:      "name_is_trimmed_and_color_lowercased",
+			tagName:   "  前端  ",
+			color:     "#1E90FF",
+			wantName:  "前端",
+			wantColor: "#1e90ff",
+		},
+		{
+			name:    "empty_name_should_fail",
+			tagName: "   ",
+			wantErr: true,
+			errMsg:  "tag name cannot be empty",
+		},
+		{
+			name:    "name_too_long_should_fail",
+			tagName: strings.Repeat("標", 31),
+			wantErr: true,
+			errMsg:  "tag name cannot exceed 30 characters",
+		},
+		{
+			name:    "invalid_color_should_fail",
+			tagName: "bug",
+			color:   "red",
+			wantErr: true,
+			errMsg:  "tag color must be a hex color like #1e90ff",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tag, err := NewTag(tt.tagName, tt.color)
+
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				assert.Nil(t, tag)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantName, tag.Name)
+			assert.Equal(t, tt.wantColor, tag.Color)
+			assert.False(t, tag.CreatedAt.IsZero())
+		})
+	}
+}
+
+func Test_tag_rename_keeps_name_on_error(t *testing.T) {
+	tag, err := NewTag("bug", "")
+	assert.NoError(t, err)
+
+	assert.Error(t, tag.Rename(""))
+	assert.Equal(t, "bug", tag.Name)
+
+	assert.NoError(t, tag.Rename("feature"))
+	assert.Equal(t, "feature", tag.Name)
+}
+
+func Test_tag_set_color_keeps_color_on_error(t *testing.T) {
+	tag, err := NewTag("bug", "#ff0000")
+	assert.NoError(t, err)
+
+	assert.Error(t, tag.SetColor("#ff00"))
+	assert.Equal(t, "#ff0000", tag.Color)
+}
+
+func Test_todo_set_tags_removes_duplicates(t *testing.T) {
+	todo, err := NewTodo("測試標題", nil, nil, nil)
+	assert.NoError(t, err)
+
+	todo.SetTags([]Tag{{ID: 2, Name: "b"}, {ID: 1, Name: "a"}, {ID: 2, Name: "b"}})
+
+	assert.Len(t, todo.Tags, 2)
+	assert.Equal(t, []uint{2, 1}, todo.TagIDs())
+}
@@ -5,38 +5,85 @@ import (
 	"time"
 )
 
-// TodoStatus represents the status of a todo item
+// TodoStatus is the name of a status of the todo's workflow
 type TodoStatus string
 
+// Statuses of the default workflow
 const (
 	StatusPending TodoStatus = "pending"
 	StatusDoing   TodoStatus = "doing"
 	StatusDone    TodoStatus = "done"
 )
 
-// IsValid checks if the TodoStatus is one of the valid values
-func (s TodoStatus) IsValid() bool {
-	switch s {
-	case StatusPending, StatusDoing, StatusDone:
-		return true
-	default:
-		return false
+// TodoPriority represents the triage priority of a todo item
+type TodoPriority string
+
+const (
+	PriorityNone   TodoPriority = "none"
+	PriorityLow    TodoPriority = "low"
+	PriorityMedium TodoPriority = "medium"
+	PriorityHigh   TodoPriority = "high"
+	PriorityUrgent TodoPriority = "urgent"
+)
+
+// priorityLevels orders priorities from lowest to highest
+var priorityLevels = []TodoPriority{PriorityNone, PriorityLow, PriorityMedium, PriorityHigh, PriorityUrgent}
+
+// IsValid checks if the TodoPriority is one of the valid values
+func (p TodoPriority) IsValid() bool {
+	return p.Level() >= 0
+}
+
+// Level returns the rank of the priority, 0 for none up to 4 for urgent, -1 if invalid
+func (p TodoPriority) Level() int {
+	for i, priority := range priorityLevels {
+		if p == priority {
+			return i
+		}
+	}
+	return -1
+}
+
+// PriorityFromLevel returns the priority of the given rank, none if out of range
+func PriorityFromLevel(level int) TodoPriority {
+	if level < 0 || level >= len(priorityLevels) {
+		return PriorityNone
 	}
+	return priorityLevels[level]
 }
 
 // Todo represents a todo item in the domain layer
 type Todo struct {
-	ID          uint       `json:"id"`
-	Title       string     `json:"title"`
-	Description *string    `json:"description,omitempty"`
-	Status      TodoStatus `json:"status"`
-	DueDate     *time.Time `json:"due_date,omitempty"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
-	DeletedAt   *time.Time `json:"deleted_at,omitempty"`
+	ID           uint            `json:"id"`
+	OwnerID      uint            `json:"owner_id,omitempty"`     // user the todo belongs to, 0 for todos created before accounts
+	WorkspaceID  uint            `json:"workspace_id,omitempty"` // workspace the todo belongs to, 0 for todos created before workspaces
+	Title        string          `json:"title"`
+	Description  *string         `json:"description,omitempty"`
+	Status       TodoStatus      `json:"status"`
+	WorkflowID   uint            `json:"workflow_id,omitempty"` // workflow the status belongs to
+	ProjectID    *uint           `json:"project_id,omitempty"`  // project grouping the todo, nil when ungrouped
+	Position     string          `json:"position,omitempty"`    // rank key of the manual order, see RankBetween
+	Priority     TodoPriority    `json:"priority"`
+	DueDate      *time.Time      `json:"due_date,omitempty"`
+	ParentID     *uint           `json:"parent_id,omitempty"` // set when the todo is a subtask
+	Tags         []Tag           `json:"tags,omitempty"`
+	Checklist    []ChecklistItem `json:"checklist,omitempty"`     // ordered by position
+	BlockedBy    []uint          `json:"blocked_by,omitempty"`    // IDs of the todos blocking this one, see Dependency
+	AssigneeIDs  []uint          `json:"assignee_ids,omitempty"`  // IDs of the users responsible for the todo, see Assignment
+	CommentCount int             `json:"comment_count,omitempty"` // comments that are not deleted, filled when reading
+	Recurrence   *Recurrence     `json:"recurrence,omitempty"`    // set on the open occurrence of a recurring todo
+	SeriesID     *uint           `json:"series_id,omitempty"`     // first todo of the recurring series, nil on the first itself
+	Occurrence   int             `json:"occurrence,omitempty"`    // 1-based position in the recurring series
+	StartedAt    *time.Time      `json:"started_at,omitempty"`    // first time the todo moved to an in progress status
+	CompletedAt  *time.Time      `json:"completed_at,omitempty"`  // last time the todo moved to a done status
+	Version      uint            `json:"version,omitempty"`       // incremented on every write, updates of an older version are rejected
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+	DeletedAt    *time.Time      `json:"deleted_at,omitempty"`
 }
 
-// NewTodo creates a new Todo with validation
+// NewTodo creates a new Todo with validation, the status is checked against
+// the workflow by SetWorkflow
 func NewTodo(title string, description *string, status *TodoStatus, dueDate *time.Time) (*Todo, error) {
 	// Validate title
 	if len(title) == 0 {
@@ -59,7 +106,7 @@ func NewTodo(title string, description *string, status *TodoStatus, dueDate *tim
 	// Set default status if not provided
 	todoStatus := StatusPending
 	if status != nil {
-		if !status.IsValid() {
+		if len(*status) == 0 {
 			return nil, errors.New("invalid status")
 		}
 		todoStatus = *status
@@ -72,15 +119,143 @@ func NewTodo(title string, description *string, status *TodoStatus, dueDate *tim
 		return nil, errors.New("due date must be in the future")
 	}
 
-	return &Todo{
+	todo := &Todo{
 		Title:       title,
 		Description: description,
 		Status:      todoStatus,
+		Priority:    PriorityNone,
 		DueDate:     dueDate,
 		CreatedAt:   now,
 		UpdatedAt:   now,
 		DeletedAt:   nil, // New todos are not deleted
-	}, nil
+	}
+
+	return todo, nil
+}
+
+// SetPriority changes the priority of the todo
+func (t *Todo) SetPriority(priority TodoPriority) error {
+	if !priority.IsValid() {
+		return errors.New("invalid priority")
+	}
+	t.Priority = priority
+	return nil
+}
+
+// SetTags replaces the tags of the todo, duplicates are dropped
+func (t *Todo) SetTags(tags []Tag) {
+	seen := make(map[uint]bool, len(tags))
+	t.Tags = make([]Tag, 0, len(tags))
+	for _, tag := range tags {
+		if seen[tag.ID] {
+			continue
+		}
+		seen[tag.ID] = true
+		t.Tags = append(t.Tags, tag)
+	}
+}
+
+// TagIDs returns the IDs of the tags attached to the todo
+func (t *Todo) TagIDs() []uint {
+	ids := make([]uint, len(t.Tags))
+	for i, tag := range t.Tags {
+		ids[i] = tag.ID
+	}
+	return ids
+}
+
+// SetParent makes the todo a subtask of the given parent, nil makes it a top-level todo
+func (t *Todo) SetParent(parentID *uint) error {
+	if parentID != nil && (*parentID == 0 || *parentID == t.ID) {
+		return errors.New("invalid parent")
+	}
+	t.ParentID = parentID
+	return nil
+}
+
+// Progress returns the number of done checklist items and the total number of items
+func (t *Todo) Progress() (done int, total int) {
+	for _, item := range t.Checklist {
+		if item.Done {
+			done++
+		}
+	}
+	return done, len(t.Checklist)
+}
+
+// SetRecurrence makes the todo repeat on the given schedule, nil ends the series with this todo
+func (t *Todo) SetRecurrence(recurrence *Recurrence) error {
+	if recurrence != nil {
+		if t.DueDate == nil {
+			return errors.New("a recurring todo needs a due date")
+		}
+		if err := recurrence.Validate(); err != nil {
+			return err
+		}
+		if t.Occurrence == 0 {
+			t.Occurrence = 1
+		}
+	}
+	t.Recurrence = recurrence
+	return nil
+}
+
+// NextOccurrence builds the todo that follows t in its recurring series, starting in the
+// initial status of t's workflow, occurrences already due by now are skipped. Returns nil
+// when t does not recur or the series has ended by its until or count
+func (t *Todo) NextOccurrence(now time.Time, workflow *Workflow) *Todo {
+	if t.Recurrence == nil || t.DueDate == nil {
+		return nil
+	}
+
+	due, occurrence := *t.DueDate, max(t.Occurrence, 1)
+	for {
+		if t.Recurrence.Count > 0 && occurrence >= t.Recurrence.Count {
+			return nil
+		}
+		next, ok := t.Recurrence.Next(due)
+		if !ok {
+			return nil
+		}
+		due, occurrence = next, occurrence+1
+		if due.After(now) {
+			break
+		}
+	}
+
+	seriesID := t.ID
+	if t.SeriesID != nil {
+		seriesID = *t.SeriesID
+	}
+
+	// the checklist starts over unchecked
+	now = now.UTC()
+	var checklist []ChecklistItem
+	for i, item := range t.Checklist {
+		checklist = append(checklist, ChecklistItem{Text: item.Text, Position: i, CreatedAt: now, UpdatedAt: now})
+	}
+
+	recurrence := *t.Recurrence
+	return &Todo{
+		OwnerID:     t.OwnerID,
+		WorkspaceID: t.WorkspaceID,
+		Title:       t.Title,
+		Description: t.Description,
+		Status:      workflow.InitialStatus(),
+		WorkflowID:  workflow.ID,
+		ProjectID:   t.ProjectID,
+		Priority:    t.Priority,
+		DueDate:     &due,
+		ParentID:    t.ParentID,
+		Tags:        t.Tags,
+		Checklist:   checklist,
+		AssigneeIDs: t.AssigneeIDs,
+		Recurrence:  &recurrence,
+		SeriesID:    &seriesID,
+		Occurrence:  occurrence,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
 }
 
 // IsDeleted checks if the todo is soft deleted
@@ -153,7 +153,7 @@ func Test_todo_status_string(t *testing.T) {
 	}
 }
 
-func Test_todo_status_is_valid(t *testing.T) {
+func Test_todo_status_in_default_workflow(t *testing.T) {
 	tests := []struct {
 		status TodoStatus
 		valid  bool
@@ -167,7 +167,8 @@ func Test_todo_status_is_valid(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(string(tt.status), func(t *testing.T) {
-			assert.Equal(t, tt.valid, tt.status.IsValid())
+			_, ok := DefaultWorkflow().Status(tt.status)
+			assert.Equal(t, tt.valid, ok)
 		})
 	}
 }
@@ -181,6 +182,7 @@ func Test_todo_json_serialization(t *testing.T) {
 		Title:       "測試標題",
 		Description: &description,
 		Status:      StatusDoing,
+		Priority:    PriorityHigh,
 		DueDate:     &dueDate,
 		CreatedAt:   time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
 		UpdatedAt:   time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
@@ -191,7 +193,7 @@ func Test_todo_json_serialization(t *testing.T) {
 	jsonData, err := json.Marshal(todo)
 	assert.NoError(t, err)
 
-	expectedJSON := `{"id":1,"title":"測試標題","description":"測試描述","status":"doing","due_date":"2024-12-31T23:59:59Z","created_at":"2024-01-01T10:00:00Z","updated_at":"2024-01-01T10:00:00Z"}`
+	expectedJSON := `{"id":1,"title":"測試標題","description":"測試描述","status":"doing","priority":"high","due_date":"2024-12-31T23:59:59Z","created_at":"2024-01-01T10:00:00Z","updated_at":"2024-01-01T10:00:00Z"}`
 	assert.JSONEq(t, expectedJSON, string(jsonData))
 
 	// Test JSON unmarshaling
@@ -202,6 +204,7 @@ func Test_todo_json_serialization(t *testing.T) {
 	assert.Equal(t, todo.Title, unmarshaledTodo.Title)
 	assert.Equal(t, *todo.Description, *unmarshaledTodo.Description)
 	assert.Equal(t, todo.Status, unmarshaledTodo.Status)
+	assert.Equal(t, todo.Priority, unmarshaledTodo.Priority)
 	assert.Equal(t, todo.DueDate.Unix(), unmarshaledTodo.DueDate.Unix())
 }
 
@@ -211,6 +214,7 @@ func Test_todo_json_serialization_with_nil_fields(t *testing.T) {
 		Title:       "測試標題",
 		Description: nil,
 		Status:      StatusPending,
+		Priority:    PriorityNone,
 		DueDate:     nil,
 		CreatedAt:   time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
 		UpdatedAt:   time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
@@ -221,7 +225,7 @@ func Test_todo_json_serialization_with_nil_fields(t *testing.T) {
 	jsonData, err := json.Marshal(todo)
 	assert.NoError(t, err)
 
-	expectedJSON := `{"id":1,"title":"測試標題","status":"pending","created_at":"2024-01-01T10:00:00Z","updated_at":"2024-01-01T10:00:00Z"}`
+	expectedJSON := `{"id":1,"title":"測試標題","status":"pending","priority":"none","created_at":"2024-01-01T10:00:00Z","updated_at":"2024-01-01T10:00:00Z"}`
 	assert.JSONEq(t, expectedJSON, string(jsonData))
 }
 
@@ -263,6 +267,46 @@ func Test_todo_restore(t *testing.T) {
 	assert.True(t, todo.UpdatedAt.After(originalUpdatedAt))
 }
 
+func Test_todo_priority(t *testing.T) {
+	tests := []struct {
+		priority TodoPriority
+		valid    bool
+		level    int
+	}{
+		{PriorityNone, true, 0},
+		{PriorityLow, true, 1},
+		{PriorityMedium, true, 2},
+		{PriorityHigh, true, 3},
+		{PriorityUrgent, true, 4},
+		{TodoPriority("critical"), false, -1},
+		{TodoPriority(""), false, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.priority), func(t *testing.T) {
+			assert.Equal(t, tt.valid, tt.priority.IsValid())
+			assert.Equal(t, tt.level, tt.priority.Level())
+			if tt.valid {
+				assert.Equal(t, tt.priority, PriorityFromLevel(tt.level))
+			}
+		})
+	}
+
+	assert.Equal(t, PriorityNone, PriorityFromLevel(99))
+}
+
+func Test_todo_set_priority(t *testing.T) {
+	todo, err := NewTodo("測試標題", nil, nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, PriorityNone, todo.Priority)
+
+	assert.NoError(t, todo.SetPriority(PriorityUrgent))
+	assert.Equal(t, PriorityUrgent, todo.Priority)
+
+	assert.Error(t, todo.SetPriority(TodoPriority("critical")))
+	assert.Equal(t, PriorityUrgent, todo.Priority)
+}
+
 // Helper functions for test cases
 func stringPtr(s string) *string {
 	return &s
@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// User represents an account that signs in to the service and owns todos
+type User struct {
+	ID           uint      `json:"id"`
+	Email        string    `json:"email"`
+	PasswordHash string    `json:"-"` // bcrypt hash, the password itself is never stored
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// NewUser creates a new User with validation, the password is hashed with bcrypt
+func NewUser(email string, password string) (*User, error) {
+	email, err := NormalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	user := &User{
+		Email:     email,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := user.SetPassword(password); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// NormalizeEmail validates an email address and returns it trimmed and lower cased,
+// so the same address always finds the same account
+func NormalizeEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if len(email) == 0 {
+		return "", errors.New("email cannot be empty")
+	}
+	if len(email) > 255 {
+		return "", errors.New("email cannot exceed 255 characters")
+	}
+
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return "", errors.New("email is not a valid address")
+	}
+
+	return email, nil
+}
+
+// SetPassword validates the password and replaces the password hash
+func (u *User) SetPassword(password string) error {
+	if len([]rune(password)) < 8 {
+		return errors.New("password must be at least 8 characters")
+	}
+	// bcrypt only uses the first 72 bytes, longer passwords are rejected instead of truncated
+	if len(password) > 72 {
+		return errors.New("password cannot exceed 72 bytes")
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	u.PasswordHash = string(hash)
+	u.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// CheckPassword reports whether the password matches the password hash
+func (u *User) CheckPassword(password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)) == nil
+}
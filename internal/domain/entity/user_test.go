@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_user_new_user(t *testing.T) {
+	tests := []struct {
+		name      string
+		email     string
+		password  string
+		wantEmail string
+		wantErr   bool
+		errMsg    string
+	}{
+		{
+			name:      "valid_user",
+			email:     "  Alice@Example.com ",
+			password:  "correct horse",
+			wantEmail: "alice@example.com",
+		},
+		{
+			name:     "empty_email_should_fail",
+			email:    " ",
+			password: "correct horse",
+			wantErr:  true,
+			errMsg:   "email cannot be empty",
+		},
+		{
+			name:     "invalid_email_should_fail",
+			email:    "alice",
+			password: "correct horse",
+			wantErr:  true,
+			errMsg:   "email is not a valid address",
+		},
+		{
+			name:     "display_name_should_fail",
+			email:    "Alice <alice@example.com>",
+			password: "correct horse",
+			wantErr:  true,
+			errMsg:   "email is not a valid address",
+		},
+		{
+			name:     "short_password_should_fail",
+			email:    "alice@example.com",
+			password: "1234567",
+			wantErr:  true,
+			errMsg:   "password must be at least 8 characters",
+		},
+		{
+			name:     "long_password_should_fail",
+			email:    "alice@example.com",
+			password: strings.Repeat("a", 73),
+			wantErr:  true,
+			errMsg:   "password cannot exceed 72 bytes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, err := NewUser(tt.email, tt.password)
+
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				assert.Nil(t, user)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEmail, user.Email)
+			assert.NotEqual(t, tt.password, user.PasswordHash)
+			assert.True(t, user.CheckPassword(tt.password))
+			assert.False(t, user.CheckPassword("wrong password"))
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// StatusCategory groups the statuses of a workflow by the stage of work they stand for,
+// the status machine, timestamps and done checks only look at the category
+type StatusCategory string
+
+const (
+	CategoryTodo       StatusCategory = "todo"
+	CategoryInProgress StatusCategory = "in_progress"
+	CategoryDone       StatusCategory = "done"
+)
+
+// IsValid checks if the StatusCategory is one of the valid values
+func (c StatusCategory) IsValid() bool {
+	switch c {
+	case CategoryTodo, CategoryInProgress, CategoryDone:
+		return true
+	default:
+		return false
+	}
+}
+
+// statusNamePattern keeps status names usable as query string values
+var statusNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// WorkflowStatus is a single column of a workflow
+type WorkflowStatus struct {
+	ID         uint           `json:"id"`
+	WorkflowID uint           `json:"workflow_id"`
+	Name       TodoStatus     `json:"name"`
+	Category   StatusCategory `json:"category"`
+	Position   int            `json:"position"` // 0-based order within the workflow
+}
+
+// Workflow is the ordered set of statuses a todo can move through,
+// exactly one workflow of a workspace is the default used by todos that do not pick one
+type Workflow struct {
+	ID          uint             `json:"id"`
+	Name        string           `json:"name"`
+	IsDefault   bool             `json:"is_default"`
+	Statuses    []WorkflowStatus `json:"statuses"`
+	WorkspaceID uint             `json:"workspace_id,omitempty"` // workspace the workflow belongs to, 0 for workflows created before workspaces
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+// DefaultWorkflow returns the built-in pending/doing/done workflow
+func DefaultWorkflow() *Workflow {
+	now := time.Now().UTC()
+	return &Workflow{
+		Name:      "default",
+		IsDefault: true,
+		Statuses: []WorkflowStatus{
+			{Name: StatusPending, Category: CategoryTodo, Position: 0},
+			{Name: StatusDoing, Category: CategoryInProgress, Position: 1},
+			{Name: StatusDone, Category: CategoryDone, Position: 2},
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// NewWorkflow creates a new Workflow with validation, statuses are ordered as given
+func NewWorkflow(name string, statuses []WorkflowStatus) (*Workflow, error) {
+	now := time.Now().UTC()
+	workflow := &Workflow{
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := workflow.Update(name, statuses); err != nil {
+		return nil, err
+	}
+
+	return workflow, nil
+}
+
+// Update replaces the name and statuses of the workflow, statuses are ordered as given and
+// keep their ID so the repository can tell renamed statuses from new ones
+func (w *Workflow) Update(name string, statuses []WorkflowStatus) error {
+	name = strings.TrimSpace(name)
+	if len(name) == 0 {
+		return errors.New("workflow name cannot be empty")
+	}
+	if len([]rune(name)) > 50 {
+		return errors.New("workflow name cannot exceed 50 characters")
+	}
+
+	if len(statuses) == 0 {
+		return errors.New("workflow needs at least one status")
+	}
+	normalized := make([]WorkflowStatus, len(statuses))
+	seen := make(map[TodoStatus]bool)
+	hasTodo, hasDone := false, false
+	for i, status := range statuses {
+		status.Name = TodoStatus(strings.ToLower(strings.TrimSpace(string(status.Name))))
+		if !statusNamePattern.MatchString(string(status.Name)) {
+			return fmt.Errorf("invalid status name %q, use lowercase letters, digits, - and _", status.Name)
+		}
+		if len(status.Name) > 30 {
+			return errors.New("status name cannot exceed 30 characters")
+		}
+		if seen[status.Name] {
+			return fmt.Errorf("duplicate status %s", status.Name)
+		}
+		seen[status.Name] = true
+		if !status.Category.IsValid() {
+			return fmt.Errorf("invalid category %q of status %s", status.Category, status.Name)
+		}
+		hasTodo = hasTodo || status.Category == CategoryTodo
+		hasDone = hasDone || status.Category == CategoryDone
+
+		status.WorkflowID = w.ID
+		status.Position = i
+		normalized[i] = status
+	}
+	if !hasTodo || !hasDone {
+		return errors.New("workflow needs at least one todo and one done status")
+	}
+
+	w.Name = name
+	w.Statuses = normalized
+	w.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// Status returns the status with the given name, false when the workflow does not have it
+func (w *Workflow) Status(name TodoStatus) (WorkflowStatus, bool) {
+	for _, status := range w.Statuses {
+		if status.Name == name {
+			return status, true
+		}
+	}
+	return WorkflowStatus{}, false
+}
+
+// Category returns the category of the given status, statuses unknown to the workflow,
+// e.g. removed while a trashed todo still used them, count as todo
+func (w *Workflow) Category(name TodoStatus) StatusCategory {
+	if status, ok := w.Status(name); ok {
+		return status.Category
+	}
+	return CategoryTodo
+}
+
+// InitialStatus returns the first todo status, used when a todo is created without one
+func (w *Workflow) InitialStatus() TodoStatus {
+	for _, status := range w.Statuses {
+		if status.Category == CategoryTodo {
+			return status.Name
+		}
+	}
+	return StatusPending
+}
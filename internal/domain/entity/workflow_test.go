@@ -0,0 +1,135 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_new_workflow(t *testing.T) {
+	tests := []struct {
+		name     string
+		flowName string
+		statuses []WorkflowStatus
+		errMsg   string
+	}{
+		{
+			name:     "valid",
+			flowName: " Review flow ",
+			statuses: []WorkflowStatus{
+				{Name: "Backlog", Category: CategoryTodo},
+				{Name: "in-review", Category: CategoryInProgress},
+				{Name: "shipped", Category: CategoryDone},
+			},
+		},
+		{
+			name:     "empty_name",
+			flowName: " ",
+			statuses: []WorkflowStatus{{Name: "todo", Category: CategoryTodo}, {Name: "done", Category: CategoryDone}},
+			errMsg:   "workflow name cannot be empty",
+		},
+		{
+			name:     "no_statuses",
+			flowName: "empty",
+			errMsg:   "workflow needs at least one status",
+		},
+		{
+			name:     "invalid_status_name",
+			flowName: "flow",
+			statuses: []WorkflowStatus{{Name: "in review", Category: CategoryTodo}, {Name: "done", Category: CategoryDone}},
+			errMsg:   "invalid status name \"in review\", use lowercase letters, digits, - and _",
+		},
+		{
+			name:     "status_name_too_long",
+			flowName: "flow",
+			statuses: []WorkflowStatus{{Name: "waiting-for-the-other-team-xyz1", Category: CategoryTodo}, {Name: "done", Category: CategoryDone}},
+			errMsg:   "status name cannot exceed 30 characters",
+		},
+		{
+			name:     "duplicate_status",
+			flowName: "flow",
+			statuses: []WorkflowStatus{{Name: "todo", Category: CategoryTodo}, {Name: "TODO", Category: CategoryDone}},
+			errMsg:   "duplicate status todo",
+		},
+		{
+			name:     "invalid_category",
+			flowName: "flow",
+			statuses: []WorkflowStatus{{Name: "todo", Category: "blocked"}, {Name: "done", Category: CategoryDone}},
+			errMsg:   "invalid category \"blocked\" of status todo",
+		},
+		{
+			name:     "missing_done_status",
+			flowName: "flow",
+			statuses: []WorkflowStatus{{Name: "todo", Category: CategoryTodo}, {Name: "doing", Category: CategoryInProgress}},
+			errMsg:   "workflow needs at least one todo and one done status",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workflow, err := NewWorkflow(tt.flowName, tt.statuses)
+
+			if tt.errMsg != "" {
+				assert.EqualError(t, err, tt.errMsg)
+				assert.Nil(t, workflow)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "Review flow", workflow.Name)
+			assert.False(t, workflow.IsDefault)
+			assert.Equal(t, []WorkflowStatus{
+				{Name: "backlog", Category: CategoryTodo, Position: 0},
+				{Name: "in-review", Category: CategoryInProgress, Position: 1},
+				{Name: "shipped", Category: CategoryDone, Position: 2},
+			}, workflow.Statuses)
+			assert.Equal(t, TodoStatus("backlog"), workflow.InitialStatus())
+		})
+	}
+}
+
+func Test_workflow_category(t *testing.T) {
+	workflow := DefaultWorkflow()
+
+	assert.Equal(t, CategoryTodo, workflow.Category(StatusPending))
+	assert.Equal(t, CategoryInProgress, workflow.Category(StatusDoing))
+	assert.Equal(t, CategoryDone, workflow.Category(StatusDone))
+	assert.Equal(t, CategoryTodo, workflow.Category("removed"))
+	assert.Equal(t, StatusPending, workflow.InitialStatus())
+}
+
+func Test_status_machine_custom_workflow(t *testing.T) {
+	workflow, err := NewWorkflow("qa", []WorkflowStatus{
+		{Name: "open", Category: CategoryTodo},
+		{Name: "blocked", Category: CategoryTodo},
+		{Name: "review", Category: CategoryInProgress},
+		{Name: "qa", Category: CategoryInProgress},
+		{Name: "released", Category: CategoryDone},
+		{Name: "wontfix", Category: CategoryDone},
+	})
+	require.NoError(t, err)
+	machine := StatusMachine{Workflow: workflow}
+
+	assert.Equal(t, []TodoStatus{"open", "review", "qa", "released", "wontfix"}, machine.Transitions("blocked"))
+	// without reopen a done todo may only move to another done status
+	assert.Equal(t, []TodoStatus{"wontfix"}, machine.Transitions("released"))
+	assert.False(t, machine.CanTransition("released", "open"))
+	assert.False(t, machine.CanTransition("open", StatusDoing))
+
+	started := time.Date(2026, 4, 1, 9, 0, 0, 0, time.UTC)
+	released := started.Add(48 * time.Hour)
+	todo := &Todo{ID: 1, Status: "open", WorkflowID: workflow.ID}
+	require.NoError(t, todo.TransitionTo("review", machine, started))
+	require.NoError(t, todo.TransitionTo("qa", machine, started.Add(time.Hour)))
+	assert.Equal(t, &started, todo.StartedAt)
+
+	require.NoError(t, todo.TransitionTo("released", machine, released))
+	require.NoError(t, todo.TransitionTo("wontfix", machine, released.Add(time.Hour)))
+	assert.Equal(t, &released, todo.CompletedAt) // moving between done statuses keeps the completion
+	cycleTime, ok := todo.CycleTime()
+	assert.True(t, ok)
+	assert.Equal(t, 48*time.Hour, cycleTime)
+
+	assert.EqualError(t, todo.TransitionTo(StatusDoing, machine, released), "invalid status")
+}
@@ -0,0 +1,115 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WorkspaceRole is what a member may do in a workspace, each role includes the ones below it
+type WorkspaceRole string
+
+const (
+	RoleOwner  WorkspaceRole = "owner"  // everything, including granting and taking the owner role
+	RoleAdmin  WorkspaceRole = "admin"  // manage members and every todo
+	RoleMember WorkspaceRole = "member" // create and edit todos, delete and restore their own
+	RoleViewer WorkspaceRole = "viewer" // read only
+)
+
+// workspaceRoleRanks orders the roles from least to most privileged
+var workspaceRoleRanks = map[WorkspaceRole]int{
+	RoleViewer: 1,
+	RoleMember: 2,
+	RoleAdmin:  3,
+	RoleOwner:  4,
+}
+
+// IsValid reports whether the role is known
+func (r WorkspaceRole) IsValid() bool {
+	_, ok := workspaceRoleRanks[r]
+	return ok
+}
+
+// AtLeast reports whether the role includes the other role, unknown roles include nothing
+func (r WorkspaceRole) AtLeast(other WorkspaceRole) bool {
+	return r.IsValid() && workspaceRoleRanks[r] >= workspaceRoleRanks[other]
+}
+
+// ParseWorkspaceRole validates a role, empty gives the member role
+func ParseWorkspaceRole(role string) (WorkspaceRole, error) {
+	if role == "" {
+		return RoleMember, nil
+	}
+	if !WorkspaceRole(role).IsValid() {
+		return "", fmt.Errorf("invalid workspace role: %s", role)
+	}
+	return WorkspaceRole(role), nil
+}
+
+// Workspace is the tenant of a team, its todos are only visible to its members
+type Workspace struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// WorkspaceMember means the user may work in the workspace
+type WorkspaceMember struct {
+	WorkspaceID uint          `json:"workspace_id"`
+	UserID      uint          `json:"user_id"`
+	Role        WorkspaceRole `json:"role"`
+	Email       string        `json:"email,omitempty"` // email of the user, filled when members are listed
+	CreatedAt   time.Time     `json:"created_at"`
+}
+
+// NewWorkspace creates a new Workspace with validation
+func NewWorkspace(name string) (*Workspace, error) {
+	now := time.Now().UTC()
+	workspace := &Workspace{
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := workspace.Rename(name); err != nil {
+		return nil, err
+	}
+
+	return workspace, nil
+}
+
+// NewWorkspaceMember creates a new WorkspaceMember with validation
+func NewWorkspaceMember(workspaceID uint, userID uint, role WorkspaceRole) (*WorkspaceMember, error) {
+	if workspaceID == 0 {
+		return nil, errors.New("workspace ID cannot be 0")
+	}
+	if userID == 0 {
+		return nil, errors.New("user ID cannot be 0")
+	}
+	if !role.IsValid() {
+		return nil, fmt.Errorf("invalid workspace role: %s", role)
+	}
+
+	return &WorkspaceMember{
+		WorkspaceID: workspaceID,
+		UserID:      userID,
+		Role:        role,
+		CreatedAt:   time.Now().UTC(),
+	}, nil
+}
+
+// Rename changes the name of the workspace, surrounding spaces are trimmed
+func (w *Workspace) Rename(name string) error {
+	name = strings.TrimSpace(name)
+	if len(name) == 0 {
+		return errors.New("workspace name cannot be empty")
+	}
+	if len([]rune(name)) > 50 {
+		return errors.New("workspace name cannot exceed 50 characters")
+	}
+
+	w.Name = name
+	w.UpdatedAt = time.Now().UTC()
+	return nil
+}
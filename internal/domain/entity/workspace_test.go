@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_workspace_new_workspace(t *testing.T) {
+	tests := []struct {
+		name          string
+		workspaceName string
+		wantName      string
+		wantErr       bool
+		errMsg        string
+	}{
+		{
+			name:          "name_is_trimmed",
+			workspaceName: "  平台團隊  ",
+			wantName:      "平台團隊",
+		},
+		{
+			name:          "empty_name_should_fail",
+			workspaceName: "   ",
+			wantErr:       true,
+			errMsg:        "workspace name cannot be empty",
+		},
+		{
+			name:          "name_too_long_should_fail",
+			workspaceName: strings.Repeat("團", 51),
+			wantErr:       true,
+			errMsg:        "workspace name cannot exceed 50 characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workspace, err := NewWorkspace(tt.workspaceName)
+
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				assert.Nil(t, workspace)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantName, workspace.Name)
+		})
+	}
+}
+
+func Test_workspace_new_member(t *testing.T) {
+	member, err := NewWorkspaceMember(1, 2, RoleAdmin)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(1), member.WorkspaceID)
+	assert.Equal(t, uint(2), member.UserID)
+	assert.Equal(t, RoleAdmin, member.Role)
+
+	_, err = NewWorkspaceMember(0, 2, RoleMember)
+	assert.EqualError(t, err, "workspace ID cannot be 0")
+
+	_, err = NewWorkspaceMember(1, 0, RoleMember)
+	assert.EqualError(t, err, "user ID cannot be 0")
+
+	_, err = NewWorkspaceMember(1, 2, "guest")
+	assert.EqualError(t, err, "invalid workspace role: guest")
+}
+
+func Test_workspace_role(t *testing.T) {
+	tests := []struct {
+		role  WorkspaceRole
+		other WorkspaceRole
+		want  bool
+	}{
+		{role: RoleOwner, other: RoleAdmin, want: true},
+		{role: RoleAdmin, other: RoleAdmin, want: true},
+		{role: RoleMember, other: RoleAdmin, want: false},
+		{role: RoleViewer, other: RoleMember, want: false},
+		{role: RoleViewer, other: RoleViewer, want: true},
+		{role: "guest", other: RoleViewer, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role)+"_at_least_"+string(tt.other), func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.role.AtLeast(tt.other))
+		})
+	}
+
+	role, err := ParseWorkspaceRole("")
+	assert.NoError(t, err)
+	assert.Equal(t, RoleMember, role)
+
+	_, err = ParseWorkspaceRole("root")
+	assert.EqualError(t, err, "invalid workspace role: root")
+}
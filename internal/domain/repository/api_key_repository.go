@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// APIKeyRepository defines the interface for API key persistence operations
+//
+//go:generate mockgen -source=api_key_repository.go -destination=api_key_repository_mock.go -package=repository
+type APIKeyRepository interface {
+	// Create creates a new API key and returns the created key with assigned ID
+	Create(ctx context.Context, apiKey *entity.APIKey) (*entity.APIKey, error)
+
+	// GetByID retrieves an API key by its ID, revoked keys included
+	// Returns nil if API key is not found
+	GetByID(ctx context.Context, id uint) (*entity.APIKey, error)
+
+	// GetByPrefix retrieves an API key by its visible prefix, revoked keys included
+	// Returns nil if API key is not found
+	GetByPrefix(ctx context.Context, prefix string) (*entity.APIKey, error)
+
+	// ListByUser retrieves the API keys of a user, newest first, revoked keys included
+	ListByUser(ctx context.Context, userID uint) ([]*entity.APIKey, error)
+
+	// Revoke sets the revoked time of a key that is not revoked yet and returns the number of affected rows
+	Revoke(ctx context.Context, id uint, revokedAt time.Time) (int64, error)
+
+	// TouchLastUsed sets the last used time of a key
+	TouchLastUsed(ctx context.Context, id uint, usedAt time.Time) error
+}
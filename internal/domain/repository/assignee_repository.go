@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// AssigneeRepository defines the interface for todo assignee persistence operations
+//
+//go:generate mockgen -source=assignee_repository.go -destination=assignee_repository_mock.go -package=repository
+type AssigneeRepository interface {
+	// Create assigns a user to a todo and bumps the version of the todo
+	Create(ctx context.Context, assignment *entity.Assignment) error
+
+	// Exists checks if the user is already assigned to the todo
+	Exists(ctx context.Context, todoID uint, userID uint) (bool, error)
+
+	// Delete unassigns a user from a todo, bumps the version of the todo and returns the number of affected rows
+	Delete(ctx context.Context, todoID uint, userID uint) (int64, error)
+}
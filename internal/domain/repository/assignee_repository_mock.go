@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: assignee_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=assignee_repository.go -destination=assignee_repository_mock.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	entity "itmrchow/go-todolist-service/internal/domain/entity"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAssigneeRepository is a mock of AssigneeRepository interface.
+type MockAssigneeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAssigneeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAssigneeRepositoryMockRecorder is the mock recorder for MockAssigneeRepository.
+type MockAssigneeRepositoryMockRecorder struct {
+	mock *MockAssigneeRepository
+}
+
+// NewMockAssigneeRepository creates a new mock instance.
+func NewMockAssigneeRepository(ctrl *gomock.Controller) *MockAssigneeRepository {
+	mock := &MockAssigneeRepository{ctrl: ctrl}
+	mock.recorder = &MockAssigneeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAssigneeRepository) EXPECT() *MockAssigneeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAssigneeRepository) Create(ctx context.Context, assignment *entity.Assignment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, assignment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAssigneeRepositoryMockRecorder) Create(ctx, assignment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAssigneeRepository)(nil).Create), ctx, assignment)
+}
+
+// Delete mocks base method.
+func (m *MockAssigneeRepository) Delete(ctx context.Context, todoID, userID uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, todoID, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAssigneeRepositoryMockRecorder) Delete(ctx, todoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAssigneeRepository)(nil).Delete), ctx, todoID, userID)
+}
+
+// Exists mocks base method.
+func (m *MockAssigneeRepository) Exists(ctx context.Context, todoID, userID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, todoID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockAssigneeRepositoryMockRecorder) Exists(ctx, todoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockAssigneeRepository)(nil).Exists), ctx, todoID, userID)
+}
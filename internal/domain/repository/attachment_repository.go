@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// AttachmentRepository defines the interface for attachment metadata persistence operations
+//
+//go:generate mockgen -source=attachment_repository.go -destination=attachment_repository_mock.go -package=repository
+type AttachmentRepository interface {
+	// Create creates a new attachment and returns the created attachment with assigned ID
+	Create(ctx context.Context, attachment *entity.Attachment) (*entity.Attachment, error)
+
+	// GetByID retrieves an attachment by its ID
+	// Returns nil if attachment is not found
+	GetByID(ctx context.Context, id uint) (*entity.Attachment, error)
+
+	// ListByTodo retrieves the attachments of a todo ordered by ID
+	ListByTodo(ctx context.Context, todoID uint) ([]*entity.Attachment, error)
+
+	// Delete deletes an attachment and returns the number of affected rows
+	Delete(ctx context.Context, id uint) (int64, error)
+
+	// ListOrphans retrieves up to limit attachments whose todo has been purged, ordered by ID
+	// Attachments of soft deleted todos are not orphans
+	ListOrphans(ctx context.Context, limit int) ([]*entity.Attachment, error)
+}
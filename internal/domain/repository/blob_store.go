@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrBlobNotFound is returned when a blob does not exist in the store
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore defines the interface for storing the content of attachments
+// Keys are relative slash separated paths chosen by the caller
+//
+//go:generate mockgen -source=blob_store.go -destination=blob_store_mock.go -package=repository
+type BlobStore interface {
+	// Put stores the content read from r under key, replacing any existing blob
+	Put(ctx context.Context, key string, r io.Reader) error
+
+	// Open opens the blob stored under key, the caller must close it
+	// Returns ErrBlobNotFound if no blob is stored under key
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete removes the blob stored under key
+	// Deleting a missing blob is not an error
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// ChecklistRepository defines the interface for checklist item data persistence operations
+//
+//go:generate mockgen -source=checklist_repository.go -destination=checklist_repository_mock.go -package=repository
+type ChecklistRepository interface {
+	// Create appends a new item to the end of its todo's checklist, bumps the version of the todo
+	// and returns the created item with assigned ID and position
+	Create(ctx context.Context, item *entity.ChecklistItem) (*entity.ChecklistItem, error)
+
+	// GetByID retrieves a checklist item by its ID
+	// Returns nil if item is not found
+	GetByID(ctx context.Context, id uint) (*entity.ChecklistItem, error)
+
+	// ListByTodo retrieves the checklist items of a todo ordered by position
+	ListByTodo(ctx context.Context, todoID uint) ([]*entity.ChecklistItem, error)
+
+	// Update updates the text and done flag of an item, bumps the version of its todo
+	// and returns the number of affected rows
+	Update(ctx context.Context, item *entity.ChecklistItem) (int64, error)
+
+	// Delete permanently removes an item, bumps the version of its todo and returns the number of affected rows
+	Delete(ctx context.Context, id uint) (int64, error)
+
+	// Reorder sets the positions of a todo's items to their index in itemIDs and bumps the version of the todo
+	Reorder(ctx context.Context, todoID uint, itemIDs []uint) error
+}
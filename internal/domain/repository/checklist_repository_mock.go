@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: checklist_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=checklist_repository.go -destination=checklist_repository_mock.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	entity "itmrchow/go-todolist-service/internal/domain/entity"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockChecklistRepository is a mock of ChecklistRepository interface.
+type MockChecklistRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockChecklistRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockChecklistRepositoryMockRecorder is the mock recorder for MockChecklistRepository.
+type MockChecklistRepositoryMockRecorder struct {
+	mock *MockChecklistRepository
+}
+
+// NewMockChecklistRepository creates a new mock instance.
+func NewMockChecklistRepository(ctrl *gomock.Controller) *MockChecklistRepository {
+	mock := &MockChecklistRepository{ctrl: ctrl}
+	mock.recorder = &MockChecklistRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChecklistRepository) EXPECT() *MockChecklistRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockChecklistRepository) Create(ctx context.Context, item *entity.ChecklistItem) (*entity.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, item)
+	ret0, _ := ret[0].(*entity.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockChecklistRepositoryMockRecorder) Create(ctx, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockChecklistRepository)(nil).Create), ctx, item)
+}
+
+// Delete mocks base method.
+func (m *MockChecklistRepository) Delete(ctx context.Context, id uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockChecklistRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockChecklistRepository)(nil).Delete), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockChecklistRepository) GetByID(ctx context.Context, id uint) (*entity.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockChecklistRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockChecklistRepository)(nil).GetByID), ctx, id)
+}
+
+// ListByTodo mocks base method.
+func (m *MockChecklistRepository) ListByTodo(ctx context.Context, todoID uint) ([]*entity.ChecklistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTodo", ctx, todoID)
+	ret0, _ := ret[0].([]*entity.ChecklistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTodo indicates an expected call of ListByTodo.
+func (mr *MockChecklistRepositoryMockRecorder) ListByTodo(ctx, todoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTodo", reflect.TypeOf((*MockChecklistRepository)(nil).ListByTodo), ctx, todoID)
+}
+
+// Reorder mocks base method.
+func (m *MockChecklistRepository) Reorder(ctx context.Context, todoID uint, itemIDs []uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", ctx, todoID, itemIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockChecklistRepositoryMockRecorder) Reorder(ctx, todoID, itemIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockChecklistRepository)(nil).Reorder), ctx, todoID, itemIDs)
+}
+
+// Update mocks base method.
+func (m *MockChecklistRepository) Update(ctx context.Context, item *entity.ChecklistItem) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, item)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockChecklistRepositoryMockRecorder) Update(ctx, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockChecklistRepository)(nil).Update), ctx, item)
+}
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// CommentRepository defines the interface for todo comment persistence operations
+//
+//go:generate mockgen -source=comment_repository.go -destination=comment_repository_mock.go -package=repository
+type CommentRepository interface {
+	// Create creates a new comment and returns the created comment with assigned ID
+	Create(ctx context.Context, comment *entity.Comment) (*entity.Comment, error)
+
+	// GetByID retrieves a comment by its ID
+	// Returns nil if comment is not found or soft deleted
+	GetByID(ctx context.Context, id uint) (*entity.Comment, error)
+
+	// ListByTodo retrieves the comments of a todo with pagination, deleted ones are left out
+	ListByTodo(ctx context.Context, todoID uint, pagination *Pagination[entity.Comment]) error
+
+	// Update updates the body of a comment and returns the number of affected rows
+	Update(ctx context.Context, comment *entity.Comment) (int64, error)
+
+	// Delete soft deletes a comment and returns the number of affected rows
+	Delete(ctx context.Context, id uint) (int64, error)
+}
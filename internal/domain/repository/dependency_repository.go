@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// DependencyRepository defines the interface for todo dependency persistence operations
+//
+//go:generate mockgen -source=dependency_repository.go -destination=dependency_repository_mock.go -package=repository
+type DependencyRepository interface {
+	// Create stores a dependency link between two todos and bumps the version of the blocked todo
+	Create(ctx context.Context, dep *entity.Dependency) error
+
+	// Exists checks if the todo is already blocked by the blocker
+	Exists(ctx context.Context, todoID uint, blockerID uint) (bool, error)
+
+	// Delete removes a dependency link, bumps the version of the blocked todo and returns the number of affected rows
+	Delete(ctx context.Context, todoID uint, blockerID uint) (int64, error)
+
+	// ListByTodos retrieves the links of the given blocked todos in one query
+	ListByTodos(ctx context.Context, todoIDs []uint) ([]*entity.Dependency, error)
+
+	// CountUnfinishedBlockers counts the blockers of a todo that are not in a done status,
+	// blockers in the trash are ignored
+	CountUnfinishedBlockers(ctx context.Context, todoID uint) (int64, error)
+}
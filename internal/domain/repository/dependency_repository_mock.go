@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: dependency_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=dependency_repository.go -destination=dependency_repository_mock.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	entity "itmrchow/go-todolist-service/internal/domain/entity"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDependencyRepository is a mock of DependencyRepository interface.
+type MockDependencyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDependencyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockDependencyRepositoryMockRecorder is the mock recorder for MockDependencyRepository.
+type MockDependencyRepositoryMockRecorder struct {
+	mock *MockDependencyRepository
+}
+
+// NewMockDependencyRepository creates a new mock instance.
+func NewMockDependencyRepository(ctrl *gomock.Controller) *MockDependencyRepository {
+	mock := &MockDependencyRepository{ctrl: ctrl}
+	mock.recorder = &MockDependencyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDependencyRepository) EXPECT() *MockDependencyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountUnfinishedBlockers mocks base method.
+func (m *MockDependencyRepository) CountUnfinishedBlockers(ctx context.Context, todoID uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnfinishedBlockers", ctx, todoID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnfinishedBlockers indicates an expected call of CountUnfinishedBlockers.
+func (mr *MockDependencyRepositoryMockRecorder) CountUnfinishedBlockers(ctx, todoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnfinishedBlockers", reflect.TypeOf((*MockDependencyRepository)(nil).CountUnfinishedBlockers), ctx, todoID)
+}
+
+// Create mocks base method.
+func (m *MockDependencyRepository) Create(ctx context.Context, dep *entity.Dependency) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, dep)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDependencyRepositoryMockRecorder) Create(ctx, dep any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDependencyRepository)(nil).Create), ctx, dep)
+}
+
+// Delete mocks base method.
+func (m *MockDependencyRepository) Delete(ctx context.Context, todoID, blockerID uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, todoID, blockerID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDependencyRepositoryMockRecorder) Delete(ctx, todoID, blockerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDependencyRepository)(nil).Delete), ctx, todoID, blockerID)
+}
+
+// Exists mocks base method.
+func (m *MockDependencyRepository) Exists(ctx context.Context, todoID, blockerID uint) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, todoID, blockerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockDependencyRepositoryMockRecorder) Exists(ctx, todoID, blockerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockDependencyRepository)(nil).Exists), ctx, todoID, blockerID)
+}
+
+// ListByTodos mocks base method.
+func (m *MockDependencyRepository) ListByTodos(ctx context.Context, todoIDs []uint) ([]*entity.Dependency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTodos", ctx, todoIDs)
+	ret0, _ := ret[0].([]*entity.Dependency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTodos indicates an expected call of ListByTodos.
+func (mr *MockDependencyRepositoryMockRecorder) ListByTodos(ctx, todoIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTodos", reflect.TypeOf((*MockDependencyRepository)(nil).ListByTodos), ctx, todoIDs)
+}
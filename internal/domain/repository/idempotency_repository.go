@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// IdempotencyRepository defines the interface for idempotency key persistence operations
+//
+//go:generate mockgen -source=idempotency_repository.go -destination=idempotency_repository_mock.go -package=repository
+type IdempotencyRepository interface {
+	// Create stores a new record unless the user already holds its key,
+	// returns false when the key is taken and the record was not stored
+	Create(ctx context.Context, record *entity.IdempotencyRecord) (bool, error)
+
+	// Get retrieves the record of a key sent by a user, expired records included
+	// Returns nil if the record is not found
+	Get(ctx context.Context, userID uint, key string) (*entity.IdempotencyRecord, error)
+
+	// Update stores the response of a record and returns the number of affected rows
+	Update(ctx context.Context, record *entity.IdempotencyRecord) (int64, error)
+
+	// Delete removes a record and returns the number of affected rows
+	Delete(ctx context.Context, id uint) (int64, error)
+
+	// DeleteExpired removes at most limit records expired at the given time
+	// and returns the number of affected rows
+	DeleteExpired(ctx context.Context, before time.Time, limit int) (int64, error)
+}
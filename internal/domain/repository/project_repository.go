@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// ProjectRepository defines the interface for project data persistence operations
+// Projects belong to a workspace, reads, updates and deletes only touch the projects of the workspace
+// carried by ctx (see actor.WorkspaceID), projects of another workspace behave as missing
+//
+//go:generate mockgen -source=project_repository.go -destination=project_repository_mock.go -package=repository
+type ProjectRepository interface {
+	// Create creates a new project and returns the created project with assigned ID
+	Create(ctx context.Context, project *entity.Project) (*entity.Project, error)
+
+	// GetByID retrieves a project by its ID
+	// Returns nil if project is not found
+	GetByID(ctx context.Context, id uint) (*entity.Project, error)
+
+	// GetByName retrieves a project by its exact name
+	// Returns nil if project is not found
+	GetByName(ctx context.Context, name string) (*entity.Project, error)
+
+	// List retrieves all projects ordered by name
+	List(ctx context.Context) ([]*entity.Project, error)
+
+	// CountByWorkflow counts the projects using the workflow
+	CountByWorkflow(ctx context.Context, workflowID uint) (int64, error)
+
+	// Update updates the name and description of a project and returns the number of affected rows
+	Update(ctx context.Context, project *entity.Project) (int64, error)
+
+	// Delete permanently removes a project and returns the number of affected rows,
+	// cascade moves its todos to the trash, todos left in the project, e.g. trashed ones,
+	// are detached from it; every todo it changes gets a new version
+	Delete(ctx context.Context, id uint, cascade bool) (int64, error)
+}
@@ -0,0 +1,57 @@
+package repository
+
+// SortDirection represents the direction of a sort key
+type SortDirection string
+
+const (
+	SortAsc  SortDirection = "asc"
+	SortDesc SortDirection = "desc"
+)
+
+// IsValid checks if the SortDirection is one of the valid values
+func (d SortDirection) IsValid() bool {
+	switch d {
+	case SortAsc, SortDesc:
+		return true
+	default:
+		return false
+	}
+}
+
+// SortOption is a single validated sort key
+// Field must be a whitelisted column name, never raw user input
+type SortOption struct {
+	Field     string
+	Direction SortDirection
+	NullsLast bool // order NULL values after non-NULL values regardless of direction
+}
+
+// TodoSortField whitelists the columns todos can be sorted by
+type TodoSortField string
+
+const (
+	TodoSortID        TodoSortField = "id"
+	TodoSortTitle     TodoSortField = "title"
+	TodoSortStatus    TodoSortField = "status"
+	TodoSortPriority  TodoSortField = "priority"
+	TodoSortDueDate   TodoSortField = "due_date"
+	TodoSortCreatedAt TodoSortField = "created_at"
+	TodoSortUpdatedAt TodoSortField = "updated_at"
+	TodoSortDeletedAt TodoSortField = "deleted_at" // only meaningful for the trash
+	TodoSortPosition  TodoSortField = "position"   // manual order set by moving todos
+)
+
+// IsValid checks if the TodoSortField can be used to sort active todos
+func (f TodoSortField) IsValid() bool {
+	switch f {
+	case TodoSortID, TodoSortTitle, TodoSortStatus, TodoSortPriority, TodoSortDueDate, TodoSortCreatedAt, TodoSortUpdatedAt, TodoSortPosition:
+		return true
+	default:
+		return false
+	}
+}
+
+// Nullable reports whether the column may hold NULL values
+func (f TodoSortField) Nullable() bool {
+	return f == TodoSortDueDate
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// StatusHistoryRepository defines the interface for todo status history persistence operations
+//
+//go:generate mockgen -source=status_history_repository.go -destination=status_history_repository_mock.go -package=repository
+type StatusHistoryRepository interface {
+	// Create records a status change and returns it with assigned ID
+	Create(ctx context.Context, change *entity.StatusChange) (*entity.StatusChange, error)
+
+	// ListByTodo retrieves the status changes of a todo, oldest first
+	ListByTodo(ctx context.Context, todoID uint) ([]*entity.StatusChange, error)
+}
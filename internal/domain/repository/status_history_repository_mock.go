@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: status_history_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=status_history_repository.go -destination=status_history_repository_mock.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	entity "itmrchow/go-todolist-service/internal/domain/entity"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStatusHistoryRepository is a mock of StatusHistoryRepository interface.
+type MockStatusHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatusHistoryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockStatusHistoryRepositoryMockRecorder is the mock recorder for MockStatusHistoryRepository.
+type MockStatusHistoryRepositoryMockRecorder struct {
+	mock *MockStatusHistoryRepository
+}
+
+// NewMockStatusHistoryRepository creates a new mock instance.
+func NewMockStatusHistoryRepository(ctrl *gomock.Controller) *MockStatusHistoryRepository {
+	mock := &MockStatusHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockStatusHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatusHistoryRepository) EXPECT() *MockStatusHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockStatusHistoryRepository) Create(ctx context.Context, change *entity.StatusChange) (*entity.StatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, change)
+	ret0, _ := ret[0].(*entity.StatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockStatusHistoryRepositoryMockRecorder) Create(ctx, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockStatusHistoryRepository)(nil).Create), ctx, change)
+}
+
+// ListByTodo mocks base method.
+func (m *MockStatusHistoryRepository) ListByTodo(ctx context.Context, todoID uint) ([]*entity.StatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTodo", ctx, todoID)
+	ret0, _ := ret[0].([]*entity.StatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTodo indicates an expected call of ListByTodo.
+func (mr *MockStatusHistoryRepositoryMockRecorder) ListByTodo(ctx, todoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTodo", reflect.TypeOf((*MockStatusHistoryRepository)(nil).ListByTodo), ctx, todoID)
+}
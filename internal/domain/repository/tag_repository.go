@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// TagRepository defines the interface for tag data persistence operations
+// Tags belong to a workspace, reads, updates and deletes only touch the tags of the workspace
+// carried by ctx (see actor.WorkspaceID), tags of another workspace behave as missing
+//
+//go:generate mockgen -source=tag_repository.go -destination=tag_repository_mock.go -package=repository
+type TagRepository interface {
+	// Create creates a new tag and returns the created tag with assigned ID
+	Create(ctx context.Context, tag *entity.Tag) (*entity.Tag, error)
+
+	// GetByID retrieves a tag by its ID
+	// Returns nil if tag is not found
+	GetByID(ctx context.Context, id uint) (*entity.Tag, error)
+
+	// GetByName retrieves a tag by its exact name
+	// Returns nil if tag is not found
+	GetByName(ctx context.Context, name string) (*entity.Tag, error)
+
+	// GetByIDs retrieves the tags with the given IDs, unknown IDs and tags of another workspace are skipped
+	GetByIDs(ctx context.Context, ids []uint) ([]*entity.Tag, error)
+
+	// List retrieves all tags ordered by name
+	List(ctx context.Context) ([]*entity.Tag, error)
+
+	// Update updates the name and color of a tag, bumps the version of the todos carrying it
+	// and returns the number of affected rows
+	Update(ctx context.Context, tag *entity.Tag) (int64, error)
+
+	// Delete permanently removes a tag, detaching it from every todo and bumping their versions,
+	// and returns the number of affected rows
+	Delete(ctx context.Context, id uint) (int64, error)
+}
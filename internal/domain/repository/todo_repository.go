@@ -2,40 +2,109 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"itmrchow/go-todolist-service/internal/domain/entity"
 )
 
 // TodoRepository defines the interface for todo data persistence operations
+// When ctx carries a workspace (see actor.WorkspaceID), reads, counts, updates, deletes and purges
+// only touch the todos of that workspace, todos of another workspace behave as missing.
+// Outside a workspace they only touch the todos owned by the signed-in user (see actor.UserID).
+// Background work marked with actor.WithSystem touches every todo, any other ctx matches no todo
 //
 //go:generate mockgen -source=todo_repository.go -destination=todo_repository_mock.go -package=repository
 type TodoRepository interface {
-	// Create creates a new todo and returns the created todo with assigned ID
+	// Create creates a new todo with its checklist and assignees, attaches its tags and returns the created todo with assigned ID
 	Create(ctx context.Context, todo *entity.Todo) (*entity.Todo, error)
 
 	// GetByID retrieves a todo by its ID
 	// Returns nil if todo is not found or is soft deleted
 	GetByID(ctx context.Context, id uint) (*entity.Todo, error)
 
-	// Update updates an existing todo and returns the number of affected rows
+	// Update updates an existing todo, replacing its tags, and returns the number of affected rows
+	// The row is only written while it still has todo.Version, which is then incremented,
+	// ErrVersionConflict is returned when another write got there first
 	Update(ctx context.Context, todo *entity.Todo) (int64, error)
 
-	// Delete soft deletes a todo (sets DeletedAt timestamp)
+	// UpdateWithHistory updates an existing todo like Update and, in the same transaction, records
+	// the status change and creates the next occurrence of a recurring todo when they are not nil,
+	// nothing is written when any of them fails
+	UpdateWithHistory(ctx context.Context, todo *entity.Todo, change *entity.StatusChange, next *entity.Todo) (int64, error)
+
+	// Delete soft deletes a todo (sets DeletedAt timestamp) and bumps its version
 	Delete(ctx context.Context, id uint) (int64, error)
 
 	// List retrieves todos with pagination and filtering options
 	List(ctx context.Context, queryParams TodoQueryParams, pagination *Pagination[entity.Todo]) error
+
+	// Count returns the number of todos matching the filters (excluding soft deleted ones)
+	Count(ctx context.Context, filters TodoQueryParams) (int64, error)
+
+	// LastPosition returns the highest rank key of the todos, soft deleted ones included,
+	// empty when no todo has a position yet
+	LastPosition(ctx context.Context) (string, error)
+
+	// NeighborPosition returns the rank key of the closest todo after (or before) the given key,
+	// ignoring the todo with excludeID, empty when there is none
+	NeighborPosition(ctx context.Context, position string, after bool, excludeID uint) (string, error)
+
+	// BackfillPositions gives the todos without rank key one after the last key in ID order
+	// and returns the number of updated todos
+	BackfillPositions(ctx context.Context) (int64, error)
+
+	// RebalancePositions replaces the rank keys of the todos, soft deleted ones included,
+	// with short keys in the same order and returns the number of updated todos
+	RebalancePositions(ctx context.Context) (int64, error)
+
+	// GetByIDUnscoped retrieves a todo by its ID including soft deleted ones
+	// Returns nil if todo is not found
+	GetByIDUnscoped(ctx context.Context, id uint) (*entity.Todo, error)
+
+	// ListDeleted retrieves soft deleted todos (the trash) with pagination
+	ListDeleted(ctx context.Context, pagination *Pagination[entity.Todo]) error
+
+	// Restore clears DeletedAt of a soft deleted todo, bumps its version and returns the number of affected rows
+	Restore(ctx context.Context, todo *entity.Todo) (int64, error)
+
+	// HardDelete permanently removes a soft deleted todo with its checklist, detaching its subtasks,
+	// and returns the number of affected rows
+	HardDelete(ctx context.Context, id uint) (int64, error)
+
+	// PurgeDeleted permanently removes all soft deleted todos and returns the number of affected rows
+	PurgeDeleted(ctx context.Context) (int64, error)
+
+	// PurgeDeletedBefore permanently removes at most limit todos soft deleted before the given time
+	// and returns the number of affected rows
+	PurgeDeletedBefore(ctx context.Context, before time.Time, limit int) (int64, error)
+
+	// AssignWorkspace moves the todos of the owner that belong to no workspace into the workspace,
+	// soft deleted ones included, and returns the number of affected rows
+	AssignWorkspace(ctx context.Context, ownerID uint, workspaceID uint) (int64, error)
 }
 
+// ErrInvalidCursor is returned when a pagination cursor is malformed or was issued for another sort
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// ErrVersionConflict is returned when a todo was written by someone else since it was read
+var ErrVersionConflict = errors.New("version conflict")
+
 // Pagination defines options for listing todos
+// With UseCursor set the page is located by Cursor (keyset pagination) instead of Page,
+// an empty Cursor returns the first page
 type Pagination[T any] struct {
-	Limit      int    `json:"limit,omitempty;query:limit"`
-	Page       int    `json:"page,omitempty;query:page"`
-	Sort       string `json:"sort,omitempty;query:sort"`
-	TotalRows  int64  `json:"total_rows"`
-	TotalPages int    `json:"total_pages"`
-	Rows       []*T   `json:"rows"`
+	Limit      int          `json:"limit,omitempty;query:limit"`
+	Page       int          `json:"page,omitempty;query:page"`
+	Sorts      []SortOption `json:"sorts,omitempty"`
+	UseCursor  bool         `json:"use_cursor,omitempty"`
+	Cursor     string       `json:"cursor,omitempty"`
+	SkipCount  bool         `json:"skip_count,omitempty"` // leave TotalRows and TotalPages unset
+	TotalRows  int64        `json:"total_rows"`
+	TotalPages int          `json:"total_pages"`
+	NextCursor string       `json:"next_cursor,omitempty"` // empty when there is no next page
+	PrevCursor string       `json:"prev_cursor,omitempty"` // empty when there is no previous page
+	Rows       []*T         `json:"rows"`
 }
 
 func (p *Pagination[T]) GetOffset() int {
@@ -55,19 +124,47 @@ func (p *Pagination[T]) GetPage() int {
 	return p.Page
 }
 
-func (p *Pagination[T]) GetSort() string {
-	if p.Sort == "" {
-		p.Sort = "Id desc"
+// GetSorts returns the sort keys, always ending with an ID tiebreaker
+// so that rows with equal keys keep a deterministic order
+func (p *Pagination[T]) GetSorts() []SortOption {
+	if len(p.Sorts) == 0 {
+		p.Sorts = []SortOption{{Field: "id", Direction: SortDesc}}
+	}
+
+	for _, sort := range p.Sorts {
+		if sort.Field == "id" {
+			return p.Sorts
+		}
 	}
-	return p.Sort
+
+	// Tiebreak in the direction of the last key
+	p.Sorts = append(p.Sorts, SortOption{Field: "id", Direction: p.Sorts[len(p.Sorts)-1].Direction})
+	return p.Sorts
 }
 
 // TodoQueryParams defines filters for listing todos
+// Range bounds (From/To) are inclusive
 type TodoQueryParams struct {
-	Status      *entity.TodoStatus // filter by status
-	CreatedFrom *time.Time         `json:"created_from"`
-	CreatedTo   *time.Time         `json:"created_to"`
-	DueFrom     *time.Time         `json:"due_from"`
-	DueTo       *time.Time         `json:"due_to"`
-	Keyword     *string            // search in title and description
+	Status       *entity.TodoStatus      // filter by status
+	Statuses     []entity.TodoStatus     // filter by any of the statuses
+	Categories   []entity.StatusCategory // filter by statuses in any of the categories of the todo's workflow
+	WorkflowID   *uint                   // filter by todos of the workflow
+	ProjectID    *uint                   // filter by todos of the project
+	Priorities   []entity.TodoPriority   // filter by any of the priorities
+	MinPriority  *entity.TodoPriority    // filter by priority at or above
+	TagsAny      []uint                  // filter by todos having any of the tag IDs
+	TagsAll      []uint                  // filter by todos having all of the tag IDs
+	AssigneeID   *uint                   // filter by todos assigned to the user
+	Unassigned   bool                    // filter by todos without assignee
+	ParentID     *uint                   // filter by subtasks of the todo
+	SeriesID     *uint                   // filter by occurrences of the recurring series started by the todo
+	CreatedFrom  *time.Time              `json:"created_from"`
+	CreatedTo    *time.Time              `json:"created_to"`
+	DueFrom      *time.Time              `json:"due_from"`
+	DueTo        *time.Time              `json:"due_to"`
+	UpdatedSince *time.Time              `json:"updated_since"`
+	Overdue      *bool                   // true=due date passed and not in a done status, false=not overdue
+	HasDueDate   *bool                   // true=due date set, false=no due date
+	Keyword      *string                 // search in title and description
+	WithTrashed  bool                    // include soft deleted todos
 }
@@ -13,6 +13,7 @@ import (
 	context "context"
 	entity "itmrchow/go-todolist-service/internal/domain/entity"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -41,6 +42,51 @@ func (m *MockTodoRepository) EXPECT() *MockTodoRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AssignWorkspace mocks base method.
+func (m *MockTodoRepository) AssignWorkspace(ctx context.Context, ownerID, workspaceID uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignWorkspace", ctx, ownerID, workspaceID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignWorkspace indicates an expected call of AssignWorkspace.
+func (mr *MockTodoRepositoryMockRecorder) AssignWorkspace(ctx, ownerID, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignWorkspace", reflect.TypeOf((*MockTodoRepository)(nil).AssignWorkspace), ctx, ownerID, workspaceID)
+}
+
+// BackfillPositions mocks base method.
+func (m *MockTodoRepository) BackfillPositions(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillPositions", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackfillPositions indicates an expected call of BackfillPositions.
+func (mr *MockTodoRepositoryMockRecorder) BackfillPositions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillPositions", reflect.TypeOf((*MockTodoRepository)(nil).BackfillPositions), ctx)
+}
+
+// Count mocks base method.
+func (m *MockTodoRepository) Count(ctx context.Context, filters TodoQueryParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, filters)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockTodoRepositoryMockRecorder) Count(ctx, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockTodoRepository)(nil).Count), ctx, filters)
+}
+
 // Create mocks base method.
 func (m *MockTodoRepository) Create(ctx context.Context, todo *entity.Todo) (*entity.Todo, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +132,51 @@ func (mr *MockTodoRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTodoRepository)(nil).GetByID), ctx, id)
 }
 
+// GetByIDUnscoped mocks base method.
+func (m *MockTodoRepository) GetByIDUnscoped(ctx context.Context, id uint) (*entity.Todo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDUnscoped", ctx, id)
+	ret0, _ := ret[0].(*entity.Todo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByIDUnscoped indicates an expected call of GetByIDUnscoped.
+func (mr *MockTodoRepositoryMockRecorder) GetByIDUnscoped(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDUnscoped", reflect.TypeOf((*MockTodoRepository)(nil).GetByIDUnscoped), ctx, id)
+}
+
+// HardDelete mocks base method.
+func (m *MockTodoRepository) HardDelete(ctx context.Context, id uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HardDelete", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HardDelete indicates an expected call of HardDelete.
+func (mr *MockTodoRepositoryMockRecorder) HardDelete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HardDelete", reflect.TypeOf((*MockTodoRepository)(nil).HardDelete), ctx, id)
+}
+
+// LastPosition mocks base method.
+func (m *MockTodoRepository) LastPosition(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastPosition", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastPosition indicates an expected call of LastPosition.
+func (mr *MockTodoRepositoryMockRecorder) LastPosition(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastPosition", reflect.TypeOf((*MockTodoRepository)(nil).LastPosition), ctx)
+}
+
 // List mocks base method.
 func (m *MockTodoRepository) List(ctx context.Context, queryParams TodoQueryParams, pagination *Pagination[entity.Todo]) error {
 	m.ctrl.T.Helper()
@@ -100,6 +191,95 @@ func (mr *MockTodoRepositoryMockRecorder) List(ctx, queryParams, pagination any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTodoRepository)(nil).List), ctx, queryParams, pagination)
 }
 
+// ListDeleted mocks base method.
+func (m *MockTodoRepository) ListDeleted(ctx context.Context, pagination *Pagination[entity.Todo]) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeleted", ctx, pagination)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListDeleted indicates an expected call of ListDeleted.
+func (mr *MockTodoRepositoryMockRecorder) ListDeleted(ctx, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockTodoRepository)(nil).ListDeleted), ctx, pagination)
+}
+
+// NeighborPosition mocks base method.
+func (m *MockTodoRepository) NeighborPosition(ctx context.Context, position string, after bool, excludeID uint) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NeighborPosition", ctx, position, after, excludeID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NeighborPosition indicates an expected call of NeighborPosition.
+func (mr *MockTodoRepositoryMockRecorder) NeighborPosition(ctx, position, after, excludeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NeighborPosition", reflect.TypeOf((*MockTodoRepository)(nil).NeighborPosition), ctx, position, after, excludeID)
+}
+
+// PurgeDeleted mocks base method.
+func (m *MockTodoRepository) PurgeDeleted(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeleted", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeleted indicates an expected call of PurgeDeleted.
+func (mr *MockTodoRepositoryMockRecorder) PurgeDeleted(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeleted", reflect.TypeOf((*MockTodoRepository)(nil).PurgeDeleted), ctx)
+}
+
+// PurgeDeletedBefore mocks base method.
+func (m *MockTodoRepository) PurgeDeletedBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedBefore", ctx, before, limit)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedBefore indicates an expected call of PurgeDeletedBefore.
+func (mr *MockTodoRepositoryMockRecorder) PurgeDeletedBefore(ctx, before, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedBefore", reflect.TypeOf((*MockTodoRepository)(nil).PurgeDeletedBefore), ctx, before, limit)
+}
+
+// RebalancePositions mocks base method.
+func (m *MockTodoRepository) RebalancePositions(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebalancePositions", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebalancePositions indicates an expected call of RebalancePositions.
+func (mr *MockTodoRepositoryMockRecorder) RebalancePositions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebalancePositions", reflect.TypeOf((*MockTodoRepository)(nil).RebalancePositions), ctx)
+}
+
+// Restore mocks base method.
+func (m *MockTodoRepository) Restore(ctx context.Context, todo *entity.Todo) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, todo)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockTodoRepositoryMockRecorder) Restore(ctx, todo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockTodoRepository)(nil).Restore), ctx, todo)
+}
+
 // Update mocks base method.
 func (m *MockTodoRepository) Update(ctx context.Context, todo *entity.Todo) (int64, error) {
 	m.ctrl.T.Helper()
@@ -114,3 +294,18 @@ func (mr *MockTodoRepositoryMockRecorder) Update(ctx, todo any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTodoRepository)(nil).Update), ctx, todo)
 }
+
+// UpdateWithHistory mocks base method.
+func (m *MockTodoRepository) UpdateWithHistory(ctx context.Context, todo *entity.Todo, change *entity.StatusChange, next *entity.Todo) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWithHistory", ctx, todo, change, next)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWithHistory indicates an expected call of UpdateWithHistory.
+func (mr *MockTodoRepositoryMockRecorder) UpdateWithHistory(ctx, todo, change, next any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithHistory", reflect.TypeOf((*MockTodoRepository)(nil).UpdateWithHistory), ctx, todo, change, next)
+}
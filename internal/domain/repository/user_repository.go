@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"context"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// UserRepository defines the interface for user account persistence operations
+//
+//go:generate mockgen -source=user_repository.go -destination=user_repository_mock.go -package=repository
+type UserRepository interface {
+	// Create creates a new user and returns the created user with assigned ID
+	Create(ctx context.Context, user *entity.User) (*entity.User, error)
+
+	// GetByID retrieves a user by its ID
+	// Returns nil if user is not found
+	GetByID(ctx context.Context, id uint) (*entity.User, error)
+
+	// GetByEmail retrieves a user by its normalized email
+	// Returns nil if user is not found
+	GetByEmail(ctx context.Context, email string) (*entity.User, error)
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// WorkflowRepository defines the interface for workflow data persistence operations
+// Workflows belong to a workspace, reads, updates and deletes only touch the workflows of the workspace
+// carried by ctx (see actor.WorkspaceID), workflows of another workspace behave as missing
+//
+//go:generate mockgen -source=workflow_repository.go -destination=workflow_repository_mock.go -package=repository
+type WorkflowRepository interface {
+	// Create creates a new workflow with its statuses and returns it with assigned IDs
+	Create(ctx context.Context, workflow *entity.Workflow) (*entity.Workflow, error)
+
+	// GetByID retrieves a workflow with its statuses in order
+	// Returns nil if workflow is not found
+	GetByID(ctx context.Context, id uint) (*entity.Workflow, error)
+
+	// GetByName retrieves a workflow by its exact name
+	// Returns nil if workflow is not found
+	GetByName(ctx context.Context, name string) (*entity.Workflow, error)
+
+	// GetDefault retrieves the default workflow of the workspace
+	// Returns nil if no default workflow exists yet
+	GetDefault(ctx context.Context) (*entity.Workflow, error)
+
+	// List retrieves all workflows with their statuses, ordered by name
+	List(ctx context.Context) ([]*entity.Workflow, error)
+
+	// Update saves the name and statuses of a workflow and returns the number of affected rows,
+	// statuses without ID are added, missing ones removed and renamed ones are renamed
+	// on the todos of the workflow, trashed todos included
+	Update(ctx context.Context, workflow *entity.Workflow) (int64, error)
+
+	// Delete permanently removes a workflow with its statuses and returns the number of affected rows
+	Delete(ctx context.Context, id uint) (int64, error)
+
+	// EnsureDefault creates the given workflow as the default of the workspace carried by ctx when it has
+	// none yet and moves the todos of the workspace without workflow into it, returns the default workflow
+	EnsureDefault(ctx context.Context, workflow *entity.Workflow) (*entity.Workflow, error)
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// WorkspaceRepository defines the interface for workspace and membership persistence operations
+//
+//go:generate mockgen -source=workspace_repository.go -destination=workspace_repository_mock.go -package=repository
+type WorkspaceRepository interface {
+	// Create creates a new workspace with the creator as its first member and owner
+	// and returns the created workspace with assigned ID
+	Create(ctx context.Context, workspace *entity.Workspace, creatorID uint) (*entity.Workspace, error)
+
+	// CreatePersonal creates the personal workspace of a user like Create unless the user already has one,
+	// returns the personal workspace of the user and false when it already existed
+	CreatePersonal(ctx context.Context, workspace *entity.Workspace, ownerID uint) (*entity.Workspace, bool, error)
+
+	// GetByID retrieves a workspace by its ID
+	// Returns nil if workspace is not found
+	GetByID(ctx context.Context, id uint) (*entity.Workspace, error)
+
+	// ListByMember retrieves the workspaces the user is a member of, oldest first
+	ListByMember(ctx context.Context, userID uint) ([]*entity.Workspace, error)
+
+	// GetMember retrieves the membership of the user in the workspace
+	// Returns nil if the user is not a member
+	GetMember(ctx context.Context, workspaceID uint, userID uint) (*entity.WorkspaceMember, error)
+
+	// ListMembers retrieves the members of a workspace with their email, oldest first
+	ListMembers(ctx context.Context, workspaceID uint) ([]*entity.WorkspaceMember, error)
+
+	// AddMember adds a user to a workspace with the role of the member
+	AddMember(ctx context.Context, member *entity.WorkspaceMember) error
+
+	// UpdateMemberRole changes the role of a member and returns the number of memberships changed
+	UpdateMemberRole(ctx context.Context, workspaceID uint, userID uint, role entity.WorkspaceRole) (int64, error)
+
+	// RemoveMember removes a user from a workspace and returns the number of memberships removed
+	RemoveMember(ctx context.Context, workspaceID uint, userID uint) (int64, error)
+
+	// CountOwners counts the members of a workspace with the owner role
+	CountOwners(ctx context.Context, workspaceID uint) (int64, error)
+
+	// EnsureOwners makes the oldest member the owner of every workspace without one,
+	// such as workspaces created before members had roles, and returns the number of workspaces fixed
+	EnsureOwners(ctx context.Context) (int64, error)
+
+	// ListIDs retrieves the IDs of every workspace, oldest first
+	ListIDs(ctx context.Context) ([]uint, error)
+
+	// AdoptLegacyResources gives the workspace its own copy of the workflows, projects and tags created
+	// before workspaces that its todos use, moves the todos onto the copies and returns the number of copies made.
+	// A resource of the workspace with the same name is taken as the copy, so running it again copies nothing
+	AdoptLegacyResources(ctx context.Context, workspaceID uint) (int64, error)
+}
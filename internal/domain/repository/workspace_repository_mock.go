@@ -0,0 +1,237 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: workspace_repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=workspace_repository.go -destination=workspace_repository_mock.go -package=repository
+//
+
+// Package repository is a generated GoMock package.
+package repository
+
+import (
+	context "context"
+	entity "itmrchow/go-todolist-service/internal/domain/entity"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWorkspaceRepository is a mock of WorkspaceRepository interface.
+type MockWorkspaceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkspaceRepositoryMockRecorder is the mock recorder for MockWorkspaceRepository.
+type MockWorkspaceRepositoryMockRecorder struct {
+	mock *MockWorkspaceRepository
+}
+
+// NewMockWorkspaceRepository creates a new mock instance.
+func NewMockWorkspaceRepository(ctrl *gomock.Controller) *MockWorkspaceRepository {
+	mock := &MockWorkspaceRepository{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkspaceRepository) EXPECT() *MockWorkspaceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddMember mocks base method.
+func (m *MockWorkspaceRepository) AddMember(ctx context.Context, member *entity.WorkspaceMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", ctx, member)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockWorkspaceRepositoryMockRecorder) AddMember(ctx, member any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockWorkspaceRepository)(nil).AddMember), ctx, member)
+}
+
+// AdoptLegacyResources mocks base method.
+func (m *MockWorkspaceRepository) AdoptLegacyResources(ctx context.Context, workspaceID uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdoptLegacyResources", ctx, workspaceID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdoptLegacyResources indicates an expected call of AdoptLegacyResources.
+func (mr *MockWorkspaceRepositoryMockRecorder) AdoptLegacyResources(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdoptLegacyResources", reflect.TypeOf((*MockWorkspaceRepository)(nil).AdoptLegacyResources), ctx, workspaceID)
+}
+
+// CountOwners mocks base method.
+func (m *MockWorkspaceRepository) CountOwners(ctx context.Context, workspaceID uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOwners", ctx, workspaceID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOwners indicates an expected call of CountOwners.
+func (mr *MockWorkspaceRepositoryMockRecorder) CountOwners(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOwners", reflect.TypeOf((*MockWorkspaceRepository)(nil).CountOwners), ctx, workspaceID)
+}
+
+// Create mocks base method.
+func (m *MockWorkspaceRepository) Create(ctx context.Context, workspace *entity.Workspace, creatorID uint) (*entity.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, workspace, creatorID)
+	ret0, _ := ret[0].(*entity.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWorkspaceRepositoryMockRecorder) Create(ctx, workspace, creatorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWorkspaceRepository)(nil).Create), ctx, workspace, creatorID)
+}
+
+// CreatePersonal mocks base method.
+func (m *MockWorkspaceRepository) CreatePersonal(ctx context.Context, workspace *entity.Workspace, ownerID uint) (*entity.Workspace, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePersonal", ctx, workspace, ownerID)
+	ret0, _ := ret[0].(*entity.Workspace)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreatePersonal indicates an expected call of CreatePersonal.
+func (mr *MockWorkspaceRepositoryMockRecorder) CreatePersonal(ctx, workspace, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePersonal", reflect.TypeOf((*MockWorkspaceRepository)(nil).CreatePersonal), ctx, workspace, ownerID)
+}
+
+// EnsureOwners mocks base method.
+func (m *MockWorkspaceRepository) EnsureOwners(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureOwners", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnsureOwners indicates an expected call of EnsureOwners.
+func (mr *MockWorkspaceRepositoryMockRecorder) EnsureOwners(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureOwners", reflect.TypeOf((*MockWorkspaceRepository)(nil).EnsureOwners), ctx)
+}
+
+// GetByID mocks base method.
+func (m *MockWorkspaceRepository) GetByID(ctx context.Context, id uint) (*entity.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockWorkspaceRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockWorkspaceRepository)(nil).GetByID), ctx, id)
+}
+
+// GetMember mocks base method.
+func (m *MockWorkspaceRepository) GetMember(ctx context.Context, workspaceID, userID uint) (*entity.WorkspaceMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMember", ctx, workspaceID, userID)
+	ret0, _ := ret[0].(*entity.WorkspaceMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMember indicates an expected call of GetMember.
+func (mr *MockWorkspaceRepositoryMockRecorder) GetMember(ctx, workspaceID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockWorkspaceRepository)(nil).GetMember), ctx, workspaceID, userID)
+}
+
+// ListByMember mocks base method.
+func (m *MockWorkspaceRepository) ListByMember(ctx context.Context, userID uint) ([]*entity.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByMember", ctx, userID)
+	ret0, _ := ret[0].([]*entity.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByMember indicates an expected call of ListByMember.
+func (mr *MockWorkspaceRepositoryMockRecorder) ListByMember(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByMember", reflect.TypeOf((*MockWorkspaceRepository)(nil).ListByMember), ctx, userID)
+}
+
+// ListIDs mocks base method.
+func (m *MockWorkspaceRepository) ListIDs(ctx context.Context) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIDs", ctx)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIDs indicates an expected call of ListIDs.
+func (mr *MockWorkspaceRepositoryMockRecorder) ListIDs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIDs", reflect.TypeOf((*MockWorkspaceRepository)(nil).ListIDs), ctx)
+}
+
+// ListMembers mocks base method.
+func (m *MockWorkspaceRepository) ListMembers(ctx context.Context, workspaceID uint) ([]*entity.WorkspaceMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", ctx, workspaceID)
+	ret0, _ := ret[0].([]*entity.WorkspaceMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockWorkspaceRepositoryMockRecorder) ListMembers(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockWorkspaceRepository)(nil).ListMembers), ctx, workspaceID)
+}
+
+// RemoveMember mocks base method.
+func (m *MockWorkspaceRepository) RemoveMember(ctx context.Context, workspaceID, userID uint) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, workspaceID, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockWorkspaceRepositoryMockRecorder) RemoveMember(ctx, workspaceID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockWorkspaceRepository)(nil).RemoveMember), ctx, workspaceID, userID)
+}
+
+// UpdateMemberRole mocks base method.
+func (m *MockWorkspaceRepository) UpdateMemberRole(ctx context.Context, workspaceID, userID uint, role entity.WorkspaceRole) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMemberRole", ctx, workspaceID, userID, role)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMemberRole indicates an expected call of UpdateMemberRole.
+func (mr *MockWorkspaceRepositoryMockRecorder) UpdateMemberRole(ctx, workspaceID, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMemberRole", reflect.TypeOf((*MockWorkspaceRepository)(nil).UpdateMemberRole), ctx, workspaceID, userID, role)
+}
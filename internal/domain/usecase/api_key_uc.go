@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=api_key_uc.go -destination=api_key_uc_mock.go -package=usecase
+type APIKeyUseCase interface {
+
+	// CreateAPIKey creates an API key for the signed-in user, the key itself is only returned here
+	// Error:
+	// - unauthorized (no signed-in user)
+	// - validation fail
+	// - internal fail
+	CreateAPIKey(ctx context.Context, req CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+
+	// ListAPIKeys lists the API keys of the signed-in user, newest first, revoked keys included
+	// Error:
+	// - unauthorized (no signed-in user)
+	// - internal fail
+	ListAPIKeys(ctx context.Context) (*ListAPIKeysResponse, error)
+
+	// RevokeAPIKey revokes an API key of the signed-in user, revoking a revoked key succeeds
+	// Error:
+	// - unauthorized (no signed-in user)
+	// - not found (missing or owned by another user)
+	// - internal fail
+	RevokeAPIKey(ctx context.Context, id uint) error
+
+	// Authenticate resolves the user and scopes of an API key and records when it was used,
+	// used by the auth middleware
+	// Error:
+	// - unauthorized (unknown or revoked key, or the user no longer exists)
+	// - internal fail
+	Authenticate(ctx context.Context, key string) (*APIKeyPrincipal, error)
+}
+
+type CreateAPIKeyRequest struct {
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"` // todos:read and/or todos:write
+}
+
+type CreateAPIKeyResponse struct {
+	APIKeyResponse
+	Key string `json:"key"` // shown once, only a hash is stored
+}
+
+type ListAPIKeysResponse struct {
+	APIKeys []APIKeyResponse `json:"api_keys"`
+}
+
+type APIKeyResponse struct {
+	ID         uint       `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// APIKeyPrincipal is who an API key signs in as and what it may do
+type APIKeyPrincipal struct {
+	KeyID  uint     `json:"key_id"`
+	UserID uint     `json:"user_id"`
+	Email  string   `json:"email"`
+	Scopes []string `json:"scopes"`
+}
@@ -0,0 +1,160 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+var _ APIKeyUseCase = &apiKeyUseCaseImpl{}
+
+// lastUsedResolution limits how often last_used_at is written for a busy key
+const lastUsedResolution = time.Minute
+
+type apiKeyUseCaseImpl struct {
+	apiKeyRepo repository.APIKeyRepository
+	userRepo   repository.UserRepository
+}
+
+func NewAPIKeyUseCaseImpl(apiKeyRepo repository.APIKeyRepository, userRepo repository.UserRepository) APIKeyUseCase {
+	return &apiKeyUseCaseImpl{
+		apiKeyRepo: apiKeyRepo,
+		userRepo:   userRepo,
+	}
+}
+
+// CreateAPIKey creates an API key for the signed-in user
+func (a *apiKeyUseCaseImpl) CreateAPIKey(ctx context.Context, req CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	userID := actor.UserID(ctx)
+	if userID == 0 {
+		return nil, errors.New("unauthorized: sign in to manage api keys")
+	}
+
+	scopes := make([]entity.APIKeyScope, len(req.Scopes))
+	for i, scope := range req.Scopes {
+		scopes[i] = entity.APIKeyScope(scope)
+	}
+
+	apiKey, key, err := entity.NewAPIKey(userID, req.Name, scopes)
+	if err != nil {
+		return nil, errors.Join(errors.New("validation fail"), err)
+	}
+
+	apiKey, err = a.apiKeyRepo.Create(ctx, apiKey)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	return &CreateAPIKeyResponse{
+		APIKeyResponse: toAPIKeyResponse(apiKey),
+		Key:            key,
+	}, nil
+}
+
+// ListAPIKeys lists the API keys of the signed-in user
+func (a *apiKeyUseCaseImpl) ListAPIKeys(ctx context.Context) (*ListAPIKeysResponse, error) {
+	userID := actor.UserID(ctx)
+	if userID == 0 {
+		return nil, errors.New("unauthorized: sign in to manage api keys")
+	}
+
+	apiKeys, err := a.apiKeyRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	resp := &ListAPIKeysResponse{APIKeys: make([]APIKeyResponse, len(apiKeys))}
+	for i, apiKey := range apiKeys {
+		resp.APIKeys[i] = toAPIKeyResponse(apiKey)
+	}
+
+	return resp, nil
+}
+
+// RevokeAPIKey revokes an API key of the signed-in user
+func (a *apiKeyUseCaseImpl) RevokeAPIKey(ctx context.Context, id uint) error {
+	userID := actor.UserID(ctx)
+	if userID == 0 {
+		return errors.New("unauthorized: sign in to manage api keys")
+	}
+
+	apiKey, err := a.apiKeyRepo.GetByID(ctx, id)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	// keys of other users are reported missing, so their IDs are not revealed
+	if apiKey == nil || apiKey.UserID != userID {
+		return errors.New("not found: api key not found")
+	}
+	if apiKey.IsRevoked() {
+		return nil
+	}
+
+	if _, err := a.apiKeyRepo.Revoke(ctx, id, time.Now().UTC()); err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+
+	return nil
+}
+
+// Authenticate resolves the user and scopes of an API key
+func (a *apiKeyUseCaseImpl) Authenticate(ctx context.Context, key string) (*APIKeyPrincipal, error) {
+	prefix, ok := entity.ParseAPIKeyPrefix(key)
+	if !ok {
+		return nil, errors.New("unauthorized: malformed api key")
+	}
+
+	apiKey, err := a.apiKeyRepo.GetByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if apiKey == nil || !apiKey.Matches(key) {
+		return nil, errors.New("unauthorized: invalid api key")
+	}
+	if apiKey.IsRevoked() {
+		return nil, errors.New("unauthorized: api key has been revoked")
+	}
+
+	user, err := a.userRepo.GetByID(ctx, apiKey.UserID)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if user == nil {
+		return nil, errors.New("unauthorized: user not found")
+	}
+
+	// last_used_at is informational, a failed write must not reject a valid key
+	now := time.Now().UTC()
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= lastUsedResolution {
+		_ = a.apiKeyRepo.TouchLastUsed(ctx, apiKey.ID, now)
+	}
+
+	return &APIKeyPrincipal{
+		KeyID:  apiKey.ID,
+		UserID: user.ID,
+		Email:  user.Email,
+		Scopes: toAPIKeyResponse(apiKey).Scopes,
+	}, nil
+}
+
+// toAPIKeyResponse converts an API key entity to the usecase response, the hash is left out
+func toAPIKeyResponse(apiKey *entity.APIKey) APIKeyResponse {
+	scopes := make([]string, len(apiKey.Scopes))
+	for i, scope := range apiKey.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	return APIKeyResponse{
+		ID:         apiKey.ID,
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		Scopes:     scopes,
+		LastUsedAt: apiKey.LastUsedAt,
+		RevokedAt:  apiKey.RevokedAt,
+		CreatedAt:  apiKey.CreatedAt,
+	}
+}
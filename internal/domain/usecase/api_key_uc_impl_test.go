@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+type APIKeyUseCaseTestSuite struct {
+	suite.Suite
+	ctrl        *gomock.Controller
+	mockAPIKeys *repository.MockAPIKeyRepository
+	mockUsers   *repository.MockUserRepository
+	uc          APIKeyUseCase
+	ctx         context.Context
+}
+
+func TestAPIKeyUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(APIKeyUseCaseTestSuite))
+}
+
+func (suite *APIKeyUseCaseTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockAPIKeys = repository.NewMockAPIKeyRepository(suite.ctrl)
+	suite.mockUsers = repository.NewMockUserRepository(suite.ctrl)
+	suite.uc = NewAPIKeyUseCaseImpl(suite.mockAPIKeys, suite.mockUsers)
+	suite.ctx = actor.WithUserID(context.Background(), 7)
+}
+
+func (suite *APIKeyUseCaseTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+// newAPIKey returns a stored API key of user 7 with the key itself
+func (suite *APIKeyUseCaseTestSuite) newAPIKey(scopes ...entity.APIKeyScope) (*entity.APIKey, string) {
+	apiKey, key, err := entity.NewAPIKey(7, "ci bot", scopes)
+	suite.Require().NoError(err)
+	apiKey.ID = 3
+	return apiKey, key
+}
+
+func (suite *APIKeyUseCaseTestSuite) TestCreateAPIKey() {
+	suite.Run("success", func() {
+		suite.mockAPIKeys.EXPECT().
+			Create(suite.ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, apiKey *entity.APIKey) (*entity.APIKey, error) {
+				suite.Equal(uint(7), apiKey.UserID)
+				suite.Equal([]entity.APIKeyScope{entity.ScopeTodosRead}, apiKey.Scopes)
+				apiKey.ID = 3
+				return apiKey, nil
+			}).
+			Times(1)
+
+		resp, err := suite.uc.CreateAPIKey(suite.ctx, CreateAPIKeyRequest{Name: "ci bot", Scopes: []string{"todos:read"}})
+
+		suite.Require().NoError(err)
+		suite.Equal(uint(3), resp.ID)
+		suite.Equal([]string{"todos:read"}, resp.Scopes)
+		prefix, ok := entity.ParseAPIKeyPrefix(resp.Key)
+		suite.True(ok)
+		suite.Equal(resp.Prefix, prefix)
+	})
+
+	suite.Run("invalid_scope", func() {
+		_, err := suite.uc.CreateAPIKey(suite.ctx, CreateAPIKeyRequest{Name: "ci bot", Scopes: []string{"admin"}})
+
+		suite.ErrorContains(err, "validation fail")
+	})
+
+	suite.Run("not_signed_in", func() {
+		_, err := suite.uc.CreateAPIKey(context.Background(), CreateAPIKeyRequest{Name: "ci bot", Scopes: []string{"todos:read"}})
+
+		suite.EqualError(err, "unauthorized: sign in to manage api keys")
+	})
+}
+
+func (suite *APIKeyUseCaseTestSuite) TestListAPIKeys() {
+	apiKey, _ := suite.newAPIKey(entity.ScopeTodosRead, entity.ScopeTodosWrite)
+	suite.mockAPIKeys.EXPECT().ListByUser(suite.ctx, uint(7)).Return([]*entity.APIKey{apiKey}, nil).Times(1)
+
+	resp, err := suite.uc.ListAPIKeys(suite.ctx)
+
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.APIKeys, 1)
+	suite.Equal(apiKey.Prefix, resp.APIKeys[0].Prefix)
+	suite.Equal([]string{"todos:read", "todos:write"}, resp.APIKeys[0].Scopes)
+}
+
+func (suite *APIKeyUseCaseTestSuite) TestRevokeAPIKey() {
+	suite.Run("success", func() {
+		apiKey, _ := suite.newAPIKey(entity.ScopeTodosRead)
+		suite.mockAPIKeys.EXPECT().GetByID(suite.ctx, uint(3)).Return(apiKey, nil).Times(1)
+		suite.mockAPIKeys.EXPECT().Revoke(suite.ctx, uint(3), gomock.Any()).Return(int64(1), nil).Times(1)
+
+		suite.NoError(suite.uc.RevokeAPIKey(suite.ctx, 3))
+	})
+
+	suite.Run("already_revoked", func() {
+		apiKey, _ := suite.newAPIKey(entity.ScopeTodosRead)
+		apiKey.Revoke()
+		suite.mockAPIKeys.EXPECT().GetByID(suite.ctx, uint(3)).Return(apiKey, nil).Times(1)
+
+		suite.NoError(suite.uc.RevokeAPIKey(suite.ctx, 3))
+	})
+
+	suite.Run("owned_by_another_user", func() {
+		apiKey, _ := suite.newAPIKey(entity.ScopeTodosRead)
+		apiKey.UserID = 8
+		suite.mockAPIKeys.EXPECT().GetByID(suite.ctx, uint(3)).Return(apiKey, nil).Times(1)
+
+		suite.EqualError(suite.uc.RevokeAPIKey(suite.ctx, 3), "not found: api key not found")
+	})
+}
+
+func (suite *APIKeyUseCaseTestSuite) TestAuthenticate() {
+	ctx := context.Background()
+	alice := &entity.User{ID: 7, Email: "alice@example.com"}
+
+	suite.Run("success_records_last_used", func() {
+		apiKey, key := suite.newAPIKey(entity.ScopeTodosRead)
+		suite.mockAPIKeys.EXPECT().GetByPrefix(ctx, apiKey.Prefix).Return(apiKey, nil).Times(1)
+		suite.mockUsers.EXPECT().GetByID(ctx, uint(7)).Return(alice, nil).Times(1)
+		suite.mockAPIKeys.EXPECT().TouchLastUsed(ctx, uint(3), gomock.Any()).Return(nil).Times(1)
+
+		principal, err := suite.uc.Authenticate(ctx, key)
+
+		suite.NoError(err)
+		suite.Equal(&APIKeyPrincipal{KeyID: 3, UserID: 7, Email: "alice@example.com", Scopes: []string{"todos:read"}}, principal)
+	})
+
+	suite.Run("recently_used_is_not_written_again", func() {
+		apiKey, key := suite.newAPIKey(entity.ScopeTodosRead)
+		usedAt := time.Now().UTC().Add(-10 * time.Second)
+		apiKey.LastUsedAt = &usedAt
+		suite.mockAPIKeys.EXPECT().GetByPrefix(ctx, apiKey.Prefix).Return(apiKey, nil).Times(1)
+		suite.mockUsers.EXPECT().GetByID(ctx, uint(7)).Return(alice, nil).Times(1)
+
+		_, err := suite.uc.Authenticate(ctx, key)
+
+		suite.NoError(err)
+	})
+
+	suite.Run("touch_fail_still_signs_in", func() {
+		apiKey, key := suite.newAPIKey(entity.ScopeTodosRead)
+		suite.mockAPIKeys.EXPECT().GetByPrefix(ctx, apiKey.Prefix).Return(apiKey, nil).Times(1)
+		suite.mockUsers.EXPECT().GetByID(ctx, uint(7)).Return(alice, nil).Times(1)
+		suite.mockAPIKeys.EXPECT().TouchLastUsed(ctx, uint(3), gomock.Any()).Return(errors.New("database is locked")).Times(1)
+
+		_, err := suite.uc.Authenticate(ctx, key)
+
+		suite.NoError(err)
+	})
+
+	suite.Run("wrong_secret", func() {
+		apiKey, key := suite.newAPIKey(entity.ScopeTodosRead)
+		suite.mockAPIKeys.EXPECT().GetByPrefix(ctx, apiKey.Prefix).Return(apiKey, nil).Times(1)
+
+		_, err := suite.uc.Authenticate(ctx, key+"x")
+
+		suite.EqualError(err, "unauthorized: invalid api key")
+	})
+
+	suite.Run("revoked", func() {
+		apiKey, key := suite.newAPIKey(entity.ScopeTodosRead)
+		apiKey.Revoke()
+		suite.mockAPIKeys.EXPECT().GetByPrefix(ctx, apiKey.Prefix).Return(apiKey, nil).Times(1)
+
+		_, err := suite.uc.Authenticate(ctx, key)
+
+		suite.EqualError(err, "unauthorized: api key has been revoked")
+	})
+
+	suite.Run("malformed", func() {
+		_, err := suite.uc.Authenticate(ctx, "not-a-key")
+
+		suite.EqualError(err, "unauthorized: malformed api key")
+	})
+}
@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=assignee_uc.go -destination=assignee_uc_mock.go -package=usecase
+type AssigneeUseCase interface {
+
+	// AssignTodo adds a user to the assignees of a todo and records it in the todo history,
+	// the user has to be a member of the workspace of the todo
+	// Error:
+	// - validation fail (missing IDs, unknown user or not a member)
+	// - forbidden (role may not reassign the todo)
+	// - not found (todo missing or soft deleted)
+	// - conflict (user already assigned)
+	// - internal fail
+	AssignTodo(ctx context.Context, req AssignTodoRequest) error
+
+	// UnassignTodo removes a user from the assignees of a todo and records it in the todo history
+	// Error:
+	// - validation fail
+	// - forbidden (role may not reassign the todo)
+	// - not found (todo or assignment)
+	// - internal fail
+	UnassignTodo(ctx context.Context, req UnassignTodoRequest) error
+}
+
+type AssignTodoRequest struct {
+	TodoID uint `json:"todo_id"`
+	UserID uint `json:"user_id"` // the assignee
+}
+
+type UnassignTodoRequest struct {
+	TodoID uint `json:"todo_id"`
+	UserID uint `json:"user_id"`
+}
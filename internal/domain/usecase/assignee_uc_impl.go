@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+var _ AssigneeUseCase = &assigneeUseCaseImpl{}
+
+type assigneeUseCaseImpl struct {
+	todoRepo      repository.TodoRepository
+	assigneeRepo  repository.AssigneeRepository
+	workspaceRepo repository.WorkspaceRepository
+	userRepo      repository.UserRepository
+	historyRepo   repository.StatusHistoryRepository
+}
+
+func NewAssigneeUseCaseImpl(
+	todoRepo repository.TodoRepository,
+	assigneeRepo repository.AssigneeRepository,
+	workspaceRepo repository.WorkspaceRepository,
+	userRepo repository.UserRepository,
+	historyRepo repository.StatusHistoryRepository,
+) AssigneeUseCase {
+	return &assigneeUseCaseImpl{
+		todoRepo:      todoRepo,
+		assigneeRepo:  assigneeRepo,
+		workspaceRepo: workspaceRepo,
+		userRepo:      userRepo,
+		historyRepo:   historyRepo,
+	}
+}
+
+// AssignTodo adds a user to the assignees of a todo
+func (a *assigneeUseCaseImpl) AssignTodo(ctx context.Context, req AssignTodoRequest) error {
+	assignment, err := entity.NewAssignment(req.TodoID, req.UserID)
+	if err != nil {
+		return errors.Join(errors.New("validation fail"), err)
+	}
+
+	todo, err := a.getTodo(ctx, req.TodoID)
+	if err != nil {
+		return err
+	}
+
+	if err := a.checkAssignee(ctx, todo, req.UserID); err != nil {
+		return err
+	}
+
+	exists, err := a.assigneeRepo.Exists(ctx, req.TodoID, req.UserID)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if exists {
+		return errors.New("conflict: user is already assigned")
+	}
+
+	if err := a.assigneeRepo.Create(ctx, assignment); err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+
+	return a.record(ctx, req.TodoID, entity.EventAssigned, req.UserID)
+}
+
+// UnassignTodo removes a user from the assignees of a todo
+func (a *assigneeUseCaseImpl) UnassignTodo(ctx context.Context, req UnassignTodoRequest) error {
+	if req.UserID == 0 {
+		return errors.New("validation fail: user ID cannot be 0")
+	}
+
+	if _, err := a.getTodo(ctx, req.TodoID); err != nil {
+		return err
+	}
+
+	rowsAffected, err := a.assigneeRepo.Delete(ctx, req.TodoID, req.UserID)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if rowsAffected == 0 {
+		return errors.New("not found: assignment not found")
+	}
+
+	return a.record(ctx, req.TodoID, entity.EventUnassigned, req.UserID)
+}
+
+// getTodo loads an active todo and checks the caller may reassign it
+func (a *assigneeUseCaseImpl) getTodo(ctx context.Context, todoID uint) (*entity.Todo, error) {
+	todo, err := requireTodo(ctx, a.todoRepo, todoID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorizeTodo(ctx, ActionReassignTodo, todo); err != nil {
+		return nil, err
+	}
+
+	return todo, nil
+}
+
+// checkAssignee makes sure the user can work on the todo, a member of its workspace
+// or, for todos outside a workspace, any existing user
+func (a *assigneeUseCaseImpl) checkAssignee(ctx context.Context, todo *entity.Todo, userID uint) error {
+	if todo.WorkspaceID != 0 {
+		member, err := a.workspaceRepo.GetMember(ctx, todo.WorkspaceID, userID)
+		if err != nil {
+			return errors.Join(errors.New("internal fail"), err)
+		}
+		if member == nil {
+			return fmt.Errorf("validation fail: user %d is not a member of the workspace", userID)
+		}
+		return nil
+	}
+
+	user, err := a.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if user == nil {
+		return fmt.Errorf("validation fail: user %d not found", userID)
+	}
+
+	return nil
+}
+
+// record adds the assignee change to the todo history
+func (a *assigneeUseCaseImpl) record(ctx context.Context, todoID uint, event entity.HistoryEvent, userID uint) error {
+	change := entity.NewAssigneeChange(todoID, event, userID, actor.Name(ctx), time.Now())
+	if _, err := a.historyRepo.Create(ctx, change); err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+type AssigneeUseCaseTestSuite struct {
+	suite.Suite
+	ctrl           *gomock.Controller
+	mockRepo       *repository.MockTodoRepository
+	mockAssignees  *repository.MockAssigneeRepository
+	mockWorkspaces *repository.MockWorkspaceRepository
+	mockUsers      *repository.MockUserRepository
+	mockHistory    *repository.MockStatusHistoryRepository
+	uc             AssigneeUseCase
+}
+
+func TestAssigneeUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AssigneeUseCaseTestSuite))
+}
+
+func (suite *AssigneeUseCaseTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockRepo = repository.NewMockTodoRepository(suite.ctrl)
+	suite.mockAssignees = repository.NewMockAssigneeRepository(suite.ctrl)
+	suite.mockWorkspaces = repository.NewMockWorkspaceRepository(suite.ctrl)
+	suite.mockUsers = repository.NewMockUserRepository(suite.ctrl)
+	suite.mockHistory = repository.NewMockStatusHistoryRepository(suite.ctrl)
+	suite.uc = NewAssigneeUseCaseImpl(suite.mockRepo, suite.mockAssignees, suite.mockWorkspaces, suite.mockUsers, suite.mockHistory)
+}
+
+func (suite *AssigneeUseCaseTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+// expectHistory expects the assignee change to be recorded
+func (suite *AssigneeUseCaseTestSuite) expectHistory(ctx context.Context, event entity.HistoryEvent, userID uint) {
+	suite.mockHistory.EXPECT().
+		Create(ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, change *entity.StatusChange) (*entity.StatusChange, error) {
+			assert.Equal(suite.T(), uint(1), change.TodoID)
+			assert.Equal(suite.T(), event, change.Event)
+			assert.Equal(suite.T(), userID, *change.AssigneeID)
+			assert.Equal(suite.T(), "alice", change.Actor)
+			return change, nil
+		}).
+		Times(1)
+}
+
+func (suite *AssigneeUseCaseTestSuite) TestAssignTodo() {
+	ctx := actor.WithName(ownerCtx(), "alice")
+
+	suite.Run("success", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockWorkspaces.EXPECT().GetMember(ctx, uint(3), uint(7)).
+			Return(&entity.WorkspaceMember{WorkspaceID: 3, UserID: 7, Role: entity.RoleMember}, nil).Times(1)
+		suite.mockAssignees.EXPECT().Exists(ctx, uint(1), uint(7)).Return(false, nil).Times(1)
+		suite.mockAssignees.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, assignment *entity.Assignment) error {
+				assert.Equal(suite.T(), uint(1), assignment.TodoID)
+				assert.Equal(suite.T(), uint(7), assignment.UserID)
+				return nil
+			}).
+			Times(1)
+		suite.expectHistory(ctx, entity.EventAssigned, 7)
+
+		err := suite.uc.AssignTodo(ctx, AssignTodoRequest{TodoID: 1, UserID: 7})
+
+		assert.NoError(suite.T(), err)
+	})
+
+	suite.Run("missing_user", func() {
+		err := suite.uc.AssignTodo(ctx, AssignTodoRequest{TodoID: 1})
+
+		assert.EqualError(suite.T(), err, "validation fail\nuser ID cannot be 0")
+	})
+
+	suite.Run("todo_not_found", func() {
+		expectTodo(ctx, suite.mockRepo, 1, false)
+
+		err := suite.uc.AssignTodo(ctx, AssignTodoRequest{TodoID: 1, UserID: 7})
+
+		assert.EqualError(suite.T(), err, "not found: todo not found")
+	})
+
+	suite.Run("not_a_member", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockWorkspaces.EXPECT().GetMember(ctx, uint(3), uint(7)).Return(nil, nil).Times(1)
+
+		err := suite.uc.AssignTodo(ctx, AssignTodoRequest{TodoID: 1, UserID: 7})
+
+		assert.EqualError(suite.T(), err, "validation fail: user 7 is not a member of the workspace")
+	})
+
+	suite.Run("outside_workspace_unknown_user", func() {
+		todo := &entity.Todo{ID: 1, Title: "寫文件", Status: entity.StatusPending}
+		suite.mockRepo.EXPECT().GetByID(ctx, uint(1)).Return(todo, nil).Times(1)
+		suite.mockUsers.EXPECT().GetByID(ctx, uint(7)).Return(nil, nil).Times(1)
+
+		err := suite.uc.AssignTodo(ctx, AssignTodoRequest{TodoID: 1, UserID: 7})
+
+		assert.EqualError(suite.T(), err, "validation fail: user 7 not found")
+	})
+
+	suite.Run("already_assigned", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockWorkspaces.EXPECT().GetMember(ctx, uint(3), uint(7)).
+			Return(&entity.WorkspaceMember{WorkspaceID: 3, UserID: 7, Role: entity.RoleMember}, nil).Times(1)
+		suite.mockAssignees.EXPECT().Exists(ctx, uint(1), uint(7)).Return(true, nil).Times(1)
+
+		err := suite.uc.AssignTodo(ctx, AssignTodoRequest{TodoID: 1, UserID: 7})
+
+		assert.EqualError(suite.T(), err, "conflict: user is already assigned")
+	})
+
+	suite.Run("member_reassigns_own_todo", func() {
+		ownerCtx := actor.WithWorkspaceRole(actor.WithUserID(ctx, 5), string(entity.RoleMember))
+		expectTodo(ownerCtx, suite.mockRepo, 1, true)
+		suite.mockWorkspaces.EXPECT().GetMember(ownerCtx, uint(3), uint(7)).
+			Return(&entity.WorkspaceMember{WorkspaceID: 3, UserID: 7, Role: entity.RoleMember}, nil).Times(1)
+		suite.mockAssignees.EXPECT().Exists(ownerCtx, uint(1), uint(7)).Return(false, nil).Times(1)
+		suite.mockAssignees.EXPECT().Create(ownerCtx, gomock.Any()).Return(nil).Times(1)
+		suite.expectHistory(ownerCtx, entity.EventAssigned, 7)
+
+		err := suite.uc.AssignTodo(ownerCtx, AssignTodoRequest{TodoID: 1, UserID: 7})
+
+		assert.NoError(suite.T(), err)
+	})
+
+	suite.Run("member_forbidden_on_others_todo", func() {
+		memberCtx := actor.WithWorkspaceRole(actor.WithUserID(ctx, 6), string(entity.RoleMember))
+		expectTodo(memberCtx, suite.mockRepo, 1, true)
+
+		err := suite.uc.AssignTodo(memberCtx, AssignTodoRequest{TodoID: 1, UserID: 7})
+
+		assert.EqualError(suite.T(), err, "forbidden: the member role may not reassign_todo")
+	})
+}
+
+func (suite *AssigneeUseCaseTestSuite) TestUnassignTodo() {
+	ctx := actor.WithName(ownerCtx(), "alice")
+
+	suite.Run("success", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockAssignees.EXPECT().Delete(ctx, uint(1), uint(7)).Return(int64(1), nil).Times(1)
+		suite.expectHistory(ctx, entity.EventUnassigned, 7)
+
+		err := suite.uc.UnassignTodo(ctx, UnassignTodoRequest{TodoID: 1, UserID: 7})
+
+		assert.NoError(suite.T(), err)
+	})
+
+	suite.Run("not_assigned", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockAssignees.EXPECT().Delete(ctx, uint(1), uint(7)).Return(int64(0), nil).Times(1)
+
+		err := suite.uc.UnassignTodo(ctx, UnassignTodoRequest{TodoID: 1, UserID: 7})
+
+		assert.EqualError(suite.T(), err, "not found: assignment not found")
+	})
+
+	suite.Run("viewer_forbidden", func() {
+		viewerCtx := actor.WithWorkspaceRole(actor.WithUserID(ctx, 5), string(entity.RoleViewer))
+		expectTodo(viewerCtx, suite.mockRepo, 1, true)
+
+		err := suite.uc.UnassignTodo(viewerCtx, UnassignTodoRequest{TodoID: 1, UserID: 7})
+
+		assert.EqualError(suite.T(), err, "forbidden: the viewer role may not reassign_todo")
+	})
+}
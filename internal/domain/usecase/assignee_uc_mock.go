@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: assignee_uc.go
+//
+// Generated by this command:
+//
+//	mockgen -source=assignee_uc.go -destination=assignee_uc_mock.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAssigneeUseCase is a mock of AssigneeUseCase interface.
+type MockAssigneeUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAssigneeUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockAssigneeUseCaseMockRecorder is the mock recorder for MockAssigneeUseCase.
+type MockAssigneeUseCaseMockRecorder struct {
+	mock *MockAssigneeUseCase
+}
+
+// NewMockAssigneeUseCase creates a new mock instance.
+func NewMockAssigneeUseCase(ctrl *gomock.Controller) *MockAssigneeUseCase {
+	mock := &MockAssigneeUseCase{ctrl: ctrl}
+	mock.recorder = &MockAssigneeUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAssigneeUseCase) EXPECT() *MockAssigneeUseCaseMockRecorder {
+	return m.recorder
+}
+
+// AssignTodo mocks base method.
+func (m *MockAssigneeUseCase) AssignTodo(ctx context.Context, req AssignTodoRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignTodo", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignTodo indicates an expected call of AssignTodo.
+func (mr *MockAssigneeUseCaseMockRecorder) AssignTodo(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignTodo", reflect.TypeOf((*MockAssigneeUseCase)(nil).AssignTodo), ctx, req)
+}
+
+// UnassignTodo mocks base method.
+func (m *MockAssigneeUseCase) UnassignTodo(ctx context.Context, req UnassignTodoRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignTodo", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnassignTodo indicates an expected call of UnassignTodo.
+func (mr *MockAssigneeUseCaseMockRecorder) UnassignTodo(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignTodo", reflect.TypeOf((*MockAssigneeUseCase)(nil).UnassignTodo), ctx, req)
+}
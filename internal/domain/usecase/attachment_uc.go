@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+//go:generate mockgen -source=attachment_uc.go -destination=attachment_uc_mock.go -package=usecase
+type AttachmentUseCase interface {
+
+	// ListAttachments lists the files attached to a todo, oldest first
+	// Error:
+	// - validation fail
+	// - not found (todo missing or soft deleted)
+	// - internal fail
+	ListAttachments(ctx context.Context, todoID uint) (*ListAttachmentsResponse, error)
+
+	// UploadAttachment stores a file and attaches it to a todo, uploaded by the actor of the request
+	// The content type is detected from the content, the name of the file is kept as is
+	// Error:
+	// - validation fail
+	// - not found (todo missing or soft deleted)
+	// - too large (content exceeds the max size)
+	// - unsupported type (content type not allowed)
+	// - internal fail
+	UploadAttachment(ctx context.Context, req UploadAttachmentRequest) (*AttachmentResponse, error)
+
+	// DownloadAttachment opens the content of an attachment, the caller must close it
+	// Error:
+	// - validation fail
+	// - not found (todo, attachment or its content)
+	// - internal fail
+	DownloadAttachment(ctx context.Context, todoID uint, id uint) (*DownloadAttachmentResponse, error)
+
+	// DeleteAttachment removes an attachment and its content
+	// Error:
+	// - validation fail
+	// - not found (todo or attachment)
+	// - internal fail
+	DeleteAttachment(ctx context.Context, todoID uint, id uint) error
+}
+
+// AttachmentOptions holds the upload limits of attachments
+type AttachmentOptions struct {
+	MaxSize      int64    // largest accepted file in bytes, <= 0 means no limit
+	AllowedTypes []string // accepted media types, empty means every type
+}
+
+type ListAttachmentsResponse struct {
+	Attachments []AttachmentResponse `json:"attachments"`
+}
+
+type UploadAttachmentRequest struct {
+	TodoID  uint      `json:"todo_id"`
+	Name    string    `json:"name"`
+	Content io.Reader `json:"-"`
+}
+
+type DownloadAttachmentResponse struct {
+	Attachment AttachmentResponse `json:"attachment"`
+	Content    io.ReadCloser      `json:"-"`
+}
+
+type AttachmentResponse struct {
+	ID          uint      `json:"id"`
+	Name        string    `json:"name"`
+	Size        int64     `json:"size"`
+	ContentType string    `json:"content_type"`
+	SHA256      string    `json:"sha256"`
+	Uploader    string    `json:"uploader"`
+	CreatedAt   time.Time `json:"created_at"`
+}
@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+var _ AttachmentUseCase = &attachmentUseCaseImpl{}
+
+type attachmentUseCaseImpl struct {
+	todoRepo       repository.TodoRepository
+	attachmentRepo repository.AttachmentRepository
+	blobStore      repository.BlobStore
+	opts           AttachmentOptions
+}
+
+func NewAttachmentUseCaseImpl(
+	todoRepo repository.TodoRepository,
+	attachmentRepo repository.AttachmentRepository,
+	blobStore repository.BlobStore,
+	opts AttachmentOptions,
+) AttachmentUseCase {
+	return &attachmentUseCaseImpl{
+		todoRepo:       todoRepo,
+		attachmentRepo: attachmentRepo,
+		blobStore:      blobStore,
+		opts:           opts,
+	}
+}
+
+// ListAttachments lists the files attached to a todo, oldest first
+func (a *attachmentUseCaseImpl) ListAttachments(ctx context.Context, todoID uint) (*ListAttachmentsResponse, error) {
+	if _, err := requireTodo(ctx, a.todoRepo, todoID); err != nil {
+		return nil, err
+	}
+
+	attachments, err := a.attachmentRepo.ListByTodo(ctx, todoID)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	resp := &ListAttachmentsResponse{Attachments: make([]AttachmentResponse, len(attachments))}
+	for i, attachment := range attachments {
+		resp.Attachments[i] = toAttachmentResponse(attachment)
+	}
+
+	return resp, nil
+}
+
+// UploadAttachment stores a file and attaches it to a todo
+func (a *attachmentUseCaseImpl) UploadAttachment(ctx context.Context, req UploadAttachmentRequest) (*AttachmentResponse, error) {
+	if _, err := requireTodo(ctx, a.todoRepo, req.TodoID); err != nil {
+		return nil, err
+	}
+
+	attachment, err := entity.NewAttachment(req.TodoID, req.Name, actor.Name(ctx))
+	if err != nil {
+		return nil, errors.Join(errors.New("validation fail"), err)
+	}
+	if req.Content == nil {
+		return nil, errors.New("validation fail: attachment content cannot be nil")
+	}
+
+	// the declared type of the client is not trusted, sniff it from the first bytes instead
+	content := bufio.NewReaderSize(req.Content, 512)
+	head, err := content.Peek(512)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if len(a.opts.AllowedTypes) > 0 && !slices.Contains(a.opts.AllowedTypes, contentType) {
+		return nil, fmt.Errorf("unsupported type: content type %s is not allowed", contentType)
+	}
+
+	storageKey, err := newStorageKey(req.TodoID)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	// read one byte past the limit so oversized content can be told apart
+	var reader io.Reader = content
+	if a.opts.MaxSize > 0 {
+		reader = io.LimitReader(content, a.opts.MaxSize+1)
+	}
+	hash := sha256.New()
+	var size byteCounter
+	if err := a.blobStore.Put(ctx, storageKey, io.TeeReader(reader, io.MultiWriter(hash, &size))); err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if a.opts.MaxSize > 0 && int64(size) > a.opts.MaxSize {
+		_ = a.blobStore.Delete(ctx, storageKey)
+		return nil, fmt.Errorf("too large: attachment cannot exceed %d bytes", a.opts.MaxSize)
+	}
+
+	attachment.Size = int64(size)
+	attachment.ContentType = contentType
+	attachment.SHA256 = hex.EncodeToString(hash.Sum(nil))
+	attachment.StorageKey = storageKey
+
+	attachment, err = a.attachmentRepo.Create(ctx, attachment)
+	if err != nil {
+		_ = a.blobStore.Delete(ctx, storageKey)
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	resp := toAttachmentResponse(attachment)
+	return &resp, nil
+}
+
+// DownloadAttachment opens the content of an attachment
+func (a *attachmentUseCaseImpl) DownloadAttachment(ctx context.Context, todoID uint, id uint) (*DownloadAttachmentResponse, error) {
+	attachment, err := a.findAttachment(ctx, todoID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := a.blobStore.Open(ctx, attachment.StorageKey)
+	if errors.Is(err, repository.ErrBlobNotFound) {
+		return nil, errors.New("not found: attachment content not found")
+	}
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	return &DownloadAttachmentResponse{
+		Attachment: toAttachmentResponse(attachment),
+		Content:    content,
+	}, nil
+}
+
+// DeleteAttachment removes an attachment and its content
+func (a *attachmentUseCaseImpl) DeleteAttachment(ctx context.Context, todoID uint, id uint) error {
+	attachment, err := a.findAttachment(ctx, todoID, id)
+	if err != nil {
+		return err
+	}
+
+	// the row goes first, a blob left behind is unreachable but harmless
+	rowsAffected, err := a.attachmentRepo.Delete(ctx, id)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if rowsAffected == 0 {
+		return errors.New("not found: attachment not found")
+	}
+
+	if err := a.blobStore.Delete(ctx, attachment.StorageKey); err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+
+	return nil
+}
+
+// findAttachment loads an attachment of the todo, attachments of other todos are reported as not found
+func (a *attachmentUseCaseImpl) findAttachment(ctx context.Context, todoID uint, id uint) (*entity.Attachment, error) {
+	if id == 0 {
+		return nil, errors.New("validation fail: attachment ID cannot be 0")
+	}
+
+	if _, err := requireTodo(ctx, a.todoRepo, todoID); err != nil {
+		return nil, err
+	}
+
+	attachment, err := a.attachmentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if attachment == nil || attachment.TodoID != todoID {
+		return nil, errors.New("not found: attachment not found")
+	}
+
+	return attachment, nil
+}
+
+// newStorageKey returns a random blob key under the folder of the todo,
+// the file name is never part of the key
+func newStorageKey(todoID uint) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("todos/%d/%s", todoID, hex.EncodeToString(b)), nil
+}
+
+// byteCounter counts the bytes written to it
+type byteCounter int64
+
+func (c *byteCounter) Write(p []byte) (int, error) {
+	*c += byteCounter(len(p))
+	return len(p), nil
+}
+
+func toAttachmentResponse(attachment *entity.Attachment) AttachmentResponse {
+	return AttachmentResponse{
+		ID:          attachment.ID,
+		Name:        attachment.Name,
+		Size:        attachment.Size,
+		ContentType: attachment.ContentType,
+		SHA256:      attachment.SHA256,
+		Uploader:    attachment.Uploader,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
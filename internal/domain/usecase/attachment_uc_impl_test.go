@@ -0,0 +1,250 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+type AttachmentUseCaseTestSuite struct {
+	suite.Suite
+	ctrl      *gomock.Controller
+	mockRepo  *repository.MockTodoRepository
+	mockAtts  *repository.MockAttachmentRepository
+	mockBlobs *repository.MockBlobStore
+	uc        AttachmentUseCase
+}
+
+func TestAttachmentUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AttachmentUseCaseTestSuite))
+}
+
+func (suite *AttachmentUseCaseTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockRepo = repository.NewMockTodoRepository(suite.ctrl)
+	suite.mockAtts = repository.NewMockAttachmentRepository(suite.ctrl)
+	suite.mockBlobs = repository.NewMockBlobStore(suite.ctrl)
+	suite.uc = NewAttachmentUseCaseImpl(suite.mockRepo, suite.mockAtts, suite.mockBlobs, AttachmentOptions{
+		MaxSize:      16,
+		AllowedTypes: []string{"text/plain", "image/png"},
+	})
+}
+
+func (suite *AttachmentUseCaseTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+// expectPut stores the blob content into the buffer and returns the key it was stored under
+func (suite *AttachmentUseCaseTestSuite) expectPut(ctx context.Context, buf *bytes.Buffer, key *string) {
+	suite.mockBlobs.EXPECT().
+		Put(ctx, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, k string, r io.Reader) error {
+			*key = k
+			_, err := io.Copy(buf, r)
+			return err
+		}).
+		Times(1)
+}
+
+func (suite *AttachmentUseCaseTestSuite) TestUploadAttachment() {
+	ctx := actor.WithName(context.Background(), "alice")
+
+	suite.Run("success", func() {
+		var stored bytes.Buffer
+		var key string
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.expectPut(ctx, &stored, &key)
+		suite.mockAtts.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, attachment *entity.Attachment) (*entity.Attachment, error) {
+				assert.Equal(suite.T(), key, attachment.StorageKey)
+				attachment.ID = 3
+				return attachment, nil
+			}).
+			Times(1)
+
+		resp, err := suite.uc.UploadAttachment(ctx, UploadAttachmentRequest{
+			TodoID:  1,
+			Name:    "notes/hello.txt",
+			Content: strings.NewReader("hello"),
+		})
+
+		suite.Require().NoError(err)
+		suite.Equal("hello", stored.String())
+		suite.Regexp(`^todos/1/[0-9a-f]{32}$`, key)
+		suite.Equal(uint(3), resp.ID)
+		suite.Equal("hello.txt", resp.Name)
+		suite.Equal(int64(5), resp.Size)
+		suite.Equal("text/plain", resp.ContentType)
+		suite.Equal("2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", resp.SHA256)
+		suite.Equal("alice", resp.Uploader)
+	})
+
+	suite.Run("sniffed_type_not_allowed", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+
+		_, err := suite.uc.UploadAttachment(ctx, UploadAttachmentRequest{
+			TodoID:  1,
+			Name:    "report.pdf",
+			Content: strings.NewReader("%PDF-1.7 rest of the file"),
+		})
+
+		suite.EqualError(err, "unsupported type: content type application/pdf is not allowed")
+	})
+
+	suite.Run("too_large_deletes_the_blob", func() {
+		var stored bytes.Buffer
+		var key string
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.expectPut(ctx, &stored, &key)
+		suite.mockBlobs.EXPECT().Delete(ctx, gomock.Any()).Return(nil).Times(1)
+
+		_, err := suite.uc.UploadAttachment(ctx, UploadAttachmentRequest{
+			TodoID:  1,
+			Name:    "big.txt",
+			Content: strings.NewReader(strings.Repeat("a", 100)),
+		})
+
+		suite.EqualError(err, "too large: attachment cannot exceed 16 bytes")
+		// reading stops one byte past the limit
+		suite.Equal(17, stored.Len())
+	})
+
+	suite.Run("create_fail_deletes_the_blob", func() {
+		var stored bytes.Buffer
+		var key string
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.expectPut(ctx, &stored, &key)
+		suite.mockAtts.EXPECT().Create(ctx, gomock.Any()).Return(nil, errors.New("database error")).Times(1)
+		suite.mockBlobs.EXPECT().Delete(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, k string) error {
+				assert.Equal(suite.T(), key, k)
+				return nil
+			}).
+			Times(1)
+
+		_, err := suite.uc.UploadAttachment(ctx, UploadAttachmentRequest{
+			TodoID:  1,
+			Name:    "hello.txt",
+			Content: strings.NewReader("hello"),
+		})
+
+		suite.ErrorContains(err, "internal fail")
+	})
+
+	suite.Run("invalid_name", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+
+		_, err := suite.uc.UploadAttachment(ctx, UploadAttachmentRequest{
+			TodoID:  1,
+			Name:    "",
+			Content: strings.NewReader("hello"),
+		})
+
+		suite.ErrorContains(err, "validation fail")
+	})
+
+	suite.Run("todo_not_found", func() {
+		expectTodo(ctx, suite.mockRepo, 2, false)
+
+		_, err := suite.uc.UploadAttachment(ctx, UploadAttachmentRequest{
+			TodoID:  2,
+			Name:    "hello.txt",
+			Content: strings.NewReader("hello"),
+		})
+
+		suite.EqualError(err, "not found: todo not found")
+	})
+}
+
+func (suite *AttachmentUseCaseTestSuite) TestListAttachments() {
+	ctx := context.Background()
+
+	expectTodo(ctx, suite.mockRepo, 1, true)
+	suite.mockAtts.EXPECT().ListByTodo(ctx, uint(1)).Return([]*entity.Attachment{
+		{ID: 1, TodoID: 1, Name: "hello.txt", Size: 5, ContentType: "text/plain", StorageKey: "todos/1/a"},
+	}, nil).Times(1)
+
+	resp, err := suite.uc.ListAttachments(ctx, 1)
+
+	suite.NoError(err)
+	suite.Equal([]AttachmentResponse{{ID: 1, Name: "hello.txt", Size: 5, ContentType: "text/plain"}}, resp.Attachments)
+}
+
+func (suite *AttachmentUseCaseTestSuite) TestDownloadAttachment() {
+	ctx := context.Background()
+	attachment := &entity.Attachment{ID: 1, TodoID: 1, Name: "hello.txt", Size: 5, ContentType: "text/plain", StorageKey: "todos/1/a"}
+
+	suite.Run("success", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockAtts.EXPECT().GetByID(ctx, uint(1)).Return(attachment, nil).Times(1)
+		suite.mockBlobs.EXPECT().Open(ctx, "todos/1/a").Return(io.NopCloser(strings.NewReader("hello")), nil).Times(1)
+
+		resp, err := suite.uc.DownloadAttachment(ctx, 1, 1)
+
+		suite.Require().NoError(err)
+		defer resp.Content.Close()
+		content, _ := io.ReadAll(resp.Content)
+		suite.Equal("hello", string(content))
+		suite.Equal("hello.txt", resp.Attachment.Name)
+	})
+
+	suite.Run("attachment_of_another_todo", func() {
+		expectTodo(ctx, suite.mockRepo, 2, true)
+		suite.mockAtts.EXPECT().GetByID(ctx, uint(1)).Return(attachment, nil).Times(1)
+
+		_, err := suite.uc.DownloadAttachment(ctx, 2, 1)
+
+		suite.EqualError(err, "not found: attachment not found")
+	})
+
+	suite.Run("blob_missing", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockAtts.EXPECT().GetByID(ctx, uint(1)).Return(attachment, nil).Times(1)
+		suite.mockBlobs.EXPECT().Open(ctx, "todos/1/a").Return(nil, repository.ErrBlobNotFound).Times(1)
+
+		_, err := suite.uc.DownloadAttachment(ctx, 1, 1)
+
+		suite.EqualError(err, "not found: attachment content not found")
+	})
+}
+
+func (suite *AttachmentUseCaseTestSuite) TestDeleteAttachment() {
+	ctx := context.Background()
+	attachment := &entity.Attachment{ID: 1, TodoID: 1, Name: "hello.txt", StorageKey: "todos/1/a"}
+
+	suite.Run("success", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockAtts.EXPECT().GetByID(ctx, uint(1)).Return(attachment, nil).Times(1)
+		gomock.InOrder(
+			suite.mockAtts.EXPECT().Delete(ctx, uint(1)).Return(int64(1), nil),
+			suite.mockBlobs.EXPECT().Delete(ctx, "todos/1/a").Return(nil),
+		)
+
+		suite.NoError(suite.uc.DeleteAttachment(ctx, 1, 1))
+	})
+
+	suite.Run("zero_id", func() {
+		suite.ErrorContains(suite.uc.DeleteAttachment(ctx, 1, 0), "validation fail")
+	})
+
+	suite.Run("not_found", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockAtts.EXPECT().GetByID(ctx, uint(9)).Return(nil, nil).Times(1)
+
+		suite.EqualError(suite.uc.DeleteAttachment(ctx, 1, 9), "not found: attachment not found")
+	})
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: attachment_uc.go
+//
+// Generated by this command:
+//
+//	mockgen -source=attachment_uc.go -destination=attachment_uc_mock.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAttachmentUseCase is a mock of AttachmentUseCase interface.
+type MockAttachmentUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttachmentUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockAttachmentUseCaseMockRecorder is the mock recorder for MockAttachmentUseCase.
+type MockAttachmentUseCaseMockRecorder struct {
+	mock *MockAttachmentUseCase
+}
+
+// NewMockAttachmentUseCase creates a new mock instance.
+func NewMockAttachmentUseCase(ctrl *gomock.Controller) *MockAttachmentUseCase {
+	mock := &MockAttachmentUseCase{ctrl: ctrl}
+	mock.recorder = &MockAttachmentUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttachmentUseCase) EXPECT() *MockAttachmentUseCaseMockRecorder {
+	return m.recorder
+}
+
+// DeleteAttachment mocks base method.
+func (m *MockAttachmentUseCase) DeleteAttachment(ctx context.Context, todoID, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachment", ctx, todoID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachment indicates an expected call of DeleteAttachment.
+func (mr *MockAttachmentUseCaseMockRecorder) DeleteAttachment(ctx, todoID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachment", reflect.TypeOf((*MockAttachmentUseCase)(nil).DeleteAttachment), ctx, todoID, id)
+}
+
+// DownloadAttachment mocks base method.
+func (m *MockAttachmentUseCase) DownloadAttachment(ctx context.Context, todoID, id uint) (*DownloadAttachmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadAttachment", ctx, todoID, id)
+	ret0, _ := ret[0].(*DownloadAttachmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadAttachment indicates an expected call of DownloadAttachment.
+func (mr *MockAttachmentUseCaseMockRecorder) DownloadAttachment(ctx, todoID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadAttachment", reflect.TypeOf((*MockAttachmentUseCase)(nil).DownloadAttachment), ctx, todoID, id)
+}
+
+// ListAttachments mocks base method.
+func (m *MockAttachmentUseCase) ListAttachments(ctx context.Context, todoID uint) (*ListAttachmentsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAttachments", ctx, todoID)
+	ret0, _ := ret[0].(*ListAttachmentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttachments indicates an expected call of ListAttachments.
+func (mr *MockAttachmentUseCaseMockRecorder) ListAttachments(ctx, todoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttachments", reflect.TypeOf((*MockAttachmentUseCase)(nil).ListAttachments), ctx, todoID)
+}
+
+// UploadAttachment mocks base method.
+func (m *MockAttachmentUseCase) UploadAttachment(ctx context.Context, req UploadAttachmentRequest) (*AttachmentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadAttachment", ctx, req)
+	ret0, _ := ret[0].(*AttachmentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadAttachment indicates an expected call of UploadAttachment.
+func (mr *MockAttachmentUseCaseMockRecorder) UploadAttachment(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachment", reflect.TypeOf((*MockAttachmentUseCase)(nil).UploadAttachment), ctx, req)
+}
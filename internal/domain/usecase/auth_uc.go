@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=auth_uc.go -destination=auth_uc_mock.go -package=usecase
+type AuthUseCase interface {
+
+	// Register creates a user account
+	// Error:
+	// - validation fail
+	// - conflict (email already registered)
+	// - internal fail
+	Register(ctx context.Context, req RegisterRequest) (*RegisterResponse, error)
+
+	// Login checks the credentials of a user and issues an access token and a refresh token
+	// Error:
+	// - unauthorized (unknown email or wrong password, never told apart)
+	// - internal fail
+	Login(ctx context.Context, req LoginRequest) (*TokenResponse, error)
+
+	// Refresh issues a new token pair for a valid refresh token
+	// Error:
+	// - unauthorized (invalid or expired token, or the user no longer exists)
+	// - internal fail
+	Refresh(ctx context.Context, refreshToken string) (*TokenResponse, error)
+
+	// Authenticate resolves the user of an access token, used by the auth middleware
+	// Error:
+	// - unauthorized (invalid or expired token, or the user no longer exists)
+	// - internal fail
+	Authenticate(ctx context.Context, accessToken string) (*UserResponse, error)
+}
+
+// AuthOptions holds the signing secret and lifetimes of the issued tokens
+type AuthOptions struct {
+	Secret          []byte
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+}
+
+type RegisterRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+type RegisterResponse struct {
+	ID uint `json:"id"`
+}
+
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+type TokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"` // always Bearer
+	ExpiresIn    int64  `json:"expires_in"` // lifetime of the access token in seconds
+}
+
+type UserResponse struct {
+	ID    uint   `json:"id"`
+	Email string `json:"email"`
+}
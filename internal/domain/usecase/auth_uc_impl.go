@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/utils/token"
+)
+
+var _ AuthUseCase = &authUseCaseImpl{}
+
+// dummyPasswordHash is compared against when the email is unknown,
+// so a failed login takes as long whether or not the account exists
+const dummyPasswordHash = "$2a$10$KO3TwWqvQXFzOyxMrmYc5.drj/DejrL9N7MbCa5RqvPYHiQELODsW"
+
+type authUseCaseImpl struct {
+	userRepo repository.UserRepository
+	opts     AuthOptions
+}
+
+func NewAuthUseCaseImpl(userRepo repository.UserRepository, opts AuthOptions) AuthUseCase {
+	return &authUseCaseImpl{
+		userRepo: userRepo,
+		opts:     opts,
+	}
+}
+
+// Register creates a user account
+func (a *authUseCaseImpl) Register(ctx context.Context, req RegisterRequest) (*RegisterResponse, error) {
+	user, err := entity.NewUser(req.Email, req.Password)
+	if err != nil {
+		return nil, errors.Join(errors.New("validation fail"), err)
+	}
+
+	existing, err := a.userRepo.GetByEmail(ctx, user.Email)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if existing != nil {
+		return nil, errors.New("conflict: email already registered")
+	}
+
+	user, err = a.userRepo.Create(ctx, user)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	return &RegisterResponse{ID: user.ID}, nil
+}
+
+// Login checks the credentials of a user and issues a token pair
+func (a *authUseCaseImpl) Login(ctx context.Context, req LoginRequest) (*TokenResponse, error) {
+	var user *entity.User
+	if email, err := entity.NormalizeEmail(req.Email); err == nil {
+		if user, err = a.userRepo.GetByEmail(ctx, email); err != nil {
+			return nil, errors.Join(errors.New("internal fail"), err)
+		}
+	}
+
+	if user == nil {
+		(&entity.User{PasswordHash: dummyPasswordHash}).CheckPassword(req.Password)
+		return nil, errors.New("unauthorized: invalid email or password")
+	}
+	if !user.CheckPassword(req.Password) {
+		return nil, errors.New("unauthorized: invalid email or password")
+	}
+
+	return a.issueTokens(user)
+}
+
+// Refresh issues a new token pair for a valid refresh token
+func (a *authUseCaseImpl) Refresh(ctx context.Context, refreshToken string) (*TokenResponse, error) {
+	user, err := a.verify(ctx, refreshToken, token.TypeRefresh)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.issueTokens(user)
+}
+
+// Authenticate resolves the user of an access token
+func (a *authUseCaseImpl) Authenticate(ctx context.Context, accessToken string) (*UserResponse, error) {
+	user, err := a.verify(ctx, accessToken, token.TypeAccess)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserResponse{ID: user.ID, Email: user.Email}, nil
+}
+
+// verify checks a token of the given type and loads its user, which must still exist
+func (a *authUseCaseImpl) verify(ctx context.Context, tokenString string, tokenType token.Type) (*entity.User, error) {
+	claims, err := token.Verify(tokenString, a.opts.Secret, time.Now())
+	if err != nil {
+		return nil, errors.Join(errors.New("unauthorized"), err)
+	}
+	if claims.Type != tokenType {
+		return nil, errors.New("unauthorized: wrong token type")
+	}
+
+	userID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil || userID == 0 {
+		return nil, errors.New("unauthorized: invalid token subject")
+	}
+
+	user, err := a.userRepo.GetByID(ctx, uint(userID))
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if user == nil {
+		return nil, errors.New("unauthorized: user not found")
+	}
+
+	return user, nil
+}
+
+// issueTokens signs a new access token and refresh token for the user
+func (a *authUseCaseImpl) issueTokens(user *entity.User) (*TokenResponse, error) {
+	now := time.Now()
+	subject := strconv.FormatUint(uint64(user.ID), 10)
+
+	accessToken, err := token.Sign(token.Claims{
+		Subject:   subject,
+		Type:      token.TypeAccess,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(a.opts.AccessTokenTTL).Unix(),
+	}, a.opts.Secret)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	refreshToken, err := token.Sign(token.Claims{
+		Subject:   subject,
+		Type:      token.TypeRefresh,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(a.opts.RefreshTokenTTL).Unix(),
+	}, a.opts.Secret)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	return &TokenResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(a.opts.AccessTokenTTL / time.Second),
+	}, nil
+}
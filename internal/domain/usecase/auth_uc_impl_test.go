@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/utils/token"
+)
+
+var testAuthSecret = []byte("0123456789abcdef0123456789abcdef")
+
+type AuthUseCaseTestSuite struct {
+	suite.Suite
+	ctrl      *gomock.Controller
+	mockUsers *repository.MockUserRepository
+	uc        AuthUseCase
+	alice     *entity.User
+}
+
+func TestAuthUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(AuthUseCaseTestSuite))
+}
+
+// SetupSuite hashes the password once, bcrypt is slow on purpose
+func (suite *AuthUseCaseTestSuite) SetupSuite() {
+	alice, err := entity.NewUser("alice@example.com", "correct horse")
+	suite.Require().NoError(err)
+	alice.ID = 7
+	suite.alice = alice
+}
+
+func (suite *AuthUseCaseTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockUsers = repository.NewMockUserRepository(suite.ctrl)
+	suite.uc = NewAuthUseCaseImpl(suite.mockUsers, AuthOptions{
+		Secret:          testAuthSecret,
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: time.Hour,
+	})
+}
+
+func (suite *AuthUseCaseTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+// signToken signs a token for the user that expires after ttl, negative ttl gives an expired token
+func signToken(userID string, tokenType token.Type, ttl time.Duration) string {
+	now := time.Now()
+	signed, _ := token.Sign(token.Claims{Subject: userID, Type: tokenType, IssuedAt: now.Unix(), ExpiresAt: now.Add(ttl).Unix()}, testAuthSecret)
+	return signed
+}
+
+func (suite *AuthUseCaseTestSuite) TestRegister() {
+	ctx := context.Background()
+
+	suite.Run("success", func() {
+		suite.mockUsers.EXPECT().GetByEmail(ctx, "bob@example.com").Return(nil, nil).Times(1)
+		suite.mockUsers.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, user *entity.User) (*entity.User, error) {
+				suite.Equal("bob@example.com", user.Email)
+				suite.True(user.CheckPassword("battery staple"))
+				user.ID = 8
+				return user, nil
+			}).
+			Times(1)
+
+		resp, err := suite.uc.Register(ctx, RegisterRequest{Email: "Bob@Example.com", Password: "battery staple"})
+
+		suite.NoError(err)
+		suite.Equal(uint(8), resp.ID)
+	})
+
+	suite.Run("email_taken", func() {
+		suite.mockUsers.EXPECT().GetByEmail(ctx, "alice@example.com").Return(suite.alice, nil).Times(1)
+
+		_, err := suite.uc.Register(ctx, RegisterRequest{Email: "alice@example.com", Password: "battery staple"})
+
+		suite.EqualError(err, "conflict: email already registered")
+	})
+
+	suite.Run("weak_password", func() {
+		_, err := suite.uc.Register(ctx, RegisterRequest{Email: "bob@example.com", Password: "short"})
+
+		suite.ErrorContains(err, "validation fail")
+	})
+}
+
+func (suite *AuthUseCaseTestSuite) TestLogin() {
+	ctx := context.Background()
+
+	suite.Run("success", func() {
+		suite.mockUsers.EXPECT().GetByEmail(ctx, "alice@example.com").Return(suite.alice, nil).Times(1)
+
+		resp, err := suite.uc.Login(ctx, LoginRequest{Email: " Alice@example.com", Password: "correct horse"})
+
+		suite.Require().NoError(err)
+		suite.Equal("Bearer", resp.TokenType)
+		suite.Equal(int64(900), resp.ExpiresIn)
+
+		access, err := token.Verify(resp.AccessToken, testAuthSecret, time.Now())
+		suite.Require().NoError(err)
+		suite.Equal("7", access.Subject)
+		suite.Equal(token.TypeAccess, access.Type)
+
+		refresh, err := token.Verify(resp.RefreshToken, testAuthSecret, time.Now())
+		suite.Require().NoError(err)
+		suite.Equal(token.TypeRefresh, refresh.Type)
+	})
+
+	suite.Run("wrong_password", func() {
+		suite.mockUsers.EXPECT().GetByEmail(ctx, "alice@example.com").Return(suite.alice, nil).Times(1)
+
+		_, err := suite.uc.Login(ctx, LoginRequest{Email: "alice@example.com", Password: "wrong password"})
+
+		suite.EqualError(err, "unauthorized: invalid email or password")
+	})
+
+	suite.Run("unknown_email_gives_the_same_error", func() {
+		suite.mockUsers.EXPECT().GetByEmail(ctx, "bob@example.com").Return(nil, nil).Times(1)
+
+		_, err := suite.uc.Login(ctx, LoginRequest{Email: "bob@example.com", Password: "correct horse"})
+
+		suite.EqualError(err, "unauthorized: invalid email or password")
+	})
+
+	suite.Run("repository_fail", func() {
+		suite.mockUsers.EXPECT().GetByEmail(ctx, "alice@example.com").Return(nil, errors.New("database error")).Times(1)
+
+		_, err := suite.uc.Login(ctx, LoginRequest{Email: "alice@example.com", Password: "correct horse"})
+
+		suite.ErrorContains(err, "internal fail")
+	})
+}
+
+func (suite *AuthUseCaseTestSuite) TestRefresh() {
+	ctx := context.Background()
+
+	suite.Run("success", func() {
+		suite.mockUsers.EXPECT().GetByID(ctx, uint(7)).Return(suite.alice, nil).Times(1)
+
+		resp, err := suite.uc.Refresh(ctx, signToken("7", token.TypeRefresh, time.Hour))
+
+		suite.NoError(err)
+		suite.NotEmpty(resp.AccessToken)
+	})
+
+	suite.Run("access_token_is_not_a_refresh_token", func() {
+		_, err := suite.uc.Refresh(ctx, signToken("7", token.TypeAccess, time.Hour))
+
+		suite.EqualError(err, "unauthorized: wrong token type")
+	})
+
+	suite.Run("expired", func() {
+		_, err := suite.uc.Refresh(ctx, signToken("7", token.TypeRefresh, -time.Minute))
+
+		suite.ErrorContains(err, "unauthorized")
+	})
+}
+
+func (suite *AuthUseCaseTestSuite) TestAuthenticate() {
+	ctx := context.Background()
+
+	suite.Run("success", func() {
+		suite.mockUsers.EXPECT().GetByID(ctx, uint(7)).Return(suite.alice, nil).Times(1)
+
+		resp, err := suite.uc.Authenticate(ctx, signToken("7", token.TypeAccess, time.Minute))
+
+		suite.NoError(err)
+		suite.Equal(&UserResponse{ID: 7, Email: "alice@example.com"}, resp)
+	})
+
+	suite.Run("deleted_user", func() {
+		suite.mockUsers.EXPECT().GetByID(ctx, uint(9)).Return(nil, nil).Times(1)
+
+		_, err := suite.uc.Authenticate(ctx, signToken("9", token.TypeAccess, time.Minute))
+
+		suite.EqualError(err, "unauthorized: user not found")
+	})
+
+	suite.Run("invalid_subject", func() {
+		_, err := suite.uc.Authenticate(ctx, signToken("alice", token.TypeAccess, time.Minute))
+
+		suite.EqualError(err, "unauthorized: invalid token subject")
+	})
+
+	suite.Run("tampered", func() {
+		_, err := suite.uc.Authenticate(ctx, signToken("7", token.TypeAccess, time.Minute)+"x")
+
+		suite.ErrorContains(err, "unauthorized")
+	})
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=checklist_uc.go -destination=checklist_uc_mock.go -package=usecase
+type ChecklistUseCase interface {
+
+	// ListChecklist lists the checklist items of a todo in order
+	// Error:
+	// - validation fail
+	// - not found (todo missing or soft deleted)
+	// - internal fail
+	ListChecklist(ctx context.Context, todoID uint) (*ListChecklistResponse, error)
+
+	// AddChecklistItem appends an item to the checklist of a todo and returns its ID
+	// Error:
+	// - validation fail
+	// - not found (todo missing or soft deleted)
+	// - internal fail
+	AddChecklistItem(ctx context.Context, req AddChecklistItemRequest) (*AddChecklistItemResponse, error)
+
+	// UpdateChecklistItem edits the text of an item and/or checks or unchecks it
+	// Error:
+	// - validation fail
+	// - not found (todo or item)
+	// - internal fail
+	UpdateChecklistItem(ctx context.Context, req UpdateChecklistItemRequest) error
+
+	// DeleteChecklistItem removes an item from the checklist of a todo
+	// Error:
+	// - validation fail
+	// - not found (todo or item)
+	// - internal fail
+	DeleteChecklistItem(ctx context.Context, todoID uint, itemID uint) error
+
+	// ReorderChecklist puts the items of a todo in the given order
+	// Error:
+	// - validation fail (ItemIDs must list every item of the todo exactly once)
+	// - not found (todo missing or soft deleted)
+	// - internal fail
+	ReorderChecklist(ctx context.Context, req ReorderChecklistRequest) error
+}
+
+type ListChecklistResponse struct {
+	Items    []ChecklistItemResponse `json:"items"`
+	Progress TodoProgress            `json:"progress"`
+}
+
+type AddChecklistItemRequest struct {
+	TodoID uint   `json:"todo_id"`
+	Text   string `json:"text"`
+}
+
+type AddChecklistItemResponse struct {
+	ID uint `json:"id"`
+}
+
+type UpdateChecklistItemRequest struct {
+	TodoID uint    `json:"todo_id"`
+	ID     uint    `json:"id"`
+	Text   *string `json:"text"` // nil=keep current, "value"=update
+	Done   *bool   `json:"done"` // nil=keep current, true/false=check/uncheck
+}
+
+type ReorderChecklistRequest struct {
+	TodoID  uint   `json:"todo_id"`
+	ItemIDs []uint `json:"item_ids"` // every item ID of the todo in the new order
+}
+
+type ChecklistItemResponse struct {
+	ID        uint      `json:"id"`
+	Text      string    `json:"text"`
+	Done      bool      `json:"done"`
+	Position  int       `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
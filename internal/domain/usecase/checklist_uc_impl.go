@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+)
+
+var _ ChecklistUseCase = &checklistUseCaseImpl{}
+
+type checklistUseCaseImpl struct {
+	todoRepo      repository.TodoRepository
+	checklistRepo repository.ChecklistRepository
+}
+
+func NewChecklistUseCaseImpl(todoRepo repository.TodoRepository, checklistRepo repository.ChecklistRepository) ChecklistUseCase {
+	return &checklistUseCaseImpl{
+		todoRepo:      todoRepo,
+		checklistRepo: checklistRepo,
+	}
+}
+
+// ListChecklist lists the checklist items of a todo in order
+func (c *checklistUseCaseImpl) ListChecklist(ctx context.Context, todoID uint) (*ListChecklistResponse, error) {
+	if _, err := requireTodo(ctx, c.todoRepo, todoID); err != nil {
+		return nil, err
+	}
+
+	items, err := c.checklistRepo.ListByTodo(ctx, todoID)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	resp := &ListChecklistResponse{Items: make([]ChecklistItemResponse, len(items))}
+	for i, item := range items {
+		resp.Items[i] = toChecklistItemResponse(item)
+		if item.Done {
+			resp.Progress.Done++
+		}
+	}
+	resp.Progress.Total = len(items)
+
+	return resp, nil
+}
+
+// AddChecklistItem appends an item to the checklist of a todo
+func (c *checklistUseCaseImpl) AddChecklistItem(ctx context.Context, req AddChecklistItemRequest) (*AddChecklistItemResponse, error) {
+	if _, err := requireTodo(ctx, c.todoRepo, req.TodoID); err != nil {
+		return nil, err
+	}
+
+	item, err := entity.NewChecklistItem(req.TodoID, req.Text)
+	if err != nil {
+		return nil, errors.Join(errors.New("validation fail"), err)
+	}
+
+	item, err = c.checklistRepo.Create(ctx, item)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	return &AddChecklistItemResponse{ID: item.ID}, nil
+}
+
+// UpdateChecklistItem edits the text of an item and/or checks or unchecks it
+func (c *checklistUseCaseImpl) UpdateChecklistItem(ctx context.Context, req UpdateChecklistItemRequest) error {
+	item, err := c.findItem(ctx, req.TodoID, req.ID)
+	if err != nil {
+		return err
+	}
+
+	if req.Text != nil {
+		if err := item.SetText(*req.Text); err != nil {
+			return errors.Join(errors.New("validation fail"), err)
+		}
+	}
+
+	if req.Done != nil {
+		item.SetDone(*req.Done)
+	}
+
+	rowsAffected, err := c.checklistRepo.Update(ctx, item)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if rowsAffected == 0 {
+		return errors.New("not found: checklist item not found")
+	}
+
+	return nil
+}
+
+// DeleteChecklistItem removes an item from the checklist of a todo
+func (c *checklistUseCaseImpl) DeleteChecklistItem(ctx context.Context, todoID uint, itemID uint) error {
+	if _, err := c.findItem(ctx, todoID, itemID); err != nil {
+		return err
+	}
+
+	rowsAffected, err := c.checklistRepo.Delete(ctx, itemID)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if rowsAffected == 0 {
+		return errors.New("not found: checklist item not found")
+	}
+
+	return nil
+}
+
+// ReorderChecklist puts the items of a todo in the given order
+func (c *checklistUseCaseImpl) ReorderChecklist(ctx context.Context, req ReorderChecklistRequest) error {
+	if _, err := requireTodo(ctx, c.todoRepo, req.TodoID); err != nil {
+		return err
+	}
+
+	items, err := c.checklistRepo.ListByTodo(ctx, req.TodoID)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+
+	// The new order must be a permutation of the current items
+	if len(req.ItemIDs) != len(items) {
+		return errors.New("validation fail: item_ids must list every checklist item of the todo")
+	}
+	remaining := make(map[uint]bool, len(items))
+	for _, item := range items {
+		remaining[item.ID] = true
+	}
+	for _, id := range req.ItemIDs {
+		if !remaining[id] {
+			return errors.New("validation fail: item_ids must list every checklist item of the todo exactly once")
+		}
+		delete(remaining, id)
+	}
+
+	if err := c.checklistRepo.Reorder(ctx, req.TodoID, req.ItemIDs); err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+
+	return nil
+}
+
+// findItem loads an item of the todo, items of other todos are reported as not found
+func (c *checklistUseCaseImpl) findItem(ctx context.Context, todoID uint, itemID uint) (*entity.ChecklistItem, error) {
+	if itemID == 0 {
+		return nil, errors.New("validation fail: item ID cannot be 0")
+	}
+
+	if _, err := requireTodo(ctx, c.todoRepo, todoID); err != nil {
+		return nil, err
+	}
+
+	item, err := c.checklistRepo.GetByID(ctx, itemID)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if item == nil || item.TodoID != todoID {
+		return nil, errors.New("not found: checklist item not found")
+	}
+
+	return item, nil
+}
+
+// toChecklistItemResponse converts a checklist item entity to the usecase response
+func toChecklistItemResponse(item *entity.ChecklistItem) ChecklistItemResponse {
+	return ChecklistItemResponse{
+		ID:        item.ID,
+		Text:      item.Text,
+		Done:      item.Done,
+		Position:  item.Position,
+		CreatedAt: item.CreatedAt,
+		UpdatedAt: item.UpdatedAt,
+	}
+}
@@ -0,0 +1,245 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+)
+
+type ChecklistUseCaseTestSuite struct {
+	suite.Suite
+	ctrl          *gomock.Controller
+	mockRepo      *repository.MockTodoRepository
+	mockChecklist *repository.MockChecklistRepository
+	uc            ChecklistUseCase
+}
+
+func TestChecklistUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ChecklistUseCaseTestSuite))
+}
+
+func (suite *ChecklistUseCaseTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockRepo = repository.NewMockTodoRepository(suite.ctrl)
+	suite.mockChecklist = repository.NewMockChecklistRepository(suite.ctrl)
+	suite.uc = NewChecklistUseCaseImpl(suite.mockRepo, suite.mockChecklist)
+}
+
+func (suite *ChecklistUseCaseTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+func (suite *ChecklistUseCaseTestSuite) TestListChecklist() {
+	ctx := context.Background()
+	expectTodo(ctx, suite.mockRepo, 1, true)
+	suite.mockChecklist.EXPECT().
+		ListByTodo(ctx, uint(1)).
+		Return([]*entity.ChecklistItem{
+			{ID: 1, TodoID: 1, Text: "第一步", Done: true, Position: 0},
+			{ID: 2, TodoID: 1, Text: "第二步", Position: 1},
+		}, nil).
+		Times(1)
+
+	resp, err := suite.uc.ListChecklist(ctx, 1)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), TodoProgress{Done: 1, Total: 2}, resp.Progress)
+	assert.Equal(suite.T(), []ChecklistItemResponse{
+		{ID: 1, Text: "第一步", Done: true, Position: 0},
+		{ID: 2, Text: "第二步", Position: 1},
+	}, resp.Items)
+}
+
+func (suite *ChecklistUseCaseTestSuite) TestAddChecklistItem() {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		req          AddChecklistItemRequest
+		setupMock    func()
+		expectResp   *AddChecklistItemResponse
+		expectErrMsg string
+	}{
+		{
+			name:         "zero_todo_id",
+			req:          AddChecklistItemRequest{Text: "第一步"},
+			setupMock:    func() {},
+			expectErrMsg: "validation fail",
+		},
+		{
+			name: "todo_not_found",
+			req:  AddChecklistItemRequest{TodoID: 9, Text: "第一步"},
+			setupMock: func() {
+				expectTodo(ctx, suite.mockRepo, 9, false)
+			},
+			expectErrMsg: "not found",
+		},
+		{
+			name: "empty_text",
+			req:  AddChecklistItemRequest{TodoID: 1, Text: " "},
+			setupMock: func() {
+				expectTodo(ctx, suite.mockRepo, 1, true)
+			},
+			expectErrMsg: "validation fail",
+		},
+		{
+			name: "success",
+			req:  AddChecklistItemRequest{TodoID: 1, Text: "第一步"},
+			setupMock: func() {
+				expectTodo(ctx, suite.mockRepo, 1, true)
+				suite.mockChecklist.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, item *entity.ChecklistItem) (*entity.ChecklistItem, error) {
+						assert.Equal(suite.T(), uint(1), item.TodoID)
+						item.ID = 3
+						return item, nil
+					}).
+					Times(1)
+			},
+			expectResp: &AddChecklistItemResponse{ID: 3},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.setupMock()
+
+			resp, err := suite.uc.AddChecklistItem(ctx, tt.req)
+
+			if tt.expectErrMsg != "" {
+				assert.ErrorContains(suite.T(), err, tt.expectErrMsg)
+				assert.Nil(suite.T(), resp)
+			} else {
+				assert.NoError(suite.T(), err)
+				assert.Equal(suite.T(), tt.expectResp, resp)
+			}
+		})
+	}
+}
+
+func (suite *ChecklistUseCaseTestSuite) TestUpdateChecklistItem() {
+	ctx := context.Background()
+	done := true
+
+	tests := []struct {
+		name         string
+		req          UpdateChecklistItemRequest
+		setupMock    func()
+		expectErrMsg string
+	}{
+		{
+			name: "item_of_another_todo",
+			req:  UpdateChecklistItemRequest{TodoID: 1, ID: 5, Done: &done},
+			setupMock: func() {
+				expectTodo(ctx, suite.mockRepo, 1, true)
+				suite.mockChecklist.EXPECT().
+					GetByID(ctx, uint(5)).
+					Return(&entity.ChecklistItem{ID: 5, TodoID: 2, Text: "別的清單"}, nil).
+					Times(1)
+			},
+			expectErrMsg: "not found",
+		},
+		{
+			name: "db_fail",
+			req:  UpdateChecklistItemRequest{TodoID: 1, ID: 5, Done: &done},
+			setupMock: func() {
+				expectTodo(ctx, suite.mockRepo, 1, true)
+				suite.mockChecklist.EXPECT().
+					GetByID(ctx, uint(5)).
+					Return(nil, errors.New("database error")).
+					Times(1)
+			},
+			expectErrMsg: "internal fail",
+		},
+		{
+			name: "toggle_done",
+			req:  UpdateChecklistItemRequest{TodoID: 1, ID: 5, Done: &done},
+			setupMock: func() {
+				expectTodo(ctx, suite.mockRepo, 1, true)
+				suite.mockChecklist.EXPECT().
+					GetByID(ctx, uint(5)).
+					Return(&entity.ChecklistItem{ID: 5, TodoID: 1, Text: "第一步"}, nil).
+					Times(1)
+				suite.mockChecklist.EXPECT().
+					Update(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, item *entity.ChecklistItem) (int64, error) {
+						assert.True(suite.T(), item.Done)
+						assert.Equal(suite.T(), "第一步", item.Text) // Text kept when omitted
+						return 1, nil
+					}).
+					Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.setupMock()
+
+			err := suite.uc.UpdateChecklistItem(ctx, tt.req)
+
+			if tt.expectErrMsg != "" {
+				assert.ErrorContains(suite.T(), err, tt.expectErrMsg)
+			} else {
+				assert.NoError(suite.T(), err)
+			}
+		})
+	}
+}
+
+func (suite *ChecklistUseCaseTestSuite) TestDeleteChecklistItem() {
+	ctx := context.Background()
+	expectTodo(ctx, suite.mockRepo, 1, true)
+	suite.mockChecklist.EXPECT().
+		GetByID(ctx, uint(5)).
+		Return(&entity.ChecklistItem{ID: 5, TodoID: 1}, nil).
+		Times(1)
+	suite.mockChecklist.EXPECT().Delete(ctx, uint(5)).Return(int64(1), nil).Times(1)
+
+	err := suite.uc.DeleteChecklistItem(ctx, 1, 5)
+
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *ChecklistUseCaseTestSuite) TestReorderChecklist() {
+	ctx := context.Background()
+	items := []*entity.ChecklistItem{{ID: 1, TodoID: 1}, {ID: 2, TodoID: 1}, {ID: 3, TodoID: 1}}
+
+	tests := []struct {
+		name         string
+		itemIDs      []uint
+		expectErrMsg string
+	}{
+		{name: "missing_item", itemIDs: []uint{3, 1}, expectErrMsg: "validation fail"},
+		{name: "duplicate_item", itemIDs: []uint{3, 1, 1}, expectErrMsg: "validation fail"},
+		{name: "unknown_item", itemIDs: []uint{3, 1, 4}, expectErrMsg: "validation fail"},
+		{name: "success", itemIDs: []uint{3, 1, 2}},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			expectTodo(ctx, suite.mockRepo, 1, true)
+			suite.mockChecklist.EXPECT().ListByTodo(ctx, uint(1)).Return(items, nil).Times(1)
+			if tt.expectErrMsg == "" {
+				suite.mockChecklist.EXPECT().Reorder(ctx, uint(1), tt.itemIDs).Return(nil).Times(1)
+			}
+
+			err := suite.uc.ReorderChecklist(ctx, ReorderChecklistRequest{TodoID: 1, ItemIDs: tt.itemIDs})
+
+			if tt.expectErrMsg != "" {
+				assert.ErrorContains(suite.T(), err, tt.expectErrMsg)
+			} else {
+				assert.NoError(suite.T(), err)
+			}
+		})
+	}
+}
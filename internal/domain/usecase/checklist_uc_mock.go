@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: checklist_uc.go
+//
+// Generated by this command:
+//
+//	mockgen -source=checklist_uc.go -destination=checklist_uc_mock.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockChecklistUseCase is a mock of ChecklistUseCase interface.
+type MockChecklistUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockChecklistUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockChecklistUseCaseMockRecorder is the mock recorder for MockChecklistUseCase.
+type MockChecklistUseCaseMockRecorder struct {
+	mock *MockChecklistUseCase
+}
+
+// NewMockChecklistUseCase creates a new mock instance.
+func NewMockChecklistUseCase(ctrl *gomock.Controller) *MockChecklistUseCase {
+	mock := &MockChecklistUseCase{ctrl: ctrl}
+	mock.recorder = &MockChecklistUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChecklistUseCase) EXPECT() *MockChecklistUseCaseMockRecorder {
+	return m.recorder
+}
+
+// AddChecklistItem mocks base method.
+func (m *MockChecklistUseCase) AddChecklistItem(ctx context.Context, req AddChecklistItemRequest) (*AddChecklistItemResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddChecklistItem", ctx, req)
+	ret0, _ := ret[0].(*AddChecklistItemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddChecklistItem indicates an expected call of AddChecklistItem.
+func (mr *MockChecklistUseCaseMockRecorder) AddChecklistItem(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChecklistItem", reflect.TypeOf((*MockChecklistUseCase)(nil).AddChecklistItem), ctx, req)
+}
+
+// DeleteChecklistItem mocks base method.
+func (m *MockChecklistUseCase) DeleteChecklistItem(ctx context.Context, todoID, itemID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChecklistItem", ctx, todoID, itemID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChecklistItem indicates an expected call of DeleteChecklistItem.
+func (mr *MockChecklistUseCaseMockRecorder) DeleteChecklistItem(ctx, todoID, itemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChecklistItem", reflect.TypeOf((*MockChecklistUseCase)(nil).DeleteChecklistItem), ctx, todoID, itemID)
+}
+
+// ListChecklist mocks base method.
+func (m *MockChecklistUseCase) ListChecklist(ctx context.Context, todoID uint) (*ListChecklistResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChecklist", ctx, todoID)
+	ret0, _ := ret[0].(*ListChecklistResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChecklist indicates an expected call of ListChecklist.
+func (mr *MockChecklistUseCaseMockRecorder) ListChecklist(ctx, todoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChecklist", reflect.TypeOf((*MockChecklistUseCase)(nil).ListChecklist), ctx, todoID)
+}
+
+// ReorderChecklist mocks base method.
+func (m *MockChecklistUseCase) ReorderChecklist(ctx context.Context, req ReorderChecklistRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderChecklist", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderChecklist indicates an expected call of ReorderChecklist.
+func (mr *MockChecklistUseCaseMockRecorder) ReorderChecklist(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderChecklist", reflect.TypeOf((*MockChecklistUseCase)(nil).ReorderChecklist), ctx, req)
+}
+
+// UpdateChecklistItem mocks base method.
+func (m *MockChecklistUseCase) UpdateChecklistItem(ctx context.Context, req UpdateChecklistItemRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChecklistItem", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateChecklistItem indicates an expected call of UpdateChecklistItem.
+func (mr *MockChecklistUseCaseMockRecorder) UpdateChecklistItem(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChecklistItem", reflect.TypeOf((*MockChecklistUseCase)(nil).UpdateChecklistItem), ctx, req)
+}
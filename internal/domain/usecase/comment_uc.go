@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"itmrchow/go-todolist-service/internal/utils/dto"
+)
+
+//go:generate mockgen -source=comment_uc.go -destination=comment_uc_mock.go -package=usecase
+type CommentUseCase interface {
+
+	// ListComments lists the comments of a todo in the order they were written,
+	// oldest first unless sort_order is desc
+	// Error:
+	// - validation fail
+	// - not found (todo missing or soft deleted)
+	// - internal fail
+	ListComments(ctx context.Context, req ListCommentsRequest) (*ListCommentsResponse, error)
+
+	// CreateComment adds a comment to a todo, written by the actor of the request
+	// Error:
+	// - validation fail
+	// - not found (todo missing or soft deleted)
+	// - internal fail
+	CreateComment(ctx context.Context, req CreateCommentRequest) (*CreateCommentResponse, error)
+
+	// EditComment replaces the body of a comment, only its author or an admin may edit it
+	// Error:
+	// - validation fail
+	// - forbidden (comment of another member)
+	// - not found (todo or comment)
+	// - internal fail
+	EditComment(ctx context.Context, req EditCommentRequest) error
+
+	// DeleteComment soft deletes a comment of a todo, only its author or an admin may delete it
+	// Error:
+	// - validation fail
+	// - forbidden (comment of another member)
+	// - not found (todo or comment)
+	// - internal fail
+	DeleteComment(ctx context.Context, todoID uint, id uint) error
+}
+
+type ListCommentsRequest struct {
+	TodoID     uint              `json:"todo_id"`
+	Pagination dto.PaginationReq `json:"pagination"` // sort_by is not supported, sort_order is asc or desc
+}
+
+type ListCommentsResponse struct {
+	Comments   []CommentResponse  `json:"comments"`
+	Pagination dto.PaginationResp `json:"pagination"`
+}
+
+type CreateCommentRequest struct {
+	TodoID uint   `json:"todo_id"`
+	Body   string `json:"body"`
+}
+
+type CreateCommentResponse struct {
+	ID uint `json:"id"`
+}
+
+type EditCommentRequest struct {
+	TodoID uint   `json:"todo_id"`
+	ID     uint   `json:"id"`
+	Body   string `json:"body"`
+}
+
+type CommentResponse struct {
+	ID        uint       `json:"id"`
+	Author    string     `json:"author"`
+	Body      string     `json:"body"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	EditedAt  *time.Time `json:"edited_at,omitempty"`
+}
@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+var _ CommentUseCase = &commentUseCaseImpl{}
+
+type commentUseCaseImpl struct {
+	todoRepo    repository.TodoRepository
+	commentRepo repository.CommentRepository
+}
+
+func NewCommentUseCaseImpl(todoRepo repository.TodoRepository, commentRepo repository.CommentRepository) CommentUseCase {
+	return &commentUseCaseImpl{
+		todoRepo:    todoRepo,
+		commentRepo: commentRepo,
+	}
+}
+
+// ListComments lists the comments of a todo in the order they were written
+func (c *commentUseCaseImpl) ListComments(ctx context.Context, req ListCommentsRequest) (*ListCommentsResponse, error) {
+	if _, err := requireTodo(ctx, c.todoRepo, req.TodoID); err != nil {
+		return nil, err
+	}
+
+	// comments are shown as a thread, IDs follow the order they were written
+	if req.Pagination.SortBy != "" && req.Pagination.SortBy != "id" && req.Pagination.SortBy != "created_at" {
+		return nil, errors.New("validation fail: comments can only be sorted by created_at")
+	}
+	direction := repository.SortAsc
+	switch strings.ToLower(strings.TrimSpace(req.Pagination.SortOrder)) {
+	case "", "asc":
+	case "desc":
+		direction = repository.SortDesc
+	default:
+		return nil, errors.New("validation fail: invalid sort order")
+	}
+	pagination := newPagination[entity.Comment](req.Pagination, []repository.SortOption{{Field: "id", Direction: direction}})
+
+	err := c.commentRepo.ListByTodo(ctx, req.TodoID, pagination)
+	if errors.Is(err, repository.ErrInvalidCursor) {
+		return nil, errors.Join(errors.New("validation fail"), err)
+	}
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	comments := make([]CommentResponse, len(pagination.Rows))
+	for i, comment := range pagination.Rows {
+		comments[i] = toCommentResponse(comment)
+	}
+
+	return &ListCommentsResponse{
+		Comments:   comments,
+		Pagination: toPaginationResp(pagination),
+	}, nil
+}
+
+// CreateComment adds a comment to a todo, written by the actor of the request
+func (c *commentUseCaseImpl) CreateComment(ctx context.Context, req CreateCommentRequest) (*CreateCommentResponse, error) {
+	if _, err := requireTodo(ctx, c.todoRepo, req.TodoID); err != nil {
+		return nil, err
+	}
+
+	comment, err := entity.NewComment(req.TodoID, actor.Name(ctx), req.Body)
+	if err != nil {
+		return nil, errors.Join(errors.New("validation fail"), err)
+	}
+	comment.AuthorID = actor.UserID(ctx)
+
+	comment, err = c.commentRepo.Create(ctx, comment)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	return &CreateCommentResponse{ID: comment.ID}, nil
+}
+
+// EditComment replaces the body of a comment, only its author or an admin may edit it
+func (c *commentUseCaseImpl) EditComment(ctx context.Context, req EditCommentRequest) error {
+	comment, err := c.findComment(ctx, req.TodoID, req.ID)
+	if err != nil {
+		return err
+	}
+	if err := authorizeComment(ctx, comment); err != nil {
+		return err
+	}
+
+	if err := comment.Edit(req.Body); err != nil {
+		return errors.Join(errors.New("validation fail"), err)
+	}
+
+	rowsAffected, err := c.commentRepo.Update(ctx, comment)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if rowsAffected == 0 {
+		return errors.New("not found: comment not found")
+	}
+
+	return nil
+}
+
+// DeleteComment soft deletes a comment of a todo, only its author or an admin may delete it
+func (c *commentUseCaseImpl) DeleteComment(ctx context.Context, todoID uint, id uint) error {
+	comment, err := c.findComment(ctx, todoID, id)
+	if err != nil {
+		return err
+	}
+	if err := authorizeComment(ctx, comment); err != nil {
+		return err
+	}
+
+	rowsAffected, err := c.commentRepo.Delete(ctx, id)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if rowsAffected == 0 {
+		return errors.New("not found: comment not found")
+	}
+
+	return nil
+}
+
+// findComment loads a comment of the todo, comments of other todos are reported as not found
+func (c *commentUseCaseImpl) findComment(ctx context.Context, todoID uint, id uint) (*entity.Comment, error) {
+	if id == 0 {
+		return nil, errors.New("validation fail: comment ID cannot be 0")
+	}
+
+	if _, err := requireTodo(ctx, c.todoRepo, todoID); err != nil {
+		return nil, err
+	}
+
+	comment, err := c.commentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if comment == nil || comment.TodoID != todoID {
+		return nil, errors.New("not found: comment not found")
+	}
+
+	return comment, nil
+}
+
+func toCommentResponse(comment *entity.Comment) CommentResponse {
+	return CommentResponse{
+		ID:        comment.ID,
+		Author:    comment.Author,
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+		EditedAt:  comment.EditedAt,
+	}
+}
@@ -0,0 +1,238 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+	"itmrchow/go-todolist-service/internal/utils/dto"
+)
+
+type CommentUseCaseTestSuite struct {
+	suite.Suite
+	ctrl         *gomock.Controller
+	mockRepo     *repository.MockTodoRepository
+	mockComments *repository.MockCommentRepository
+	uc           CommentUseCase
+}
+
+func TestCommentUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(CommentUseCaseTestSuite))
+}
+
+func (suite *CommentUseCaseTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockRepo = repository.NewMockTodoRepository(suite.ctrl)
+	suite.mockComments = repository.NewMockCommentRepository(suite.ctrl)
+	suite.uc = NewCommentUseCaseImpl(suite.mockRepo, suite.mockComments)
+}
+
+func (suite *CommentUseCaseTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+func (suite *CommentUseCaseTestSuite) TestListComments() {
+	ctx := context.Background()
+
+	suite.Run("newest_first", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockComments.EXPECT().
+			ListByTodo(ctx, uint(1), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, todoID uint, pagination *repository.Pagination[entity.Comment]) error {
+				assert.Equal(suite.T(), []repository.SortOption{{Field: "id", Direction: repository.SortDesc}}, pagination.Sorts)
+				assert.Equal(suite.T(), 5, pagination.Limit)
+				pagination.Rows = []*entity.Comment{{ID: 2, TodoID: 1, Author: "alice", Body: "第二則"}}
+				pagination.TotalRows = 1
+				pagination.TotalPages = 1
+				return nil
+			}).
+			Times(1)
+
+		resp, err := suite.uc.ListComments(ctx, ListCommentsRequest{
+			TodoID:     1,
+			Pagination: dto.PaginationReq{Page: 1, PageSize: 5, SortOrder: "desc"},
+		})
+
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), []CommentResponse{{ID: 2, Author: "alice", Body: "第二則"}}, resp.Comments)
+		assert.Equal(suite.T(), 1, *resp.Pagination.TotalCount)
+	})
+
+	suite.Run("unsupported_sort", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+
+		_, err := suite.uc.ListComments(ctx, ListCommentsRequest{
+			TodoID:     1,
+			Pagination: dto.PaginationReq{PageSize: 5, SortBy: "body"},
+		})
+
+		assert.EqualError(suite.T(), err, "validation fail: comments can only be sorted by created_at")
+	})
+
+	suite.Run("todo_not_found", func() {
+		expectTodo(ctx, suite.mockRepo, 1, false)
+
+		_, err := suite.uc.ListComments(ctx, ListCommentsRequest{TodoID: 1})
+
+		assert.EqualError(suite.T(), err, "not found: todo not found")
+	})
+}
+
+func (suite *CommentUseCaseTestSuite) TestCreateComment() {
+	ctx := actor.WithName(actor.WithUserID(context.Background(), 7), "alice")
+
+	suite.Run("success", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockComments.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, comment *entity.Comment) (*entity.Comment, error) {
+				assert.Equal(suite.T(), uint(1), comment.TodoID)
+				assert.Equal(suite.T(), "alice", comment.Author)
+				assert.Equal(suite.T(), uint(7), comment.AuthorID)
+				assert.Equal(suite.T(), "明天再確認", comment.Body)
+				comment.ID = 3
+				return comment, nil
+			}).
+			Times(1)
+
+		resp, err := suite.uc.CreateComment(ctx, CreateCommentRequest{TodoID: 1, Body: " 明天再確認 "})
+
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), uint(3), resp.ID)
+	})
+
+	suite.Run("empty_body", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+
+		_, err := suite.uc.CreateComment(ctx, CreateCommentRequest{TodoID: 1, Body: " "})
+
+		assert.EqualError(suite.T(), err, "validation fail\ncomment body cannot be empty")
+	})
+
+	suite.Run("internal_fail", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockComments.EXPECT().Create(ctx, gomock.Any()).Return(nil, errors.New("db down")).Times(1)
+
+		_, err := suite.uc.CreateComment(ctx, CreateCommentRequest{TodoID: 1, Body: "明天再確認"})
+
+		assert.EqualError(suite.T(), err, "internal fail\ndb down")
+	})
+}
+
+// authorCtx is a member signed in as user 7, the author of the comments in the tests
+func authorCtx() context.Context {
+	return actor.WithWorkspaceRole(actor.WithUserID(context.Background(), 7), "member")
+}
+
+func (suite *CommentUseCaseTestSuite) TestEditComment() {
+	ctx := authorCtx()
+
+	suite.Run("success", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockComments.EXPECT().GetByID(ctx, uint(3)).Return(&entity.Comment{ID: 3, TodoID: 1, AuthorID: 7, Body: "明天再確認"}, nil).Times(1)
+		suite.mockComments.EXPECT().
+			Update(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, comment *entity.Comment) (int64, error) {
+				assert.Equal(suite.T(), "今天確認了", comment.Body)
+				assert.NotNil(suite.T(), comment.EditedAt)
+				return 1, nil
+			}).
+			Times(1)
+
+		err := suite.uc.EditComment(ctx, EditCommentRequest{TodoID: 1, ID: 3, Body: "今天確認了"})
+
+		assert.NoError(suite.T(), err)
+	})
+
+	suite.Run("comment_of_other_todo", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockComments.EXPECT().GetByID(ctx, uint(3)).Return(&entity.Comment{ID: 3, TodoID: 2, Body: "明天再確認"}, nil).Times(1)
+
+		err := suite.uc.EditComment(ctx, EditCommentRequest{TodoID: 1, ID: 3, Body: "今天確認了"})
+
+		assert.EqualError(suite.T(), err, "not found: comment not found")
+	})
+
+	suite.Run("comment_of_other_member", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockComments.EXPECT().GetByID(ctx, uint(3)).Return(&entity.Comment{ID: 3, TodoID: 1, AuthorID: 8, Body: "明天再確認"}, nil).Times(1)
+
+		err := suite.uc.EditComment(ctx, EditCommentRequest{TodoID: 1, ID: 3, Body: "今天確認了"})
+
+		assert.EqualError(suite.T(), err, "forbidden: the member role may not moderate_comments")
+	})
+
+	suite.Run("comment_written_before_accounts", func() {
+		// comments without author ID belong to nobody, a request without user may not claim them
+		ctx := actor.WithWorkspaceRole(context.Background(), "member")
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockComments.EXPECT().GetByID(ctx, uint(3)).Return(&entity.Comment{ID: 3, TodoID: 1, Body: "明天再確認"}, nil).Times(1)
+
+		err := suite.uc.EditComment(ctx, EditCommentRequest{TodoID: 1, ID: 3, Body: "今天確認了"})
+
+		assert.ErrorContains(suite.T(), err, "forbidden")
+	})
+
+	suite.Run("admin_edits_comment_of_other_member", func() {
+		ctx := actor.WithWorkspaceRole(actor.WithUserID(context.Background(), 9), "admin")
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockComments.EXPECT().GetByID(ctx, uint(3)).Return(&entity.Comment{ID: 3, TodoID: 1, AuthorID: 7, Body: "明天再確認"}, nil).Times(1)
+		suite.mockComments.EXPECT().Update(ctx, gomock.Any()).Return(int64(1), nil).Times(1)
+
+		err := suite.uc.EditComment(ctx, EditCommentRequest{TodoID: 1, ID: 3, Body: "今天確認了"})
+
+		assert.NoError(suite.T(), err)
+	})
+}
+
+func (suite *CommentUseCaseTestSuite) TestDeleteComment() {
+	ctx := authorCtx()
+
+	suite.Run("success", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockComments.EXPECT().GetByID(ctx, uint(3)).Return(&entity.Comment{ID: 3, TodoID: 1, AuthorID: 7, Body: "明天再確認"}, nil).Times(1)
+		suite.mockComments.EXPECT().Delete(ctx, uint(3)).Return(int64(1), nil).Times(1)
+
+		err := suite.uc.DeleteComment(ctx, 1, 3)
+
+		assert.NoError(suite.T(), err)
+	})
+
+	suite.Run("comment_of_other_member", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockComments.EXPECT().GetByID(ctx, uint(3)).Return(&entity.Comment{ID: 3, TodoID: 1, AuthorID: 8, Body: "明天再確認"}, nil).Times(1)
+
+		err := suite.uc.DeleteComment(ctx, 1, 3)
+
+		assert.EqualError(suite.T(), err, "forbidden: the member role may not moderate_comments")
+	})
+
+	suite.Run("owner_deletes_comment_of_other_member", func() {
+		ctx := actor.WithWorkspaceRole(actor.WithUserID(context.Background(), 9), "owner")
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockComments.EXPECT().GetByID(ctx, uint(3)).Return(&entity.Comment{ID: 3, TodoID: 1, AuthorID: 7, Body: "明天再確認"}, nil).Times(1)
+		suite.mockComments.EXPECT().Delete(ctx, uint(3)).Return(int64(1), nil).Times(1)
+
+		err := suite.uc.DeleteComment(ctx, 1, 3)
+
+		assert.NoError(suite.T(), err)
+	})
+
+	suite.Run("already_deleted", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockComments.EXPECT().GetByID(ctx, uint(3)).Return(nil, nil).Times(1)
+
+		err := suite.uc.DeleteComment(ctx, 1, 3)
+
+		assert.EqualError(suite.T(), err, "not found: comment not found")
+	})
+}
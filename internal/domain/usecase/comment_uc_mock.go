@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: comment_uc.go
+//
+// Generated by this command:
+//
+//	mockgen -source=comment_uc.go -destination=comment_uc_mock.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCommentUseCase is a mock of CommentUseCase interface.
+type MockCommentUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockCommentUseCaseMockRecorder is the mock recorder for MockCommentUseCase.
+type MockCommentUseCaseMockRecorder struct {
+	mock *MockCommentUseCase
+}
+
+// NewMockCommentUseCase creates a new mock instance.
+func NewMockCommentUseCase(ctrl *gomock.Controller) *MockCommentUseCase {
+	mock := &MockCommentUseCase{ctrl: ctrl}
+	mock.recorder = &MockCommentUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentUseCase) EXPECT() *MockCommentUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CreateComment mocks base method.
+func (m *MockCommentUseCase) CreateComment(ctx context.Context, req CreateCommentRequest) (*CreateCommentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateComment", ctx, req)
+	ret0, _ := ret[0].(*CreateCommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateComment indicates an expected call of CreateComment.
+func (mr *MockCommentUseCaseMockRecorder) CreateComment(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockCommentUseCase)(nil).CreateComment), ctx, req)
+}
+
+// DeleteComment mocks base method.
+func (m *MockCommentUseCase) DeleteComment(ctx context.Context, todoID, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", ctx, todoID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockCommentUseCaseMockRecorder) DeleteComment(ctx, todoID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockCommentUseCase)(nil).DeleteComment), ctx, todoID, id)
+}
+
+// EditComment mocks base method.
+func (m *MockCommentUseCase) EditComment(ctx context.Context, req EditCommentRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditComment", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EditComment indicates an expected call of EditComment.
+func (mr *MockCommentUseCaseMockRecorder) EditComment(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditComment", reflect.TypeOf((*MockCommentUseCase)(nil).EditComment), ctx, req)
+}
+
+// ListComments mocks base method.
+func (m *MockCommentUseCase) ListComments(ctx context.Context, req ListCommentsRequest) (*ListCommentsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComments", ctx, req)
+	ret0, _ := ret[0].(*ListCommentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListComments indicates an expected call of ListComments.
+func (mr *MockCommentUseCaseMockRecorder) ListComments(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComments", reflect.TypeOf((*MockCommentUseCase)(nil).ListComments), ctx, req)
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=dependency_uc.go -destination=dependency_uc_mock.go -package=usecase
+type DependencyUseCase interface {
+
+	// AddDependency marks a todo as blocked by another todo, links that would close a loop
+	// of todos blocking each other are rejected
+	// Error:
+	// - validation fail (self link, missing blocker or a cycle)
+	// - not found (todo missing or soft deleted)
+	// - conflict (link already exists)
+	// - internal fail
+	AddDependency(ctx context.Context, req AddDependencyRequest) error
+
+	// RemoveDependency removes the link between a todo and its blocker
+	// Error:
+	// - validation fail
+	// - not found (todo or link)
+	// - internal fail
+	RemoveDependency(ctx context.Context, req RemoveDependencyRequest) error
+}
+
+type AddDependencyRequest struct {
+	TodoID    uint `json:"todo_id"`    // the blocked todo
+	BlockerID uint `json:"blocker_id"` // the todo that has to be done first
+}
+
+type RemoveDependencyRequest struct {
+	TodoID    uint `json:"todo_id"`
+	BlockerID uint `json:"blocker_id"`
+}
@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+)
+
+var _ DependencyUseCase = &dependencyUseCaseImpl{}
+
+type dependencyUseCaseImpl struct {
+	todoRepo       repository.TodoRepository
+	dependencyRepo repository.DependencyRepository
+}
+
+func NewDependencyUseCaseImpl(todoRepo repository.TodoRepository, dependencyRepo repository.DependencyRepository) DependencyUseCase {
+	return &dependencyUseCaseImpl{
+		todoRepo:       todoRepo,
+		dependencyRepo: dependencyRepo,
+	}
+}
+
+// AddDependency marks a todo as blocked by another todo
+func (d *dependencyUseCaseImpl) AddDependency(ctx context.Context, req AddDependencyRequest) error {
+	dep, err := entity.NewDependency(req.TodoID, req.BlockerID)
+	if err != nil {
+		return errors.Join(errors.New("validation fail"), err)
+	}
+
+	if _, err := requireTodo(ctx, d.todoRepo, req.TodoID); err != nil {
+		return err
+	}
+
+	blocker, err := d.todoRepo.GetByID(ctx, req.BlockerID)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if blocker == nil {
+		return fmt.Errorf("validation fail: blocker todo %d not found", req.BlockerID)
+	}
+
+	exists, err := d.dependencyRepo.Exists(ctx, req.TodoID, req.BlockerID)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if exists {
+		return errors.New("conflict: dependency already exists")
+	}
+
+	if err := d.checkCycle(ctx, req.TodoID, req.BlockerID); err != nil {
+		return err
+	}
+
+	if err := d.dependencyRepo.Create(ctx, dep); err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+
+	return nil
+}
+
+// RemoveDependency removes the link between a todo and its blocker
+func (d *dependencyUseCaseImpl) RemoveDependency(ctx context.Context, req RemoveDependencyRequest) error {
+	if req.BlockerID == 0 {
+		return errors.New("validation fail: blocker ID cannot be 0")
+	}
+
+	if _, err := requireTodo(ctx, d.todoRepo, req.TodoID); err != nil {
+		return err
+	}
+
+	rowsAffected, err := d.dependencyRepo.Delete(ctx, req.TodoID, req.BlockerID)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if rowsAffected == 0 {
+		return errors.New("not found: dependency not found")
+	}
+
+	return nil
+}
+
+// checkCycle walks the blockers of blockerID breadth first, one query per level,
+// reaching todoID means the new link would close a loop
+func (d *dependencyUseCaseImpl) checkCycle(ctx context.Context, todoID uint, blockerID uint) error {
+	visited := map[uint]bool{blockerID: true}
+	for level := []uint{blockerID}; len(level) > 0; {
+		deps, err := d.dependencyRepo.ListByTodos(ctx, level)
+		if err != nil {
+			return errors.Join(errors.New("internal fail"), err)
+		}
+
+		level = nil
+		for _, dep := range deps {
+			if dep.BlockerID == todoID {
+				return fmt.Errorf("validation fail: todo %d already blocks todo %d, the dependency would create a cycle", todoID, blockerID)
+			}
+			if !visited[dep.BlockerID] {
+				visited[dep.BlockerID] = true
+				level = append(level, dep.BlockerID)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+)
+
+type DependencyUseCaseTestSuite struct {
+	suite.Suite
+	ctrl     *gomock.Controller
+	mockRepo *repository.MockTodoRepository
+	mockDeps *repository.MockDependencyRepository
+	uc       DependencyUseCase
+}
+
+func TestDependencyUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(DependencyUseCaseTestSuite))
+}
+
+func (suite *DependencyUseCaseTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockRepo = repository.NewMockTodoRepository(suite.ctrl)
+	suite.mockDeps = repository.NewMockDependencyRepository(suite.ctrl)
+	suite.uc = NewDependencyUseCaseImpl(suite.mockRepo, suite.mockDeps)
+}
+
+func (suite *DependencyUseCaseTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+func (suite *DependencyUseCaseTestSuite) TestAddDependency() {
+	ctx := context.Background()
+
+	suite.Run("success", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		expectTodo(ctx, suite.mockRepo, 2, true)
+		suite.mockDeps.EXPECT().Exists(ctx, uint(1), uint(2)).Return(false, nil).Times(1)
+		// 2 is blocked by 3, nothing blocks 3
+		suite.mockDeps.EXPECT().ListByTodos(ctx, []uint{2}).Return([]*entity.Dependency{{TodoID: 2, BlockerID: 3}}, nil).Times(1)
+		suite.mockDeps.EXPECT().ListByTodos(ctx, []uint{3}).Return(nil, nil).Times(1)
+		suite.mockDeps.EXPECT().
+			Create(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, dep *entity.Dependency) error {
+				assert.Equal(suite.T(), uint(1), dep.TodoID)
+				assert.Equal(suite.T(), uint(2), dep.BlockerID)
+				return nil
+			}).
+			Times(1)
+
+		err := suite.uc.AddDependency(ctx, AddDependencyRequest{TodoID: 1, BlockerID: 2})
+
+		assert.NoError(suite.T(), err)
+	})
+
+	suite.Run("cycle", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		expectTodo(ctx, suite.mockRepo, 2, true)
+		suite.mockDeps.EXPECT().Exists(ctx, uint(1), uint(2)).Return(false, nil).Times(1)
+		// 2 is blocked by 3 which is blocked by 1
+		suite.mockDeps.EXPECT().ListByTodos(ctx, []uint{2}).Return([]*entity.Dependency{{TodoID: 2, BlockerID: 3}}, nil).Times(1)
+		suite.mockDeps.EXPECT().ListByTodos(ctx, []uint{3}).Return([]*entity.Dependency{{TodoID: 3, BlockerID: 1}}, nil).Times(1)
+
+		err := suite.uc.AddDependency(ctx, AddDependencyRequest{TodoID: 1, BlockerID: 2})
+
+		assert.EqualError(suite.T(), err, "validation fail: todo 1 already blocks todo 2, the dependency would create a cycle")
+	})
+
+	suite.Run("self", func() {
+		err := suite.uc.AddDependency(ctx, AddDependencyRequest{TodoID: 1, BlockerID: 1})
+
+		assert.EqualError(suite.T(), err, "validation fail\na todo cannot block itself")
+	})
+
+	suite.Run("todo_not_found", func() {
+		expectTodo(ctx, suite.mockRepo, 1, false)
+
+		err := suite.uc.AddDependency(ctx, AddDependencyRequest{TodoID: 1, BlockerID: 2})
+
+		assert.EqualError(suite.T(), err, "not found: todo not found")
+	})
+
+	suite.Run("blocker_not_found", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		expectTodo(ctx, suite.mockRepo, 2, false)
+
+		err := suite.uc.AddDependency(ctx, AddDependencyRequest{TodoID: 1, BlockerID: 2})
+
+		assert.EqualError(suite.T(), err, "validation fail: blocker todo 2 not found")
+	})
+
+	suite.Run("already_linked", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		expectTodo(ctx, suite.mockRepo, 2, true)
+		suite.mockDeps.EXPECT().Exists(ctx, uint(1), uint(2)).Return(true, nil).Times(1)
+
+		err := suite.uc.AddDependency(ctx, AddDependencyRequest{TodoID: 1, BlockerID: 2})
+
+		assert.EqualError(suite.T(), err, "conflict: dependency already exists")
+	})
+}
+
+func (suite *DependencyUseCaseTestSuite) TestRemoveDependency() {
+	ctx := context.Background()
+
+	suite.Run("success", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockDeps.EXPECT().Delete(ctx, uint(1), uint(2)).Return(int64(1), nil).Times(1)
+
+		err := suite.uc.RemoveDependency(ctx, RemoveDependencyRequest{TodoID: 1, BlockerID: 2})
+
+		assert.NoError(suite.T(), err)
+	})
+
+	suite.Run("not_linked", func() {
+		expectTodo(ctx, suite.mockRepo, 1, true)
+		suite.mockDeps.EXPECT().Delete(ctx, uint(1), uint(2)).Return(int64(0), nil).Times(1)
+
+		err := suite.uc.RemoveDependency(ctx, RemoveDependencyRequest{TodoID: 1, BlockerID: 2})
+
+		assert.EqualError(suite.T(), err, "not found: dependency not found")
+	})
+}
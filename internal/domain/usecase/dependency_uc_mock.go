@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: dependency_uc.go
+//
+// Generated by this command:
+//
+//	mockgen -source=dependency_uc.go -destination=dependency_uc_mock.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDependencyUseCase is a mock of DependencyUseCase interface.
+type MockDependencyUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDependencyUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockDependencyUseCaseMockRecorder is the mock recorder for MockDependencyUseCase.
+type MockDependencyUseCaseMockRecorder struct {
+	mock *MockDependencyUseCase
+}
+
+// NewMockDependencyUseCase creates a new mock instance.
+func NewMockDependencyUseCase(ctrl *gomock.Controller) *MockDependencyUseCase {
+	mock := &MockDependencyUseCase{ctrl: ctrl}
+	mock.recorder = &MockDependencyUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDependencyUseCase) EXPECT() *MockDependencyUseCaseMockRecorder {
+	return m.recorder
+}
+
+// AddDependency mocks base method.
+func (m *MockDependencyUseCase) AddDependency(ctx context.Context, req AddDependencyRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddDependency", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddDependency indicates an expected call of AddDependency.
+func (mr *MockDependencyUseCaseMockRecorder) AddDependency(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddDependency", reflect.TypeOf((*MockDependencyUseCase)(nil).AddDependency), ctx, req)
+}
+
+// RemoveDependency mocks base method.
+func (m *MockDependencyUseCase) RemoveDependency(ctx context.Context, req RemoveDependencyRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveDependency", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveDependency indicates an expected call of RemoveDependency.
+func (mr *MockDependencyUseCaseMockRecorder) RemoveDependency(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveDependency", reflect.TypeOf((*MockDependencyUseCase)(nil).RemoveDependency), ctx, req)
+}
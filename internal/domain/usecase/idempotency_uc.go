@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=idempotency_uc.go -destination=idempotency_uc_mock.go -package=usecase
+type IdempotencyUseCase interface {
+
+	// Begin claims an idempotency key of the signed-in user for a request, used by the idempotency middleware.
+	// Returns nil when the request should run, or the stored response when the key was already used for it
+	// Error:
+	// - unauthorized (no signed-in user)
+	// - validation fail (malformed key)
+	// - unprocessable (the key was used for a different request)
+	// - conflict (the first request with the key is still running)
+	// - internal fail
+	Begin(ctx context.Context, req BeginIdempotencyRequest) (*IdempotentResponse, error)
+
+	// Complete stores the response of a request that claimed a key, server errors release the key
+	// so the client may retry. Completing a key that is not held succeeds
+	// Error:
+	// - unauthorized (no signed-in user)
+	// - validation fail
+	// - internal fail
+	Complete(ctx context.Context, req CompleteIdempotencyRequest) error
+
+	// PurgeExpired deletes at most batchSize idempotency keys expired at the given time,
+	// used by the idempotency cleanup job
+	// Error:
+	// - validation fail
+	// - internal fail
+	PurgeExpired(ctx context.Context, before time.Time, batchSize int) (int64, error)
+}
+
+// IdempotencyOptions holds how long an idempotency key is remembered
+type IdempotencyOptions struct {
+	KeyTTL time.Duration
+}
+
+type BeginIdempotencyRequest struct {
+	Key    string
+	Method string
+	Path   string // path and query of the request
+	Body   []byte
+}
+
+type CompleteIdempotencyRequest struct {
+	Key        string
+	StatusCode int
+	Headers    map[string]string // response headers to replay
+	Body       []byte
+}
+
+// IdempotentResponse is the stored response of the first request sent with a key
+type IdempotentResponse struct {
+	StatusCode int
+	Headers    map[string]string
+	Body       []byte
+}
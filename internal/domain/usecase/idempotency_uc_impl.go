@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+var _ IdempotencyUseCase = &idempotencyUseCaseImpl{}
+
+// idempotencyClaimAttempts is how many times Begin tries to claim a key whose old record expired
+const idempotencyClaimAttempts = 2
+
+type idempotencyUseCaseImpl struct {
+	idempotencyRepo repository.IdempotencyRepository
+	opts            IdempotencyOptions
+}
+
+func NewIdempotencyUseCaseImpl(idempotencyRepo repository.IdempotencyRepository, opts IdempotencyOptions) IdempotencyUseCase {
+	return &idempotencyUseCaseImpl{
+		idempotencyRepo: idempotencyRepo,
+		opts:            opts,
+	}
+}
+
+// Begin claims an idempotency key of the signed-in user for a request
+func (i *idempotencyUseCaseImpl) Begin(ctx context.Context, req BeginIdempotencyRequest) (*IdempotentResponse, error) {
+	userID := actor.UserID(ctx)
+	if userID == 0 {
+		return nil, errors.New("unauthorized: sign in to use idempotency keys")
+	}
+	if err := entity.ValidateIdempotencyKey(req.Key); err != nil {
+		return nil, errors.Join(errors.New("validation fail"), err)
+	}
+
+	// The workspace is part of the request, the same body sent to another workspace is another request
+	requestHash := entity.HashIdempotentRequest(req.Method, req.Path, actor.WorkspaceID(ctx), req.Body)
+	now := time.Now().UTC()
+
+	for attempt := 0; attempt < idempotencyClaimAttempts; attempt++ {
+		record, err := entity.NewIdempotencyRecord(userID, req.Key, requestHash, now, i.opts.KeyTTL)
+		if err != nil {
+			return nil, errors.Join(errors.New("validation fail"), err)
+		}
+
+		created, err := i.idempotencyRepo.Create(ctx, record)
+		if err != nil {
+			return nil, errors.Join(errors.New("internal fail"), err)
+		}
+		if created {
+			return nil, nil
+		}
+
+		existing, err := i.idempotencyRepo.Get(ctx, userID, req.Key)
+		if err != nil {
+			return nil, errors.Join(errors.New("internal fail"), err)
+		}
+		if existing == nil {
+			continue // released by a failed request in the meantime
+		}
+		// The cleanup job has not caught up with an expired key yet, it is free to use again
+		if existing.Expired(now) {
+			if _, err := i.idempotencyRepo.Delete(ctx, existing.ID); err != nil {
+				return nil, errors.Join(errors.New("internal fail"), err)
+			}
+			continue
+		}
+
+		if existing.RequestHash != requestHash {
+			return nil, errors.New("unprocessable: idempotency key was already used for a different request")
+		}
+		if !existing.Completed() {
+			return nil, errors.New("conflict: a request with this idempotency key is still in progress")
+		}
+
+		return &IdempotentResponse{
+			StatusCode: existing.StatusCode,
+			Headers:    existing.Headers,
+			Body:       existing.Body,
+		}, nil
+	}
+
+	return nil, errors.New("conflict: a request with this idempotency key is still in progress")
+}
+
+// Complete stores the response of a request that claimed a key
+func (i *idempotencyUseCaseImpl) Complete(ctx context.Context, req CompleteIdempotencyRequest) error {
+	userID := actor.UserID(ctx)
+	if userID == 0 {
+		return errors.New("unauthorized: sign in to use idempotency keys")
+	}
+
+	record, err := i.idempotencyRepo.Get(ctx, userID, req.Key)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if record == nil || record.Completed() {
+		return nil
+	}
+
+	// A server error may not happen again, so the key is released instead of replaying the error
+	if req.StatusCode >= http.StatusInternalServerError {
+		if _, err := i.idempotencyRepo.Delete(ctx, record.ID); err != nil {
+			return errors.Join(errors.New("internal fail"), err)
+		}
+		return nil
+	}
+
+	if err := record.Complete(req.StatusCode, req.Headers, req.Body); err != nil {
+		return errors.Join(errors.New("validation fail"), err)
+	}
+	if _, err := i.idempotencyRepo.Update(ctx, record); err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+
+	return nil
+}
+
+// PurgeExpired deletes at most batchSize idempotency keys expired at the given time
+func (i *idempotencyUseCaseImpl) PurgeExpired(ctx context.Context, before time.Time, batchSize int) (int64, error) {
+	// Validate request
+	if batchSize <= 0 {
+		return 0, errors.New("validation fail: batch size must be greater than 0")
+	}
+
+	purgedCount, err := i.idempotencyRepo.DeleteExpired(ctx, before, batchSize)
+	if err != nil {
+		return 0, errors.Join(errors.New("internal fail"), err)
+	}
+
+	return purgedCount, nil
+}
@@ -0,0 +1,260 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+type IdempotencyUseCaseTestSuite struct {
+	suite.Suite
+	ctrl        *gomock.Controller
+	mockRecords *repository.MockIdempotencyRepository
+	uc          IdempotencyUseCase
+	ctx         context.Context
+	req         BeginIdempotencyRequest
+}
+
+func TestIdempotencyUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyUseCaseTestSuite))
+}
+
+func (suite *IdempotencyUseCaseTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockRecords = repository.NewMockIdempotencyRepository(suite.ctrl)
+	suite.uc = NewIdempotencyUseCaseImpl(suite.mockRecords, IdempotencyOptions{KeyTTL: time.Hour})
+	suite.ctx = actor.WithWorkspaceID(actor.WithUserID(context.Background(), 7), 2)
+	suite.req = BeginIdempotencyRequest{Key: "k", Method: "POST", Path: "/api/v1/create-todo", Body: []byte(`{"title":"a"}`)}
+}
+
+func (suite *IdempotencyUseCaseTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+// storedRecord returns the record of key k sent by user 7 for suite.req
+func (suite *IdempotencyUseCaseTestSuite) storedRecord() *entity.IdempotencyRecord {
+	hash := entity.HashIdempotentRequest(suite.req.Method, suite.req.Path, 2, suite.req.Body)
+	record, err := entity.NewIdempotencyRecord(7, "k", hash, time.Now(), time.Hour)
+	suite.Require().NoError(err)
+	record.ID = 3
+	return record
+}
+
+func (suite *IdempotencyUseCaseTestSuite) TestBegin() {
+	suite.Run("new_key", func() {
+		suite.mockRecords.EXPECT().
+			Create(suite.ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, record *entity.IdempotencyRecord) (bool, error) {
+				suite.Equal(uint(7), record.UserID)
+				suite.Equal("k", record.Key)
+				suite.Equal(entity.HashIdempotentRequest("POST", "/api/v1/create-todo", 2, []byte(`{"title":"a"}`)), record.RequestHash)
+				suite.False(record.Completed())
+				return true, nil
+			}).
+			Times(1)
+
+		resp, err := suite.uc.Begin(suite.ctx, suite.req)
+
+		suite.NoError(err)
+		suite.Nil(resp)
+	})
+
+	suite.Run("replay", func() {
+		record := suite.storedRecord()
+		suite.Require().NoError(record.Complete(201, map[string]string{"Content-Type": "application/json"}, []byte(`{"id":5}`)))
+		suite.mockRecords.EXPECT().Create(suite.ctx, gomock.Any()).Return(false, nil).Times(1)
+		suite.mockRecords.EXPECT().Get(suite.ctx, uint(7), "k").Return(record, nil).Times(1)
+
+		resp, err := suite.uc.Begin(suite.ctx, suite.req)
+
+		suite.Require().NoError(err)
+		suite.Equal(&IdempotentResponse{
+			StatusCode: 201,
+			Headers:    map[string]string{"Content-Type": "application/json"},
+			Body:       []byte(`{"id":5}`),
+		}, resp)
+	})
+
+	suite.Run("different_request", func() {
+		record := suite.storedRecord()
+		suite.Require().NoError(record.Complete(201, nil, nil))
+		suite.mockRecords.EXPECT().Create(suite.ctx, gomock.Any()).Return(false, nil).Times(1)
+		suite.mockRecords.EXPECT().Get(suite.ctx, uint(7), "k").Return(record, nil).Times(1)
+
+		req := suite.req
+		req.Body = []byte(`{"title":"b"}`)
+		_, err := suite.uc.Begin(suite.ctx, req)
+
+		suite.ErrorContains(err, "unprocessable")
+	})
+
+	suite.Run("other_workspace", func() {
+		record := suite.storedRecord()
+		suite.Require().NoError(record.Complete(201, nil, nil))
+		ctx := actor.WithWorkspaceID(suite.ctx, 9)
+		suite.mockRecords.EXPECT().Create(ctx, gomock.Any()).Return(false, nil).Times(1)
+		suite.mockRecords.EXPECT().Get(ctx, uint(7), "k").Return(record, nil).Times(1)
+
+		_, err := suite.uc.Begin(ctx, suite.req)
+
+		suite.ErrorContains(err, "unprocessable")
+	})
+
+	suite.Run("in_progress", func() {
+		suite.mockRecords.EXPECT().Create(suite.ctx, gomock.Any()).Return(false, nil).Times(1)
+		suite.mockRecords.EXPECT().Get(suite.ctx, uint(7), "k").Return(suite.storedRecord(), nil).Times(1)
+
+		_, err := suite.uc.Begin(suite.ctx, suite.req)
+
+		suite.ErrorContains(err, "conflict")
+	})
+
+	suite.Run("expired_key_is_claimed_again", func() {
+		expired := suite.storedRecord()
+		expired.ExpiresAt = time.Now().Add(-time.Minute)
+		gomock.InOrder(
+			suite.mockRecords.EXPECT().Create(suite.ctx, gomock.Any()).Return(false, nil),
+			suite.mockRecords.EXPECT().Get(suite.ctx, uint(7), "k").Return(expired, nil),
+			suite.mockRecords.EXPECT().Delete(suite.ctx, uint(3)).Return(int64(1), nil),
+			suite.mockRecords.EXPECT().Create(suite.ctx, gomock.Any()).Return(true, nil),
+		)
+
+		resp, err := suite.uc.Begin(suite.ctx, suite.req)
+
+		suite.NoError(err)
+		suite.Nil(resp)
+	})
+
+	suite.Run("released_key_is_claimed_again", func() {
+		gomock.InOrder(
+			suite.mockRecords.EXPECT().Create(suite.ctx, gomock.Any()).Return(false, nil),
+			suite.mockRecords.EXPECT().Get(suite.ctx, uint(7), "k").Return(nil, nil),
+			suite.mockRecords.EXPECT().Create(suite.ctx, gomock.Any()).Return(true, nil),
+		)
+
+		resp, err := suite.uc.Begin(suite.ctx, suite.req)
+
+		suite.NoError(err)
+		suite.Nil(resp)
+	})
+
+	suite.Run("invalid_key", func() {
+		req := suite.req
+		req.Key = ""
+		_, err := suite.uc.Begin(suite.ctx, req)
+
+		suite.ErrorContains(err, "validation fail")
+	})
+
+	suite.Run("not_signed_in", func() {
+		_, err := suite.uc.Begin(context.Background(), suite.req)
+
+		suite.ErrorContains(err, "unauthorized")
+	})
+
+	suite.Run("create_fails", func() {
+		suite.mockRecords.EXPECT().Create(suite.ctx, gomock.Any()).Return(false, errors.New("db down")).Times(1)
+
+		_, err := suite.uc.Begin(suite.ctx, suite.req)
+
+		suite.ErrorContains(err, "internal fail")
+	})
+}
+
+func (suite *IdempotencyUseCaseTestSuite) TestComplete() {
+	req := CompleteIdempotencyRequest{
+		Key:        "k",
+		StatusCode: 201,
+		Headers:    map[string]string{"Location": "/api/v2/todos/5"},
+		Body:       []byte(`{"id":5}`),
+	}
+
+	suite.Run("stores_response", func() {
+		suite.mockRecords.EXPECT().Get(suite.ctx, uint(7), "k").Return(suite.storedRecord(), nil).Times(1)
+		suite.mockRecords.EXPECT().
+			Update(suite.ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, record *entity.IdempotencyRecord) (int64, error) {
+				suite.Equal(uint(3), record.ID)
+				suite.Equal(201, record.StatusCode)
+				suite.Equal(req.Headers, record.Headers)
+				suite.Equal(req.Body, record.Body)
+				return 1, nil
+			}).
+			Times(1)
+
+		suite.NoError(suite.uc.Complete(suite.ctx, req))
+	})
+
+	suite.Run("server_error_releases_key", func() {
+		suite.mockRecords.EXPECT().Get(suite.ctx, uint(7), "k").Return(suite.storedRecord(), nil).Times(1)
+		suite.mockRecords.EXPECT().Delete(suite.ctx, uint(3)).Return(int64(1), nil).Times(1)
+
+		suite.NoError(suite.uc.Complete(suite.ctx, CompleteIdempotencyRequest{Key: "k", StatusCode: 500}))
+	})
+
+	suite.Run("key_not_held", func() {
+		suite.mockRecords.EXPECT().Get(suite.ctx, uint(7), "k").Return(nil, nil).Times(1)
+
+		suite.NoError(suite.uc.Complete(suite.ctx, req))
+	})
+
+	suite.Run("already_completed", func() {
+		record := suite.storedRecord()
+		suite.Require().NoError(record.Complete(200, nil, nil))
+		suite.mockRecords.EXPECT().Get(suite.ctx, uint(7), "k").Return(record, nil).Times(1)
+
+		suite.NoError(suite.uc.Complete(suite.ctx, req))
+	})
+
+	suite.Run("invalid_status", func() {
+		suite.mockRecords.EXPECT().Get(suite.ctx, uint(7), "k").Return(suite.storedRecord(), nil).Times(1)
+
+		err := suite.uc.Complete(suite.ctx, CompleteIdempotencyRequest{Key: "k"})
+
+		suite.ErrorContains(err, "validation fail")
+	})
+
+	suite.Run("update_fails", func() {
+		suite.mockRecords.EXPECT().Get(suite.ctx, uint(7), "k").Return(suite.storedRecord(), nil).Times(1)
+		suite.mockRecords.EXPECT().Update(suite.ctx, gomock.Any()).Return(int64(0), errors.New("db down")).Times(1)
+
+		suite.ErrorContains(suite.uc.Complete(suite.ctx, req), "internal fail")
+	})
+}
+
+func (suite *IdempotencyUseCaseTestSuite) TestPurgeExpired() {
+	before := time.Now().UTC()
+
+	suite.Run("success", func() {
+		suite.mockRecords.EXPECT().DeleteExpired(suite.ctx, before, 100).Return(int64(4), nil).Times(1)
+
+		purged, err := suite.uc.PurgeExpired(suite.ctx, before, 100)
+
+		suite.NoError(err)
+		suite.Equal(int64(4), purged)
+	})
+
+	suite.Run("invalid_batch_size", func() {
+		_, err := suite.uc.PurgeExpired(suite.ctx, before, 0)
+
+		suite.ErrorContains(err, "validation fail")
+	})
+
+	suite.Run("delete_fails", func() {
+		suite.mockRecords.EXPECT().DeleteExpired(suite.ctx, before, 100).Return(int64(0), errors.New("db down")).Times(1)
+
+		_, err := suite.uc.PurgeExpired(suite.ctx, before, 100)
+
+		suite.ErrorContains(err, "internal fail")
+	})
+}
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: idempotency_uc.go
+//
+// Generated by this command:
+//
+//	mockgen -source=idempotency_uc.go -destination=idempotency_uc_mock.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIdempotencyUseCase is a mock of IdempotencyUseCase interface.
+type MockIdempotencyUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockIdempotencyUseCaseMockRecorder is the mock recorder for MockIdempotencyUseCase.
+type MockIdempotencyUseCaseMockRecorder struct {
+	mock *MockIdempotencyUseCase
+}
+
+// NewMockIdempotencyUseCase creates a new mock instance.
+func NewMockIdempotencyUseCase(ctrl *gomock.Controller) *MockIdempotencyUseCase {
+	mock := &MockIdempotencyUseCase{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyUseCase) EXPECT() *MockIdempotencyUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Begin mocks base method.
+func (m *MockIdempotencyUseCase) Begin(ctx context.Context, req BeginIdempotencyRequest) (*IdempotentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Begin", ctx, req)
+	ret0, _ := ret[0].(*IdempotentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Begin indicates an expected call of Begin.
+func (mr *MockIdempotencyUseCaseMockRecorder) Begin(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Begin", reflect.TypeOf((*MockIdempotencyUseCase)(nil).Begin), ctx, req)
+}
+
+// Complete mocks base method.
+func (m *MockIdempotencyUseCase) Complete(ctx context.Context, req CompleteIdempotencyRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockIdempotencyUseCaseMockRecorder) Complete(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockIdempotencyUseCase)(nil).Complete), ctx, req)
+}
+
+// PurgeExpired mocks base method.
+func (m *MockIdempotencyUseCase) PurgeExpired(ctx context.Context, before time.Time, batchSize int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExpired", ctx, before, batchSize)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeExpired indicates an expected call of PurgeExpired.
+func (mr *MockIdempotencyUseCaseMockRecorder) PurgeExpired(ctx, before, batchSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpired", reflect.TypeOf((*MockIdempotencyUseCase)(nil).PurgeExpired), ctx, before, batchSize)
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+// WorkspaceAction is something a member does in a workspace, the policy decides which roles may do it
+type WorkspaceAction string
+
+const (
+	ActionViewTodos          WorkspaceAction = "view_todos"          // read todos and everything attached to them
+	ActionEditTodos          WorkspaceAction = "edit_todos"          // create and change todos, their checklist, comments and attachments
+	ActionDeleteTodo         WorkspaceAction = "delete_todo"         // move a todo to the trash
+	ActionRestoreTodo        WorkspaceAction = "restore_todo"        // bring a todo back from the trash
+	ActionReassignTodo       WorkspaceAction = "reassign_todo"       // change who works on a todo
+	ActionPurgeTodos         WorkspaceAction = "purge_todos"         // delete todos from the trash for good
+	ActionManageMembers      WorkspaceAction = "manage_members"      // invite and remove members, change their role
+	ActionDeleteProject      WorkspaceAction = "delete_project"      // delete a project, a cascade moves its todos to the trash
+	ActionModerateComments   WorkspaceAction = "moderate_comments"   // edit and delete comments written by other members
+	ActionConfigureWorkspace WorkspaceAction = "configure_workspace" // create, change and delete the workflows and tags shared by the todos
+)
+
+// rolePolicy is the lowest role allowed to perform each action on any todo
+var rolePolicy = map[WorkspaceAction]entity.WorkspaceRole{
+	ActionViewTodos:          entity.RoleViewer,
+	ActionEditTodos:          entity.RoleMember,
+	ActionDeleteTodo:         entity.RoleAdmin,
+	ActionRestoreTodo:        entity.RoleAdmin,
+	ActionReassignTodo:       entity.RoleAdmin,
+	ActionPurgeTodos:         entity.RoleAdmin,
+	ActionManageMembers:      entity.RoleAdmin,
+	ActionDeleteProject:      entity.RoleAdmin,
+	ActionModerateComments:   entity.RoleAdmin,
+	ActionConfigureWorkspace: entity.RoleAdmin,
+}
+
+// ownerPolicy is the lowest role allowed to perform each action on the todos the user created
+var ownerPolicy = map[WorkspaceAction]entity.WorkspaceRole{
+	ActionDeleteTodo:   entity.RoleMember,
+	ActionRestoreTodo:  entity.RoleMember,
+	ActionReassignTodo: entity.RoleMember,
+}
+
+// Authorize returns a forbidden error unless the role may perform the action on any todo,
+// an empty or unknown role may do nothing
+func Authorize(role entity.WorkspaceRole, action WorkspaceAction) error {
+	if !role.IsValid() {
+		return forbidden(role, action)
+	}
+	lowest, ok := rolePolicy[action]
+	if !ok || !role.AtLeast(lowest) {
+		return forbidden(role, action)
+	}
+	return nil
+}
+
+// AuthorizeTodo returns a forbidden error unless the user with the role may perform the action on the todo,
+// members may delete, restore and reassign the todos they created
+func AuthorizeTodo(role entity.WorkspaceRole, userID uint, action WorkspaceAction, todo *entity.Todo) error {
+	if Authorize(role, action) == nil {
+		return nil
+	}
+
+	lowest, ok := ownerPolicy[action]
+	if ok && role.AtLeast(lowest) && todo != nil && userID != 0 && todo.OwnerID == userID {
+		return nil
+	}
+
+	return forbidden(role, action)
+}
+
+// authorize checks the role carried by ctx, only background jobs acting for the system skip the check
+func authorize(ctx context.Context, action WorkspaceAction) error {
+	if actor.IsSystem(ctx) {
+		return nil
+	}
+	return Authorize(entity.WorkspaceRole(actor.WorkspaceRole(ctx)), action)
+}
+
+// authorizeTodo checks the member carried by ctx against the todo, only the system skips the check
+func authorizeTodo(ctx context.Context, action WorkspaceAction, todo *entity.Todo) error {
+	if actor.IsSystem(ctx) {
+		return nil
+	}
+	return AuthorizeTodo(entity.WorkspaceRole(actor.WorkspaceRole(ctx)), actor.UserID(ctx), action, todo)
+}
+
+// authorizeComment lets the member carried by ctx change the comments they wrote,
+// admins and owners may change any comment and only the system skips the check
+func authorizeComment(ctx context.Context, comment *entity.Comment) error {
+	if actor.IsSystem(ctx) {
+		return nil
+	}
+	if userID := actor.UserID(ctx); userID != 0 && comment.AuthorID == userID {
+		return nil
+	}
+	return Authorize(entity.WorkspaceRole(actor.WorkspaceRole(ctx)), ActionModerateComments)
+}
+
+// forbidden builds the error for a role that may not perform an action
+func forbidden(role entity.WorkspaceRole, action WorkspaceAction) error {
+	if role == "" {
+		return fmt.Errorf("forbidden: %s needs a workspace role", action)
+	}
+	return fmt.Errorf("forbidden: the %s role may not %s", role, action)
+}
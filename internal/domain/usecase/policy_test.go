@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+// ownerCtx is a request of the workspace owner, who may perform every action
+func ownerCtx() context.Context {
+	return actor.WithWorkspaceRole(context.Background(), string(entity.RoleOwner))
+}
+
+// expectTodo makes the todo lookup behind requireTodo return an active todo of workspace 3
+// created by user 5, or nil when exists is false
+func expectTodo(ctx context.Context, repo *repository.MockTodoRepository, id uint, exists bool) {
+	var todo *entity.Todo
+	if exists {
+		todo = &entity.Todo{ID: id, Title: "寫文件", Status: entity.StatusPending, OwnerID: 5, WorkspaceID: 3}
+	}
+	repo.EXPECT().GetByID(ctx, id).Return(todo, nil).Times(1)
+}
+
+func TestAuthorize(t *testing.T) {
+	tests := []struct {
+		role    entity.WorkspaceRole
+		action  WorkspaceAction
+		allowed bool
+	}{
+		{role: entity.RoleViewer, action: ActionViewTodos, allowed: true},
+		{role: entity.RoleViewer, action: ActionEditTodos, allowed: false},
+		{role: entity.RoleMember, action: ActionEditTodos, allowed: true},
+		{role: entity.RoleMember, action: ActionDeleteTodo, allowed: false},
+		{role: entity.RoleMember, action: ActionManageMembers, allowed: false},
+		{role: entity.RoleAdmin, action: ActionDeleteTodo, allowed: true},
+		{role: entity.RoleAdmin, action: ActionPurgeTodos, allowed: true},
+		{role: entity.RoleAdmin, action: ActionManageMembers, allowed: true},
+		{role: entity.RoleOwner, action: ActionReassignTodo, allowed: true},
+		{role: entity.RoleMember, action: ActionDeleteProject, allowed: false},
+		{role: entity.RoleAdmin, action: ActionDeleteProject, allowed: true},
+		{role: entity.RoleMember, action: ActionModerateComments, allowed: false},
+		{role: entity.RoleAdmin, action: ActionModerateComments, allowed: true},
+		{role: entity.RoleMember, action: ActionConfigureWorkspace, allowed: false},
+		{role: entity.RoleAdmin, action: ActionConfigureWorkspace, allowed: true},
+		{role: "", action: ActionViewTodos, allowed: false},
+		{role: "superuser", action: ActionViewTodos, allowed: false},
+		{role: entity.RoleOwner, action: "drop_database", allowed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role)+"_"+string(tt.action), func(t *testing.T) {
+			err := Authorize(tt.role, tt.action)
+
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, "forbidden")
+			}
+		})
+	}
+}
+
+func TestAuthorizeContext(t *testing.T) {
+	assert.NoError(t, authorize(ownerCtx(), ActionPurgeTodos))
+	assert.NoError(t, authorize(actor.WithSystem(context.Background()), ActionPurgeTodos))
+	assert.NoError(t, authorizeTodo(actor.WithSystem(context.Background()), ActionDeleteTodo, &entity.Todo{ID: 1}))
+
+	// requests without a workspace role are denied instead of trusted
+	assert.EqualError(t, authorize(context.Background(), ActionViewTodos), "forbidden: view_todos needs a workspace role")
+	assert.ErrorContains(t, authorizeTodo(actor.WithUserID(context.Background(), 7), ActionDeleteTodo, &entity.Todo{ID: 1, OwnerID: 7}), "forbidden")
+}
+
+func TestAuthorizeTodo(t *testing.T) {
+	own := &entity.Todo{ID: 1, OwnerID: 7}
+	others := &entity.Todo{ID: 2, OwnerID: 8}
+
+	tests := []struct {
+		name    string
+		role    entity.WorkspaceRole
+		action  WorkspaceAction
+		todo    *entity.Todo
+		allowed bool
+	}{
+		{name: "member_deletes_own", role: entity.RoleMember, action: ActionDeleteTodo, todo: own, allowed: true},
+		{name: "member_deletes_others", role: entity.RoleMember, action: ActionDeleteTodo, todo: others, allowed: false},
+		{name: "member_restores_own", role: entity.RoleMember, action: ActionRestoreTodo, todo: own, allowed: true},
+		{name: "member_reassigns_own", role: entity.RoleMember, action: ActionReassignTodo, todo: own, allowed: true},
+		{name: "member_reassigns_others", role: entity.RoleMember, action: ActionReassignTodo, todo: others, allowed: false},
+		{name: "member_purges_own", role: entity.RoleMember, action: ActionPurgeTodos, todo: own, allowed: false},
+		{name: "viewer_deletes_own", role: entity.RoleViewer, action: ActionDeleteTodo, todo: own, allowed: false},
+		{name: "admin_deletes_others", role: entity.RoleAdmin, action: ActionDeleteTodo, todo: others, allowed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := AuthorizeTodo(tt.role, 7, tt.action, tt.todo)
+
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, "forbidden")
+			}
+		})
+	}
+}
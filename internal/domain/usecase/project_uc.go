@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=project_uc.go -destination=project_uc_mock.go -package=usecase
+type ProjectUseCase interface {
+
+	// CreateProject creates a new project and returns the created project ID
+	// Error:
+	// - validation fail (also unknown workflow)
+	// - conflict (name already used)
+	// - internal fail
+	CreateProject(ctx context.Context, req CreateProjectRequest) (*CreateProjectResponse, error)
+
+	// ListProjects lists every project ordered by name
+	// Error:
+	// - internal fail
+	ListProjects(ctx context.Context) (*ListProjectsResponse, error)
+
+	// GetProject gets a single project
+	// Error:
+	// - validation fail
+	// - not found
+	// - internal fail
+	GetProject(ctx context.Context, id uint) (*ProjectResponse, error)
+
+	// UpdateProject renames a project and/or changes its description
+	// Error:
+	// - validation fail
+	// - not found
+	// - conflict (name already used)
+	// - internal fail
+	UpdateProject(ctx context.Context, req UpdateProjectRequest) error
+
+	// DeleteProject deletes a project, with cascade its todos are moved to the trash,
+	// without cascade a project that still has todos is rejected
+	// Error:
+	// - validation fail
+	// - not found
+	// - conflict (project still has todos)
+	// - internal fail
+	DeleteProject(ctx context.Context, req DeleteProjectRequest) error
+}
+
+type CreateProjectRequest struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+	WorkflowID  *uint   `json:"workflow_id"` // workflow of the todos in the project, nil uses the default workflow
+}
+
+type CreateProjectResponse struct {
+	ID uint `json:"id"`
+}
+
+type UpdateProjectRequest struct {
+	ID          uint    `json:"id"`
+	Name        *string `json:"name"`        // nil=keep current, "value"=rename
+	Description *string `json:"description"` // nil=keep current, ""=clear, "value"=update
+}
+
+type DeleteProjectRequest struct {
+	ID      uint `json:"id"`
+	Cascade bool `json:"cascade"` // true=move the todos of the project to the trash
+}
+
+type ListProjectsResponse struct {
+	Projects []ProjectResponse `json:"projects"`
+}
+
+type ProjectResponse struct {
+	ID          uint      `json:"id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description,omitempty"`
+	WorkflowID  uint      `json:"workflow_id"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
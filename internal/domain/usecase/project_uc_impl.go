@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+var _ ProjectUseCase = &projectUseCaseImpl{}
+
+type projectUseCaseImpl struct {
+	projectRepo  repository.ProjectRepository
+	workflowRepo repository.WorkflowRepository
+	todoRepo     repository.TodoRepository
+}
+
+func NewProjectUseCaseImpl(
+	projectRepo repository.ProjectRepository,
+	workflowRepo repository.WorkflowRepository,
+	todoRepo repository.TodoRepository,
+) ProjectUseCase {
+	return &projectUseCaseImpl{
+		projectRepo:  projectRepo,
+		workflowRepo: workflowRepo,
+		todoRepo:     todoRepo,
+	}
+}
+
+// CreateProject creates a new project with a unique name in the requested or default workflow
+func (p *projectUseCaseImpl) CreateProject(ctx context.Context, req CreateProjectRequest) (*CreateProjectResponse, error) {
+	workflow, err := p.findWorkflow(ctx, req.WorkflowID)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := entity.NewProject(req.Name, req.Description, workflow.ID)
+	if err != nil {
+		return nil, errors.Join(errors.New("validation fail"), err)
+	}
+	project.WorkspaceID = actor.WorkspaceID(ctx)
+
+	if err := p.checkNameAvailable(ctx, project.Name, 0); err != nil {
+		return nil, err
+	}
+
+	project, err = p.projectRepo.Create(ctx, project)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	return &CreateProjectResponse{ID: project.ID}, nil
+}
+
+// ListProjects lists every project ordered by name
+func (p *projectUseCaseImpl) ListProjects(ctx context.Context) (*ListProjectsResponse, error) {
+	projects, err := p.projectRepo.List(ctx)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	resp := &ListProjectsResponse{Projects: make([]ProjectResponse, len(projects))}
+	for i, project := range projects {
+		resp.Projects[i] = toProjectResponse(*project)
+	}
+
+	return resp, nil
+}
+
+// GetProject gets a single project
+func (p *projectUseCaseImpl) GetProject(ctx context.Context, id uint) (*ProjectResponse, error) {
+	project, err := p.getProject(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := toProjectResponse(*project)
+	return &resp, nil
+}
+
+// UpdateProject renames a project and/or changes its description
+func (p *projectUseCaseImpl) UpdateProject(ctx context.Context, req UpdateProjectRequest) error {
+	project, err := p.getProject(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+
+	if req.Name != nil {
+		if err := project.Rename(*req.Name); err != nil {
+			return errors.Join(errors.New("validation fail"), err)
+		}
+		if err := p.checkNameAvailable(ctx, project.Name, project.ID); err != nil {
+			return err
+		}
+	}
+
+	if req.Description != nil {
+		if err := project.SetDescription(req.Description); err != nil {
+			return errors.Join(errors.New("validation fail"), err)
+		}
+	}
+
+	rowsAffected, err := p.projectRepo.Update(ctx, project)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if rowsAffected == 0 {
+		return errors.New("not found: project not found")
+	}
+
+	return nil
+}
+
+// DeleteProject deletes a project, trashing its todos with cascade or rejecting it while it has todos
+func (p *projectUseCaseImpl) DeleteProject(ctx context.Context, req DeleteProjectRequest) error {
+	if err := authorize(ctx, ActionDeleteProject); err != nil {
+		return err
+	}
+
+	project, err := p.getProject(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+
+	// Trashed todos do not block the delete, they are detached from the project
+	if !req.Cascade {
+		todos, err := p.todoRepo.Count(ctx, repository.TodoQueryParams{ProjectID: &project.ID})
+		if err != nil {
+			return errors.Join(errors.New("internal fail"), err)
+		}
+		if todos > 0 {
+			return fmt.Errorf("conflict: project still has %d todos, delete with cascade to move them to the trash", todos)
+		}
+	}
+
+	rowsAffected, err := p.projectRepo.Delete(ctx, project.ID, req.Cascade)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if rowsAffected == 0 {
+		return errors.New("not found: project not found")
+	}
+
+	return nil
+}
+
+// getProject loads a project and maps a missing one to a not found error
+func (p *projectUseCaseImpl) getProject(ctx context.Context, id uint) (*entity.Project, error) {
+	if id == 0 {
+		return nil, errors.New("validation fail: ID cannot be 0")
+	}
+
+	project, err := p.projectRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if project == nil {
+		return nil, errors.New("not found: project not found")
+	}
+
+	return project, nil
+}
+
+// findWorkflow loads the workflow with the given ID, nil loads the default workflow
+func (p *projectUseCaseImpl) findWorkflow(ctx context.Context, id *uint) (*entity.Workflow, error) {
+	var workflow *entity.Workflow
+	var err error
+	if id == nil {
+		workflow, err = p.workflowRepo.GetDefault(ctx)
+	} else {
+		workflow, err = p.workflowRepo.GetByID(ctx, *id)
+	}
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if workflow == nil && id == nil {
+		return nil, errors.New("internal fail: default workflow not found")
+	}
+	if workflow == nil {
+		return nil, fmt.Errorf("validation fail: workflow %d not found", *id)
+	}
+
+	return workflow, nil
+}
+
+// checkNameAvailable returns a conflict error when another project already uses the name
+func (p *projectUseCaseImpl) checkNameAvailable(ctx context.Context, name string, selfID uint) error {
+	existing, err := p.projectRepo.GetByName(ctx, name)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if existing != nil && existing.ID != selfID {
+		return errors.New("conflict: project name already exists")
+	}
+
+	return nil
+}
+
+// toProjectResponse converts a project entity to the usecase response
+func toProjectResponse(project entity.Project) ProjectResponse {
+	return ProjectResponse{
+		ID:          project.ID,
+		Name:        project.Name,
+		Description: project.Description,
+		WorkflowID:  project.WorkflowID,
+		CreatedAt:   project.CreatedAt,
+		UpdatedAt:   project.UpdatedAt,
+	}
+}
@@ -0,0 +1,215 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+type ProjectUseCaseTestSuite struct {
+	suite.Suite
+	ctrl      *gomock.Controller
+	mockRepo  *repository.MockProjectRepository
+	mockFlows *repository.MockWorkflowRepository
+	mockTodos *repository.MockTodoRepository
+	uc        ProjectUseCase
+}
+
+func TestProjectUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(ProjectUseCaseTestSuite))
+}
+
+func (suite *ProjectUseCaseTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockRepo = repository.NewMockProjectRepository(suite.ctrl)
+	suite.mockFlows = repository.NewMockWorkflowRepository(suite.ctrl)
+	suite.mockTodos = repository.NewMockTodoRepository(suite.ctrl)
+	suite.uc = NewProjectUseCaseImpl(suite.mockRepo, suite.mockFlows, suite.mockTodos)
+}
+
+func (suite *ProjectUseCaseTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+func (suite *ProjectUseCaseTestSuite) TestCreateProject() {
+	ctx := ownerCtx()
+	defaultWorkflow := entity.DefaultWorkflow()
+	defaultWorkflow.ID = 1
+	workflowID := uint(2)
+
+	tests := []struct {
+		name         string
+		req          CreateProjectRequest
+		setupMock    func()
+		expectResp   *CreateProjectResponse
+		expectErrMsg string
+	}{
+		{
+			name: "workflow_not_found",
+			req:  CreateProjectRequest{Name: "release", WorkflowID: &workflowID},
+			setupMock: func() {
+				suite.mockFlows.EXPECT().GetByID(ctx, workflowID).Return(nil, nil).Times(1)
+			},
+			expectErrMsg: "validation fail: workflow 2 not found",
+		},
+		{
+			name: "empty_name",
+			req:  CreateProjectRequest{Name: " "},
+			setupMock: func() {
+				suite.mockFlows.EXPECT().GetDefault(ctx).Return(defaultWorkflow, nil).Times(1)
+			},
+			expectErrMsg: "validation fail",
+		},
+		{
+			name: "name_conflict",
+			req:  CreateProjectRequest{Name: " release "},
+			setupMock: func() {
+				suite.mockFlows.EXPECT().GetDefault(ctx).Return(defaultWorkflow, nil).Times(1)
+				suite.mockRepo.EXPECT().GetByName(ctx, "release").Return(&entity.Project{ID: 1, Name: "release"}, nil).Times(1)
+			},
+			expectErrMsg: "conflict",
+		},
+		{
+			name: "db_fail",
+			req:  CreateProjectRequest{Name: "release"},
+			setupMock: func() {
+				suite.mockFlows.EXPECT().GetDefault(ctx).Return(defaultWorkflow, nil).Times(1)
+				suite.mockRepo.EXPECT().GetByName(ctx, "release").Return(nil, nil).Times(1)
+				suite.mockRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil, errors.New("database error")).Times(1)
+			},
+			expectErrMsg: "internal fail",
+		},
+		{
+			name: "success_default_workflow",
+			req:  CreateProjectRequest{Name: "release"},
+			setupMock: func() {
+				suite.mockFlows.EXPECT().GetDefault(ctx).Return(defaultWorkflow, nil).Times(1)
+				suite.mockRepo.EXPECT().GetByName(ctx, "release").Return(nil, nil).Times(1)
+				suite.mockRepo.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, project *entity.Project) (*entity.Project, error) {
+						assert.Equal(suite.T(), uint(1), project.WorkflowID)
+						project.ID = 3
+						return project, nil
+					}).
+					Times(1)
+			},
+			expectResp: &CreateProjectResponse{ID: 3},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.setupMock()
+
+			resp, err := suite.uc.CreateProject(ctx, tt.req)
+
+			if tt.expectErrMsg != "" {
+				assert.ErrorContains(suite.T(), err, tt.expectErrMsg)
+				assert.Nil(suite.T(), resp)
+			} else {
+				assert.NoError(suite.T(), err)
+				assert.Equal(suite.T(), tt.expectResp, resp)
+			}
+		})
+	}
+}
+
+func (suite *ProjectUseCaseTestSuite) TestUpdateProject() {
+	ctx := ownerCtx()
+	existing := func() *entity.Project {
+		return &entity.Project{ID: 1, Name: "release", WorkflowID: 1}
+	}
+
+	suite.Run("not_found", func() {
+		suite.mockRepo.EXPECT().GetByID(ctx, uint(1)).Return(nil, nil).Times(1)
+
+		err := suite.uc.UpdateProject(ctx, UpdateProjectRequest{ID: 1})
+
+		assert.EqualError(suite.T(), err, "not found: project not found")
+	})
+
+	suite.Run("name_conflict", func() {
+		name := "ops"
+		suite.mockRepo.EXPECT().GetByID(ctx, uint(1)).Return(existing(), nil).Times(1)
+		suite.mockRepo.EXPECT().GetByName(ctx, "ops").Return(&entity.Project{ID: 2, Name: "ops"}, nil).Times(1)
+
+		err := suite.uc.UpdateProject(ctx, UpdateProjectRequest{ID: 1, Name: &name})
+
+		assert.EqualError(suite.T(), err, "conflict: project name already exists")
+	})
+
+	suite.Run("clear_description", func() {
+		empty := ""
+		suite.mockRepo.EXPECT().GetByID(ctx, uint(1)).Return(existing(), nil).Times(1)
+		suite.mockRepo.EXPECT().
+			Update(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, project *entity.Project) (int64, error) {
+				assert.Equal(suite.T(), "release", project.Name)
+				assert.Nil(suite.T(), project.Description)
+				return 1, nil
+			}).
+			Times(1)
+
+		err := suite.uc.UpdateProject(ctx, UpdateProjectRequest{ID: 1, Description: &empty})
+
+		assert.NoError(suite.T(), err)
+	})
+}
+
+func (suite *ProjectUseCaseTestSuite) TestDeleteProject() {
+	ctx := ownerCtx()
+	project := &entity.Project{ID: 1, Name: "release", WorkflowID: 1}
+
+	suite.Run("not_empty", func() {
+		suite.mockRepo.EXPECT().GetByID(ctx, uint(1)).Return(project, nil).Times(1)
+		suite.mockTodos.EXPECT().
+			Count(ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, filters repository.TodoQueryParams) (int64, error) {
+				assert.Equal(suite.T(), uint(1), *filters.ProjectID)
+				assert.False(suite.T(), filters.WithTrashed)
+				return 3, nil
+			}).
+			Times(1)
+
+		err := suite.uc.DeleteProject(ctx, DeleteProjectRequest{ID: 1})
+
+		assert.EqualError(suite.T(), err, "conflict: project still has 3 todos, delete with cascade to move them to the trash")
+	})
+
+	suite.Run("empty", func() {
+		suite.mockRepo.EXPECT().GetByID(ctx, uint(1)).Return(project, nil).Times(1)
+		suite.mockTodos.EXPECT().Count(ctx, gomock.Any()).Return(int64(0), nil).Times(1)
+		suite.mockRepo.EXPECT().Delete(ctx, uint(1), false).Return(int64(1), nil).Times(1)
+
+		err := suite.uc.DeleteProject(ctx, DeleteProjectRequest{ID: 1})
+
+		assert.NoError(suite.T(), err)
+	})
+
+	suite.Run("cascade", func() {
+		suite.mockRepo.EXPECT().GetByID(ctx, uint(1)).Return(project, nil).Times(1)
+		suite.mockRepo.EXPECT().Delete(ctx, uint(1), true).Return(int64(1), nil).Times(1)
+
+		err := suite.uc.DeleteProject(ctx, DeleteProjectRequest{ID: 1, Cascade: true})
+
+		assert.NoError(suite.T(), err)
+	})
+	suite.Run("member_forbidden", func() {
+		memberCtx := actor.WithWorkspaceRole(context.Background(), string(entity.RoleMember))
+
+		err := suite.uc.DeleteProject(memberCtx, DeleteProjectRequest{ID: 1, Cascade: true})
+
+		assert.EqualError(suite.T(), err, "forbidden: the member role may not delete_project")
+	})
+}
@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: project_uc.go
+//
+// Generated by this command:
+//
+//	mockgen -source=project_uc.go -destination=project_uc_mock.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProjectUseCase is a mock of ProjectUseCase interface.
+type MockProjectUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectUseCaseMockRecorder is the mock recorder for MockProjectUseCase.
+type MockProjectUseCaseMockRecorder struct {
+	mock *MockProjectUseCase
+}
+
+// NewMockProjectUseCase creates a new mock instance.
+func NewMockProjectUseCase(ctrl *gomock.Controller) *MockProjectUseCase {
+	mock := &MockProjectUseCase{ctrl: ctrl}
+	mock.recorder = &MockProjectUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectUseCase) EXPECT() *MockProjectUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CreateProject mocks base method.
+func (m *MockProjectUseCase) CreateProject(ctx context.Context, req CreateProjectRequest) (*CreateProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProject", ctx, req)
+	ret0, _ := ret[0].(*CreateProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProject indicates an expected call of CreateProject.
+func (mr *MockProjectUseCaseMockRecorder) CreateProject(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockProjectUseCase)(nil).CreateProject), ctx, req)
+}
+
+// DeleteProject mocks base method.
+func (m *MockProjectUseCase) DeleteProject(ctx context.Context, req DeleteProjectRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProject", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProject indicates an expected call of DeleteProject.
+func (mr *MockProjectUseCaseMockRecorder) DeleteProject(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockProjectUseCase)(nil).DeleteProject), ctx, req)
+}
+
+// GetProject mocks base method.
+func (m *MockProjectUseCase) GetProject(ctx context.Context, id uint) (*ProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProject", ctx, id)
+	ret0, _ := ret[0].(*ProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProject indicates an expected call of GetProject.
+func (mr *MockProjectUseCaseMockRecorder) GetProject(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProject", reflect.TypeOf((*MockProjectUseCase)(nil).GetProject), ctx, id)
+}
+
+// ListProjects mocks base method.
+func (m *MockProjectUseCase) ListProjects(ctx context.Context) (*ListProjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjects", ctx)
+	ret0, _ := ret[0].(*ListProjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjects indicates an expected call of ListProjects.
+func (mr *MockProjectUseCaseMockRecorder) ListProjects(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockProjectUseCase)(nil).ListProjects), ctx)
+}
+
+// UpdateProject mocks base method.
+func (m *MockProjectUseCase) UpdateProject(ctx context.Context, req UpdateProjectRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProject", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProject indicates an expected call of UpdateProject.
+func (mr *MockProjectUseCaseMockRecorder) UpdateProject(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockProjectUseCase)(nil).UpdateProject), ctx, req)
+}
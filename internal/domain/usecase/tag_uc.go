@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=tag_uc.go -destination=tag_uc_mock.go -package=usecase
+type TagUseCase interface {
+
+	// CreateTag creates a new tag and returns the created tag ID
+	// Error:
+	// - validation fail
+	// - conflict (name already used)
+	// - internal fail
+	CreateTag(ctx context.Context, req CreateTagRequest) (*CreateTagResponse, error)
+
+	// ListTags lists every tag ordered by name
+	// Error:
+	// - internal fail
+	ListTags(ctx context.Context) (*ListTagsResponse, error)
+
+	// UpdateTag renames and/or recolors a tag
+	// Error:
+	// - validation fail
+	// - not found
+	// - conflict (name already used)
+	// - internal fail
+	UpdateTag(ctx context.Context, req UpdateTagRequest) error
+
+	// DeleteTag deletes a tag and detaches it from every todo
+	// Error:
+	// - validation fail
+	// - not found
+	// - internal fail
+	DeleteTag(ctx context.Context, id uint) error
+}
+
+type CreateTagRequest struct {
+	Name  string `json:"name"`
+	Color string `json:"color"` // empty uses the default color
+}
+
+type CreateTagResponse struct {
+	ID uint `json:"id"`
+}
+
+type UpdateTagRequest struct {
+	ID    uint    `json:"id"`
+	Name  *string `json:"name"`  // nil=keep current, "value"=rename
+	Color *string `json:"color"` // nil=keep current, "value"=update
+}
+
+type ListTagsResponse struct {
+	Tags []TagResponse `json:"tags"`
+}
+
+type TagResponse struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	Color     string    `json:"color"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
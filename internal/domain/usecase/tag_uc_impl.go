@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+var _ TagUseCase = &tagUseCaseImpl{}
+
+type tagUseCaseImpl struct {
+	tagRepo repository.TagRepository
+}
+
+func NewTagUseCaseImpl(tagRepo repository.TagRepository) TagUseCase {
+	return &tagUseCaseImpl{
+		tagRepo: tagRepo,
+	}
+}
+
+// CreateTag creates a new tag with a unique name
+func (t *tagUseCaseImpl) CreateTag(ctx context.Context, req CreateTagRequest) (*CreateTagResponse, error) {
+	if err := authorize(ctx, ActionConfigureWorkspace); err != nil {
+		return nil, err
+	}
+
+	tag, err := entity.NewTag(req.Name, req.Color)
+	if err != nil {
+		return nil, errors.Join(errors.New("validation fail"), err)
+	}
+	tag.WorkspaceID = actor.WorkspaceID(ctx)
+
+	if err := t.checkNameAvailable(ctx, tag.Name, 0); err != nil {
+		return nil, err
+	}
+
+	tag, err = t.tagRepo.Create(ctx, tag)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	return &CreateTagResponse{ID: tag.ID}, nil
+}
+
+// ListTags lists every tag ordered by name
+func (t *tagUseCaseImpl) ListTags(ctx context.Context) (*ListTagsResponse, error) {
+	tags, err := t.tagRepo.List(ctx)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	resp := &ListTagsResponse{Tags: make([]TagResponse, len(tags))}
+	for i, tag := range tags {
+		resp.Tags[i] = toTagResponse(*tag)
+	}
+
+	return resp, nil
+}
+
+// UpdateTag renames and/or recolors a tag
+func (t *tagUseCaseImpl) UpdateTag(ctx context.Context, req UpdateTagRequest) error {
+	if err := authorize(ctx, ActionConfigureWorkspace); err != nil {
+		return err
+	}
+
+	if req.ID == 0 {
+		return errors.New("validation fail: ID cannot be 0")
+	}
+
+	tag, err := t.tagRepo.GetByID(ctx, req.ID)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if tag == nil {
+		return errors.New("not found: tag not found")
+	}
+
+	if req.Name != nil {
+		if err := tag.Rename(*req.Name); err != nil {
+			return errors.Join(errors.New("validation fail"), err)
+		}
+		if err := t.checkNameAvailable(ctx, tag.Name, tag.ID); err != nil {
+			return err
+		}
+	}
+
+	if req.Color != nil {
+		if err := tag.SetColor(*req.Color); err != nil {
+			return errors.Join(errors.New("validation fail"), err)
+		}
+	}
+
+	rowsAffected, err := t.tagRepo.Update(ctx, tag)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if rowsAffected == 0 {
+		return errors.New("not found: tag not found")
+	}
+
+	return nil
+}
+
+// DeleteTag deletes a tag and detaches it from every todo
+func (t *tagUseCaseImpl) DeleteTag(ctx context.Context, id uint) error {
+	if err := authorize(ctx, ActionConfigureWorkspace); err != nil {
+		return err
+	}
+
+	if id == 0 {
+		return errors.New("validation fail: ID cannot be 0")
+	}
+
+	rowsAffected, err := t.tagRepo.Delete(ctx, id)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if rowsAffected == 0 {
+		return errors.New("not found: tag not found")
+	}
+
+	return nil
+}
+
+// checkNameAvailable returns a conflict error when another tag already uses the name
+func (t *tagUseCaseImpl) checkNameAvailable(ctx context.Context, name string, selfID uint) error {
+	existing, err := t.tagRepo.GetByName(ctx, name)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if existing != nil && existing.ID != selfID {
+		return errors.New("conflict: tag name already exists")
+	}
+
+	return nil
+}
+
+// toTagResponse converts a tag entity to the usecase response
+func toTagResponse(tag entity.Tag) TagResponse {
+	return TagResponse{
+		ID:        tag.ID,
+		Name:      tag.Name,
+		Color:     tag.Color,
+		CreatedAt: tag.CreatedAt,
+		UpdatedAt: tag.UpdatedAt,
+	}
+}
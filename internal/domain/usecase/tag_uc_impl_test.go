@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+type TagUseCaseTestSuite struct {
+	suite.Suite
+	ctrl     *gomock.Controller
+	mockRepo *repository.MockTagRepository
+	uc       TagUseCase
+}
+
+func TestTagUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(TagUseCaseTestSuite))
+}
+
+func (suite *TagUseCaseTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockRepo = repository.NewMockTagRepository(suite.ctrl)
+	suite.uc = NewTagUseCaseImpl(suite.mockRepo)
+}
+
+func (suite *TagUseCaseTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+func (suite *TagUseCaseTestSuite) TestCreateTag() {
+	ctx := ownerCtx()
+
+	tests := []struct {
+		name         string
+		req          CreateTagRequest
+		setupMock    func()
+		expectResp   *CreateTagResponse
+		expectErrMsg string
+	}{
+		{
+			name:         "invalid_color",
+			req:          CreateTagRequest{Name: "work", Color: "blue"},
+			setupMock:    func() {},
+			expectErrMsg: "validation fail",
+		},
+		{
+			name: "name_conflict",
+			req:  CreateTagRequest{Name: " work "},
+			setupMock: func() {
+				suite.mockRepo.EXPECT().
+					GetByName(ctx, "work").
+					Return(&entity.Tag{ID: 1, Name: "work"}, nil).
+					Times(1)
+			},
+			expectErrMsg: "conflict",
+		},
+		{
+			name: "db_fail",
+			req:  CreateTagRequest{Name: "work"},
+			setupMock: func() {
+				suite.mockRepo.EXPECT().GetByName(ctx, "work").Return(nil, nil).Times(1)
+				suite.mockRepo.EXPECT().
+					Create(ctx, gomock.Any()).
+					Return(nil, errors.New("database error")).
+					Times(1)
+			},
+			expectErrMsg: "internal fail",
+		},
+		{
+			name: "success",
+			req:  CreateTagRequest{Name: "work", Color: "#FF0000"},
+			setupMock: func() {
+				suite.mockRepo.EXPECT().GetByName(ctx, "work").Return(nil, nil).Times(1)
+				suite.mockRepo.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, tag *entity.Tag) (*entity.Tag, error) {
+						assert.Equal(suite.T(), "#ff0000", tag.Color)
+						tag.ID = 5
+						return tag, nil
+					}).
+					Times(1)
+			},
+			expectResp: &CreateTagResponse{ID: 5},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.setupMock()
+
+			resp, err := suite.uc.CreateTag(ctx, tt.req)
+
+			if tt.expectErrMsg != "" {
+				assert.ErrorContains(suite.T(), err, tt.expectErrMsg)
+				assert.Nil(suite.T(), resp)
+			} else {
+				assert.NoError(suite.T(), err)
+				assert.Equal(suite.T(), tt.expectResp, resp)
+			}
+		})
+	}
+}
+
+func (suite *TagUseCaseTestSuite) TestUpdateTag() {
+	ctx := ownerCtx()
+	name := "home"
+	color := "#00FF00"
+
+	tests := []struct {
+		name         string
+		req          UpdateTagRequest
+		setupMock    func()
+		expectErrMsg string
+	}{
+		{
+			name:         "zero_id",
+			req:          UpdateTagRequest{ID: 0},
+			setupMock:    func() {},
+			expectErrMsg: "validation fail",
+		},
+		{
+			name: "not_found",
+			req:  UpdateTagRequest{ID: 1, Name: &name},
+			setupMock: func() {
+				suite.mockRepo.EXPECT().GetByID(ctx, uint(1)).Return(nil, nil).Times(1)
+			},
+			expectErrMsg: "not found",
+		},
+		{
+			name: "name_taken_by_other_tag",
+			req:  UpdateTagRequest{ID: 1, Name: &name},
+			setupMock: func() {
+				suite.mockRepo.EXPECT().GetByID(ctx, uint(1)).Return(&entity.Tag{ID: 1, Name: "work", Color: "#808080"}, nil).Times(1)
+				suite.mockRepo.EXPECT().GetByName(ctx, "home").Return(&entity.Tag{ID: 2, Name: "home"}, nil).Times(1)
+			},
+			expectErrMsg: "conflict",
+		},
+		{
+			name: "rename_to_own_name_and_recolor",
+			req:  UpdateTagRequest{ID: 2, Name: &name, Color: &color},
+			setupMock: func() {
+				suite.mockRepo.EXPECT().GetByID(ctx, uint(2)).Return(&entity.Tag{ID: 2, Name: "home", Color: "#808080"}, nil).Times(1)
+				suite.mockRepo.EXPECT().GetByName(ctx, "home").Return(&entity.Tag{ID: 2, Name: "home"}, nil).Times(1)
+				suite.mockRepo.EXPECT().
+					Update(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, tag *entity.Tag) (int64, error) {
+						assert.Equal(suite.T(), "#00ff00", tag.Color)
+						return 1, nil
+					}).
+					Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.setupMock()
+
+			err := suite.uc.UpdateTag(ctx, tt.req)
+
+			if tt.expectErrMsg != "" {
+				assert.ErrorContains(suite.T(), err, tt.expectErrMsg)
+			} else {
+				assert.NoError(suite.T(), err)
+			}
+		})
+	}
+}
+
+func (suite *TagUseCaseTestSuite) TestDeleteTag() {
+	ctx := ownerCtx()
+
+	suite.mockRepo.EXPECT().Delete(ctx, uint(9)).Return(int64(0), nil).Times(1)
+	err := suite.uc.DeleteTag(ctx, 9)
+	assert.ErrorContains(suite.T(), err, "not found")
+
+	suite.mockRepo.EXPECT().Delete(ctx, uint(1)).Return(int64(1), nil).Times(1)
+	err = suite.uc.DeleteTag(ctx, 1)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *TagUseCaseTestSuite) TestMemberForbidden() {
+	memberCtx := actor.WithWorkspaceRole(context.Background(), string(entity.RoleMember))
+	name := "office"
+
+	// members use the tags of the workspace, admins manage them
+	_, err := suite.uc.CreateTag(memberCtx, CreateTagRequest{Name: "work"})
+	assert.EqualError(suite.T(), err, "forbidden: the member role may not configure_workspace")
+
+	err = suite.uc.UpdateTag(memberCtx, UpdateTagRequest{ID: 1, Name: &name})
+	assert.EqualError(suite.T(), err, "forbidden: the member role may not configure_workspace")
+
+	err = suite.uc.DeleteTag(memberCtx, 1)
+	assert.EqualError(suite.T(), err, "forbidden: the member role may not configure_workspace")
+}
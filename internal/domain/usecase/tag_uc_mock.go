@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: tag_uc.go
+//
+// Generated by this command:
+//
+//	mockgen -source=tag_uc.go -destination=tag_uc_mock.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockTagUseCase is a mock of TagUseCase interface.
+type MockTagUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockTagUseCaseMockRecorder is the mock recorder for MockTagUseCase.
+type MockTagUseCaseMockRecorder struct {
+	mock *MockTagUseCase
+}
+
+// NewMockTagUseCase creates a new mock instance.
+func NewMockTagUseCase(ctrl *gomock.Controller) *MockTagUseCase {
+	mock := &MockTagUseCase{ctrl: ctrl}
+	mock.recorder = &MockTagUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagUseCase) EXPECT() *MockTagUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CreateTag mocks base method.
+func (m *MockTagUseCase) CreateTag(ctx context.Context, req CreateTagRequest) (*CreateTagResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTag", ctx, req)
+	ret0, _ := ret[0].(*CreateTagResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTag indicates an expected call of CreateTag.
+func (mr *MockTagUseCaseMockRecorder) CreateTag(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTag", reflect.TypeOf((*MockTagUseCase)(nil).CreateTag), ctx, req)
+}
+
+// DeleteTag mocks base method.
+func (m *MockTagUseCase) DeleteTag(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTag", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockTagUseCaseMockRecorder) DeleteTag(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockTagUseCase)(nil).DeleteTag), ctx, id)
+}
+
+// ListTags mocks base method.
+func (m *MockTagUseCase) ListTags(ctx context.Context) (*ListTagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTags", ctx)
+	ret0, _ := ret[0].(*ListTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockTagUseCaseMockRecorder) ListTags(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockTagUseCase)(nil).ListTags), ctx)
+}
+
+// UpdateTag mocks base method.
+func (m *MockTagUseCase) UpdateTag(ctx context.Context, req UpdateTagRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTag", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTag indicates an expected call of UpdateTag.
+func (mr *MockTagUseCaseMockRecorder) UpdateTag(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTag", reflect.TypeOf((*MockTagUseCase)(nil).UpdateTag), ctx, req)
+}
@@ -12,57 +12,294 @@ type TodoUseCase interface {
 
 	// CreateTodo creates a new todo and returns the created todo with assigned ID
 	// Error:
+	// - forbidden (viewers of the workspace)
 	// - validation fail
 	// - internal fail
 	CreateTodo(ctx context.Context, req CreateTodoRequest) (*CreateTodoResponse, error)
 
 	FindTodo(ctx context.Context, req FindTodoRequest) (*FindTodoResponse, error)
 
-	// GetTodo(ctx context.Context, id uint) (*GetTodoResponse, error)
-	UpdateTodo(ctx context.Context, req UpdateTodoRequest) error
+	// GetTodo retrieves a single todo by ID
+	// Error:
+	// - validation fail
+	// - not found (missing or soft deleted)
+	// - internal fail
+	GetTodo(ctx context.Context, id uint) (*GetTodoResponse, error)
+
+	// UpdateTodo updates an existing todo like PatchTodo, the title is always written
+	UpdateTodo(ctx context.Context, req UpdateTodoRequest) (*TodoVersionResponse, error)
+
+	// PatchTodo updates only the provided fields of an existing todo,
+	// status changes go through the status machine and are recorded in the history
+	// Error:
+	// - validation fail
+	// - not found
+	// - forbidden (viewers of the workspace)
+	// - conflict (transition not allowed, started or marked done while blockers are unfinished,
+	//   marked done while subtasks are open, see TodoOptions, or the todo changed since Version was read)
+	// - internal fail
+	// Marking a recurring todo done creates its next occurrence, which takes the recurrence over
+	PatchTodo(ctx context.Context, req PatchTodoRequest) (*TodoVersionResponse, error)
+
+	// TransitionTodo moves a todo to another status through the status machine
+	// Error:
+	// - validation fail
+	// - not found
+	// - forbidden (viewers of the workspace)
+	// - conflict (already in the status, transition not allowed, unfinished blockers, open subtasks
+	//   or a stale version)
+	// - internal fail
+	TransitionTodo(ctx context.Context, req TransitionTodoRequest) (*TodoVersionResponse, error)
+
+	// MoveTodo places a todo before and/or after other todos in the manual order, only the moved
+	// todo gets a new rank key, it can move into another status and project at the same time
+	// Error:
+	// - validation fail (unknown neighbours or neighbours out of order)
+	// - not found
+	// - forbidden (viewers of the workspace)
+	// - conflict (transition not allowed, open subtasks or a stale version)
+	// - internal fail
+	MoveTodo(ctx context.Context, req MoveTodoRequest) (*TodoVersionResponse, error)
+
+	// EnsurePositions gives the todos created before manual ordering a rank key, in ID order
+	// Error:
+	// - internal fail
+	EnsurePositions(ctx context.Context) error
+
+	// ListStatusHistory lists the status and assignee changes of a todo, oldest first
+	// Error:
+	// - validation fail
+	// - not found
+	// - internal fail
+	ListStatusHistory(ctx context.Context, id uint) (*ListStatusHistoryResponse, error)
+
+	// DeleteTodo moves a todo to the trash
+	// Error:
+	// - validation fail
+	// - not found
+	// - forbidden (viewers, and members on todos they did not create)
+	// - internal fail
 	DeleteTodo(ctx context.Context, id uint) error
+
+	// FindTrash lists soft deleted todos
+	// Error:
+	// - internal fail
+	FindTrash(ctx context.Context, req FindTrashRequest) (*FindTrashResponse, error)
+
+	// RestoreTodo moves a soft deleted todo out of the trash
+	// Error:
+	// - validation fail
+	// - not found (missing or not in trash)
+	// - forbidden (viewers, and members on todos they did not create)
+	// - internal fail
+	RestoreTodo(ctx context.Context, id uint) error
+
+	// PurgeTodo permanently deletes a todo that is in the trash,
+	// the files attached to purged todos are removed from the blob store afterwards
+	// Error:
+	// - validation fail
+	// - forbidden (below admin)
+	// - not found (missing or not in trash)
+	// - internal fail
+	PurgeTodo(ctx context.Context, id uint) error
+
+	// EmptyTrash permanently deletes every todo in the trash
+	// Error:
+	// - forbidden (below admin)
+	// - internal fail
+	EmptyTrash(ctx context.Context) (*EmptyTrashResponse, error)
+
+	// PurgeExpiredTrash permanently deletes at most batchSize todos soft deleted before the given time,
+	// used by the trash retention job
+	// Error:
+	// - validation fail
+	// - internal fail
+	PurgeExpiredTrash(ctx context.Context, before time.Time, batchSize int) (int64, error)
+}
+
+// TodoOptions configures the business rules of the todo usecase
+type TodoOptions struct {
+	RequireSubtasksDone bool // a parent cannot be marked done while any of its subtasks is open
+	AllowReopen         bool // done todos may move back to pending or doing
 }
 
 type CreateTodoRequest struct {
 	Title       string
 	Description *string
-	Status      string // "pending", "doing", "done"
+	Status      string // a status of the workflow, empty defaults to its initial status
+	Priority    string // "none", "low", "medium", "high", "urgent", empty defaults to none
 	DueDate     *time.Time
+	TagIDs      []uint             // tags to attach, must exist
+	ParentID    *uint              // parent todo when creating a subtask, must exist
+	Recurrence  *RecurrenceRequest // repeat schedule, needs a due date
+	WorkflowID  *uint              // workflow of the todo, nil uses the default workflow
+	ProjectID   *uint              // project of the todo, must exist, the todo uses the project's workflow
+}
+
+// RecurrenceRequest is the schedule of a recurring todo
+type RecurrenceRequest struct {
+	Rule     string // RRULE subset, e.g. "FREQ=WEEKLY;BYDAY=MO,FR"
+	Timezone string // IANA name the schedule follows, empty = UTC
 }
 
 type CreateTodoResponse struct {
-	ID uint
+	ID      uint
+	Version uint
+}
+
+// TodoVersionResponse is the version a write left the todo at
+type TodoVersionResponse struct {
+	Version uint
 }
 
 type FindTodoRequest struct {
-	Keyword     *string           `json:"keyword"`
-	Status      *string           `json:"status"`
-	CreatedFrom *time.Time        `json:"created_from"`
-	CreatedTo   *time.Time        `json:"created_to"`
-	DueFrom     *time.Time        `json:"due_from"`
-	DueTo       *time.Time        `json:"due_to"`
-	Pagination  dto.PaginationReq `json:"pagination"`
+	Keyword      *string           `json:"keyword"`
+	Status       *string           `json:"status"`
+	Statuses     []string          `json:"statuses"`
+	Priorities   []string          `json:"priorities"`
+	MinPriority  *string           `json:"min_priority"`
+	TagsAny      []uint            `json:"tags_any"`  // todos having any of the tag IDs
+	TagsAll      []uint            `json:"tags_all"`  // todos having all of the tag IDs
+	ParentID     *uint             `json:"parent_id"` // subtasks of the todo
+	SeriesID     *uint             `json:"series_id"` // occurrences of the recurring series started by the todo
+	WorkflowID   *uint             `json:"workflow_id"`
+	ProjectID    *uint             `json:"project_id"` // todos of the project
+	CreatedFrom  *time.Time        `json:"created_from"`
+	CreatedTo    *time.Time        `json:"created_to"`
+	DueFrom      *time.Time        `json:"due_from"`
+	DueTo        *time.Time        `json:"due_to"`
+	UpdatedSince *time.Time        `json:"updated_since"`
+	Overdue      *bool             `json:"overdue"`
+	HasDueDate   *bool             `json:"has_due_date"`
+	Assignee     *string           `json:"assignee"` // user ID, AssigneeMe or AssigneeUnassigned
+	Pagination   dto.PaginationReq `json:"pagination"`
 }
 
+// values of FindTodoRequest.Assignee besides a user ID
+const (
+	AssigneeMe         = "me"         // todos assigned to the signed-in user
+	AssigneeUnassigned = "unassigned" // todos without assignees
+)
+
 type FindTodoResponse struct {
 	Todos      []TodoResponse     `json:"todos"`
 	Pagination dto.PaginationResp `json:"pagination"`
 }
 
 type TodoResponse struct {
-	ID          uint       `json:"id"`
-	Title       string     `json:"title"`
-	Description *string    `json:"description,omitempty"`
-	Status      string     `json:"status"`
-	DueDate     *time.Time `json:"due_date,omitempty"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
+	ID           uint                `json:"id"`
+	Title        string              `json:"title"`
+	Description  *string             `json:"description,omitempty"`
+	Status       string              `json:"status"`
+	WorkflowID   uint                `json:"workflow_id"`
+	ProjectID    *uint               `json:"project_id,omitempty"`
+	Position     string              `json:"position"`
+	Priority     string              `json:"priority"`
+	DueDate      *time.Time          `json:"due_date,omitempty"`
+	ParentID     *uint               `json:"parent_id,omitempty"`
+	BlockedBy    []uint              `json:"blocked_by"`   // IDs of the todos that have to be done first
+	AssigneeIDs  []uint              `json:"assignee_ids"` // IDs of the users working on the todo
+	CommentCount int                 `json:"comment_count"`
+	Tags         []TagResponse       `json:"tags"`
+	Progress     TodoProgress        `json:"progress"`
+	Recurrence   *RecurrenceResponse `json:"recurrence,omitempty"`
+	SeriesID     *uint               `json:"series_id,omitempty"`
+	Occurrence   int                 `json:"occurrence,omitempty"`
+	StartedAt    *time.Time          `json:"started_at,omitempty"`
+	CompletedAt  *time.Time          `json:"completed_at,omitempty"`
+	CreatedAt    time.Time           `json:"created_at"`
+	UpdatedAt    time.Time           `json:"updated_at"`
+	DeletedAt    *time.Time          `json:"deleted_at,omitempty"`
+	Version      uint                `json:"version"` // incremented on every write, send it back to update only this version
+}
+
+// TodoProgress counts the checklist items of a todo
+type TodoProgress struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
+
+// RecurrenceResponse is the schedule of a recurring todo
+type RecurrenceResponse struct {
+	Rule     string `json:"rule"`
+	Timezone string `json:"timezone,omitempty"`
+}
+
+type GetTodoResponse struct {
+	Todo TodoResponse `json:"todo"`
 }
 
 type UpdateTodoRequest struct {
-	ID          uint       `json:"id"`
-	Title       string     `json:"title"`       // always required for validation
-	Description *string    `json:"description"` // nil=keep current, ""=clear, "value"=update
-	Status      *string    `json:"status"`      // nil=keep current, "value"=update
-	DueDate     *time.Time `json:"due_date"`    // nil=keep current, time=update
+	ID          uint               `json:"id"`
+	Title       string             `json:"title"`       // always required for validation
+	Description *string            `json:"description"` // nil=keep current, ""=clear, "value"=update
+	Status      *string            `json:"status"`      // nil=keep current, "value"=update
+	Priority    *string            `json:"priority"`    // nil=keep current, "value"=update
+	DueDate     *time.Time         `json:"due_date"`    // nil=keep current, time=update
+	TagIDs      *[]uint            `json:"tag_ids"`     // nil=keep current, empty=detach all, ids=replace
+	ParentID    *uint              `json:"parent_id"`   // nil=keep current, id=move under the parent
+	Recurrence  *RecurrenceRequest `json:"recurrence"`  // nil=keep current, rule=replace
+	ProjectID   *uint              `json:"project_id"`  // nil=keep current, id=move into the project
+	Version     *uint              `json:"version"`     // nil=skip the check, version=the version the caller read
+}
+
+type PatchTodoRequest struct {
+	ID              uint               `json:"id"`
+	Title           *string            `json:"title"`       // nil=keep current, "value"=update
+	Description     *string            `json:"description"` // nil=keep current, ""=clear, "value"=update
+	Status          *string            `json:"status"`      // nil=keep current, "value"=update
+	Priority        *string            `json:"priority"`    // nil=keep current, "value"=update
+	DueDate         *time.Time         `json:"due_date"`    // nil=keep current, time=update
+	ClearDueDate    bool               `json:"-"`           // true=remove the due date, takes precedence over DueDate
+	TagIDs          *[]uint            `json:"tag_ids"`     // nil=keep current, empty=detach all, ids=replace
+	ParentID        *uint              `json:"parent_id"`   // nil=keep current, id=move under the parent
+	ClearParent     bool               `json:"-"`           // true=make it a top-level todo, takes precedence over ParentID
+	Recurrence      *RecurrenceRequest `json:"recurrence"`  // nil=keep current, rule=replace
+	ClearRecurrence bool               `json:"-"`           // true=stop repeating, takes precedence over Recurrence
+	ProjectID       *uint              `json:"project_id"`  // nil=keep current, id=move into the project and its workflow
+	ClearProject    bool               `json:"-"`           // true=remove from its project, takes precedence over ProjectID
+	Position        *string            `json:"-"`           // nil=keep current, rank key computed by MoveTodo
+	Version         *uint              `json:"version"`     // nil=skip the check, version=the version the caller read
+}
+
+type MoveTodoRequest struct {
+	ID        uint    `json:"id"`
+	AfterID   *uint   `json:"after_id"`   // place right after this todo, nil with BeforeID=place right before BeforeID
+	BeforeID  *uint   `json:"before_id"`  // place right before this todo, nil with AfterID=place right after AfterID
+	Status    *string `json:"status"`     // nil=keep current, "value"=move through the status machine
+	ProjectID *uint   `json:"project_id"` // nil=keep current, id=move into the project and its workflow
+	Version   *uint   `json:"version"`    // nil=skip the check, version=the version the caller read
+}
+
+type TransitionTodoRequest struct {
+	ID      uint   `json:"id"`
+	Status  string `json:"status"`  // target status
+	Version *uint  `json:"version"` // nil=skip the check, version=the version the caller read
+}
+
+type ListStatusHistoryResponse struct {
+	Changes []StatusChangeResponse `json:"changes"`
+}
+
+// StatusChangeResponse is a single recorded status transition
+type StatusChangeResponse struct {
+	Event      string    `json:"event"`                 // status_changed, assigned or unassigned
+	FromStatus string    `json:"from_status,omitempty"` // set for status changes
+	ToStatus   string    `json:"to_status,omitempty"`
+	AssigneeID *uint     `json:"assignee_id,omitempty"` // set for assignee changes
+	Actor      string    `json:"actor,omitempty"`
+	ChangedAt  time.Time `json:"changed_at"`
+}
+
+type FindTrashRequest struct {
+	Pagination dto.PaginationReq `json:"pagination"`
+}
+
+type FindTrashResponse struct {
+	Todos      []TodoResponse     `json:"todos"`
+	Pagination dto.PaginationResp `json:"pagination"`
+}
+
+type EmptyTrashResponse struct {
+	PurgedCount int64 `json:"purged_count"`
 }
@@ -3,33 +3,131 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"itmrchow/go-todolist-service/internal/domain/entity"
 	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/utils/actor"
 	"itmrchow/go-todolist-service/internal/utils/dto"
 )
 
 var _ TodoUseCase = &todoUseCaseImpl{}
 
 type todoUseCaseImpl struct {
-	todoRepo repository.TodoRepository
+	todoRepo       repository.TodoRepository
+	tagRepo        repository.TagRepository
+	historyRepo    repository.StatusHistoryRepository
+	workflowRepo   repository.WorkflowRepository
+	projectRepo    repository.ProjectRepository
+	dependencyRepo repository.DependencyRepository
+	attachmentRepo repository.AttachmentRepository
+	blobStore      repository.BlobStore
+	opts           TodoOptions
 }
 
-func NewTodoUseCaseImpl(todoRepo repository.TodoRepository) TodoUseCase {
+func NewTodoUseCaseImpl(
+	todoRepo repository.TodoRepository,
+	tagRepo repository.TagRepository,
+	historyRepo repository.StatusHistoryRepository,
+	workflowRepo repository.WorkflowRepository,
+	projectRepo repository.ProjectRepository,
+	dependencyRepo repository.DependencyRepository,
+	attachmentRepo repository.AttachmentRepository,
+	blobStore repository.BlobStore,
+	opts TodoOptions,
+) TodoUseCase {
 	return &todoUseCaseImpl{
-		todoRepo: todoRepo,
+		todoRepo:       todoRepo,
+		tagRepo:        tagRepo,
+		historyRepo:    historyRepo,
+		workflowRepo:   workflowRepo,
+		projectRepo:    projectRepo,
+		dependencyRepo: dependencyRepo,
+		attachmentRepo: attachmentRepo,
+		blobStore:      blobStore,
+		opts:           opts,
 	}
 }
 
 // CreateTodo
 func (t *todoUseCaseImpl) CreateTodo(ctx context.Context, req CreateTodoRequest) (*CreateTodoResponse, error) {
-	// create todo
+	if err := authorize(ctx, ActionEditTodos); err != nil {
+		return nil, err
+	}
+
+	// a todo in a project uses the workflow of the project
+	workflowID := req.WorkflowID
+	if req.ProjectID != nil {
+		project, err := t.findProject(ctx, *req.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		if workflowID != nil && *workflowID != 0 && *workflowID != project.WorkflowID {
+			return nil, fmt.Errorf("validation fail: project %d uses workflow %d", project.ID, project.WorkflowID)
+		}
+		workflowID = &project.WorkflowID
+	}
+
+	// the workflow decides the valid statuses, without status the todo starts in its initial one
+	workflow, err := t.findWorkflow(ctx, workflowID)
+	if err != nil {
+		return nil, err
+	}
 	status := entity.TodoStatus(req.Status)
+	if status == "" {
+		status = workflow.InitialStatus()
+	}
 
+	// create todo
 	todoEntity, err := entity.NewTodo(req.Title, req.Description, &status, req.DueDate)
 	if err != nil {
 		return nil, errors.Join(errors.New("validation fail"), err)
 	}
+	if err := todoEntity.SetWorkflow(workflow, time.Now()); err != nil {
+		return nil, errors.Join(errors.New("validation fail"), err)
+	}
+	todoEntity.OwnerID = actor.UserID(ctx)
+	todoEntity.WorkspaceID = actor.WorkspaceID(ctx)
+	todoEntity.ProjectID = req.ProjectID
+	if req.Priority != "" {
+		if err := todoEntity.SetPriority(entity.TodoPriority(req.Priority)); err != nil {
+			return nil, errors.Join(errors.New("validation fail"), err)
+		}
+	}
+
+	// tags
+	if len(req.TagIDs) > 0 {
+		tags, err := t.findTags(ctx, req.TagIDs)
+		if err != nil {
+			return nil, err
+		}
+		todoEntity.SetTags(tags)
+	}
+
+	// parent
+	if req.ParentID != nil {
+		if err := t.checkParent(ctx, 0, *req.ParentID); err != nil {
+			return nil, err
+		}
+		if err := todoEntity.SetParent(req.ParentID); err != nil {
+			return nil, errors.Join(errors.New("validation fail"), err)
+		}
+	}
+
+	// recurrence
+	if req.Recurrence != nil {
+		if err := setRecurrence(todoEntity, req.Recurrence); err != nil {
+			return nil, err
+		}
+	}
+
+	// new todos go to the end of the manual order
+	if todoEntity.Position, err = t.nextPosition(ctx); err != nil {
+		return nil, err
+	}
 
 	// repository save model
 	todoEntity, err = t.todoRepo.Create(ctx, todoEntity)
@@ -38,36 +136,74 @@ func (t *todoUseCaseImpl) CreateTodo(ctx context.Context, req CreateTodoRequest)
 	}
 
 	// return response
-	return &CreateTodoResponse{ID: todoEntity.ID}, nil
+	return &CreateTodoResponse{ID: todoEntity.ID, Version: todoEntity.Version}, nil
 }
 
 // FindTodo
 func (t *todoUseCaseImpl) FindTodo(ctx context.Context, req FindTodoRequest) (*FindTodoResponse, error) {
 
 	queryParams := repository.TodoQueryParams{
-		Keyword:     req.Keyword,
-		DueFrom:     req.DueFrom,
-		DueTo:       req.DueTo,
-		CreatedFrom: req.CreatedFrom,
-		CreatedTo:   req.CreatedTo,
+		Keyword:      req.Keyword,
+		DueFrom:      req.DueFrom,
+		DueTo:        req.DueTo,
+		CreatedFrom:  req.CreatedFrom,
+		CreatedTo:    req.CreatedTo,
+		UpdatedSince: req.UpdatedSince,
+		Overdue:      req.Overdue,
+		HasDueDate:   req.HasDueDate,
+		TagsAny:      req.TagsAny,
+		TagsAll:      req.TagsAll,
+		ParentID:     req.ParentID,
+		SeriesID:     req.SeriesID,
+		WorkflowID:   req.WorkflowID,
+		ProjectID:    req.ProjectID,
 	}
 
-	// status
-	if req.Status != nil {
+	// status, statuses differ per workflow so any name is accepted and empty values are ignored
+	if req.Status != nil && *req.Status != "" {
 		status := entity.TodoStatus(*req.Status)
-		if status.IsValid() {
-			queryParams.Status = &status
+		queryParams.Status = &status
+	}
+
+	// statuses
+	for _, s := range req.Statuses {
+		if s != "" {
+			queryParams.Statuses = append(queryParams.Statuses, entity.TodoStatus(s))
+		}
+	}
+
+	// priorities, invalid values are ignored like the status filters
+	for _, p := range req.Priorities {
+		priority := entity.TodoPriority(p)
+		if priority.IsValid() {
+			queryParams.Priorities = append(queryParams.Priorities, priority)
+		}
+	}
+	if req.MinPriority != nil {
+		priority := entity.TodoPriority(*req.MinPriority)
+		if priority.IsValid() {
+			queryParams.MinPriority = &priority
+		}
+	}
+
+	// assignee
+	if req.Assignee != nil && *req.Assignee != "" {
+		if err := parseAssignee(ctx, *req.Assignee, &queryParams); err != nil {
+			return nil, err
 		}
 	}
 
 	// pagination
-	pagination := &repository.Pagination[entity.Todo]{
-		Limit: req.Pagination.PageSize,
-		Page:  req.Pagination.Page,
-		Sort:  req.Pagination.SortBy + " " + req.Pagination.SortOrder,
+	sorts, err := parseTodoSorts(req.Pagination.SortBy, req.Pagination.SortOrder, repository.TodoSortField.IsValid)
+	if err != nil {
+		return nil, errors.Join(errors.New("validation fail"), err)
 	}
+	pagination := newPagination[entity.Todo](req.Pagination, sorts)
 
-	err := t.todoRepo.List(ctx, queryParams, pagination)
+	err = t.todoRepo.List(ctx, queryParams, pagination)
+	if errors.Is(err, repository.ErrInvalidCursor) {
+		return nil, errors.Join(errors.New("validation fail"), err)
+	}
 	if err != nil {
 		return nil, errors.Join(errors.New("internal fail"), err)
 	}
@@ -75,59 +211,227 @@ func (t *todoUseCaseImpl) FindTodo(ctx context.Context, req FindTodoRequest) (*F
 	// response
 	todos := make([]TodoResponse, len(pagination.Rows))
 	for i, todo := range pagination.Rows {
-		todos[i] = TodoResponse{
-			ID:          todo.ID,
-			Title:       todo.Title,
-			Description: todo.Description,
-			Status:      string(todo.Status),
-			DueDate:     todo.DueDate,
-			CreatedAt:   todo.CreatedAt,
-			UpdatedAt:   todo.UpdatedAt,
-		}
+		todos[i] = toTodoResponse(todo)
 	}
 
 	resp := &FindTodoResponse{
-		Todos: todos,
-		Pagination: dto.PaginationResp{
-			Page:       pagination.Page,
-			PageSize:   pagination.Limit,
-			TotalCount: int(pagination.TotalRows),
-			TotalPages: pagination.TotalPages,
-		},
+		Todos:      todos,
+		Pagination: toPaginationResp(pagination),
 	}
 
 	return resp, nil
 }
 
+// GetTodo retrieves a single todo by ID
+func (t *todoUseCaseImpl) GetTodo(ctx context.Context, id uint) (*GetTodoResponse, error) {
+	// Validate request
+	if id == 0 {
+		return nil, errors.New("validation fail: ID cannot be 0")
+	}
+
+	todo, err := t.todoRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	// GetByID returns nil for missing and soft deleted todos
+	if todo == nil {
+		return nil, errors.New("not found: todo not found")
+	}
+
+	return &GetTodoResponse{Todo: toTodoResponse(todo)}, nil
+}
+
 // UpdateTodo updates an existing todo with partial update support
-func (t *todoUseCaseImpl) UpdateTodo(ctx context.Context, req UpdateTodoRequest) error {
+func (t *todoUseCaseImpl) UpdateTodo(ctx context.Context, req UpdateTodoRequest) (*TodoVersionResponse, error) {
+	return t.PatchTodo(ctx, PatchTodoRequest{
+		ID:          req.ID,
+		Title:       &req.Title, // Title is always required
+		Description: req.Description,
+		Status:      req.Status,
+		Priority:    req.Priority,
+		DueDate:     req.DueDate,
+		TagIDs:      req.TagIDs,
+		ParentID:    req.ParentID,
+		Recurrence:  req.Recurrence,
+		ProjectID:   req.ProjectID,
+		Version:     req.Version,
+	})
+}
+
+// PatchTodo updates only the fields provided in the request
+func (t *todoUseCaseImpl) PatchTodo(ctx context.Context, req PatchTodoRequest) (*TodoVersionResponse, error) {
 	// Validate request
 	if req.ID == 0 {
-		return errors.New("validation fail: ID cannot be 0")
+		return nil, errors.New("validation fail: ID cannot be 0")
 	}
 
 	// First, get the existing todo to check if it exists
 	existingTodo, err := t.todoRepo.GetByID(ctx, req.ID)
 	if err != nil {
-		return errors.Join(errors.New("internal fail"), err)
+		return nil, errors.Join(errors.New("internal fail"), err)
 	}
 	if existingTodo == nil {
-		return errors.New("not found: todo not found")
+		return nil, errors.New("not found: todo not found")
+	}
+	if err := authorizeTodo(ctx, ActionEditTodos, existingTodo); err != nil {
+		return nil, err
+	}
+
+	return t.patchTodo(ctx, existingTodo, req)
+}
+
+// TransitionTodo moves a todo to another status through the status machine
+func (t *todoUseCaseImpl) TransitionTodo(ctx context.Context, req TransitionTodoRequest) (*TodoVersionResponse, error) {
+	// Validate request
+	if req.ID == 0 {
+		return nil, errors.New("validation fail: ID cannot be 0")
+	}
+	status := entity.TodoStatus(req.Status)
+	if status == "" {
+		return nil, errors.New("validation fail: invalid status")
+	}
+
+	existingTodo, err := t.todoRepo.GetByID(ctx, req.ID)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if existingTodo == nil {
+		return nil, errors.New("not found: todo not found")
+	}
+	if err := authorizeTodo(ctx, ActionEditTodos, existingTodo); err != nil {
+		return nil, err
+	}
+	if existingTodo.Status == status {
+		return nil, fmt.Errorf("conflict: todo is already %s", status)
+	}
+
+	return t.patchTodo(ctx, existingTodo, PatchTodoRequest{ID: req.ID, Status: &req.Status, Version: req.Version})
+}
+
+// MoveTodo places a todo between its new neighbours in the manual order, optionally changing its status and project
+func (t *todoUseCaseImpl) MoveTodo(ctx context.Context, req MoveTodoRequest) (*TodoVersionResponse, error) {
+	// Validate request
+	if req.ID == 0 {
+		return nil, errors.New("validation fail: ID cannot be 0")
+	}
+	if req.AfterID == nil && req.BeforeID == nil && req.Status == nil && req.ProjectID == nil {
+		return nil, errors.New("validation fail: nothing to move, give after_id, before_id, status or project_id")
+	}
+
+	existingTodo, err := t.todoRepo.GetByID(ctx, req.ID)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if existingTodo == nil {
+		return nil, errors.New("not found: todo not found")
+	}
+	if err := authorizeTodo(ctx, ActionEditTodos, existingTodo); err != nil {
+		return nil, err
+	}
+
+	patch := PatchTodoRequest{ID: req.ID, Status: req.Status, ProjectID: req.ProjectID, Version: req.Version}
+	if req.Status != nil && *req.Status == "" {
+		patch.Status = nil
+	}
+
+	// Without neighbours the todo keeps its place, e.g. when dropped into an empty column
+	if req.AfterID != nil || req.BeforeID != nil {
+		position, err := t.positionBetween(ctx, req.ID, req.AfterID, req.BeforeID)
+		if err != nil {
+			return nil, err
+		}
+		patch.Position = &position
+	}
+
+	return t.patchTodo(ctx, existingTodo, patch)
+}
+
+// EnsurePositions gives the todos created before manual ordering a rank key
+func (t *todoUseCaseImpl) EnsurePositions(ctx context.Context) error {
+	if _, err := t.todoRepo.BackfillPositions(ctx); err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	return nil
+}
+
+// ListStatusHistory lists the status changes of a todo, oldest first
+func (t *todoUseCaseImpl) ListStatusHistory(ctx context.Context, id uint) (*ListStatusHistoryResponse, error) {
+	// Validate request
+	if id == 0 {
+		return nil, errors.New("validation fail: ID cannot be 0")
+	}
+
+	todo, err := t.todoRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if todo == nil {
+		return nil, errors.New("not found: todo not found")
+	}
+
+	changes, err := t.historyRepo.ListByTodo(ctx, id)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	resp := &ListStatusHistoryResponse{Changes: make([]StatusChangeResponse, len(changes))}
+	for i, change := range changes {
+		resp.Changes[i] = StatusChangeResponse{
+			Event:      string(change.Event),
+			FromStatus: string(change.FromStatus),
+			ToStatus:   string(change.ToStatus),
+			AssigneeID: change.AssigneeID,
+			Actor:      change.Actor,
+			ChangedAt:  change.ChangedAt,
+		}
+	}
+
+	return resp, nil
+}
+
+// patchTodo applies the fields provided in the request to the existing todo and saves it
+func (t *todoUseCaseImpl) patchTodo(ctx context.Context, existingTodo *entity.Todo, req PatchTodoRequest) (*TodoVersionResponse, error) {
+	now := time.Now().UTC()
+
+	// The caller edited an older version, e.g. an If-Match header that no longer matches
+	if req.Version != nil && *req.Version != existingTodo.Version {
+		return nil, fmt.Errorf("conflict: todo was modified, the current version is %d", existingTodo.Version)
 	}
 
 	// Create updated entity - start with existing values
 	updatedTodo := &entity.Todo{
 		ID:          req.ID,
-		Title:       req.Title,                // Title is always required
+		OwnerID:     existingTodo.OwnerID,
+		WorkspaceID: existingTodo.WorkspaceID,
+		Title:       existingTodo.Title,       // Default to existing
 		Description: existingTodo.Description, // Default to existing
 		Status:      existingTodo.Status,      // Default to existing
+		Priority:    existingTodo.Priority,    // Default to existing
 		DueDate:     existingTodo.DueDate,     // Default to existing
+		ParentID:    existingTodo.ParentID,    // Default to existing
+		Tags:        existingTodo.Tags,        // Default to existing
+		WorkflowID:  existingTodo.WorkflowID,
+		ProjectID:   existingTodo.ProjectID,
+		Position:    existingTodo.Position,
+		Checklist:   existingTodo.Checklist,
+		AssigneeIDs: existingTodo.AssigneeIDs,
+		Recurrence:  existingTodo.Recurrence,
+		SeriesID:    existingTodo.SeriesID,
+		Occurrence:  existingTodo.Occurrence,
+		StartedAt:   existingTodo.StartedAt,
+		CompletedAt: existingTodo.CompletedAt,
+		Version:     existingTodo.Version,
 		CreatedAt:   existingTodo.CreatedAt,
 		UpdatedAt:   existingTodo.UpdatedAt,
 	}
 
 	// Partial update logic: only update fields that are provided (not nil)
 
+	// Update Title if provided
+	if req.Title != nil {
+		updatedTodo.Title = *req.Title
+	}
+
 	// Update Description if provided
 	if req.Description != nil {
 		if *req.Description == "" {
@@ -139,36 +443,156 @@ func (t *todoUseCaseImpl) UpdateTodo(ctx context.Context, req UpdateTodoRequest)
 		}
 	}
 
-	// Update Status if provided
-	if req.Status != nil {
+	// Move into another project if provided, the todo takes over the workflow of the project
+	if req.ClearProject {
+		updatedTodo.ProjectID = nil
+	} else if req.ProjectID != nil {
+		project, err := t.findProject(ctx, *req.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		updatedTodo.ProjectID = &project.ID
+		updatedTodo.WorkflowID = project.WorkflowID
+	}
+
+	// Take the rank key computed by MoveTodo
+	if req.Position != nil {
+		updatedTodo.Position = *req.Position
+	}
+
+	// Move to the provided status through the status machine of the todo's workflow
+	var workflow *entity.Workflow
+	completing := false
+	statusChanged := req.Status != nil && entity.TodoStatus(*req.Status) != updatedTodo.Status
+	if statusChanged || updatedTodo.WorkflowID != existingTodo.WorkflowID {
+		var err error
+		workflow, err = t.findWorkflow(ctx, &updatedTodo.WorkflowID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if statusChanged {
 		status := entity.TodoStatus(*req.Status)
-		if !status.IsValid() {
-			return errors.New("validation fail: invalid status")
+		if _, ok := workflow.Status(status); !ok {
+			return nil, errors.New("validation fail: invalid status")
+		}
+		if err := updatedTodo.TransitionTo(status, t.statusMachine(workflow), now); err != nil {
+			return nil, fmt.Errorf("conflict: cannot move todo from %s to %s", existingTodo.Status, status)
+		}
+		if workflow.Category(status) != entity.CategoryTodo {
+			if err := t.checkBlockers(ctx, updatedTodo.ID); err != nil {
+				return nil, err
+			}
+		}
+		completing = workflow.Category(status) == entity.CategoryDone && workflow.Category(existingTodo.Status) != entity.CategoryDone
+	} else if workflow != nil {
+		// a todo moved into another workflow keeps its status, which must exist there
+		if _, ok := workflow.Status(updatedTodo.Status); !ok {
+			return nil, fmt.Errorf("validation fail: status %s is not in the workflow of the project, move it with a status of the workflow", updatedTodo.Status)
+		}
+	}
+
+	// Update Priority if provided
+	if req.Priority != nil {
+		if err := updatedTodo.SetPriority(entity.TodoPriority(*req.Priority)); err != nil {
+			return nil, errors.Join(errors.New("validation fail"), err)
 		}
-		updatedTodo.Status = status
 	}
 
 	// Update DueDate if provided
-	if req.DueDate != nil {
+	if req.ClearDueDate {
+		updatedTodo.DueDate = nil
+	} else if req.DueDate != nil {
 		updatedTodo.DueDate = req.DueDate
 	}
 
+	// Replace Tags if provided
+	if req.TagIDs != nil {
+		tags, err := t.findTags(ctx, *req.TagIDs)
+		if err != nil {
+			return nil, err
+		}
+		updatedTodo.SetTags(tags)
+	}
+
+	// Move under another parent if provided
+	if req.ClearParent {
+		updatedTodo.ParentID = nil
+	} else if req.ParentID != nil {
+		if err := t.checkParent(ctx, req.ID, *req.ParentID); err != nil {
+			return nil, err
+		}
+		if err := updatedTodo.SetParent(req.ParentID); err != nil {
+			return nil, errors.Join(errors.New("validation fail"), err)
+		}
+	}
+
+	// Change the recurrence if provided
+	if req.ClearRecurrence {
+		updatedTodo.Recurrence = nil
+	} else if req.Recurrence != nil {
+		if err := setRecurrence(updatedTodo, req.Recurrence); err != nil {
+			return nil, err
+		}
+	}
+	if updatedTodo.Recurrence != nil && updatedTodo.DueDate == nil {
+		return nil, errors.New("validation fail: a recurring todo needs a due date")
+	}
+
+	// A parent can only be completed once its subtasks are
+	if t.opts.RequireSubtasksDone && completing {
+		openSubtasks, err := t.todoRepo.Count(ctx, repository.TodoQueryParams{
+			ParentID:   &req.ID,
+			Categories: []entity.StatusCategory{entity.CategoryTodo, entity.CategoryInProgress},
+		})
+		if err != nil {
+			return nil, errors.Join(errors.New("internal fail"), err)
+		}
+		if openSubtasks > 0 {
+			return nil, fmt.Errorf("conflict: todo has %d open subtasks", openSubtasks)
+		}
+	}
+
 	// Validate updated todo using entity rules
 	if _, err := entity.NewTodo(updatedTodo.Title, updatedTodo.Description, &updatedTodo.Status, updatedTodo.DueDate); err != nil {
-		return errors.Join(errors.New("validation fail"), err)
+		return nil, errors.Join(errors.New("validation fail"), err)
+	}
+
+	// Completing a recurring todo schedules the next occurrence, which takes the recurrence over
+	// so reopening and completing this one again does not schedule it twice
+	var next *entity.Todo
+	if completing && updatedTodo.Recurrence != nil {
+		next = updatedTodo.NextOccurrence(now, workflow)
+		if next != nil {
+			position, err := t.nextPosition(ctx)
+			if err != nil {
+				return nil, err
+			}
+			next.Position = position
+		}
+		updatedTodo.Recurrence = nil
 	}
 
-	// Update in repository
-	rowsAffected, err := t.todoRepo.Update(ctx, updatedTodo)
+	var change *entity.StatusChange
+	if updatedTodo.Status != existingTodo.Status {
+		change = entity.NewStatusChange(req.ID, existingTodo.Status, updatedTodo.Status, actor.Name(ctx), now)
+	}
+
+	// Update in repository together with the status change and the next occurrence,
+	// a concurrent write since the todo was read wins
+	rowsAffected, err := t.todoRepo.UpdateWithHistory(ctx, updatedTodo, change, next)
+	if errors.Is(err, repository.ErrVersionConflict) {
+		return nil, errors.New("conflict: todo was modified by another request")
+	}
 	if err != nil {
-		return errors.Join(errors.New("internal fail"), err)
+		return nil, errors.Join(errors.New("internal fail"), err)
 	}
 
 	if rowsAffected == 0 {
-		return errors.New("not found: todo not found")
+		return nil, errors.New("not found: todo not found")
 	}
 
-	return nil
+	return &TodoVersionResponse{Version: updatedTodo.Version}, nil
 }
 
 // DeleteTodo deletes a todo by ID
@@ -178,6 +602,20 @@ func (t *todoUseCaseImpl) DeleteTodo(ctx context.Context, id uint) error {
 		return errors.New("validation fail: ID cannot be 0")
 	}
 
+	// members may only delete their own todos, which needs the todo loaded
+	if authorize(ctx, ActionDeleteTodo) != nil {
+		todo, err := t.todoRepo.GetByID(ctx, id)
+		if err != nil {
+			return errors.Join(errors.New("internal fail"), err)
+		}
+		if todo == nil {
+			return errors.New("not found: todo not found")
+		}
+		if err := authorizeTodo(ctx, ActionDeleteTodo, todo); err != nil {
+			return err
+		}
+	}
+
 	// Delete in repository
 	rowsAffected, err := t.todoRepo.Delete(ctx, id)
 	if err != nil {
@@ -190,3 +628,540 @@ func (t *todoUseCaseImpl) DeleteTodo(ctx context.Context, id uint) error {
 
 	return nil
 }
+
+// FindTrash lists soft deleted todos, most recently deleted first by default
+func (t *todoUseCaseImpl) FindTrash(ctx context.Context, req FindTrashRequest) (*FindTrashResponse, error) {
+	// pagination, most recently deleted first unless asked otherwise
+	sorts := []repository.SortOption{{Field: string(repository.TodoSortDeletedAt), Direction: repository.SortDesc}}
+	if req.Pagination.SortBy != "" {
+		var err error
+		sorts, err = parseTodoSorts(req.Pagination.SortBy, req.Pagination.SortOrder, func(f repository.TodoSortField) bool {
+			return f.IsValid() || f == repository.TodoSortDeletedAt
+		})
+		if err != nil {
+			return nil, errors.Join(errors.New("validation fail"), err)
+		}
+	}
+	pagination := newPagination[entity.Todo](req.Pagination, sorts)
+
+	err := t.todoRepo.ListDeleted(ctx, pagination)
+	if errors.Is(err, repository.ErrInvalidCursor) {
+		return nil, errors.Join(errors.New("validation fail"), err)
+	}
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	// response
+	todos := make([]TodoResponse, len(pagination.Rows))
+	for i, todo := range pagination.Rows {
+		todos[i] = toTodoResponse(todo)
+	}
+
+	return &FindTrashResponse{
+		Todos:      todos,
+		Pagination: toPaginationResp(pagination),
+	}, nil
+}
+
+// RestoreTodo moves a soft deleted todo out of the trash
+func (t *todoUseCaseImpl) RestoreTodo(ctx context.Context, id uint) error {
+	// Validate request
+	if id == 0 {
+		return errors.New("validation fail: ID cannot be 0")
+	}
+
+	todo, err := t.todoRepo.GetByIDUnscoped(ctx, id)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if todo == nil || !todo.IsDeleted() {
+		return errors.New("not found: todo not found in trash")
+	}
+	if err := authorizeTodo(ctx, ActionRestoreTodo, todo); err != nil {
+		return err
+	}
+
+	todo.Restore()
+
+	rowsAffected, err := t.todoRepo.Restore(ctx, todo)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if rowsAffected == 0 {
+		return errors.New("not found: todo not found in trash")
+	}
+
+	return nil
+}
+
+// PurgeTodo permanently deletes a todo that is in the trash
+func (t *todoUseCaseImpl) PurgeTodo(ctx context.Context, id uint) error {
+	// Validate request
+	if id == 0 {
+		return errors.New("validation fail: ID cannot be 0")
+	}
+	if err := authorize(ctx, ActionPurgeTodos); err != nil {
+		return err
+	}
+
+	rowsAffected, err := t.todoRepo.HardDelete(ctx, id)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if rowsAffected == 0 {
+		return errors.New("not found: todo not found in trash")
+	}
+	t.cleanupOrphanAttachments(ctx)
+
+	return nil
+}
+
+// EmptyTrash permanently deletes every todo in the trash
+func (t *todoUseCaseImpl) EmptyTrash(ctx context.Context) (*EmptyTrashResponse, error) {
+	if err := authorize(ctx, ActionPurgeTodos); err != nil {
+		return nil, err
+	}
+
+	purgedCount, err := t.todoRepo.PurgeDeleted(ctx)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	t.cleanupOrphanAttachments(ctx)
+
+	return &EmptyTrashResponse{PurgedCount: purgedCount}, nil
+}
+
+// PurgeExpiredTrash permanently deletes at most batchSize todos soft deleted before the given time
+func (t *todoUseCaseImpl) PurgeExpiredTrash(ctx context.Context, before time.Time, batchSize int) (int64, error) {
+	// Validate request
+	if batchSize <= 0 {
+		return 0, errors.New("validation fail: batch size must be greater than 0")
+	}
+
+	purgedCount, err := t.todoRepo.PurgeDeletedBefore(ctx, before, batchSize)
+	if err != nil {
+		return 0, errors.Join(errors.New("internal fail"), err)
+	}
+	t.cleanupOrphanAttachments(ctx)
+
+	return purgedCount, nil
+}
+
+// orphanAttachmentBatchSize is the number of orphan attachments cleaned up per query
+const orphanAttachmentBatchSize = 100
+
+// cleanupOrphanAttachments deletes the blobs and rows of attachments whose todo has been purged
+// The purge itself has already succeeded, so failures are not reported; the remaining
+// orphans are picked up again by the next purge, including the scheduled trash purge
+func (t *todoUseCaseImpl) cleanupOrphanAttachments(ctx context.Context) {
+	for {
+		orphans, err := t.attachmentRepo.ListOrphans(ctx, orphanAttachmentBatchSize)
+		if err != nil {
+			return
+		}
+
+		for _, orphan := range orphans {
+			// the blob goes first, a row without blob is still found and retried
+			if err := t.blobStore.Delete(ctx, orphan.StorageKey); err != nil {
+				return
+			}
+			if _, err := t.attachmentRepo.Delete(ctx, orphan.ID); err != nil {
+				return
+			}
+		}
+
+		if len(orphans) < orphanAttachmentBatchSize {
+			return
+		}
+	}
+}
+
+// toTodoResponse converts a domain entity to the usecase response DTO
+func toTodoResponse(todo *entity.Todo) TodoResponse {
+	done, total := todo.Progress()
+
+	var recurrence *RecurrenceResponse
+	if todo.Recurrence != nil {
+		recurrence = &RecurrenceResponse{Rule: todo.Recurrence.String(), Timezone: todo.Recurrence.Timezone}
+	}
+
+	return TodoResponse{
+		ID:           todo.ID,
+		Title:        todo.Title,
+		Description:  todo.Description,
+		Status:       string(todo.Status),
+		WorkflowID:   todo.WorkflowID,
+		ProjectID:    todo.ProjectID,
+		Position:     todo.Position,
+		Priority:     string(todo.Priority),
+		DueDate:      todo.DueDate,
+		ParentID:     todo.ParentID,
+		BlockedBy:    toIDs(todo.BlockedBy),
+		AssigneeIDs:  toIDs(todo.AssigneeIDs),
+		CommentCount: todo.CommentCount,
+		Tags:         toTagResponses(todo.Tags),
+		Progress:     TodoProgress{Done: done, Total: total},
+		Version:      todo.Version,
+		Recurrence:   recurrence,
+		SeriesID:     todo.SeriesID,
+		Occurrence:   todo.Occurrence,
+		StartedAt:    todo.StartedAt,
+		CompletedAt:  todo.CompletedAt,
+		CreatedAt:    todo.CreatedAt,
+		UpdatedAt:    todo.UpdatedAt,
+		DeletedAt:    todo.DeletedAt,
+	}
+}
+
+// parseAssignee sets the assignee filter, "me" is the signed-in user, "unassigned" the todos nobody works on
+func parseAssignee(ctx context.Context, assignee string, queryParams *repository.TodoQueryParams) error {
+	switch assignee {
+	case AssigneeMe:
+		userID := actor.UserID(ctx)
+		if userID == 0 {
+			return errors.New("validation fail: assignee me needs a signed-in user")
+		}
+		queryParams.AssigneeID = &userID
+	case AssigneeUnassigned:
+		queryParams.Unassigned = true
+	default:
+		userID, err := strconv.ParseUint(assignee, 10, 64)
+		if err != nil || userID == 0 {
+			return fmt.Errorf("validation fail: invalid assignee: %s", assignee)
+		}
+		id := uint(userID)
+		queryParams.AssigneeID = &id
+	}
+	return nil
+}
+
+// toIDs copies the blocker or assignee IDs of a todo, never returning nil so JSON renders []
+func toIDs(ids []uint) []uint {
+	return append(make([]uint, 0, len(ids)), ids...)
+}
+
+// toTagResponses converts the tags of a todo, never returning nil so JSON renders []
+func toTagResponses(tags []entity.Tag) []TagResponse {
+	resp := make([]TagResponse, len(tags))
+	for i, tag := range tags {
+		resp[i] = toTagResponse(tag)
+	}
+	return resp
+}
+
+// checkParent makes sure the parent todo exists and that todoID is not among its ancestors,
+// todoID is 0 for a todo that is being created
+func (t *todoUseCaseImpl) checkParent(ctx context.Context, todoID uint, parentID uint) error {
+	if parentID == 0 {
+		return errors.New("validation fail: invalid parent")
+	}
+
+	visited := make(map[uint]bool)
+	for ancestorID := &parentID; ancestorID != nil && !visited[*ancestorID]; {
+		if *ancestorID == todoID {
+			return errors.New("validation fail: a todo cannot be a subtask of itself or of its subtasks")
+		}
+		visited[*ancestorID] = true
+
+		ancestor, err := t.todoRepo.GetByID(ctx, *ancestorID)
+		if err != nil {
+			return errors.Join(errors.New("internal fail"), err)
+		}
+		if ancestor == nil {
+			if *ancestorID == parentID {
+				return fmt.Errorf("validation fail: parent todo %d not found", parentID)
+			}
+			break // a trashed ancestor ends the chain
+		}
+		ancestorID = ancestor.ParentID
+	}
+
+	return nil
+}
+
+// checkBlockers makes sure a todo is not started or finished before the todos blocking it are done
+func (t *todoUseCaseImpl) checkBlockers(ctx context.Context, todoID uint) error {
+	unfinished, err := t.dependencyRepo.CountUnfinishedBlockers(ctx, todoID)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if unfinished > 0 {
+		return fmt.Errorf("conflict: todo is blocked by %d unfinished todos", unfinished)
+	}
+
+	return nil
+}
+
+// statusMachine returns the status machine of the workflow configured by the options
+func (t *todoUseCaseImpl) statusMachine(workflow *entity.Workflow) entity.StatusMachine {
+	return entity.StatusMachine{Workflow: workflow, AllowReopen: t.opts.AllowReopen}
+}
+
+// findWorkflow loads the workflow with the given ID, nil or 0 loads the default workflow
+func (t *todoUseCaseImpl) findWorkflow(ctx context.Context, id *uint) (*entity.Workflow, error) {
+	if id == nil || *id == 0 {
+		workflow, err := t.workflowRepo.GetDefault(ctx)
+		if err != nil {
+			return nil, errors.Join(errors.New("internal fail"), err)
+		}
+		if workflow == nil {
+			return nil, errors.New("internal fail: default workflow not found")
+		}
+		return workflow, nil
+	}
+
+	workflow, err := t.workflowRepo.GetByID(ctx, *id)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if workflow == nil {
+		return nil, fmt.Errorf("validation fail: workflow %d not found", *id)
+	}
+	return workflow, nil
+}
+
+// nextPosition returns a rank key after every existing todo
+func (t *todoUseCaseImpl) nextPosition(ctx context.Context) (string, error) {
+	last, err := t.todoRepo.LastPosition(ctx)
+	if err != nil {
+		return "", errors.Join(errors.New("internal fail"), err)
+	}
+	position, err := entity.RankBetween(last, "")
+	if err != nil {
+		return "", errors.Join(errors.New("internal fail"), err)
+	}
+	return position, nil
+}
+
+// positionBetween returns a rank key right after afterID and/or right before beforeID,
+// a single neighbour is completed with the todo next to it
+func (t *todoUseCaseImpl) positionBetween(ctx context.Context, id uint, afterID *uint, beforeID *uint) (string, error) {
+	position, err := t.rankBetween(ctx, id, afterID, beforeID)
+	if err != nil {
+		return "", err
+	}
+	if len(position) <= entity.MaxRankLength {
+		return position, nil
+	}
+
+	// The slot was inserted into too often, spread the keys out and place the todo again
+	if _, err := t.todoRepo.RebalancePositions(ctx); err != nil {
+		return "", errors.Join(errors.New("internal fail"), err)
+	}
+	position, err = t.rankBetween(ctx, id, afterID, beforeID)
+	if err != nil {
+		return "", err
+	}
+	if len(position) > entity.MaxRankLength {
+		return "", errors.New("conflict: the manual order is being changed by another request, try again")
+	}
+	return position, nil
+}
+
+// rankBetween computes the rank key between the neighbours of a move from their current keys
+func (t *todoUseCaseImpl) rankBetween(ctx context.Context, id uint, afterID *uint, beforeID *uint) (string, error) {
+	var lower, upper string
+	var err error
+	if afterID != nil {
+		if lower, err = t.neighbourPosition(ctx, id, *afterID); err != nil {
+			return "", err
+		}
+	}
+	if beforeID != nil {
+		if upper, err = t.neighbourPosition(ctx, id, *beforeID); err != nil {
+			return "", err
+		}
+	}
+
+	switch {
+	case beforeID == nil:
+		upper, err = t.todoRepo.NeighborPosition(ctx, lower, true, id)
+	case afterID == nil:
+		lower, err = t.todoRepo.NeighborPosition(ctx, upper, false, id)
+	}
+	if err != nil {
+		return "", errors.Join(errors.New("internal fail"), err)
+	}
+
+	position, err := entity.RankBetween(lower, upper)
+	if err != nil {
+		return "", errors.New("validation fail: after_id must come before before_id in the manual order")
+	}
+	return position, nil
+}
+
+// neighbourPosition returns the rank key of the todo the moved todo is placed next to
+func (t *todoUseCaseImpl) neighbourPosition(ctx context.Context, id uint, neighbourID uint) (string, error) {
+	if neighbourID == id {
+		return "", errors.New("validation fail: a todo cannot be moved next to itself")
+	}
+
+	neighbour, err := t.todoRepo.GetByID(ctx, neighbourID)
+	if err != nil {
+		return "", errors.Join(errors.New("internal fail"), err)
+	}
+	if neighbour == nil {
+		return "", fmt.Errorf("validation fail: todo %d not found", neighbourID)
+	}
+	return neighbour.Position, nil
+}
+
+// findProject loads the project a todo is put into, a missing project is a validation error
+func (t *todoUseCaseImpl) findProject(ctx context.Context, id uint) (*entity.Project, error) {
+	if id == 0 {
+		return nil, errors.New("validation fail: invalid project")
+	}
+
+	project, err := t.projectRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if project == nil {
+		return nil, fmt.Errorf("validation fail: project %d not found", id)
+	}
+	return project, nil
+}
+
+// setRecurrence parses the requested schedule and sets it on the todo
+func setRecurrence(todo *entity.Todo, req *RecurrenceRequest) error {
+	recurrence, err := entity.ParseRecurrence(req.Rule, req.Timezone)
+	if err != nil {
+		return errors.Join(errors.New("validation fail"), err)
+	}
+	if err := todo.SetRecurrence(recurrence); err != nil {
+		return errors.Join(errors.New("validation fail"), err)
+	}
+	return nil
+}
+
+// findTags loads the tags with the given IDs, every ID must exist
+func (t *todoUseCaseImpl) findTags(ctx context.Context, ids []uint) ([]entity.Tag, error) {
+	if len(ids) == 0 {
+		return []entity.Tag{}, nil
+	}
+
+	found, err := t.tagRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	byID := make(map[uint]*entity.Tag, len(found))
+	for _, tag := range found {
+		byID[tag.ID] = tag
+	}
+
+	tags := make([]entity.Tag, 0, len(ids))
+	for _, id := range ids {
+		tag, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("validation fail: tag %d not found", id)
+		}
+		tags = append(tags, *tag)
+	}
+
+	return tags, nil
+}
+
+// newPagination builds repository pagination from the request, a cursor implies cursor mode
+// and cursor pages only count the total when asked to
+func newPagination[T any](req dto.PaginationReq, sorts []repository.SortOption) *repository.Pagination[T] {
+	useCursor := req.Mode == "cursor" || req.Cursor != ""
+
+	return &repository.Pagination[T]{
+		Limit:     req.PageSize,
+		Page:      req.Page,
+		Sorts:     sorts,
+		UseCursor: useCursor,
+		Cursor:    req.Cursor,
+		SkipCount: useCursor && !req.IncludeTotal,
+	}
+}
+
+// toPaginationResp converts repository pagination to the response, totals are left out when not counted
+func toPaginationResp[T any](pagination *repository.Pagination[T]) dto.PaginationResp {
+	resp := dto.PaginationResp{
+		Page:       pagination.Page,
+		PageSize:   pagination.Limit,
+		NextCursor: pagination.NextCursor,
+		PrevCursor: pagination.PrevCursor,
+	}
+	if !pagination.SkipCount {
+		totalCount := int(pagination.TotalRows)
+		totalPages := pagination.TotalPages
+		resp.TotalCount = &totalCount
+		resp.TotalPages = &totalPages
+	}
+
+	return resp
+}
+
+// parseTodoSorts converts comma separated sort_by/sort_order values into whitelisted sort options
+// e.g. sort_by="status,due_date" sort_order="asc,desc"; a single order applies to every key
+// and an empty order defaults to asc. An empty sort_by falls back to the repository default.
+func parseTodoSorts(sortBy, sortOrder string, allowed func(repository.TodoSortField) bool) ([]repository.SortOption, error) {
+	if strings.TrimSpace(sortBy) == "" {
+		return nil, nil
+	}
+
+	fields := strings.Split(sortBy, ",")
+
+	var orders []string
+	if strings.TrimSpace(sortOrder) != "" {
+		orders = strings.Split(sortOrder, ",")
+	}
+	if len(orders) > 1 && len(orders) != len(fields) {
+		return nil, errors.New("sort_order must have one value or one value per sort_by field")
+	}
+
+	sorts := make([]repository.SortOption, 0, len(fields))
+	seen := make(map[repository.TodoSortField]bool, len(fields))
+	for i, f := range fields {
+		field := repository.TodoSortField(strings.ToLower(strings.TrimSpace(f)))
+		if !allowed(field) {
+			return nil, fmt.Errorf("sort_by field %d is not a sortable field", i+1)
+		}
+		if seen[field] {
+			return nil, fmt.Errorf("duplicate sort field %q", field)
+		}
+		seen[field] = true
+
+		direction := repository.SortAsc
+		switch len(orders) {
+		case 0:
+		case 1:
+			direction = repository.SortDirection(strings.ToLower(strings.TrimSpace(orders[0])))
+		default:
+			direction = repository.SortDirection(strings.ToLower(strings.TrimSpace(orders[i])))
+		}
+		if !direction.IsValid() {
+			return nil, fmt.Errorf("sort_order of sort_by field %d must be asc or desc", i+1)
+		}
+
+		sorts = append(sorts, repository.SortOption{
+			Field:     string(field),
+			Direction: direction,
+			NullsLast: field.Nullable(),
+		})
+	}
+
+	return sorts, nil
+}
+
+// requireTodo loads a todo that exists and is not in the trash,
+// the use cases of what is attached to a todo call it before touching the todo
+func requireTodo(ctx context.Context, todoRepo repository.TodoRepository, todoID uint) (*entity.Todo, error) {
+	if todoID == 0 {
+		return nil, errors.New("validation fail: todo ID cannot be 0")
+	}
+
+	todo, err := todoRepo.GetByID(ctx, todoID)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if todo == nil {
+		return nil, errors.New("not found: todo not found")
+	}
+
+	return todo, nil
+}
@@ -12,6 +12,7 @@ package usecase
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "go.uber.org/mock/gomock"
 )
@@ -69,6 +70,35 @@ func (mr *MockTodoUseCaseMockRecorder) DeleteTodo(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTodo", reflect.TypeOf((*MockTodoUseCase)(nil).DeleteTodo), ctx, id)
 }
 
+// EmptyTrash mocks base method.
+func (m *MockTodoUseCase) EmptyTrash(ctx context.Context) (*EmptyTrashResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EmptyTrash", ctx)
+	ret0, _ := ret[0].(*EmptyTrashResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EmptyTrash indicates an expected call of EmptyTrash.
+func (mr *MockTodoUseCaseMockRecorder) EmptyTrash(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmptyTrash", reflect.TypeOf((*MockTodoUseCase)(nil).EmptyTrash), ctx)
+}
+
+// EnsurePositions mocks base method.
+func (m *MockTodoUseCase) EnsurePositions(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsurePositions", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsurePositions indicates an expected call of EnsurePositions.
+func (mr *MockTodoUseCaseMockRecorder) EnsurePositions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsurePositions", reflect.TypeOf((*MockTodoUseCase)(nil).EnsurePositions), ctx)
+}
+
 // FindTodo mocks base method.
 func (m *MockTodoUseCase) FindTodo(ctx context.Context, req FindTodoRequest) (*FindTodoResponse, error) {
 	m.ctrl.T.Helper()
@@ -84,14 +114,148 @@ func (mr *MockTodoUseCaseMockRecorder) FindTodo(ctx, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTodo", reflect.TypeOf((*MockTodoUseCase)(nil).FindTodo), ctx, req)
 }
 
-// UpdateTodo mocks base method.
-func (m *MockTodoUseCase) UpdateTodo(ctx context.Context, req UpdateTodoRequest) error {
+// FindTrash mocks base method.
+func (m *MockTodoUseCase) FindTrash(ctx context.Context, req FindTrashRequest) (*FindTrashResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateTodo", ctx, req)
+	ret := m.ctrl.Call(m, "FindTrash", ctx, req)
+	ret0, _ := ret[0].(*FindTrashResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTrash indicates an expected call of FindTrash.
+func (mr *MockTodoUseCaseMockRecorder) FindTrash(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTrash", reflect.TypeOf((*MockTodoUseCase)(nil).FindTrash), ctx, req)
+}
+
+// GetTodo mocks base method.
+func (m *MockTodoUseCase) GetTodo(ctx context.Context, id uint) (*GetTodoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTodo", ctx, id)
+	ret0, _ := ret[0].(*GetTodoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTodo indicates an expected call of GetTodo.
+func (mr *MockTodoUseCaseMockRecorder) GetTodo(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTodo", reflect.TypeOf((*MockTodoUseCase)(nil).GetTodo), ctx, id)
+}
+
+// ListStatusHistory mocks base method.
+func (m *MockTodoUseCase) ListStatusHistory(ctx context.Context, id uint) (*ListStatusHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStatusHistory", ctx, id)
+	ret0, _ := ret[0].(*ListStatusHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStatusHistory indicates an expected call of ListStatusHistory.
+func (mr *MockTodoUseCaseMockRecorder) ListStatusHistory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStatusHistory", reflect.TypeOf((*MockTodoUseCase)(nil).ListStatusHistory), ctx, id)
+}
+
+// MoveTodo mocks base method.
+func (m *MockTodoUseCase) MoveTodo(ctx context.Context, req MoveTodoRequest) (*TodoVersionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTodo", ctx, req)
+	ret0, _ := ret[0].(*TodoVersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTodo indicates an expected call of MoveTodo.
+func (mr *MockTodoUseCaseMockRecorder) MoveTodo(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTodo", reflect.TypeOf((*MockTodoUseCase)(nil).MoveTodo), ctx, req)
+}
+
+// PatchTodo mocks base method.
+func (m *MockTodoUseCase) PatchTodo(ctx context.Context, req PatchTodoRequest) (*TodoVersionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchTodo", ctx, req)
+	ret0, _ := ret[0].(*TodoVersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchTodo indicates an expected call of PatchTodo.
+func (mr *MockTodoUseCaseMockRecorder) PatchTodo(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchTodo", reflect.TypeOf((*MockTodoUseCase)(nil).PatchTodo), ctx, req)
+}
+
+// PurgeExpiredTrash mocks base method.
+func (m *MockTodoUseCase) PurgeExpiredTrash(ctx context.Context, before time.Time, batchSize int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExpiredTrash", ctx, before, batchSize)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeExpiredTrash indicates an expected call of PurgeExpiredTrash.
+func (mr *MockTodoUseCaseMockRecorder) PurgeExpiredTrash(ctx, before, batchSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpiredTrash", reflect.TypeOf((*MockTodoUseCase)(nil).PurgeExpiredTrash), ctx, before, batchSize)
+}
+
+// PurgeTodo mocks base method.
+func (m *MockTodoUseCase) PurgeTodo(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTodo", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeTodo indicates an expected call of PurgeTodo.
+func (mr *MockTodoUseCaseMockRecorder) PurgeTodo(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTodo", reflect.TypeOf((*MockTodoUseCase)(nil).PurgeTodo), ctx, id)
+}
+
+// RestoreTodo mocks base method.
+func (m *MockTodoUseCase) RestoreTodo(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreTodo", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// RestoreTodo indicates an expected call of RestoreTodo.
+func (mr *MockTodoUseCaseMockRecorder) RestoreTodo(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTodo", reflect.TypeOf((*MockTodoUseCase)(nil).RestoreTodo), ctx, id)
+}
+
+// TransitionTodo mocks base method.
+func (m *MockTodoUseCase) TransitionTodo(ctx context.Context, req TransitionTodoRequest) (*TodoVersionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransitionTodo", ctx, req)
+	ret0, _ := ret[0].(*TodoVersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransitionTodo indicates an expected call of TransitionTodo.
+func (mr *MockTodoUseCaseMockRecorder) TransitionTodo(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransitionTodo", reflect.TypeOf((*MockTodoUseCase)(nil).TransitionTodo), ctx, req)
+}
+
+// UpdateTodo mocks base method.
+func (m *MockTodoUseCase) UpdateTodo(ctx context.Context, req UpdateTodoRequest) (*TodoVersionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTodo", ctx, req)
+	ret0, _ := ret[0].(*TodoVersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // UpdateTodo indicates an expected call of UpdateTodo.
 func (mr *MockTodoUseCaseMockRecorder) UpdateTodo(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=workflow_uc.go -destination=workflow_uc_mock.go -package=usecase
+type WorkflowUseCase interface {
+
+	// CreateWorkflow creates a new workflow and returns the created workflow ID
+	// Error:
+	// - validation fail
+	// - conflict (name already used)
+	// - internal fail
+	CreateWorkflow(ctx context.Context, req CreateWorkflowRequest) (*CreateWorkflowResponse, error)
+
+	// ListWorkflows lists every workflow with its statuses ordered by name
+	// Error:
+	// - internal fail
+	ListWorkflows(ctx context.Context) (*ListWorkflowsResponse, error)
+
+	// GetWorkflow gets a workflow with its statuses
+	// Error:
+	// - validation fail
+	// - not found
+	// - internal fail
+	GetWorkflow(ctx context.Context, id uint) (*WorkflowResponse, error)
+
+	// UpdateWorkflow replaces the name and statuses of a workflow,
+	// renamed statuses are renamed on the todos of the workflow
+	// Error:
+	// - validation fail
+	// - not found
+	// - conflict (name already used, removed status still used by todos)
+	// - internal fail
+	UpdateWorkflow(ctx context.Context, req UpdateWorkflowRequest) error
+
+	// DeleteWorkflow deletes a workflow no todo uses, trashed todos included
+	// Error:
+	// - validation fail
+	// - not found
+	// - conflict (default workflow, still used by todos)
+	// - internal fail
+	DeleteWorkflow(ctx context.Context, id uint) error
+}
+
+type CreateWorkflowRequest struct {
+	Name     string                  `json:"name"`
+	Statuses []WorkflowStatusRequest `json:"statuses"` // in workflow order
+}
+
+// WorkflowStatusRequest is a status of a workflow in create and update requests
+type WorkflowStatusRequest struct {
+	ID       uint   `json:"id"` // existing status to keep or rename, 0 = new status
+	Name     string `json:"name"`
+	Category string `json:"category"` // "todo", "in_progress", "done"
+}
+
+type CreateWorkflowResponse struct {
+	ID uint `json:"id"`
+}
+
+type UpdateWorkflowRequest struct {
+	ID       uint                    `json:"id"`
+	Name     string                  `json:"name"`
+	Statuses []WorkflowStatusRequest `json:"statuses"` // replaces the statuses, in workflow order
+}
+
+type ListWorkflowsResponse struct {
+	Workflows []WorkflowResponse `json:"workflows"`
+}
+
+type WorkflowResponse struct {
+	ID        uint                     `json:"id"`
+	Name      string                   `json:"name"`
+	IsDefault bool                     `json:"is_default"`
+	Statuses  []WorkflowStatusResponse `json:"statuses"`
+	CreatedAt time.Time                `json:"created_at"`
+	UpdatedAt time.Time                `json:"updated_at"`
+}
+
+type WorkflowStatusResponse struct {
+	ID       uint   `json:"id"`
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Position int    `json:"position"`
+}
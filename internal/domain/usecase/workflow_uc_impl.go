@@ -0,0 +1,266 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+var _ WorkflowUseCase = &workflowUseCaseImpl{}
+
+type workflowUseCaseImpl struct {
+	workflowRepo repository.WorkflowRepository
+	todoRepo     repository.TodoRepository
+	projectRepo  repository.ProjectRepository
+}
+
+func NewWorkflowUseCaseImpl(
+	workflowRepo repository.WorkflowRepository,
+	todoRepo repository.TodoRepository,
+	projectRepo repository.ProjectRepository,
+) WorkflowUseCase {
+	return &workflowUseCaseImpl{
+		workflowRepo: workflowRepo,
+		todoRepo:     todoRepo,
+		projectRepo:  projectRepo,
+	}
+}
+
+// CreateWorkflow creates a new workflow with a unique name
+func (w *workflowUseCaseImpl) CreateWorkflow(ctx context.Context, req CreateWorkflowRequest) (*CreateWorkflowResponse, error) {
+	if err := authorize(ctx, ActionConfigureWorkspace); err != nil {
+		return nil, err
+	}
+
+	workflow, err := entity.NewWorkflow(req.Name, toWorkflowStatuses(req.Statuses))
+	if err != nil {
+		return nil, errors.Join(errors.New("validation fail"), err)
+	}
+	workflow.WorkspaceID = actor.WorkspaceID(ctx)
+
+	if err := w.checkNameAvailable(ctx, workflow.Name, 0); err != nil {
+		return nil, err
+	}
+
+	workflow, err = w.workflowRepo.Create(ctx, workflow)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	return &CreateWorkflowResponse{ID: workflow.ID}, nil
+}
+
+// ListWorkflows lists every workflow ordered by name
+func (w *workflowUseCaseImpl) ListWorkflows(ctx context.Context) (*ListWorkflowsResponse, error) {
+	workflows, err := w.workflowRepo.List(ctx)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	resp := &ListWorkflowsResponse{Workflows: make([]WorkflowResponse, len(workflows))}
+	for i, workflow := range workflows {
+		resp.Workflows[i] = toWorkflowResponse(*workflow)
+	}
+
+	return resp, nil
+}
+
+// GetWorkflow gets a workflow with its statuses
+func (w *workflowUseCaseImpl) GetWorkflow(ctx context.Context, id uint) (*WorkflowResponse, error) {
+	workflow, err := w.getWorkflow(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := toWorkflowResponse(*workflow)
+	return &resp, nil
+}
+
+// UpdateWorkflow replaces the name and statuses of a workflow, a status keeps its todos
+// when renamed and can only be removed when no todo uses it
+func (w *workflowUseCaseImpl) UpdateWorkflow(ctx context.Context, req UpdateWorkflowRequest) error {
+	if err := authorize(ctx, ActionConfigureWorkspace); err != nil {
+		return err
+	}
+
+	workflow, err := w.getWorkflow(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+
+	previous := workflow.Statuses
+	oldNames := make(map[uint]entity.TodoStatus, len(previous))
+	for _, status := range previous {
+		oldNames[status.ID] = status.Name
+	}
+	for _, status := range req.Statuses {
+		if _, ok := oldNames[status.ID]; status.ID != 0 && !ok {
+			return fmt.Errorf("validation fail: status %d not found in workflow", status.ID)
+		}
+	}
+
+	if err := workflow.Update(req.Name, toWorkflowStatuses(req.Statuses)); err != nil {
+		return errors.Join(errors.New("validation fail"), err)
+	}
+	if err := w.checkNameAvailable(ctx, workflow.Name, workflow.ID); err != nil {
+		return err
+	}
+
+	// A kept status taking the current name of another kept status would mix their todos
+	kept := make(map[uint]bool, len(workflow.Statuses))
+	keptNames := make(map[entity.TodoStatus]uint, len(workflow.Statuses))
+	for _, status := range workflow.Statuses {
+		if status.ID != 0 {
+			kept[status.ID] = true
+			keptNames[oldNames[status.ID]] = status.ID
+		}
+	}
+	for _, status := range workflow.Statuses {
+		if owner, ok := keptNames[status.Name]; ok && status.ID != 0 && owner != status.ID {
+			return fmt.Errorf("validation fail: status %s is still used by another status", status.Name)
+		}
+	}
+
+	// Removed statuses must not leave todos without a status
+	removed := []entity.TodoStatus{}
+	for _, status := range previous {
+		if !kept[status.ID] {
+			removed = append(removed, status.Name)
+		}
+	}
+	if len(removed) > 0 {
+		used, err := w.todoRepo.Count(ctx, repository.TodoQueryParams{
+			WorkflowID:  &workflow.ID,
+			Statuses:    removed,
+			WithTrashed: true,
+		})
+		if err != nil {
+			return errors.Join(errors.New("internal fail"), err)
+		}
+		if used > 0 {
+			return fmt.Errorf("conflict: removed statuses are still used by %d todos", used)
+		}
+	}
+
+	rowsAffected, err := w.workflowRepo.Update(ctx, workflow)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if rowsAffected == 0 {
+		return errors.New("not found: workflow not found")
+	}
+
+	return nil
+}
+
+// DeleteWorkflow deletes a workflow that is not the default and no todo or project uses
+func (w *workflowUseCaseImpl) DeleteWorkflow(ctx context.Context, id uint) error {
+	if err := authorize(ctx, ActionConfigureWorkspace); err != nil {
+		return err
+	}
+
+	workflow, err := w.getWorkflow(ctx, id)
+	if err != nil {
+		return err
+	}
+	if workflow.IsDefault {
+		return errors.New("conflict: default workflow cannot be deleted")
+	}
+
+	used, err := w.todoRepo.Count(ctx, repository.TodoQueryParams{
+		WorkflowID:  &workflow.ID,
+		WithTrashed: true,
+	})
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if used > 0 {
+		return fmt.Errorf("conflict: workflow is still used by %d todos", used)
+	}
+
+	projects, err := w.projectRepo.CountByWorkflow(ctx, workflow.ID)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if projects > 0 {
+		return fmt.Errorf("conflict: workflow is still used by %d projects", projects)
+	}
+
+	rowsAffected, err := w.workflowRepo.Delete(ctx, id)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if rowsAffected == 0 {
+		return errors.New("not found: workflow not found")
+	}
+
+	return nil
+}
+
+// getWorkflow loads a workflow and maps a missing one to a not found error
+func (w *workflowUseCaseImpl) getWorkflow(ctx context.Context, id uint) (*entity.Workflow, error) {
+	if id == 0 {
+		return nil, errors.New("validation fail: ID cannot be 0")
+	}
+
+	workflow, err := w.workflowRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if workflow == nil {
+		return nil, errors.New("not found: workflow not found")
+	}
+
+	return workflow, nil
+}
+
+// checkNameAvailable returns a conflict error when another workflow already uses the name
+func (w *workflowUseCaseImpl) checkNameAvailable(ctx context.Context, name string, selfID uint) error {
+	existing, err := w.workflowRepo.GetByName(ctx, name)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if existing != nil && existing.ID != selfID {
+		return errors.New("conflict: workflow name already exists")
+	}
+
+	return nil
+}
+
+// toWorkflowStatuses converts the requested statuses to entities
+func toWorkflowStatuses(reqs []WorkflowStatusRequest) []entity.WorkflowStatus {
+	statuses := make([]entity.WorkflowStatus, len(reqs))
+	for i, req := range reqs {
+		statuses[i] = entity.WorkflowStatus{
+			ID:       req.ID,
+			Name:     entity.TodoStatus(req.Name),
+			Category: entity.StatusCategory(req.Category),
+		}
+	}
+	return statuses
+}
+
+// toWorkflowResponse converts a workflow entity to the usecase response
+func toWorkflowResponse(workflow entity.Workflow) WorkflowResponse {
+	resp := WorkflowResponse{
+		ID:        workflow.ID,
+		Name:      workflow.Name,
+		IsDefault: workflow.IsDefault,
+		Statuses:  make([]WorkflowStatusResponse, len(workflow.Statuses)),
+		CreatedAt: workflow.CreatedAt,
+		UpdatedAt: workflow.UpdatedAt,
+	}
+	for i, status := range workflow.Statuses {
+		resp.Statuses[i] = WorkflowStatusResponse{
+			ID:       status.ID,
+			Name:     string(status.Name),
+			Category: string(status.Category),
+			Position: status.Position,
+		}
+	}
+	return resp
+}
@@ -0,0 +1,317 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+type WorkflowUseCaseTestSuite struct {
+	suite.Suite
+	ctrl         *gomock.Controller
+	mockRepo     *repository.MockWorkflowRepository
+	mockTodos    *repository.MockTodoRepository
+	mockProjects *repository.MockProjectRepository
+	uc           WorkflowUseCase
+}
+
+func TestWorkflowUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(WorkflowUseCaseTestSuite))
+}
+
+func (suite *WorkflowUseCaseTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockRepo = repository.NewMockWorkflowRepository(suite.ctrl)
+	suite.mockTodos = repository.NewMockTodoRepository(suite.ctrl)
+	suite.mockProjects = repository.NewMockProjectRepository(suite.ctrl)
+	suite.uc = NewWorkflowUseCaseImpl(suite.mockRepo, suite.mockTodos, suite.mockProjects)
+}
+
+func (suite *WorkflowUseCaseTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+// releaseWorkflow returns a stored backlog/review/shipped workflow with ID 2
+func releaseWorkflow() *entity.Workflow {
+	return &entity.Workflow{
+		ID:   2,
+		Name: "release",
+		Statuses: []entity.WorkflowStatus{
+			{ID: 4, WorkflowID: 2, Name: "backlog", Category: entity.CategoryTodo, Position: 0},
+			{ID: 5, WorkflowID: 2, Name: "review", Category: entity.CategoryInProgress, Position: 1},
+			{ID: 6, WorkflowID: 2, Name: "shipped", Category: entity.CategoryDone, Position: 2},
+		},
+	}
+}
+
+func (suite *WorkflowUseCaseTestSuite) TestCreateWorkflow() {
+	ctx := ownerCtx()
+	statuses := []WorkflowStatusRequest{
+		{Name: "Backlog", Category: "todo"},
+		{Name: "shipped", Category: "done"},
+	}
+
+	tests := []struct {
+		name         string
+		req          CreateWorkflowRequest
+		setupMock    func()
+		expectResp   *CreateWorkflowResponse
+		expectErrMsg string
+	}{
+		{
+			name:         "missing_done_status",
+			req:          CreateWorkflowRequest{Name: "release", Statuses: statuses[:1]},
+			setupMock:    func() {},
+			expectErrMsg: "validation fail",
+		},
+		{
+			name: "name_conflict",
+			req:  CreateWorkflowRequest{Name: " release ", Statuses: statuses},
+			setupMock: func() {
+				suite.mockRepo.EXPECT().GetByName(ctx, "release").Return(releaseWorkflow(), nil).Times(1)
+			},
+			expectErrMsg: "conflict",
+		},
+		{
+			name: "db_fail",
+			req:  CreateWorkflowRequest{Name: "release", Statuses: statuses},
+			setupMock: func() {
+				suite.mockRepo.EXPECT().GetByName(ctx, "release").Return(nil, nil).Times(1)
+				suite.mockRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil, errors.New("database error")).Times(1)
+			},
+			expectErrMsg: "internal fail",
+		},
+		{
+			name: "success",
+			req:  CreateWorkflowRequest{Name: "release", Statuses: statuses},
+			setupMock: func() {
+				suite.mockRepo.EXPECT().GetByName(ctx, "release").Return(nil, nil).Times(1)
+				suite.mockRepo.EXPECT().
+					Create(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, workflow *entity.Workflow) (*entity.Workflow, error) {
+						assert.Equal(suite.T(), entity.TodoStatus("backlog"), workflow.Statuses[0].Name)
+						assert.Equal(suite.T(), 1, workflow.Statuses[1].Position)
+						assert.False(suite.T(), workflow.IsDefault)
+						workflow.ID = 3
+						return workflow, nil
+					}).
+					Times(1)
+			},
+			expectResp: &CreateWorkflowResponse{ID: 3},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.setupMock()
+
+			resp, err := suite.uc.CreateWorkflow(ctx, tt.req)
+
+			if tt.expectErrMsg != "" {
+				assert.ErrorContains(suite.T(), err, tt.expectErrMsg)
+				assert.Nil(suite.T(), resp)
+			} else {
+				assert.NoError(suite.T(), err)
+				assert.Equal(suite.T(), tt.expectResp, resp)
+			}
+		})
+	}
+}
+
+func (suite *WorkflowUseCaseTestSuite) TestGetWorkflow() {
+	ctx := context.Background()
+
+	_, err := suite.uc.GetWorkflow(ctx, 0)
+	assert.ErrorContains(suite.T(), err, "validation fail")
+
+	suite.mockRepo.EXPECT().GetByID(ctx, uint(9)).Return(nil, nil).Times(1)
+	_, err = suite.uc.GetWorkflow(ctx, 9)
+	assert.ErrorContains(suite.T(), err, "not found")
+
+	suite.mockRepo.EXPECT().GetByID(ctx, uint(2)).Return(releaseWorkflow(), nil).Times(1)
+	resp, err := suite.uc.GetWorkflow(ctx, 2)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "release", resp.Name)
+	assert.Equal(suite.T(), WorkflowStatusResponse{ID: 5, Name: "review", Category: "in_progress", Position: 1}, resp.Statuses[1])
+}
+
+func (suite *WorkflowUseCaseTestSuite) TestListWorkflows() {
+	ctx := context.Background()
+
+	suite.mockRepo.EXPECT().List(ctx).Return([]*entity.Workflow{releaseWorkflow()}, nil).Times(1)
+
+	resp, err := suite.uc.ListWorkflows(ctx)
+
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), resp.Workflows, 1)
+	assert.Len(suite.T(), resp.Workflows[0].Statuses, 3)
+}
+
+func (suite *WorkflowUseCaseTestSuite) TestUpdateWorkflow() {
+	ctx := ownerCtx()
+
+	tests := []struct {
+		name         string
+		req          UpdateWorkflowRequest
+		setupMock    func()
+		expectErrMsg string
+	}{
+		{
+			name: "not_found",
+			req:  UpdateWorkflowRequest{ID: 9, Name: "release"},
+			setupMock: func() {
+				suite.mockRepo.EXPECT().GetByID(ctx, uint(9)).Return(nil, nil).Times(1)
+			},
+			expectErrMsg: "not found",
+		},
+		{
+			name: "status_of_other_workflow",
+			req: UpdateWorkflowRequest{ID: 2, Name: "release", Statuses: []WorkflowStatusRequest{
+				{ID: 1, Name: "backlog", Category: "todo"},
+				{ID: 6, Name: "shipped", Category: "done"},
+			}},
+			setupMock: func() {
+				suite.mockRepo.EXPECT().GetByID(ctx, uint(2)).Return(releaseWorkflow(), nil).Times(1)
+			},
+			expectErrMsg: "validation fail: status 1 not found in workflow",
+		},
+		{
+			name: "swap_names",
+			req: UpdateWorkflowRequest{ID: 2, Name: "release", Statuses: []WorkflowStatusRequest{
+				{ID: 4, Name: "review", Category: "todo"},
+				{ID: 5, Name: "backlog", Category: "in_progress"},
+				{ID: 6, Name: "shipped", Category: "done"},
+			}},
+			setupMock: func() {
+				suite.mockRepo.EXPECT().GetByID(ctx, uint(2)).Return(releaseWorkflow(), nil).Times(1)
+				suite.mockRepo.EXPECT().GetByName(ctx, "release").Return(releaseWorkflow(), nil).Times(1)
+			},
+			expectErrMsg: "validation fail: status review is still used by another status",
+		},
+		{
+			name: "removed_status_in_use",
+			req: UpdateWorkflowRequest{ID: 2, Name: "release", Statuses: []WorkflowStatusRequest{
+				{ID: 4, Name: "backlog", Category: "todo"},
+				{ID: 6, Name: "shipped", Category: "done"},
+			}},
+			setupMock: func() {
+				suite.mockRepo.EXPECT().GetByID(ctx, uint(2)).Return(releaseWorkflow(), nil).Times(1)
+				suite.mockRepo.EXPECT().GetByName(ctx, "release").Return(releaseWorkflow(), nil).Times(1)
+				suite.mockTodos.EXPECT().
+					Count(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, params repository.TodoQueryParams) (int64, error) {
+						assert.Equal(suite.T(), uint(2), *params.WorkflowID)
+						assert.Equal(suite.T(), []entity.TodoStatus{"review"}, params.Statuses)
+						assert.True(suite.T(), params.WithTrashed)
+						return 2, nil
+					}).
+					Times(1)
+			},
+			expectErrMsg: "conflict: removed statuses are still used by 2 todos",
+		},
+		{
+			name: "rename_reorder_and_add",
+			req: UpdateWorkflowRequest{ID: 2, Name: "releases", Statuses: []WorkflowStatusRequest{
+				{ID: 4, Name: "backlog", Category: "todo"},
+				{Name: "qa", Category: "in_progress"},
+				{ID: 5, Name: "code-review", Category: "in_progress"},
+				{ID: 6, Name: "shipped", Category: "done"},
+			}},
+			setupMock: func() {
+				suite.mockRepo.EXPECT().GetByID(ctx, uint(2)).Return(releaseWorkflow(), nil).Times(1)
+				suite.mockRepo.EXPECT().GetByName(ctx, "releases").Return(nil, nil).Times(1)
+				suite.mockRepo.EXPECT().
+					Update(ctx, gomock.Any()).
+					DoAndReturn(func(ctx context.Context, workflow *entity.Workflow) (int64, error) {
+						assert.Equal(suite.T(), "releases", workflow.Name)
+						assert.Equal(suite.T(), uint(0), workflow.Statuses[1].ID)
+						assert.Equal(suite.T(), entity.WorkflowStatus{ID: 5, WorkflowID: 2, Name: "code-review", Category: entity.CategoryInProgress, Position: 2}, workflow.Statuses[2])
+						return 1, nil
+					}).
+					Times(1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			tt.setupMock()
+
+			err := suite.uc.UpdateWorkflow(ctx, tt.req)
+
+			if tt.expectErrMsg != "" {
+				assert.ErrorContains(suite.T(), err, tt.expectErrMsg)
+			} else {
+				assert.NoError(suite.T(), err)
+			}
+		})
+	}
+}
+
+func (suite *WorkflowUseCaseTestSuite) TestDeleteWorkflow() {
+	ctx := ownerCtx()
+
+	suite.Run("default_workflow", func() {
+		workflow := entity.DefaultWorkflow()
+		workflow.ID = 1
+		suite.mockRepo.EXPECT().GetByID(ctx, uint(1)).Return(workflow, nil).Times(1)
+
+		err := suite.uc.DeleteWorkflow(ctx, 1)
+
+		assert.EqualError(suite.T(), err, "conflict: default workflow cannot be deleted")
+	})
+
+	suite.Run("still_used", func() {
+		suite.mockRepo.EXPECT().GetByID(ctx, uint(2)).Return(releaseWorkflow(), nil).Times(1)
+		suite.mockTodos.EXPECT().Count(ctx, gomock.Any()).Return(int64(1), nil).Times(1)
+
+		err := suite.uc.DeleteWorkflow(ctx, 2)
+
+		assert.EqualError(suite.T(), err, "conflict: workflow is still used by 1 todos")
+	})
+
+	suite.Run("used_by_project", func() {
+		suite.mockRepo.EXPECT().GetByID(ctx, uint(2)).Return(releaseWorkflow(), nil).Times(1)
+		suite.mockTodos.EXPECT().Count(ctx, gomock.Any()).Return(int64(0), nil).Times(1)
+		suite.mockProjects.EXPECT().CountByWorkflow(ctx, uint(2)).Return(int64(2), nil).Times(1)
+
+		err := suite.uc.DeleteWorkflow(ctx, 2)
+
+		assert.EqualError(suite.T(), err, "conflict: workflow is still used by 2 projects")
+	})
+
+	suite.Run("deleted", func() {
+		suite.mockRepo.EXPECT().GetByID(ctx, uint(2)).Return(releaseWorkflow(), nil).Times(1)
+		suite.mockTodos.EXPECT().Count(ctx, gomock.Any()).Return(int64(0), nil).Times(1)
+		suite.mockProjects.EXPECT().CountByWorkflow(ctx, uint(2)).Return(int64(0), nil).Times(1)
+		suite.mockRepo.EXPECT().Delete(ctx, uint(2)).Return(int64(1), nil).Times(1)
+
+		err := suite.uc.DeleteWorkflow(ctx, 2)
+
+		assert.NoError(suite.T(), err)
+	})
+}
+
+func (suite *WorkflowUseCaseTestSuite) TestMemberForbidden() {
+	memberCtx := actor.WithWorkspaceRole(context.Background(), string(entity.RoleMember))
+
+	// members move todos through the workflows of the workspace, admins manage them
+	_, err := suite.uc.CreateWorkflow(memberCtx, CreateWorkflowRequest{Name: "release"})
+	assert.EqualError(suite.T(), err, "forbidden: the member role may not configure_workspace")
+
+	err = suite.uc.UpdateWorkflow(memberCtx, UpdateWorkflowRequest{ID: 2, Name: "release"})
+	assert.EqualError(suite.T(), err, "forbidden: the member role may not configure_workspace")
+
+	err = suite.uc.DeleteWorkflow(memberCtx, 2)
+	assert.EqualError(suite.T(), err, "forbidden: the member role may not configure_workspace")
+}
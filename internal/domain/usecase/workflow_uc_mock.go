@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: workflow_uc.go
+//
+// Generated by this command:
+//
+//	mockgen -source=workflow_uc.go -destination=workflow_uc_mock.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWorkflowUseCase is a mock of WorkflowUseCase interface.
+type MockWorkflowUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkflowUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkflowUseCaseMockRecorder is the mock recorder for MockWorkflowUseCase.
+type MockWorkflowUseCaseMockRecorder struct {
+	mock *MockWorkflowUseCase
+}
+
+// NewMockWorkflowUseCase creates a new mock instance.
+func NewMockWorkflowUseCase(ctrl *gomock.Controller) *MockWorkflowUseCase {
+	mock := &MockWorkflowUseCase{ctrl: ctrl}
+	mock.recorder = &MockWorkflowUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkflowUseCase) EXPECT() *MockWorkflowUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CreateWorkflow mocks base method.
+func (m *MockWorkflowUseCase) CreateWorkflow(ctx context.Context, req CreateWorkflowRequest) (*CreateWorkflowResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkflow", ctx, req)
+	ret0, _ := ret[0].(*CreateWorkflowResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorkflow indicates an expected call of CreateWorkflow.
+func (mr *MockWorkflowUseCaseMockRecorder) CreateWorkflow(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkflow", reflect.TypeOf((*MockWorkflowUseCase)(nil).CreateWorkflow), ctx, req)
+}
+
+// DeleteWorkflow mocks base method.
+func (m *MockWorkflowUseCase) DeleteWorkflow(ctx context.Context, id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWorkflow", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWorkflow indicates an expected call of DeleteWorkflow.
+func (mr *MockWorkflowUseCaseMockRecorder) DeleteWorkflow(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWorkflow", reflect.TypeOf((*MockWorkflowUseCase)(nil).DeleteWorkflow), ctx, id)
+}
+
+// GetWorkflow mocks base method.
+func (m *MockWorkflowUseCase) GetWorkflow(ctx context.Context, id uint) (*WorkflowResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflow", ctx, id)
+	ret0, _ := ret[0].(*WorkflowResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflow indicates an expected call of GetWorkflow.
+func (mr *MockWorkflowUseCaseMockRecorder) GetWorkflow(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflow", reflect.TypeOf((*MockWorkflowUseCase)(nil).GetWorkflow), ctx, id)
+}
+
+// ListWorkflows mocks base method.
+func (m *MockWorkflowUseCase) ListWorkflows(ctx context.Context) (*ListWorkflowsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkflows", ctx)
+	ret0, _ := ret[0].(*ListWorkflowsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkflows indicates an expected call of ListWorkflows.
+func (mr *MockWorkflowUseCaseMockRecorder) ListWorkflows(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkflows", reflect.TypeOf((*MockWorkflowUseCase)(nil).ListWorkflows), ctx)
+}
+
+// UpdateWorkflow mocks base method.
+func (m *MockWorkflowUseCase) UpdateWorkflow(ctx context.Context, req UpdateWorkflowRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflow", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkflow indicates an expected call of UpdateWorkflow.
+func (mr *MockWorkflowUseCaseMockRecorder) UpdateWorkflow(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflow", reflect.TypeOf((*MockWorkflowUseCase)(nil).UpdateWorkflow), ctx, req)
+}
@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=workspace_uc.go -destination=workspace_uc_mock.go -package=usecase
+type WorkspaceUseCase interface {
+
+	// ListWorkspaces lists the workspaces of the signed-in user, oldest first
+	// Error:
+	// - unauthorized (no signed-in user)
+	// - internal fail
+	ListWorkspaces(ctx context.Context) (*ListWorkspacesResponse, error)
+
+	// CreateWorkspace creates a workspace with the signed-in user as its first member and owner,
+	// together with its default workflow
+	// Error:
+	// - unauthorized (no signed-in user)
+	// - validation fail
+	// - internal fail
+	CreateWorkspace(ctx context.Context, req CreateWorkspaceRequest) (*CreateWorkspaceResponse, error)
+
+	// ListMembers lists the members of a workspace of the signed-in user
+	// Error:
+	// - unauthorized (no signed-in user)
+	// - not found (missing or the user is not a member)
+	// - internal fail
+	ListMembers(ctx context.Context, workspaceID uint) (*ListWorkspaceMembersResponse, error)
+
+	// AddMember invites a registered user to a workspace of the signed-in user, with the member role
+	// unless another role is given
+	// Error:
+	// - unauthorized (no signed-in user)
+	// - validation fail
+	// - not found (workspace, or no user with the email)
+	// - forbidden (below admin, or granting the owner role without being an owner)
+	// - conflict (already a member)
+	// - internal fail
+	AddMember(ctx context.Context, req AddWorkspaceMemberRequest) error
+
+	// ChangeMemberRole changes the role of a member, only owners may grant or take the owner role
+	// Error:
+	// - unauthorized (no signed-in user)
+	// - validation fail
+	// - not found (workspace or member)
+	// - forbidden (below admin, or changing the owner role without being an owner)
+	// - conflict (demoting the last owner)
+	// - internal fail
+	ChangeMemberRole(ctx context.Context, req ChangeWorkspaceMemberRoleRequest) error
+
+	// RemoveMember removes a member from a workspace, any member may leave by removing themselves
+	// Error:
+	// - unauthorized (no signed-in user)
+	// - validation fail
+	// - not found (workspace or member)
+	// - forbidden (removing others below admin, or removing an owner without being an owner)
+	// - conflict (removing the last owner)
+	// - internal fail
+	RemoveMember(ctx context.Context, workspaceID uint, userID uint) error
+
+	// EnsureOwners makes the oldest member the owner of every workspace without one,
+	// for workspaces created before members had roles
+	// Error:
+	// - internal fail
+	EnsureOwners(ctx context.Context) error
+
+	// EnsureResources gives every workspace its default workflow and its own copy of the projects, tags
+	// and workflows created before workspaces that its todos use, called at startup
+	// Error:
+	// - internal fail
+	EnsureResources(ctx context.Context) error
+
+	// ResolveWorkspace returns the workspace a request of the signed-in user works in with the role of
+	// the user in it, used by the workspace middleware. ID 0 picks the oldest workspace of the user; a user without workspace gets
+	// a personal one, which takes over the todos the user created before workspaces
+	// Error:
+	// - unauthorized (no signed-in user)
+	// - not found (missing or the user is not a member)
+	// - internal fail
+	ResolveWorkspace(ctx context.Context, id uint) (*WorkspaceResponse, error)
+}
+
+type ListWorkspacesResponse struct {
+	Workspaces []WorkspaceResponse `json:"workspaces"`
+}
+
+type CreateWorkspaceRequest struct {
+	Name string `json:"name"`
+}
+
+type CreateWorkspaceResponse struct {
+	ID uint `json:"id"`
+}
+
+type ListWorkspaceMembersResponse struct {
+	Members []WorkspaceMemberResponse `json:"members"`
+}
+
+type AddWorkspaceMemberRequest struct {
+	WorkspaceID uint   `json:"workspace_id"`
+	Email       string `json:"email"`
+	Role        string `json:"role"`
+}
+
+type ChangeWorkspaceMemberRoleRequest struct {
+	WorkspaceID uint   `json:"workspace_id"`
+	UserID      uint   `json:"user_id"`
+	Role        string `json:"role"`
+}
+
+type WorkspaceResponse struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	Role      string    `json:"role,omitempty"` // role of the signed-in user, set when the workspace is resolved
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type WorkspaceMemberResponse struct {
+	UserID    uint      `json:"user_id"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
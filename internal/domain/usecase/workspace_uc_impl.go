@@ -0,0 +1,405 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+var _ WorkspaceUseCase = &workspaceUseCaseImpl{}
+
+// personalWorkspaceName names the workspace created for a user without any
+const personalWorkspaceName = "Personal"
+
+type workspaceUseCaseImpl struct {
+	workspaceRepo repository.WorkspaceRepository
+	userRepo      repository.UserRepository
+	todoRepo      repository.TodoRepository
+	workflowRepo  repository.WorkflowRepository
+}
+
+func NewWorkspaceUseCaseImpl(
+	workspaceRepo repository.WorkspaceRepository,
+	userRepo repository.UserRepository,
+	todoRepo repository.TodoRepository,
+	workflowRepo repository.WorkflowRepository,
+) WorkspaceUseCase {
+	return &workspaceUseCaseImpl{
+		workspaceRepo: workspaceRepo,
+		userRepo:      userRepo,
+		todoRepo:      todoRepo,
+		workflowRepo:  workflowRepo,
+	}
+}
+
+// ListWorkspaces lists the workspaces of the signed-in user
+func (w *workspaceUseCaseImpl) ListWorkspaces(ctx context.Context) (*ListWorkspacesResponse, error) {
+	userID := actor.UserID(ctx)
+	if userID == 0 {
+		return nil, errors.New("unauthorized: sign in to use workspaces")
+	}
+
+	workspaces, err := w.workspaceRepo.ListByMember(ctx, userID)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	resp := &ListWorkspacesResponse{Workspaces: make([]WorkspaceResponse, len(workspaces))}
+	for i, workspace := range workspaces {
+		resp.Workspaces[i] = toWorkspaceResponse(workspace)
+	}
+
+	return resp, nil
+}
+
+// CreateWorkspace creates a workspace with the signed-in user as its first member and owner
+func (w *workspaceUseCaseImpl) CreateWorkspace(ctx context.Context, req CreateWorkspaceRequest) (*CreateWorkspaceResponse, error) {
+	userID := actor.UserID(ctx)
+	if userID == 0 {
+		return nil, errors.New("unauthorized: sign in to use workspaces")
+	}
+
+	workspace, err := entity.NewWorkspace(req.Name)
+	if err != nil {
+		return nil, errors.Join(errors.New("validation fail"), err)
+	}
+
+	workspace, err = w.workspaceRepo.Create(ctx, workspace, userID)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if err := w.setUpResources(ctx, workspace.ID); err != nil {
+		return nil, err
+	}
+
+	return &CreateWorkspaceResponse{ID: workspace.ID}, nil
+}
+
+// ListMembers lists the members of a workspace of the signed-in user
+func (w *workspaceUseCaseImpl) ListMembers(ctx context.Context, workspaceID uint) (*ListWorkspaceMembersResponse, error) {
+	if _, _, err := w.memberWorkspace(ctx, workspaceID); err != nil {
+		return nil, err
+	}
+
+	members, err := w.workspaceRepo.ListMembers(ctx, workspaceID)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+
+	resp := &ListWorkspaceMembersResponse{Members: make([]WorkspaceMemberResponse, len(members))}
+	for i, member := range members {
+		resp.Members[i] = WorkspaceMemberResponse{
+			UserID:    member.UserID,
+			Email:     member.Email,
+			Role:      string(member.Role),
+			CreatedAt: member.CreatedAt,
+		}
+	}
+
+	return resp, nil
+}
+
+// AddMember invites a registered user to a workspace of the signed-in user
+func (w *workspaceUseCaseImpl) AddMember(ctx context.Context, req AddWorkspaceMemberRequest) error {
+	_, caller, err := w.memberWorkspace(ctx, req.WorkspaceID)
+	if err != nil {
+		return err
+	}
+	if err := Authorize(caller.Role, ActionManageMembers); err != nil {
+		return err
+	}
+
+	email, err := entity.NormalizeEmail(req.Email)
+	if err != nil {
+		return errors.Join(errors.New("validation fail"), err)
+	}
+	role, err := entity.ParseWorkspaceRole(req.Role)
+	if err != nil {
+		return errors.Join(errors.New("validation fail"), err)
+	}
+	if role == entity.RoleOwner && caller.Role != entity.RoleOwner {
+		return errors.New("forbidden: only an owner may grant the owner role")
+	}
+
+	user, err := w.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if user == nil {
+		return errors.New("not found: no user with this email")
+	}
+
+	existing, err := w.workspaceRepo.GetMember(ctx, req.WorkspaceID, user.ID)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if existing != nil {
+		return errors.New("conflict: user is already a member of the workspace")
+	}
+
+	member, err := entity.NewWorkspaceMember(req.WorkspaceID, user.ID, role)
+	if err != nil {
+		return errors.Join(errors.New("validation fail"), err)
+	}
+	if err := w.workspaceRepo.AddMember(ctx, member); err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+
+	return nil
+}
+
+// ChangeMemberRole changes the role of a member, only owners may grant or take the owner role
+func (w *workspaceUseCaseImpl) ChangeMemberRole(ctx context.Context, req ChangeWorkspaceMemberRoleRequest) error {
+	_, caller, err := w.memberWorkspace(ctx, req.WorkspaceID)
+	if err != nil {
+		return err
+	}
+	if err := Authorize(caller.Role, ActionManageMembers); err != nil {
+		return err
+	}
+
+	if req.Role == "" {
+		return errors.New("validation fail: role is required")
+	}
+	role, err := entity.ParseWorkspaceRole(req.Role)
+	if err != nil {
+		return errors.Join(errors.New("validation fail"), err)
+	}
+
+	target, err := w.findMember(ctx, req.WorkspaceID, req.UserID)
+	if err != nil {
+		return err
+	}
+	if target.Role == role {
+		return nil
+	}
+	if (target.Role == entity.RoleOwner || role == entity.RoleOwner) && caller.Role != entity.RoleOwner {
+		return errors.New("forbidden: only an owner may grant or take the owner role")
+	}
+	if target.Role == entity.RoleOwner {
+		if err := w.keepOwner(ctx, req.WorkspaceID); err != nil {
+			return err
+		}
+	}
+
+	rowsAffected, err := w.workspaceRepo.UpdateMemberRole(ctx, req.WorkspaceID, req.UserID, role)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if rowsAffected == 0 {
+		return errors.New("not found: member not found")
+	}
+
+	return nil
+}
+
+// RemoveMember removes a member from a workspace, any member may leave by removing themselves
+func (w *workspaceUseCaseImpl) RemoveMember(ctx context.Context, workspaceID uint, userID uint) error {
+	_, caller, err := w.memberWorkspace(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+
+	target, err := w.findMember(ctx, workspaceID, userID)
+	if err != nil {
+		return err
+	}
+	if target.UserID != caller.UserID {
+		if err := Authorize(caller.Role, ActionManageMembers); err != nil {
+			return err
+		}
+		if target.Role == entity.RoleOwner && caller.Role != entity.RoleOwner {
+			return errors.New("forbidden: only an owner may remove an owner")
+		}
+	}
+	if target.Role == entity.RoleOwner {
+		if err := w.keepOwner(ctx, workspaceID); err != nil {
+			return err
+		}
+	}
+
+	rowsAffected, err := w.workspaceRepo.RemoveMember(ctx, workspaceID, userID)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if rowsAffected == 0 {
+		return errors.New("not found: member not found")
+	}
+
+	return nil
+}
+
+// EnsureOwners makes the oldest member the owner of every workspace without one
+func (w *workspaceUseCaseImpl) EnsureOwners(ctx context.Context) error {
+	if _, err := w.workspaceRepo.EnsureOwners(ctx); err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+
+	return nil
+}
+
+// EnsureResources gives every workspace a default workflow and its copy of the resources created before workspaces
+func (w *workspaceUseCaseImpl) EnsureResources(ctx context.Context) error {
+	workspaceIDs, err := w.workspaceRepo.ListIDs(ctx)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+
+	for _, workspaceID := range workspaceIDs {
+		if err := w.setUpResources(ctx, workspaceID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResolveWorkspace returns the workspace a request of the signed-in user works in
+func (w *workspaceUseCaseImpl) ResolveWorkspace(ctx context.Context, id uint) (*WorkspaceResponse, error) {
+	if id != 0 {
+		workspace, member, err := w.memberWorkspace(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		resp := toWorkspaceResponse(workspace)
+		resp.Role = string(member.Role)
+		return &resp, nil
+	}
+
+	userID := actor.UserID(ctx)
+	if userID == 0 {
+		return nil, errors.New("unauthorized: sign in to use workspaces")
+	}
+
+	workspaces, err := w.workspaceRepo.ListByMember(ctx, userID)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if len(workspaces) > 0 {
+		return w.withRole(ctx, workspaces[0], userID)
+	}
+
+	workspace, err := entity.NewWorkspace(personalWorkspaceName)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	workspace, created, err := w.workspaceRepo.CreatePersonal(ctx, workspace, userID)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if !created {
+		// a concurrent request of the user created the workspace and sets it up
+		return w.withRole(ctx, workspace, userID)
+	}
+	if _, err := w.todoRepo.AssignWorkspace(ctx, userID, workspace.ID); err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if err := w.setUpResources(ctx, workspace.ID); err != nil {
+		return nil, err
+	}
+
+	resp := toWorkspaceResponse(workspace)
+	resp.Role = string(entity.RoleOwner)
+	return &resp, nil
+}
+
+// withRole returns the workspace with the role the user holds in it
+func (w *workspaceUseCaseImpl) withRole(ctx context.Context, workspace *entity.Workspace, userID uint) (*WorkspaceResponse, error) {
+	member, err := w.workspaceRepo.GetMember(ctx, workspace.ID, userID)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if member == nil {
+		return nil, errors.New("not found: workspace not found")
+	}
+
+	resp := toWorkspaceResponse(workspace)
+	resp.Role = string(member.Role)
+	return &resp, nil
+}
+
+// setUpResources copies the resources created before workspaces that the todos of a workspace use
+// into it, then creates its default workflow unless a copied one became the default
+func (w *workspaceUseCaseImpl) setUpResources(ctx context.Context, workspaceID uint) error {
+	if _, err := w.workspaceRepo.AdoptLegacyResources(ctx, workspaceID); err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if _, err := w.workflowRepo.EnsureDefault(actor.WithWorkspaceID(ctx, workspaceID), entity.DefaultWorkflow()); err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+
+	return nil
+}
+
+// memberWorkspace loads a workspace of the signed-in user with their membership, workspaces the user
+// is not a member of are reported missing so their IDs are not revealed
+func (w *workspaceUseCaseImpl) memberWorkspace(ctx context.Context, id uint) (*entity.Workspace, *entity.WorkspaceMember, error) {
+	userID := actor.UserID(ctx)
+	if userID == 0 {
+		return nil, nil, errors.New("unauthorized: sign in to use workspaces")
+	}
+	if id == 0 {
+		return nil, nil, errors.New("validation fail: ID cannot be 0")
+	}
+
+	member, err := w.workspaceRepo.GetMember(ctx, id, userID)
+	if err != nil {
+		return nil, nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if member == nil {
+		return nil, nil, errors.New("not found: workspace not found")
+	}
+
+	workspace, err := w.workspaceRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if workspace == nil {
+		return nil, nil, errors.New("not found: workspace not found")
+	}
+
+	return workspace, member, nil
+}
+
+// findMember loads the membership of a user in a workspace the caller belongs to
+func (w *workspaceUseCaseImpl) findMember(ctx context.Context, workspaceID uint, userID uint) (*entity.WorkspaceMember, error) {
+	if userID == 0 {
+		return nil, errors.New("validation fail: user ID cannot be 0")
+	}
+
+	member, err := w.workspaceRepo.GetMember(ctx, workspaceID, userID)
+	if err != nil {
+		return nil, errors.Join(errors.New("internal fail"), err)
+	}
+	if member == nil {
+		return nil, errors.New("not found: member not found")
+	}
+
+	return member, nil
+}
+
+// keepOwner refuses to take the owner role from a member when they are the last owner of the workspace
+func (w *workspaceUseCaseImpl) keepOwner(ctx context.Context, workspaceID uint) error {
+	owners, err := w.workspaceRepo.CountOwners(ctx, workspaceID)
+	if err != nil {
+		return errors.Join(errors.New("internal fail"), err)
+	}
+	if owners <= 1 {
+		return errors.New("conflict: the workspace needs at least one owner")
+	}
+
+	return nil
+}
+
+// toWorkspaceResponse converts a workspace entity to the usecase response
+func toWorkspaceResponse(workspace *entity.Workspace) WorkspaceResponse {
+	return WorkspaceResponse{
+		ID:        workspace.ID,
+		Name:      workspace.Name,
+		CreatedAt: workspace.CreatedAt,
+		UpdatedAt: workspace.UpdatedAt,
+	}
+}
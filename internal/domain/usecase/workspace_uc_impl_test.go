@@ -0,0 +1,363 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+type WorkspaceUseCaseTestSuite struct {
+	suite.Suite
+	ctrl           *gomock.Controller
+	mockWorkspaces *repository.MockWorkspaceRepository
+	mockUsers      *repository.MockUserRepository
+	mockTodos      *repository.MockTodoRepository
+	mockWorkflows  *repository.MockWorkflowRepository
+	uc             WorkspaceUseCase
+	ctx            context.Context
+	team           *entity.Workspace
+}
+
+func TestWorkspaceUseCaseTestSuite(t *testing.T) {
+	suite.Run(t, new(WorkspaceUseCaseTestSuite))
+}
+
+func (suite *WorkspaceUseCaseTestSuite) SetupTest() {
+	suite.ctrl = gomock.NewController(suite.T())
+	suite.mockWorkspaces = repository.NewMockWorkspaceRepository(suite.ctrl)
+	suite.mockUsers = repository.NewMockUserRepository(suite.ctrl)
+	suite.mockTodos = repository.NewMockTodoRepository(suite.ctrl)
+	suite.mockWorkflows = repository.NewMockWorkflowRepository(suite.ctrl)
+	suite.uc = NewWorkspaceUseCaseImpl(suite.mockWorkspaces, suite.mockUsers, suite.mockTodos, suite.mockWorkflows)
+	suite.ctx = actor.WithUserID(context.Background(), 7)
+	suite.team = &entity.Workspace{ID: 2, Name: "平台團隊"}
+}
+
+func (suite *WorkspaceUseCaseTestSuite) TearDownTest() {
+	if suite.ctrl != nil {
+		suite.ctrl.Finish()
+	}
+}
+
+// expectSetUp expects the legacy resources and the default workflow of the workspace to be set up
+func (suite *WorkspaceUseCaseTestSuite) expectSetUp(workspaceID uint) {
+	suite.mockWorkspaces.EXPECT().AdoptLegacyResources(suite.ctx, workspaceID).Return(int64(0), nil).Times(1)
+	suite.mockWorkflows.EXPECT().
+		EnsureDefault(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, workflow *entity.Workflow) (*entity.Workflow, error) {
+			suite.Equal(workspaceID, actor.WorkspaceID(ctx))
+			suite.True(workflow.IsDefault)
+			return workflow, nil
+		}).
+		Times(1)
+}
+
+func (suite *WorkspaceUseCaseTestSuite) TestCreateWorkspace() {
+	suite.Run("success", func() {
+		suite.mockWorkspaces.EXPECT().
+			Create(suite.ctx, gomock.Any(), uint(7)).
+			DoAndReturn(func(ctx context.Context, workspace *entity.Workspace, creatorID uint) (*entity.Workspace, error) {
+				suite.Equal("平台團隊", workspace.Name)
+				workspace.ID = 2
+				return workspace, nil
+			}).
+			Times(1)
+		suite.expectSetUp(2)
+
+		resp, err := suite.uc.CreateWorkspace(suite.ctx, CreateWorkspaceRequest{Name: " 平台團隊 "})
+
+		suite.NoError(err)
+		suite.Equal(uint(2), resp.ID)
+	})
+
+	suite.Run("empty_name", func() {
+		_, err := suite.uc.CreateWorkspace(suite.ctx, CreateWorkspaceRequest{Name: " "})
+
+		suite.ErrorContains(err, "validation fail")
+	})
+
+	suite.Run("not_signed_in", func() {
+		_, err := suite.uc.CreateWorkspace(context.Background(), CreateWorkspaceRequest{Name: "平台團隊"})
+
+		suite.EqualError(err, "unauthorized: sign in to use workspaces")
+	})
+}
+
+// expectCaller expects the signed-in user to be looked up as a member of the team with the role
+func (suite *WorkspaceUseCaseTestSuite) expectCaller(role entity.WorkspaceRole) {
+	suite.mockWorkspaces.EXPECT().GetMember(suite.ctx, uint(2), uint(7)).Return(&entity.WorkspaceMember{WorkspaceID: 2, UserID: 7, Role: role}, nil).Times(1)
+	suite.mockWorkspaces.EXPECT().GetByID(suite.ctx, uint(2)).Return(suite.team, nil).Times(1)
+}
+
+func (suite *WorkspaceUseCaseTestSuite) TestAddMember() {
+	bob := &entity.User{ID: 8, Email: "bob@example.com"}
+
+	suite.Run("success", func() {
+		suite.expectCaller(entity.RoleAdmin)
+		suite.mockUsers.EXPECT().GetByEmail(suite.ctx, "bob@example.com").Return(bob, nil).Times(1)
+		suite.mockWorkspaces.EXPECT().GetMember(suite.ctx, uint(2), uint(8)).Return(nil, nil).Times(1)
+		suite.mockWorkspaces.EXPECT().
+			AddMember(suite.ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, member *entity.WorkspaceMember) error {
+				suite.Equal(uint(2), member.WorkspaceID)
+				suite.Equal(uint(8), member.UserID)
+				suite.Equal(entity.RoleMember, member.Role)
+				return nil
+			}).
+			Times(1)
+
+		suite.NoError(suite.uc.AddMember(suite.ctx, AddWorkspaceMemberRequest{WorkspaceID: 2, Email: "Bob@example.com"}))
+	})
+
+	suite.Run("invite_as_viewer", func() {
+		suite.expectCaller(entity.RoleOwner)
+		suite.mockUsers.EXPECT().GetByEmail(suite.ctx, "bob@example.com").Return(bob, nil).Times(1)
+		suite.mockWorkspaces.EXPECT().GetMember(suite.ctx, uint(2), uint(8)).Return(nil, nil).Times(1)
+		suite.mockWorkspaces.EXPECT().
+			AddMember(suite.ctx, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, member *entity.WorkspaceMember) error {
+				suite.Equal(entity.RoleViewer, member.Role)
+				return nil
+			}).
+			Times(1)
+
+		suite.NoError(suite.uc.AddMember(suite.ctx, AddWorkspaceMemberRequest{WorkspaceID: 2, Email: "bob@example.com", Role: "viewer"}))
+	})
+
+	suite.Run("members_cannot_invite", func() {
+		suite.expectCaller(entity.RoleMember)
+
+		err := suite.uc.AddMember(suite.ctx, AddWorkspaceMemberRequest{WorkspaceID: 2, Email: "bob@example.com"})
+
+		suite.ErrorContains(err, "forbidden")
+	})
+
+	suite.Run("admins_cannot_grant_owner", func() {
+		suite.expectCaller(entity.RoleAdmin)
+
+		err := suite.uc.AddMember(suite.ctx, AddWorkspaceMemberRequest{WorkspaceID: 2, Email: "bob@example.com", Role: "owner"})
+
+		suite.EqualError(err, "forbidden: only an owner may grant the owner role")
+	})
+
+	suite.Run("unknown_role", func() {
+		suite.expectCaller(entity.RoleAdmin)
+
+		err := suite.uc.AddMember(suite.ctx, AddWorkspaceMemberRequest{WorkspaceID: 2, Email: "bob@example.com", Role: "guest"})
+
+		suite.ErrorContains(err, "validation fail")
+	})
+
+	suite.Run("already_member", func() {
+		suite.expectCaller(entity.RoleAdmin)
+		suite.mockUsers.EXPECT().GetByEmail(suite.ctx, "bob@example.com").Return(bob, nil).Times(1)
+		suite.mockWorkspaces.EXPECT().GetMember(suite.ctx, uint(2), uint(8)).Return(&entity.WorkspaceMember{WorkspaceID: 2, UserID: 8}, nil).Times(1)
+
+		err := suite.uc.AddMember(suite.ctx, AddWorkspaceMemberRequest{WorkspaceID: 2, Email: "bob@example.com"})
+
+		suite.EqualError(err, "conflict: user is already a member of the workspace")
+	})
+
+	suite.Run("not_a_member_of_the_workspace", func() {
+		suite.mockWorkspaces.EXPECT().GetMember(suite.ctx, uint(3), uint(7)).Return(nil, nil).Times(1)
+
+		err := suite.uc.AddMember(suite.ctx, AddWorkspaceMemberRequest{WorkspaceID: 3, Email: "bob@example.com"})
+
+		suite.EqualError(err, "not found: workspace not found")
+	})
+
+	suite.Run("unknown_email", func() {
+		suite.expectCaller(entity.RoleAdmin)
+		suite.mockUsers.EXPECT().GetByEmail(suite.ctx, "carol@example.com").Return(nil, nil).Times(1)
+
+		err := suite.uc.AddMember(suite.ctx, AddWorkspaceMemberRequest{WorkspaceID: 2, Email: "carol@example.com"})
+
+		suite.EqualError(err, "not found: no user with this email")
+	})
+}
+
+func (suite *WorkspaceUseCaseTestSuite) TestChangeMemberRole() {
+	suite.Run("success", func() {
+		suite.expectCaller(entity.RoleAdmin)
+		suite.mockWorkspaces.EXPECT().GetMember(suite.ctx, uint(2), uint(8)).Return(&entity.WorkspaceMember{WorkspaceID: 2, UserID: 8, Role: entity.RoleMember}, nil).Times(1)
+		suite.mockWorkspaces.EXPECT().UpdateMemberRole(suite.ctx, uint(2), uint(8), entity.RoleViewer).Return(int64(1), nil).Times(1)
+
+		suite.NoError(suite.uc.ChangeMemberRole(suite.ctx, ChangeWorkspaceMemberRoleRequest{WorkspaceID: 2, UserID: 8, Role: "viewer"}))
+	})
+
+	suite.Run("admins_cannot_demote_owners", func() {
+		suite.expectCaller(entity.RoleAdmin)
+		suite.mockWorkspaces.EXPECT().GetMember(suite.ctx, uint(2), uint(8)).Return(&entity.WorkspaceMember{WorkspaceID: 2, UserID: 8, Role: entity.RoleOwner}, nil).Times(1)
+
+		err := suite.uc.ChangeMemberRole(suite.ctx, ChangeWorkspaceMemberRoleRequest{WorkspaceID: 2, UserID: 8, Role: "member"})
+
+		suite.EqualError(err, "forbidden: only an owner may grant or take the owner role")
+	})
+
+	suite.Run("last_owner_cannot_step_down", func() {
+		suite.expectCaller(entity.RoleOwner)
+		suite.mockWorkspaces.EXPECT().GetMember(suite.ctx, uint(2), uint(7)).Return(&entity.WorkspaceMember{WorkspaceID: 2, UserID: 7, Role: entity.RoleOwner}, nil).Times(1)
+		suite.mockWorkspaces.EXPECT().CountOwners(suite.ctx, uint(2)).Return(int64(1), nil).Times(1)
+
+		err := suite.uc.ChangeMemberRole(suite.ctx, ChangeWorkspaceMemberRoleRequest{WorkspaceID: 2, UserID: 7, Role: "admin"})
+
+		suite.EqualError(err, "conflict: the workspace needs at least one owner")
+	})
+
+	suite.Run("missing_role", func() {
+		suite.expectCaller(entity.RoleOwner)
+
+		err := suite.uc.ChangeMemberRole(suite.ctx, ChangeWorkspaceMemberRoleRequest{WorkspaceID: 2, UserID: 8})
+
+		suite.EqualError(err, "validation fail: role is required")
+	})
+
+	suite.Run("unknown_member", func() {
+		suite.expectCaller(entity.RoleOwner)
+		suite.mockWorkspaces.EXPECT().GetMember(suite.ctx, uint(2), uint(9)).Return(nil, nil).Times(1)
+
+		err := suite.uc.ChangeMemberRole(suite.ctx, ChangeWorkspaceMemberRoleRequest{WorkspaceID: 2, UserID: 9, Role: "admin"})
+
+		suite.EqualError(err, "not found: member not found")
+	})
+}
+
+func (suite *WorkspaceUseCaseTestSuite) TestRemoveMember() {
+	suite.Run("admin_removes_member", func() {
+		suite.expectCaller(entity.RoleAdmin)
+		suite.mockWorkspaces.EXPECT().GetMember(suite.ctx, uint(2), uint(8)).Return(&entity.WorkspaceMember{WorkspaceID: 2, UserID: 8, Role: entity.RoleMember}, nil).Times(1)
+		suite.mockWorkspaces.EXPECT().RemoveMember(suite.ctx, uint(2), uint(8)).Return(int64(1), nil).Times(1)
+
+		suite.NoError(suite.uc.RemoveMember(suite.ctx, 2, 8))
+	})
+
+	suite.Run("viewer_leaves", func() {
+		suite.expectCaller(entity.RoleViewer)
+		suite.mockWorkspaces.EXPECT().GetMember(suite.ctx, uint(2), uint(7)).Return(&entity.WorkspaceMember{WorkspaceID: 2, UserID: 7, Role: entity.RoleViewer}, nil).Times(1)
+		suite.mockWorkspaces.EXPECT().RemoveMember(suite.ctx, uint(2), uint(7)).Return(int64(1), nil).Times(1)
+
+		suite.NoError(suite.uc.RemoveMember(suite.ctx, 2, 7))
+	})
+
+	suite.Run("members_cannot_remove_others", func() {
+		suite.expectCaller(entity.RoleMember)
+		suite.mockWorkspaces.EXPECT().GetMember(suite.ctx, uint(2), uint(8)).Return(&entity.WorkspaceMember{WorkspaceID: 2, UserID: 8, Role: entity.RoleViewer}, nil).Times(1)
+
+		err := suite.uc.RemoveMember(suite.ctx, 2, 8)
+
+		suite.ErrorContains(err, "forbidden")
+	})
+
+	suite.Run("last_owner_cannot_leave", func() {
+		suite.expectCaller(entity.RoleOwner)
+		suite.mockWorkspaces.EXPECT().GetMember(suite.ctx, uint(2), uint(7)).Return(&entity.WorkspaceMember{WorkspaceID: 2, UserID: 7, Role: entity.RoleOwner}, nil).Times(1)
+		suite.mockWorkspaces.EXPECT().CountOwners(suite.ctx, uint(2)).Return(int64(1), nil).Times(1)
+
+		err := suite.uc.RemoveMember(suite.ctx, 2, 7)
+
+		suite.EqualError(err, "conflict: the workspace needs at least one owner")
+	})
+
+	suite.Run("owner_removes_co_owner", func() {
+		suite.expectCaller(entity.RoleOwner)
+		suite.mockWorkspaces.EXPECT().GetMember(suite.ctx, uint(2), uint(8)).Return(&entity.WorkspaceMember{WorkspaceID: 2, UserID: 8, Role: entity.RoleOwner}, nil).Times(1)
+		suite.mockWorkspaces.EXPECT().CountOwners(suite.ctx, uint(2)).Return(int64(2), nil).Times(1)
+		suite.mockWorkspaces.EXPECT().RemoveMember(suite.ctx, uint(2), uint(8)).Return(int64(1), nil).Times(1)
+
+		suite.NoError(suite.uc.RemoveMember(suite.ctx, 2, 8))
+	})
+}
+
+func (suite *WorkspaceUseCaseTestSuite) TestResolveWorkspace() {
+	suite.Run("requested_workspace", func() {
+		suite.expectCaller(entity.RoleViewer)
+
+		resp, err := suite.uc.ResolveWorkspace(suite.ctx, 2)
+
+		suite.NoError(err)
+		suite.Equal(uint(2), resp.ID)
+		suite.Equal("viewer", resp.Role)
+	})
+
+	suite.Run("workspace_of_another_team", func() {
+		suite.mockWorkspaces.EXPECT().GetMember(suite.ctx, uint(3), uint(7)).Return(nil, nil).Times(1)
+
+		_, err := suite.uc.ResolveWorkspace(suite.ctx, 3)
+
+		suite.EqualError(err, "not found: workspace not found")
+	})
+
+	suite.Run("oldest_workspace_by_default", func() {
+		suite.mockWorkspaces.EXPECT().
+			ListByMember(suite.ctx, uint(7)).
+			Return([]*entity.Workspace{{ID: 1, Name: "Personal"}, suite.team}, nil).
+			Times(1)
+		suite.mockWorkspaces.EXPECT().GetMember(suite.ctx, uint(1), uint(7)).Return(&entity.WorkspaceMember{WorkspaceID: 1, UserID: 7, Role: entity.RoleOwner}, nil).Times(1)
+
+		resp, err := suite.uc.ResolveWorkspace(suite.ctx, 0)
+
+		suite.NoError(err)
+		suite.Equal(uint(1), resp.ID)
+		suite.Equal("owner", resp.Role)
+	})
+
+	suite.Run("personal_workspace_takes_over_earlier_todos", func() {
+		suite.mockWorkspaces.EXPECT().ListByMember(suite.ctx, uint(7)).Return(nil, nil).Times(1)
+		suite.mockWorkspaces.EXPECT().
+			CreatePersonal(suite.ctx, gomock.Any(), uint(7)).
+			DoAndReturn(func(ctx context.Context, workspace *entity.Workspace, ownerID uint) (*entity.Workspace, bool, error) {
+				suite.Equal("Personal", workspace.Name)
+				workspace.ID = 5
+				return workspace, true, nil
+			}).
+			Times(1)
+		suite.mockTodos.EXPECT().AssignWorkspace(suite.ctx, uint(7), uint(5)).Return(int64(3), nil).Times(1)
+		suite.expectSetUp(5)
+
+		resp, err := suite.uc.ResolveWorkspace(suite.ctx, 0)
+
+		suite.NoError(err)
+		suite.Equal(uint(5), resp.ID)
+		suite.Equal("owner", resp.Role)
+	})
+
+	suite.Run("personal_workspace_created_by_a_concurrent_request", func() {
+		suite.mockWorkspaces.EXPECT().ListByMember(suite.ctx, uint(7)).Return(nil, nil).Times(1)
+		suite.mockWorkspaces.EXPECT().
+			CreatePersonal(suite.ctx, gomock.Any(), uint(7)).
+			Return(&entity.Workspace{ID: 5, Name: "Personal"}, false, nil).
+			Times(1)
+		suite.mockWorkspaces.EXPECT().GetMember(suite.ctx, uint(5), uint(7)).Return(&entity.WorkspaceMember{WorkspaceID: 5, UserID: 7, Role: entity.RoleOwner}, nil).Times(1)
+
+		// the request that created it takes over the todos and sets it up
+		resp, err := suite.uc.ResolveWorkspace(suite.ctx, 0)
+
+		suite.NoError(err)
+		suite.Equal(uint(5), resp.ID)
+		suite.Equal("owner", resp.Role)
+	})
+}
+
+func (suite *WorkspaceUseCaseTestSuite) TestEnsureResources() {
+	suite.Run("every_workspace", func() {
+		suite.mockWorkspaces.EXPECT().ListIDs(suite.ctx).Return([]uint{1, 2}, nil).Times(1)
+		suite.expectSetUp(1)
+		suite.expectSetUp(2)
+
+		suite.NoError(suite.uc.EnsureResources(suite.ctx))
+	})
+
+	suite.Run("adopt_fails", func() {
+		suite.mockWorkspaces.EXPECT().ListIDs(suite.ctx).Return([]uint{1}, nil).Times(1)
+		suite.mockWorkspaces.EXPECT().AdoptLegacyResources(suite.ctx, uint(1)).Return(int64(0), errors.New("db down")).Times(1)
+
+		suite.ErrorContains(suite.uc.EnsureResources(suite.ctx), "internal fail")
+	})
+}
@@ -0,0 +1,171 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: workspace_uc.go
+//
+// Generated by this command:
+//
+//	mockgen -source=workspace_uc.go -destination=workspace_uc_mock.go -package=usecase
+//
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWorkspaceUseCase is a mock of WorkspaceUseCase interface.
+type MockWorkspaceUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkspaceUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockWorkspaceUseCaseMockRecorder is the mock recorder for MockWorkspaceUseCase.
+type MockWorkspaceUseCaseMockRecorder struct {
+	mock *MockWorkspaceUseCase
+}
+
+// NewMockWorkspaceUseCase creates a new mock instance.
+func NewMockWorkspaceUseCase(ctrl *gomock.Controller) *MockWorkspaceUseCase {
+	mock := &MockWorkspaceUseCase{ctrl: ctrl}
+	mock.recorder = &MockWorkspaceUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkspaceUseCase) EXPECT() *MockWorkspaceUseCaseMockRecorder {
+	return m.recorder
+}
+
+// AddMember mocks base method.
+func (m *MockWorkspaceUseCase) AddMember(ctx context.Context, req AddWorkspaceMemberRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockWorkspaceUseCaseMockRecorder) AddMember(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockWorkspaceUseCase)(nil).AddMember), ctx, req)
+}
+
+// ChangeMemberRole mocks base method.
+func (m *MockWorkspaceUseCase) ChangeMemberRole(ctx context.Context, req ChangeWorkspaceMemberRoleRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeMemberRole", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeMemberRole indicates an expected call of ChangeMemberRole.
+func (mr *MockWorkspaceUseCaseMockRecorder) ChangeMemberRole(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeMemberRole", reflect.TypeOf((*MockWorkspaceUseCase)(nil).ChangeMemberRole), ctx, req)
+}
+
+// CreateWorkspace mocks base method.
+func (m *MockWorkspaceUseCase) CreateWorkspace(ctx context.Context, req CreateWorkspaceRequest) (*CreateWorkspaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWorkspace", ctx, req)
+	ret0, _ := ret[0].(*CreateWorkspaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWorkspace indicates an expected call of CreateWorkspace.
+func (mr *MockWorkspaceUseCaseMockRecorder) CreateWorkspace(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorkspace", reflect.TypeOf((*MockWorkspaceUseCase)(nil).CreateWorkspace), ctx, req)
+}
+
+// EnsureOwners mocks base method.
+func (m *MockWorkspaceUseCase) EnsureOwners(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureOwners", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureOwners indicates an expected call of EnsureOwners.
+func (mr *MockWorkspaceUseCaseMockRecorder) EnsureOwners(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureOwners", reflect.TypeOf((*MockWorkspaceUseCase)(nil).EnsureOwners), ctx)
+}
+
+// EnsureResources mocks base method.
+func (m *MockWorkspaceUseCase) EnsureResources(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureResources", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureResources indicates an expected call of EnsureResources.
+func (mr *MockWorkspaceUseCaseMockRecorder) EnsureResources(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureResources", reflect.TypeOf((*MockWorkspaceUseCase)(nil).EnsureResources), ctx)
+}
+
+// ListMembers mocks base method.
+func (m *MockWorkspaceUseCase) ListMembers(ctx context.Context, workspaceID uint) (*ListWorkspaceMembersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMembers", ctx, workspaceID)
+	ret0, _ := ret[0].(*ListWorkspaceMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMembers indicates an expected call of ListMembers.
+func (mr *MockWorkspaceUseCaseMockRecorder) ListMembers(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMembers", reflect.TypeOf((*MockWorkspaceUseCase)(nil).ListMembers), ctx, workspaceID)
+}
+
+// ListWorkspaces mocks base method.
+func (m *MockWorkspaceUseCase) ListWorkspaces(ctx context.Context) (*ListWorkspacesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorkspaces", ctx)
+	ret0, _ := ret[0].(*ListWorkspacesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorkspaces indicates an expected call of ListWorkspaces.
+func (mr *MockWorkspaceUseCaseMockRecorder) ListWorkspaces(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorkspaces", reflect.TypeOf((*MockWorkspaceUseCase)(nil).ListWorkspaces), ctx)
+}
+
+// RemoveMember mocks base method.
+func (m *MockWorkspaceUseCase) RemoveMember(ctx context.Context, workspaceID, userID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, workspaceID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockWorkspaceUseCaseMockRecorder) RemoveMember(ctx, workspaceID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockWorkspaceUseCase)(nil).RemoveMember), ctx, workspaceID, userID)
+}
+
+// ResolveWorkspace mocks base method.
+func (m *MockWorkspaceUseCase) ResolveWorkspace(ctx context.Context, id uint) (*WorkspaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveWorkspace", ctx, id)
+	ret0, _ := ret[0].(*WorkspaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveWorkspace indicates an expected call of ResolveWorkspace.
+func (mr *MockWorkspaceUseCaseMockRecorder) ResolveWorkspace(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveWorkspace", reflect.TypeOf((*MockWorkspaceUseCase)(nil).ResolveWorkspace), ctx, id)
+}
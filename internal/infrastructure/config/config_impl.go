@@ -42,7 +42,8 @@ func (c *ConfigImpl) GetDatabaseConfig() *DatabaseConfig {
 
 func (c *ConfigImpl) GetAPIServerConfig() *APIServerConfig {
 	return &APIServerConfig{
-		ServerPort: viper.GetInt("SERVER_PORT"),
+		ServerPort:     viper.GetInt("SERVER_PORT"),
+		AllowedOrigins: viper.GetStringSlice("CORS_ALLOWED_ORIGINS"),
 	}
 }
 
@@ -51,3 +52,43 @@ func (c *ConfigImpl) GetLogConfig() *LogConfig {
 		Level: viper.GetString("LOG_LEVEL"),
 	}
 }
+
+func (c *ConfigImpl) GetTrashRetentionConfig() *TrashRetentionConfig {
+	return &TrashRetentionConfig{
+		RetentionDays: viper.GetInt("TRASH_RETENTION_DAYS"),
+		PurgeInterval: viper.GetDuration("TRASH_PURGE_INTERVAL"),
+		BatchSize:     viper.GetInt("TRASH_PURGE_BATCH_SIZE"),
+	}
+}
+
+func (c *ConfigImpl) GetTodoConfig() *TodoConfig {
+	return &TodoConfig{
+		RequireSubtasksDone: viper.GetBool("TODO_REQUIRE_SUBTASKS_DONE"),
+		AllowReopen:         viper.GetBool("TODO_ALLOW_REOPEN"),
+	}
+}
+
+func (c *ConfigImpl) GetAttachmentConfig() *AttachmentConfig {
+	return &AttachmentConfig{
+		Dir:          viper.GetString("ATTACHMENT_DIR"),
+		MaxSize:      viper.GetInt64("ATTACHMENT_MAX_SIZE"),
+		AllowedTypes: viper.GetStringSlice("ATTACHMENT_ALLOWED_TYPES"),
+	}
+}
+
+func (c *ConfigImpl) GetAuthConfig() *AuthConfig {
+	return &AuthConfig{
+		JWTSecret:       viper.GetString("AUTH_JWT_SECRET"),
+		AccessTokenTTL:  viper.GetDuration("AUTH_ACCESS_TOKEN_TTL"),
+		RefreshTokenTTL: viper.GetDuration("AUTH_REFRESH_TOKEN_TTL"),
+	}
+}
+
+func (c *ConfigImpl) GetIdempotencyConfig() *IdempotencyConfig {
+	return &IdempotencyConfig{
+		KeyTTL:        viper.GetDuration("IDEMPOTENCY_KEY_TTL"),
+		PurgeInterval: viper.GetDuration("IDEMPOTENCY_PURGE_INTERVAL"),
+		BatchSize:     viper.GetInt("IDEMPOTENCY_PURGE_BATCH_SIZE"),
+		MaxBodySize:   viper.GetInt64("IDEMPOTENCY_MAX_BODY_SIZE"),
+	}
+}
@@ -1,11 +1,18 @@
 package config
 
+import "time"
+
 // Config interface defines methods for loading and accessing configuration settings.
 type Config interface {
 	LoadConfig() error
 	GetDatabaseConfig() *DatabaseConfig
 	GetAPIServerConfig() *APIServerConfig
 	GetLogConfig() *LogConfig
+	GetTrashRetentionConfig() *TrashRetentionConfig
+	GetTodoConfig() *TodoConfig
+	GetAttachmentConfig() *AttachmentConfig
+	GetAuthConfig() *AuthConfig
+	GetIdempotencyConfig() *IdempotencyConfig
 }
 
 // DatabaseConfig 資料庫設定值
@@ -20,10 +27,46 @@ type DatabaseConfig struct {
 
 // APIServerConfig API 服務設定值
 type APIServerConfig struct {
-	ServerPort int // API 服務端口
+	ServerPort     int      // API 服務端口
+	AllowedOrigins []string // 允許跨域請求的來源，空值表示不允許跨域
 }
 
 // LogConfig 日誌設定值
 type LogConfig struct {
 	Level string // 日誌級別
 }
+
+// TrashRetentionConfig 垃圾桶保留設定值
+type TrashRetentionConfig struct {
+	RetentionDays int           // 軟刪除資料保留天數，<= 0 表示不自動清除
+	PurgeInterval time.Duration // 清除排程間隔
+	BatchSize     int           // 每批次永久刪除的最大筆數
+}
+
+// TodoConfig Todo 業務規則設定值
+type TodoConfig struct {
+	RequireSubtasksDone bool // 子任務未完成時，父任務不可標記為完成
+	AllowReopen         bool // 已完成的任務可重新開啟
+}
+
+// AttachmentConfig 附件設定值
+type AttachmentConfig struct {
+	Dir          string   // 附件檔案存放目錄
+	MaxSize      int64    // 單一附件大小上限，單位為byte
+	AllowedTypes []string // 允許上傳的MIME類型
+}
+
+// AuthConfig 帳號驗證設定值
+type AuthConfig struct {
+	JWTSecret       string        // JWT 簽章密鑰，至少32個字元
+	AccessTokenTTL  time.Duration // access token 有效時間
+	RefreshTokenTTL time.Duration // refresh token 有效時間
+}
+
+// IdempotencyConfig Idempotency-Key 設定值
+type IdempotencyConfig struct {
+	KeyTTL        time.Duration // 金鑰與回應保留時間，過期後可重新使用
+	PurgeInterval time.Duration // 清除過期金鑰的排程間隔
+	BatchSize     int           // 每批次刪除的最大筆數
+	MaxBodySize   int64         // 帶有金鑰的請求內容上限(bytes)，超過時回傳413
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -18,6 +19,7 @@ func TestConfigImpl(t *testing.T) {
 	// assert API server config info
 	apiServerConfig := config.GetAPIServerConfig()
 	assert.Equal(t, apiServerConfig.ServerPort, 8080, "API server port should be 8080")
+	assert.Equal(t, apiServerConfig.AllowedOrigins, []string{"http://localhost:3000"}, "CORS allowed origins should match")
 
 	// assert Database config info
 	dbConfig := config.GetDatabaseConfig()
@@ -31,4 +33,34 @@ func TestConfigImpl(t *testing.T) {
 	// assert Log config info
 	logConfig := config.GetLogConfig()
 	assert.Equal(t, logConfig.Level, "debug", "Log level should be debug")
+
+	// assert Trash retention config info
+	trashConfig := config.GetTrashRetentionConfig()
+	assert.Equal(t, trashConfig.RetentionDays, 30, "Trash retention should be 30 days")
+	assert.Equal(t, trashConfig.PurgeInterval, time.Hour, "Trash purge interval should be 1h")
+	assert.Equal(t, trashConfig.BatchSize, 500, "Trash purge batch size should be 500")
+
+	// assert Todo rules config info
+	todoConfig := config.GetTodoConfig()
+	assert.True(t, todoConfig.RequireSubtasksDone, "Subtasks should be required to be done")
+	assert.True(t, todoConfig.AllowReopen, "Done todos should be allowed to reopen")
+
+	// assert Attachment config info
+	attachmentConfig := config.GetAttachmentConfig()
+	assert.Equal(t, attachmentConfig.Dir, "./data/attachments", "Attachment dir should be ./data/attachments")
+	assert.Equal(t, attachmentConfig.MaxSize, int64(10485760), "Attachment max size should be 10MB")
+	assert.Equal(t, attachmentConfig.AllowedTypes, []string{"image/png", "image/jpeg", "image/gif", "image/webp", "application/pdf", "text/plain", "application/zip"}, "Attachment allowed types should match")
+
+	// assert Auth config info
+	authConfig := config.GetAuthConfig()
+	assert.Empty(t, authConfig.JWTSecret, "JWT secret should not be committed")
+	assert.Equal(t, authConfig.AccessTokenTTL, 15*time.Minute, "Access token TTL should be 15m")
+	assert.Equal(t, authConfig.RefreshTokenTTL, 720*time.Hour, "Refresh token TTL should be 720h")
+
+	// assert Idempotency config info
+	idempotencyConfig := config.GetIdempotencyConfig()
+	assert.Equal(t, idempotencyConfig.KeyTTL, 24*time.Hour, "Idempotency key TTL should be 24h")
+	assert.Equal(t, idempotencyConfig.PurgeInterval, time.Hour, "Idempotency purge interval should be 1h")
+	assert.Equal(t, idempotencyConfig.BatchSize, 500, "Idempotency purge batch size should be 500")
+	assert.Equal(t, idempotencyConfig.MaxBodySize, int64(12582912), "Idempotency max body size should be 12MB")
 }
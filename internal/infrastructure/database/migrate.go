@@ -0,0 +1,27 @@
+package database
+
+import (
+	"gorm.io/gorm"
+)
+
+// obsoleteIndexer is implemented by models that replaced an index, AutoMigrate only adds
+// missing indexes so the old ones are dropped before migrating the model
+type obsoleteIndexer interface {
+	ObsoleteIndexes() []string
+}
+
+// migrateModel drops the obsolete indexes of a model and auto migrates it
+func migrateModel(db *gorm.DB, model interface{}) error {
+	if indexer, ok := model.(obsoleteIndexer); ok {
+		for _, name := range indexer.ObsoleteIndexes() {
+			if !db.Migrator().HasIndex(model, name) {
+				continue
+			}
+			if err := db.Migrator().DropIndex(model, name); err != nil {
+				return err
+			}
+		}
+	}
+
+	return db.AutoMigrate(model)
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// APIKey represents the GORM model for api_keys table
+// API keys are never deleted, a revoked key keeps its row so its prefix stays taken
+type APIKey struct {
+	ID         uint       `gorm:"primarykey"`
+	UserID     uint       `gorm:"not null;index;comment:所屬使用者ID" json:"user_id"`
+	Name       string     `gorm:"type:varchar(400);not null;comment:金鑰名稱，最多100個字符" json:"name"`
+	Prefix     string     `gorm:"type:varchar(20);not null;uniqueIndex;comment:金鑰開頭，用於查詢與顯示" json:"prefix"`
+	KeyHash    string     `gorm:"type:char(64);not null;comment:金鑰SHA-256雜湊" json:"key_hash"`
+	Scopes     string     `gorm:"type:varchar(200);not null;comment:權限範圍，以逗號分隔" json:"scopes"`
+	LastUsedAt *time.Time `gorm:"type:timestamp;null;comment:最後使用時間，UTC時間" json:"last_used_at"`
+	RevokedAt  *time.Time `gorm:"type:timestamp;null;comment:撤銷時間，UTC時間" json:"revoked_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+// TableName specifies the table name for GORM
+func (APIKey) TableName() string {
+	return "api_keys"
+}
+
+// APIKeyEntityToModel converts domain entity to GORM model
+func APIKeyEntityToModel(entityAPIKey *entity.APIKey) *APIKey {
+	if entityAPIKey == nil {
+		return nil
+	}
+
+	scopes := make([]string, len(entityAPIKey.Scopes))
+	for i, scope := range entityAPIKey.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	return &APIKey{
+		ID:         entityAPIKey.ID,
+		UserID:     entityAPIKey.UserID,
+		Name:       entityAPIKey.Name,
+		Prefix:     entityAPIKey.Prefix,
+		KeyHash:    entityAPIKey.KeyHash,
+		Scopes:     strings.Join(scopes, ","),
+		LastUsedAt: entityAPIKey.LastUsedAt,
+		RevokedAt:  entityAPIKey.RevokedAt,
+		CreatedAt:  entityAPIKey.CreatedAt,
+	}
+}
+
+// APIKeyModelToEntity converts GORM model to domain entity
+func APIKeyModelToEntity(modelAPIKey *APIKey) *entity.APIKey {
+	if modelAPIKey == nil {
+		return nil
+	}
+
+	var scopes []entity.APIKeyScope
+	for _, scope := range strings.Split(modelAPIKey.Scopes, ",") {
+		if scope != "" {
+			scopes = append(scopes, entity.APIKeyScope(scope))
+		}
+	}
+
+	return &entity.APIKey{
+		ID:         modelAPIKey.ID,
+		UserID:     modelAPIKey.UserID,
+		Name:       modelAPIKey.Name,
+		Prefix:     modelAPIKey.Prefix,
+		KeyHash:    modelAPIKey.KeyHash,
+		Scopes:     scopes,
+		LastUsedAt: modelAPIKey.LastUsedAt,
+		RevokedAt:  modelAPIKey.RevokedAt,
+		CreatedAt:  modelAPIKey.CreatedAt,
+	}
+}
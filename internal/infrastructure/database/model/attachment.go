@@ -0,0 +1,77 @@
+package model
+
+import (
+	"time"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// Attachment represents the GORM model for todo_attachments table
+// Rows outlive a purged todo until its blob has been deleted from the blob store
+type Attachment struct {
+	ID          uint      `gorm:"primarykey"`
+	TodoID      uint      `gorm:"not null;index;comment:所屬Todo ID" json:"todo_id"`
+	Name        string    `gorm:"type:varchar(255);not null;comment:檔案名稱" json:"name"`
+	Size        int64     `gorm:"not null;comment:檔案大小，單位為byte" json:"size"`
+	ContentType string    `gorm:"type:varchar(100);not null;comment:檔案MIME類型" json:"content_type"`
+	SHA256      string    `gorm:"column:sha256;type:char(64);not null;comment:檔案內容的SHA-256，十六進位" json:"sha256"`
+	StorageKey  string    `gorm:"type:varchar(255);not null;uniqueIndex;comment:檔案在儲存空間中的key" json:"storage_key"`
+	Uploader    string    `gorm:"type:varchar(100);not null;default:'';comment:上傳者，空值為未知" json:"uploader"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Attachment) TableName() string {
+	return "todo_attachments"
+}
+
+// AttachmentEntityToModel converts domain entity to GORM model
+func AttachmentEntityToModel(entityAttachment *entity.Attachment) *Attachment {
+	if entityAttachment == nil {
+		return nil
+	}
+
+	return &Attachment{
+		ID:          entityAttachment.ID,
+		TodoID:      entityAttachment.TodoID,
+		Name:        entityAttachment.Name,
+		Size:        entityAttachment.Size,
+		ContentType: entityAttachment.ContentType,
+		SHA256:      entityAttachment.SHA256,
+		StorageKey:  entityAttachment.StorageKey,
+		Uploader:    entityAttachment.Uploader,
+		CreatedAt:   entityAttachment.CreatedAt,
+	}
+}
+
+// AttachmentModelToEntity converts GORM model to domain entity
+func AttachmentModelToEntity(modelAttachment *Attachment) *entity.Attachment {
+	if modelAttachment == nil {
+		return nil
+	}
+
+	return &entity.Attachment{
+		ID:          modelAttachment.ID,
+		TodoID:      modelAttachment.TodoID,
+		Name:        modelAttachment.Name,
+		Size:        modelAttachment.Size,
+		ContentType: modelAttachment.ContentType,
+		SHA256:      modelAttachment.SHA256,
+		StorageKey:  modelAttachment.StorageKey,
+		Uploader:    modelAttachment.Uploader,
+		CreatedAt:   modelAttachment.CreatedAt,
+	}
+}
+
+// AttachmentModelsToEntities converts slice of GORM models to slice of domain entities
+func AttachmentModelsToEntities(modelAttachments []*Attachment) []*entity.Attachment {
+	if modelAttachments == nil {
+		return nil
+	}
+
+	entities := make([]*entity.Attachment, len(modelAttachments))
+	for i, model := range modelAttachments {
+		entities[i] = AttachmentModelToEntity(model)
+	}
+	return entities
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"time"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// ChecklistItem represents the GORM model for checklist_items table
+// Items are hard deleted together with their todo when it is purged
+type ChecklistItem struct {
+	ID        uint      `gorm:"primarykey"`
+	TodoID    uint      `gorm:"not null;index:idx_checklist_items_todo_position,priority:1;comment:所屬Todo ID" json:"todo_id"`
+	Text      string    `gorm:"type:varchar(400);not null;comment:項目內容，最多100個字符" json:"text"`
+	Done      bool      `gorm:"not null;default:false;comment:是否完成" json:"done"`
+	Position  int       `gorm:"not null;default:0;index:idx_checklist_items_todo_position,priority:2;comment:排序位置，從0開始" json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name for GORM
+func (ChecklistItem) TableName() string {
+	return "checklist_items"
+}
+
+// ChecklistItemEntityToModel converts domain entity to GORM model
+func ChecklistItemEntityToModel(entityItem *entity.ChecklistItem) *ChecklistItem {
+	if entityItem == nil {
+		return nil
+	}
+
+	return &ChecklistItem{
+		ID:        entityItem.ID,
+		TodoID:    entityItem.TodoID,
+		Text:      entityItem.Text,
+		Done:      entityItem.Done,
+		Position:  entityItem.Position,
+		CreatedAt: entityItem.CreatedAt,
+		UpdatedAt: entityItem.UpdatedAt,
+	}
+}
+
+// ChecklistItemModelToEntity converts GORM model to domain entity
+func ChecklistItemModelToEntity(modelItem *ChecklistItem) *entity.ChecklistItem {
+	if modelItem == nil {
+		return nil
+	}
+
+	return &entity.ChecklistItem{
+		ID:        modelItem.ID,
+		TodoID:    modelItem.TodoID,
+		Text:      modelItem.Text,
+		Done:      modelItem.Done,
+		Position:  modelItem.Position,
+		CreatedAt: modelItem.CreatedAt,
+		UpdatedAt: modelItem.UpdatedAt,
+	}
+}
+
+// ChecklistItemModelsToEntities converts slice of GORM models to slice of domain entities
+func ChecklistItemModelsToEntities(modelItems []*ChecklistItem) []*entity.ChecklistItem {
+	if modelItems == nil {
+		return nil
+	}
+
+	entities := make([]*entity.ChecklistItem, len(modelItems))
+	for i, model := range modelItems {
+		entities[i] = ChecklistItemModelToEntity(model)
+	}
+	return entities
+}
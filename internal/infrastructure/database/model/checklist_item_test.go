@@ -0,0 +1,44 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+func TestChecklistItem_TableName(t *testing.T) {
+	assert.Equal(t, "checklist_items", ChecklistItem{}.TableName())
+}
+
+func TestChecklistItem_Conversions(t *testing.T) {
+	now := time.Now().UTC()
+	item := &entity.ChecklistItem{ID: 1, TodoID: 2, Text: "買牛奶", Done: true, Position: 3, CreatedAt: now, UpdatedAt: now}
+
+	modelItem := ChecklistItemEntityToModel(item)
+	assert.Equal(t, &ChecklistItem{ID: 1, TodoID: 2, Text: "買牛奶", Done: true, Position: 3, CreatedAt: now, UpdatedAt: now}, modelItem)
+	assert.Equal(t, item, ChecklistItemModelToEntity(modelItem))
+
+	assert.Nil(t, ChecklistItemEntityToModel(nil))
+	assert.Nil(t, ChecklistItemModelToEntity(nil))
+	assert.Nil(t, ChecklistItemModelsToEntities(nil))
+	assert.Equal(t, []*entity.ChecklistItem{item}, ChecklistItemModelsToEntities([]*ChecklistItem{modelItem}))
+}
+
+func TestTodo_ParentAndChecklistConversions(t *testing.T) {
+	parentID := uint(7)
+	modelTodo := &Todo{
+		Title:     "測試標題",
+		Status:    "pending",
+		ParentID:  &parentID,
+		Checklist: []ChecklistItem{{ID: 1, TodoID: 8, Text: "買牛奶"}},
+	}
+
+	entityTodo := ModelToEntity(modelTodo)
+	assert.Equal(t, &parentID, entityTodo.ParentID)
+	assert.Equal(t, []entity.ChecklistItem{{ID: 1, TodoID: 8, Text: "買牛奶"}}, entityTodo.Checklist)
+
+	assert.Equal(t, &parentID, EntityToModel(entityTodo).ParentID)
+}
@@ -0,0 +1,92 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// Comment represents the GORM model for todo_comments table
+// Comments are soft deleted, and hard deleted together with their todo when it is purged
+type Comment struct {
+	ID        uint           `gorm:"primarykey"`
+	TodoID    uint           `gorm:"not null;index;comment:所屬Todo ID" json:"todo_id"`
+	Author    string         `gorm:"type:varchar(100);not null;default:'';comment:留言者，空值為未知" json:"author"`
+	AuthorID  uint           `gorm:"not null;default:0;index;comment:留言者使用者ID，0為帳號功能上線前建立" json:"author_id"`
+	Body      string         `gorm:"type:text;not null;comment:留言內容，最多2000個字符" json:"body"`
+	EditedAt  *time.Time     `gorm:"type:timestamp;null;comment:最後一次編輯內容的時間，UTC時間" json:"edited_at"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Comment) TableName() string {
+	return "todo_comments"
+}
+
+// CommentEntityToModel converts domain entity to GORM model
+func CommentEntityToModel(entityComment *entity.Comment) *Comment {
+	if entityComment == nil {
+		return nil
+	}
+
+	model := &Comment{
+		ID:        entityComment.ID,
+		TodoID:    entityComment.TodoID,
+		Author:    entityComment.Author,
+		AuthorID:  entityComment.AuthorID,
+		Body:      entityComment.Body,
+		EditedAt:  entityComment.EditedAt,
+		CreatedAt: entityComment.CreatedAt,
+		UpdatedAt: entityComment.UpdatedAt,
+	}
+
+	if entityComment.DeletedAt != nil {
+		model.DeletedAt = gorm.DeletedAt{
+			Time:  *entityComment.DeletedAt,
+			Valid: true,
+		}
+	}
+
+	return model
+}
+
+// CommentModelToEntity converts GORM model to domain entity
+func CommentModelToEntity(modelComment *Comment) *entity.Comment {
+	if modelComment == nil {
+		return nil
+	}
+
+	entityComment := &entity.Comment{
+		ID:        modelComment.ID,
+		TodoID:    modelComment.TodoID,
+		Author:    modelComment.Author,
+		AuthorID:  modelComment.AuthorID,
+		Body:      modelComment.Body,
+		EditedAt:  modelComment.EditedAt,
+		CreatedAt: modelComment.CreatedAt,
+		UpdatedAt: modelComment.UpdatedAt,
+	}
+
+	if modelComment.DeletedAt.Valid {
+		entityComment.DeletedAt = &modelComment.DeletedAt.Time
+	}
+
+	return entityComment
+}
+
+// CommentModelsToEntities converts slice of GORM models to slice of domain entities
+func CommentModelsToEntities(modelComments []*Comment) []*entity.Comment {
+	if modelComments == nil {
+		return nil
+	}
+
+	entities := make([]*entity.Comment, len(modelComments))
+	for i, model := range modelComments {
+		entities[i] = CommentModelToEntity(model)
+	}
+	return entities
+}
@@ -0,0 +1,76 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// IdempotencyRecord represents the GORM model for idempotency_keys table
+// Rows are removed by the cleanup job once they expire
+type IdempotencyRecord struct {
+	ID          uint      `gorm:"primarykey"`
+	UserID      uint      `gorm:"not null;uniqueIndex:idx_idempotency_user_key;comment:送出請求的使用者ID" json:"user_id"`
+	Key         string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_idempotency_user_key;comment:Idempotency-Key 標頭值" json:"key"`
+	RequestHash string    `gorm:"type:char(64);not null;comment:請求SHA-256雜湊，用於拒絕不同內容的重複使用" json:"request_hash"`
+	StatusCode  int       `gorm:"not null;default:0;comment:回應狀態碼，0 表示請求仍在處理中" json:"status_code"`
+	Headers     string    `gorm:"type:text;comment:回應標頭，JSON格式" json:"headers"`
+	Body        []byte    `gorm:"comment:回應內容" json:"body"`
+	CreatedAt   time.Time `json:"created_at"`
+	ExpiresAt   time.Time `gorm:"type:timestamp;not null;index;comment:過期時間，UTC時間" json:"expires_at"`
+}
+
+// TableName specifies the table name for GORM
+func (IdempotencyRecord) TableName() string {
+	return "idempotency_keys"
+}
+
+// IdempotencyRecordEntityToModel converts domain entity to GORM model
+func IdempotencyRecordEntityToModel(entityRecord *entity.IdempotencyRecord) *IdempotencyRecord {
+	if entityRecord == nil {
+		return nil
+	}
+
+	headers := ""
+	if len(entityRecord.Headers) > 0 {
+		data, _ := json.Marshal(entityRecord.Headers) // a map of strings always marshals
+		headers = string(data)
+	}
+
+	return &IdempotencyRecord{
+		ID:          entityRecord.ID,
+		UserID:      entityRecord.UserID,
+		Key:         entityRecord.Key,
+		RequestHash: entityRecord.RequestHash,
+		StatusCode:  entityRecord.StatusCode,
+		Headers:     headers,
+		Body:        entityRecord.Body,
+		CreatedAt:   entityRecord.CreatedAt,
+		ExpiresAt:   entityRecord.ExpiresAt,
+	}
+}
+
+// IdempotencyRecordModelToEntity converts GORM model to domain entity
+func IdempotencyRecordModelToEntity(modelRecord *IdempotencyRecord) *entity.IdempotencyRecord {
+	if modelRecord == nil {
+		return nil
+	}
+
+	var headers map[string]string
+	if modelRecord.Headers != "" {
+		_ = json.Unmarshal([]byte(modelRecord.Headers), &headers) // a broken value replays without headers
+	}
+
+	return &entity.IdempotencyRecord{
+		ID:          modelRecord.ID,
+		UserID:      modelRecord.UserID,
+		Key:         modelRecord.Key,
+		RequestHash: modelRecord.RequestHash,
+		StatusCode:  modelRecord.StatusCode,
+		Headers:     headers,
+		Body:        modelRecord.Body,
+		CreatedAt:   modelRecord.CreatedAt,
+		ExpiresAt:   modelRecord.ExpiresAt,
+	}
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+func TestIdempotencyRecord_TableName(t *testing.T) {
+	assert.Equal(t, "idempotency_keys", IdempotencyRecord{}.TableName())
+}
+
+func TestIdempotencyRecord_Conversions(t *testing.T) {
+	now := time.Now().UTC()
+	record := &entity.IdempotencyRecord{
+		ID:          1,
+		UserID:      2,
+		Key:         "k",
+		RequestHash: "abc",
+		StatusCode:  201,
+		Headers:     map[string]string{"Content-Type": "application/json"},
+		Body:        []byte(`{"id":3}`),
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(time.Hour),
+	}
+
+	modelRecord := IdempotencyRecordEntityToModel(record)
+	assert.Equal(t, `{"Content-Type":"application/json"}`, modelRecord.Headers)
+	assert.Equal(t, record, IdempotencyRecordModelToEntity(modelRecord))
+
+	assert.Nil(t, IdempotencyRecordEntityToModel(nil))
+	assert.Nil(t, IdempotencyRecordModelToEntity(nil))
+}
+
+func TestIdempotencyRecord_PendingConversions(t *testing.T) {
+	record, err := entity.NewIdempotencyRecord(2, "k", "abc", time.Now(), time.Hour)
+	assert.NoError(t, err)
+
+	modelRecord := IdempotencyRecordEntityToModel(record)
+	assert.Empty(t, modelRecord.Headers)
+	assert.Equal(t, 0, modelRecord.StatusCode)
+	assert.Nil(t, IdempotencyRecordModelToEntity(modelRecord).Headers)
+}
@@ -0,0 +1,76 @@
+package model
+
+import (
+	"time"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// Project represents the GORM model for projects table
+// Projects are hard deleted, their todos are detached or moved to the trash first
+type Project struct {
+	ID          uint      `gorm:"primarykey"`
+	WorkspaceID uint      `gorm:"not null;default:0;uniqueIndex:idx_projects_workspace_name,priority:1;comment:所屬工作區ID，0為工作區功能上線前建立" json:"workspace_id"`
+	Name        string    `gorm:"type:varchar(200);not null;uniqueIndex:idx_projects_workspace_name,priority:2;comment:專案名稱，最多50個字符，同一工作區內不可重複" json:"name"`
+	Description *string   `gorm:"type:text;comment:專案描述，最多200個字符" json:"description"`
+	WorkflowID  uint      `gorm:"not null;comment:專案todo使用的工作流程ID;index" json:"workflow_id"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Project) TableName() string {
+	return "projects"
+}
+
+// ObsoleteIndexes lists the indexes dropped on migration, names were unique across workspaces
+func (Project) ObsoleteIndexes() []string {
+	return []string{"idx_projects_name"}
+}
+
+// ProjectEntityToModel converts domain entity to GORM model
+func ProjectEntityToModel(entityProject *entity.Project) *Project {
+	if entityProject == nil {
+		return nil
+	}
+
+	return &Project{
+		ID:          entityProject.ID,
+		Name:        entityProject.Name,
+		Description: entityProject.Description,
+		WorkflowID:  entityProject.WorkflowID,
+		WorkspaceID: entityProject.WorkspaceID,
+		CreatedAt:   entityProject.CreatedAt,
+		UpdatedAt:   entityProject.UpdatedAt,
+	}
+}
+
+// ProjectModelToEntity converts GORM model to domain entity
+func ProjectModelToEntity(modelProject *Project) *entity.Project {
+	if modelProject == nil {
+		return nil
+	}
+
+	return &entity.Project{
+		ID:          modelProject.ID,
+		Name:        modelProject.Name,
+		Description: modelProject.Description,
+		WorkflowID:  modelProject.WorkflowID,
+		WorkspaceID: modelProject.WorkspaceID,
+		CreatedAt:   modelProject.CreatedAt,
+		UpdatedAt:   modelProject.UpdatedAt,
+	}
+}
+
+// ProjectModelsToEntities converts slice of GORM models to slice of domain entities
+func ProjectModelsToEntities(modelProjects []*Project) []*entity.Project {
+	if modelProjects == nil {
+		return nil
+	}
+
+	entities := make([]*entity.Project, len(modelProjects))
+	for i, model := range modelProjects {
+		entities[i] = ProjectModelToEntity(model)
+	}
+	return entities
+}
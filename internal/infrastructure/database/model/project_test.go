@@ -0,0 +1,40 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+func TestProject_TableName(t *testing.T) {
+	assert.Equal(t, "projects", Project{}.TableName())
+}
+
+func TestProject_Conversions(t *testing.T) {
+	now := time.Now().UTC()
+	description := "季度目標"
+	project := &entity.Project{ID: 1, Name: "Q3", Description: &description, WorkflowID: 2, WorkspaceID: 5, CreatedAt: now, UpdatedAt: now}
+
+	modelProject := ProjectEntityToModel(project)
+	assert.Equal(t, &Project{ID: 1, WorkspaceID: 5, Name: "Q3", Description: &description, WorkflowID: 2, CreatedAt: now, UpdatedAt: now}, modelProject)
+	assert.Equal(t, project, ProjectModelToEntity(modelProject))
+
+	assert.Nil(t, ProjectEntityToModel(nil))
+	assert.Nil(t, ProjectModelToEntity(nil))
+	assert.Nil(t, ProjectModelsToEntities(nil))
+	assert.Equal(t, []*entity.Project{project}, ProjectModelsToEntities([]*Project{modelProject}))
+}
+
+func TestTodo_ProjectConversions(t *testing.T) {
+	projectID := uint(3)
+	todo, err := entity.NewTodo("測試標題", nil, nil, nil)
+	assert.NoError(t, err)
+	todo.ProjectID = &projectID
+
+	modelTodo := EntityToModel(todo)
+	assert.Equal(t, &projectID, modelTodo.ProjectID)
+	assert.Equal(t, &projectID, ModelToEntity(modelTodo).ProjectID)
+}
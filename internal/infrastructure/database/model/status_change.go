@@ -0,0 +1,74 @@
+package model
+
+import (
+	"time"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// StatusChange represents the GORM model for todo_status_history table, which also records assignee changes
+// History rows are append-only and hard deleted together with their todo when it is purged
+type StatusChange struct {
+	ID         uint      `gorm:"primarykey"`
+	TodoID     uint      `gorm:"not null;index:idx_todo_status_history_todo_changed,priority:1;comment:所屬Todo ID" json:"todo_id"`
+	Event      string    `gorm:"type:varchar(30);not null;default:'status_changed';comment:事件 status_changed/assigned/unassigned" json:"event"`
+	FromStatus string    `gorm:"type:varchar(30);not null;default:'';comment:原狀態，負責人變更為空值" json:"from_status"`
+	ToStatus   string    `gorm:"type:varchar(30);not null;default:'';comment:新狀態，負責人變更為空值" json:"to_status"`
+	AssigneeID *uint     `gorm:"null;comment:被指派或取消指派的使用者ID" json:"assignee_id"`
+	Actor      string    `gorm:"type:varchar(100);not null;default:'';comment:操作者，空值為未知" json:"actor"`
+	ChangedAt  time.Time `gorm:"not null;index:idx_todo_status_history_todo_changed,priority:2;comment:變更時間，UTC時間" json:"changed_at"`
+}
+
+// TableName specifies the table name for GORM
+func (StatusChange) TableName() string {
+	return "todo_status_history"
+}
+
+// StatusChangeEntityToModel converts domain entity to GORM model
+func StatusChangeEntityToModel(entityChange *entity.StatusChange) *StatusChange {
+	if entityChange == nil {
+		return nil
+	}
+
+	return &StatusChange{
+		ID:         entityChange.ID,
+		TodoID:     entityChange.TodoID,
+		Event:      string(entityChange.Event),
+		FromStatus: string(entityChange.FromStatus),
+		ToStatus:   string(entityChange.ToStatus),
+		AssigneeID: entityChange.AssigneeID,
+		Actor:      entityChange.Actor,
+		ChangedAt:  entityChange.ChangedAt,
+	}
+}
+
+// StatusChangeModelToEntity converts GORM model to domain entity
+func StatusChangeModelToEntity(modelChange *StatusChange) *entity.StatusChange {
+	if modelChange == nil {
+		return nil
+	}
+
+	return &entity.StatusChange{
+		ID:         modelChange.ID,
+		TodoID:     modelChange.TodoID,
+		Event:      entity.HistoryEvent(modelChange.Event),
+		FromStatus: entity.TodoStatus(modelChange.FromStatus),
+		ToStatus:   entity.TodoStatus(modelChange.ToStatus),
+		AssigneeID: modelChange.AssigneeID,
+		Actor:      modelChange.Actor,
+		ChangedAt:  modelChange.ChangedAt,
+	}
+}
+
+// StatusChangeModelsToEntities converts slice of GORM models to slice of domain entities
+func StatusChangeModelsToEntities(modelChanges []*StatusChange) []*entity.StatusChange {
+	if modelChanges == nil {
+		return nil
+	}
+
+	entities := make([]*entity.StatusChange, len(modelChanges))
+	for i, model := range modelChanges {
+		entities[i] = StatusChangeModelToEntity(model)
+	}
+	return entities
+}
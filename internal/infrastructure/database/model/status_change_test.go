@@ -0,0 +1,53 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+func TestStatusChange_TableName(t *testing.T) {
+	assert.Equal(t, "todo_status_history", StatusChange{}.TableName())
+}
+
+func TestStatusChange_Conversions(t *testing.T) {
+	now := time.Now().UTC()
+	change := &entity.StatusChange{ID: 1, TodoID: 2, Event: entity.EventStatusChanged, FromStatus: entity.StatusDoing, ToStatus: entity.StatusDone, Actor: "alice", ChangedAt: now}
+
+	modelChange := StatusChangeEntityToModel(change)
+	assert.Equal(t, &StatusChange{ID: 1, TodoID: 2, Event: "status_changed", FromStatus: "doing", ToStatus: "done", Actor: "alice", ChangedAt: now}, modelChange)
+	assert.Equal(t, change, StatusChangeModelToEntity(modelChange))
+
+	assert.Nil(t, StatusChangeEntityToModel(nil))
+	assert.Nil(t, StatusChangeModelToEntity(nil))
+	assert.Nil(t, StatusChangeModelsToEntities(nil))
+	assert.Equal(t, []*entity.StatusChange{change}, StatusChangeModelsToEntities([]*StatusChange{modelChange}))
+}
+
+func TestStatusChange_AssigneeConversions(t *testing.T) {
+	now := time.Now().UTC()
+	change := entity.NewAssigneeChange(2, entity.EventAssigned, 7, "alice", now)
+
+	modelChange := StatusChangeEntityToModel(change)
+	assert.Equal(t, "assigned", modelChange.Event)
+	assert.Equal(t, uint(7), *modelChange.AssigneeID)
+	assert.Empty(t, modelChange.FromStatus)
+	assert.Equal(t, change, StatusChangeModelToEntity(modelChange))
+}
+
+func TestTodo_StatusTimeConversions(t *testing.T) {
+	startedAt := time.Now().UTC().Add(-time.Hour)
+	completedAt := time.Now().UTC()
+	modelTodo := &Todo{Title: "測試標題", Status: "done", StartedAt: &startedAt, CompletedAt: &completedAt}
+
+	entityTodo := ModelToEntity(modelTodo)
+	assert.Equal(t, &startedAt, entityTodo.StartedAt)
+	assert.Equal(t, &completedAt, entityTodo.CompletedAt)
+
+	converted := EntityToModel(entityTodo)
+	assert.Equal(t, &startedAt, converted.StartedAt)
+	assert.Equal(t, &completedAt, converted.CompletedAt)
+}
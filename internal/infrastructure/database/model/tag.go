@@ -0,0 +1,84 @@
+package model
+
+import (
+	"time"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// Tag represents the GORM model for tag table
+// Tags are hard deleted, so there is no DeletedAt column blocking the unique name
+type Tag struct {
+	ID          uint      `gorm:"primarykey"`
+	WorkspaceID uint      `gorm:"not null;default:0;uniqueIndex:idx_tags_workspace_name,priority:1;comment:所屬工作區ID，0為工作區功能上線前建立" json:"workspace_id"`
+	Name        string    `gorm:"type:varchar(120);not null;uniqueIndex:idx_tags_workspace_name,priority:2;comment:標籤名稱，最多30個字符，同一工作區內不可重複" json:"name"`
+	Color       string    `gorm:"type:varchar(7);not null;default:'#808080';comment:標籤顏色" json:"color"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Tag) TableName() string {
+	return "tags"
+}
+
+// ObsoleteIndexes lists the indexes dropped on migration, names were unique across workspaces
+func (Tag) ObsoleteIndexes() []string {
+	return []string{"idx_tags_name"}
+}
+
+// TodoTag represents the todo_tags join table between todos and tags
+type TodoTag struct {
+	TodoID uint `gorm:"primaryKey;autoIncrement:false"`
+	TagID  uint `gorm:"primaryKey;autoIncrement:false;index"`
+}
+
+// TableName specifies the table name for GORM
+func (TodoTag) TableName() string {
+	return "todo_tags"
+}
+
+// TagEntityToModel converts domain entity to GORM model
+func TagEntityToModel(entityTag *entity.Tag) *Tag {
+	if entityTag == nil {
+		return nil
+	}
+
+	return &Tag{
+		ID:          entityTag.ID,
+		WorkspaceID: entityTag.WorkspaceID,
+		Name:        entityTag.Name,
+		Color:       entityTag.Color,
+		CreatedAt:   entityTag.CreatedAt,
+		UpdatedAt:   entityTag.UpdatedAt,
+	}
+}
+
+// TagModelToEntity converts GORM model to domain entity
+func TagModelToEntity(modelTag *Tag) *entity.Tag {
+	if modelTag == nil {
+		return nil
+	}
+
+	return &entity.Tag{
+		ID:          modelTag.ID,
+		Name:        modelTag.Name,
+		Color:       modelTag.Color,
+		WorkspaceID: modelTag.WorkspaceID,
+		CreatedAt:   modelTag.CreatedAt,
+		UpdatedAt:   modelTag.UpdatedAt,
+	}
+}
+
+// TagModelsToEntities converts slice of GORM models to slice of domain entities
+func TagModelsToEntities(modelTags []*Tag) []*entity.Tag {
+	if modelTags == nil {
+		return nil
+	}
+
+	entities := make([]*entity.Tag, len(modelTags))
+	for i, model := range modelTags {
+		entities[i] = TagModelToEntity(model)
+	}
+	return entities
+}
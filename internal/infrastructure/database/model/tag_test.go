@@ -0,0 +1,39 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+func TestTag_TableName(t *testing.T) {
+	assert.Equal(t, "tags", Tag{}.TableName())
+	assert.Equal(t, "todo_tags", TodoTag{}.TableName())
+}
+
+func TestTag_Conversions(t *testing.T) {
+	now := time.Now().UTC()
+	tag := &entity.Tag{ID: 1, Name: "bug", Color: "#ff0000", WorkspaceID: 5, CreatedAt: now, UpdatedAt: now}
+
+	modelTag := TagEntityToModel(tag)
+	assert.Equal(t, &Tag{ID: 1, WorkspaceID: 5, Name: "bug", Color: "#ff0000", CreatedAt: now, UpdatedAt: now}, modelTag)
+	assert.Equal(t, tag, TagModelToEntity(modelTag))
+
+	assert.Nil(t, TagEntityToModel(nil))
+	assert.Nil(t, TagModelToEntity(nil))
+	assert.Nil(t, TagModelsToEntities(nil))
+	assert.Equal(t, []*entity.Tag{tag}, TagModelsToEntities([]*Tag{modelTag}))
+}
+
+func TestTodo_TagConversions(t *testing.T) {
+	todo, err := entity.NewTodo("測試標題", nil, nil, nil)
+	assert.NoError(t, err)
+	todo.SetTags([]entity.Tag{{ID: 1, Name: "bug", Color: "#ff0000"}})
+
+	modelTodo := EntityToModel(todo)
+	assert.Equal(t, []Tag{{ID: 1, Name: "bug", Color: "#ff0000"}}, modelTodo.Tags)
+	assert.Equal(t, todo.Tags, ModelToEntity(modelTodo).Tags)
+}
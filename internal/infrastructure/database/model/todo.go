@@ -10,10 +10,29 @@ import (
 // Todo represents the GORM model for todo table
 type Todo struct {
 	gorm.Model
-	Title       string     `gorm:"type:varchar(80);not null;comment:Todo標題，最多20個中文字符" json:"title"`
-	Description *string    `gorm:"type:text;comment:Todo描述，最多100個中文字符" json:"description"`
-	Status      string     `gorm:"type:varchar(20);not null;default:'pending';comment:Todo狀態;index" json:"status"`
-	DueDate     *time.Time `gorm:"type:timestamp;null;comment:到期日期，UTC時間;index" json:"due_date"`
+	OwnerID            uint             `gorm:"not null;default:0;comment:擁有者使用者ID，0為帳號功能上線前建立;index" json:"owner_id"`
+	WorkspaceID        uint             `gorm:"not null;default:0;comment:所屬工作區ID，0為工作區功能上線前建立;index" json:"workspace_id"`
+	Title              string           `gorm:"type:varchar(80);not null;comment:Todo標題，最多20個中文字符" json:"title"`
+	Description        *string          `gorm:"type:text;comment:Todo描述，最多100個中文字符" json:"description"`
+	Status             string           `gorm:"type:varchar(30);not null;default:'pending';comment:Todo狀態，所屬工作流程的狀態名稱;index" json:"status"`
+	WorkflowID         uint             `gorm:"not null;default:0;comment:工作流程ID;index" json:"workflow_id"`
+	ProjectID          *uint            `gorm:"null;comment:所屬專案ID，未分組為空;index" json:"project_id"`
+	Position           string           `gorm:"type:varchar(255);not null;default:'';comment:手動排序的排名鍵，以字串比較排序;index" json:"position"`
+	Priority           int              `gorm:"type:smallint;not null;default:0;comment:優先級 0=none 1=low 2=medium 3=high 4=urgent;index" json:"priority"`
+	DueDate            *time.Time       `gorm:"type:timestamp;null;comment:到期日期，UTC時間;index" json:"due_date"`
+	ParentID           *uint            `gorm:"null;comment:父Todo ID，子任務才有值;index" json:"parent_id"`
+	Tags               []Tag            `gorm:"many2many:todo_tags" json:"tags"`
+	Checklist          []ChecklistItem  `gorm:"foreignKey:TodoID" json:"checklist"`
+	Blockers           []TodoDependency `gorm:"foreignKey:TodoID" json:"blockers"`
+	Assignees          []TodoAssignee   `gorm:"foreignKey:TodoID" json:"assignees"`
+	CommentCount       int              `gorm:"-" json:"comment_count"` // filled by the repository with one grouped query
+	Recurrence         *string          `gorm:"type:varchar(255);null;comment:重複規則，RFC 5545 RRULE子集" json:"recurrence"`
+	RecurrenceTimezone string           `gorm:"type:varchar(64);not null;default:'';comment:重複規則時區，空值為UTC" json:"recurrence_timezone"`
+	SeriesID           *uint            `gorm:"null;comment:重複系列第一個Todo ID;index" json:"series_id"`
+	Occurrence         int              `gorm:"not null;default:0;comment:在重複系列中的序號，從1開始" json:"occurrence"`
+	StartedAt          *time.Time       `gorm:"type:timestamp;null;comment:第一次開始進行的時間，UTC時間" json:"started_at"`
+	CompletedAt        *time.Time       `gorm:"type:timestamp;null;comment:最後一次完成的時間，UTC時間" json:"completed_at"`
+	Version            uint             `gorm:"not null;default:1;comment:版本號，每次寫入遞增，用於樂觀鎖" json:"version"`
 }
 
 // TableName specifies the table name for GORM
@@ -21,15 +40,6 @@ func (Todo) TableName() string {
 	return "todos"
 }
 
-// BeforeCreate is a GORM hook that runs before creating a record
-func (t *Todo) BeforeCreate(tx *gorm.DB) error {
-	// Ensure status is valid, default to pending if invalid
-	if t.Status != "pending" && t.Status != "doing" && t.Status != "done" {
-		t.Status = "pending"
-	}
-	return nil
-}
-
 // EntityToModel converts domain entity to GORM model
 func EntityToModel(entityTodo *entity.Todo) *Todo {
 	if entityTodo == nil {
@@ -42,10 +52,51 @@ func EntityToModel(entityTodo *entity.Todo) *Todo {
 			CreatedAt: entityTodo.CreatedAt,
 			UpdatedAt: entityTodo.UpdatedAt,
 		},
+		OwnerID:     entityTodo.OwnerID,
+		WorkspaceID: entityTodo.WorkspaceID,
 		Title:       entityTodo.Title,
 		Description: entityTodo.Description,
 		Status:      string(entityTodo.Status),
+		WorkflowID:  entityTodo.WorkflowID,
+		ProjectID:   entityTodo.ProjectID,
+		Position:    entityTodo.Position,
+		Priority:    max(entityTodo.Priority.Level(), 0),
 		DueDate:     entityTodo.DueDate,
+		ParentID:    entityTodo.ParentID,
+		SeriesID:    entityTodo.SeriesID,
+		Occurrence:  entityTodo.Occurrence,
+		StartedAt:   entityTodo.StartedAt,
+		CompletedAt: entityTodo.CompletedAt,
+		Version:     entityTodo.Version,
+	}
+
+	if entityTodo.Recurrence != nil {
+		rule := entityTodo.Recurrence.String()
+		model.Recurrence = &rule
+		model.RecurrenceTimezone = entityTodo.Recurrence.Timezone
+	}
+
+	// Tags are referenced by ID, the join rows are written by the repository
+	if entityTodo.Tags != nil {
+		model.Tags = make([]Tag, len(entityTodo.Tags))
+		for i := range entityTodo.Tags {
+			model.Tags[i] = *TagEntityToModel(&entityTodo.Tags[i])
+		}
+	}
+
+	if entityTodo.Checklist != nil {
+		model.Checklist = make([]ChecklistItem, len(entityTodo.Checklist))
+		for i := range entityTodo.Checklist {
+			model.Checklist[i] = *ChecklistItemEntityToModel(&entityTodo.Checklist[i])
+		}
+	}
+
+	// Assignees are written when the todo is created, later changes go through the assignee repository
+	if entityTodo.AssigneeIDs != nil {
+		model.Assignees = make([]TodoAssignee, len(entityTodo.AssigneeIDs))
+		for i, userID := range entityTodo.AssigneeIDs {
+			model.Assignees[i] = TodoAssignee{TodoID: entityTodo.ID, UserID: userID, CreatedAt: entityTodo.CreatedAt}
+		}
 	}
 
 	// Handle DeletedAt conversion
@@ -65,28 +116,63 @@ func ModelToEntity(modelTodo *Todo) *entity.Todo {
 		return nil
 	}
 
-	// Convert status string to entity.TodoStatus
-	var status entity.TodoStatus
-	switch modelTodo.Status {
-	case "pending":
-		status = entity.StatusPending
-	case "doing":
-		status = entity.StatusDoing
-	case "done":
-		status = entity.StatusDone
-	default:
-		// Default to pending for invalid status
-		status = entity.StatusPending
+	entityTodo := &entity.Todo{
+		ID:           modelTodo.ID,
+		OwnerID:      modelTodo.OwnerID,
+		WorkspaceID:  modelTodo.WorkspaceID,
+		Title:        modelTodo.Title,
+		Description:  modelTodo.Description,
+		Status:       entity.TodoStatus(modelTodo.Status),
+		WorkflowID:   modelTodo.WorkflowID,
+		ProjectID:    modelTodo.ProjectID,
+		Position:     modelTodo.Position,
+		Priority:     entity.PriorityFromLevel(modelTodo.Priority),
+		DueDate:      modelTodo.DueDate,
+		ParentID:     modelTodo.ParentID,
+		SeriesID:     modelTodo.SeriesID,
+		Occurrence:   modelTodo.Occurrence,
+		StartedAt:    modelTodo.StartedAt,
+		CompletedAt:  modelTodo.CompletedAt,
+		CommentCount: modelTodo.CommentCount,
+		Version:      modelTodo.Version,
+		CreatedAt:    modelTodo.CreatedAt,
+		UpdatedAt:    modelTodo.UpdatedAt,
 	}
 
-	entityTodo := &entity.Todo{
-		ID:          modelTodo.ID,
-		Title:       modelTodo.Title,
-		Description: modelTodo.Description,
-		Status:      status,
-		DueDate:     modelTodo.DueDate,
-		CreatedAt:   modelTodo.CreatedAt,
-		UpdatedAt:   modelTodo.UpdatedAt,
+	if modelTodo.Tags != nil {
+		entityTodo.Tags = make([]entity.Tag, len(modelTodo.Tags))
+		for i := range modelTodo.Tags {
+			entityTodo.Tags[i] = *TagModelToEntity(&modelTodo.Tags[i])
+		}
+	}
+
+	// A rule that no longer parses ends the series instead of failing the read
+	if modelTodo.Recurrence != nil {
+		if recurrence, err := entity.ParseRecurrence(*modelTodo.Recurrence, modelTodo.RecurrenceTimezone); err == nil {
+			entityTodo.Recurrence = recurrence
+		}
+	}
+
+	if modelTodo.Checklist != nil {
+		entityTodo.Checklist = make([]entity.ChecklistItem, len(modelTodo.Checklist))
+		for i := range modelTodo.Checklist {
+			entityTodo.Checklist[i] = *ChecklistItemModelToEntity(&modelTodo.Checklist[i])
+		}
+	}
+
+	// Blockers are only read here, links are written by the dependency repository
+	if modelTodo.Blockers != nil {
+		entityTodo.BlockedBy = make([]uint, len(modelTodo.Blockers))
+		for i := range modelTodo.Blockers {
+			entityTodo.BlockedBy[i] = modelTodo.Blockers[i].BlockerID
+		}
+	}
+
+	if modelTodo.Assignees != nil {
+		entityTodo.AssigneeIDs = make([]uint, len(modelTodo.Assignees))
+		for i := range modelTodo.Assignees {
+			entityTodo.AssigneeIDs[i] = modelTodo.Assignees[i].UserID
+		}
 	}
 
 	// Handle DeletedAt conversion from gorm.DeletedAt to *time.Time
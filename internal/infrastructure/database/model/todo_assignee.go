@@ -0,0 +1,47 @@
+package model
+
+import (
+	"time"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// TodoAssignee represents the GORM model for todo_assignees table,
+// a row means the user is responsible for the todo
+// Rows are hard deleted together with the todo when it is purged
+type TodoAssignee struct {
+	TodoID    uint      `gorm:"primaryKey;autoIncrement:false;comment:Todo ID" json:"todo_id"`
+	UserID    uint      `gorm:"primaryKey;autoIncrement:false;index;comment:負責的使用者ID" json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for GORM
+func (TodoAssignee) TableName() string {
+	return "todo_assignees"
+}
+
+// AssignmentEntityToModel converts domain entity to GORM model
+func AssignmentEntityToModel(entityAssignment *entity.Assignment) *TodoAssignee {
+	if entityAssignment == nil {
+		return nil
+	}
+
+	return &TodoAssignee{
+		TodoID:    entityAssignment.TodoID,
+		UserID:    entityAssignment.UserID,
+		CreatedAt: entityAssignment.CreatedAt,
+	}
+}
+
+// AssignmentModelToEntity converts GORM model to domain entity
+func AssignmentModelToEntity(modelAssignee *TodoAssignee) *entity.Assignment {
+	if modelAssignee == nil {
+		return nil
+	}
+
+	return &entity.Assignment{
+		TodoID:    modelAssignee.TodoID,
+		UserID:    modelAssignee.UserID,
+		CreatedAt: modelAssignee.CreatedAt,
+	}
+}
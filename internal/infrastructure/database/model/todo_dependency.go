@@ -0,0 +1,60 @@
+package model
+
+import (
+	"time"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// TodoDependency represents the GORM model for todo_dependencies table,
+// a row means the todo is blocked by the blocker todo
+// Rows are hard deleted together with either todo when it is purged
+type TodoDependency struct {
+	TodoID    uint      `gorm:"primaryKey;autoIncrement:false;comment:被阻擋的Todo ID" json:"todo_id"`
+	BlockerID uint      `gorm:"primaryKey;autoIncrement:false;index;comment:需先完成的Todo ID" json:"blocker_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for GORM
+func (TodoDependency) TableName() string {
+	return "todo_dependencies"
+}
+
+// DependencyEntityToModel converts domain entity to GORM model
+func DependencyEntityToModel(entityDep *entity.Dependency) *TodoDependency {
+	if entityDep == nil {
+		return nil
+	}
+
+	return &TodoDependency{
+		TodoID:    entityDep.TodoID,
+		BlockerID: entityDep.BlockerID,
+		CreatedAt: entityDep.CreatedAt,
+	}
+}
+
+// DependencyModelToEntity converts GORM model to domain entity
+func DependencyModelToEntity(modelDep *TodoDependency) *entity.Dependency {
+	if modelDep == nil {
+		return nil
+	}
+
+	return &entity.Dependency{
+		TodoID:    modelDep.TodoID,
+		BlockerID: modelDep.BlockerID,
+		CreatedAt: modelDep.CreatedAt,
+	}
+}
+
+// DependencyModelsToEntities converts slice of GORM models to slice of domain entities
+func DependencyModelsToEntities(modelDeps []*TodoDependency) []*entity.Dependency {
+	if modelDeps == nil {
+		return nil
+	}
+
+	entities := make([]*entity.Dependency, len(modelDeps))
+	for i, model := range modelDeps {
+		entities[i] = DependencyModelToEntity(model)
+	}
+	return entities
+}
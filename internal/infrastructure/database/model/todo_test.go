@@ -14,50 +14,6 @@ func TestTodo_TableName(t *testing.T) {
 	assert.Equal(t, "todos", todo.TableName())
 }
 
-func TestTodo_BeforeCreate(t *testing.T) {
-	tests := []struct {
-		name           string
-		initialStatus  string
-		expectedStatus string
-	}{
-		{
-			name:           "Valid status pending",
-			initialStatus:  "pending",
-			expectedStatus: "pending",
-		},
-		{
-			name:           "Valid status doing",
-			initialStatus:  "doing",
-			expectedStatus: "doing",
-		},
-		{
-			name:           "Valid status done",
-			initialStatus:  "done",
-			expectedStatus: "done",
-		},
-		{
-			name:           "Invalid status should default to pending",
-			initialStatus:  "invalid_status",
-			expectedStatus: "pending",
-		},
-		{
-			name:           "Empty status should default to pending",
-			initialStatus:  "",
-			expectedStatus: "pending",
-		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			todo := &Todo{Status: tt.initialStatus}
-			err := todo.BeforeCreate(nil) // tx is not used in our implementation
-			
-			assert.NoError(t, err)
-			assert.Equal(t, tt.expectedStatus, todo.Status)
-		})
-	}
-}
-
 func TestEntityToModel(t *testing.T) {
 	dueDate := time.Date(2024, 12, 31, 23, 59, 59, 0, time.UTC)
 	description := "測試描述"
@@ -173,22 +129,24 @@ func TestModelToEntity_WithNilFields(t *testing.T) {
 	assert.Nil(t, entityTodo.DeletedAt)
 }
 
-func TestModelToEntity_InvalidStatus(t *testing.T) {
+func TestModelToEntity_CustomStatus(t *testing.T) {
 	modelTodo := &Todo{
 		Model: gorm.Model{
 			ID:        1,
 			CreatedAt: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
 			UpdatedAt: time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
 		},
-		Title:  "測試標題",
-		Status: "invalid_status",
+		Title:      "測試標題",
+		Status:     "in-review",
+		WorkflowID: 2,
 	}
 
 	entityTodo := ModelToEntity(modelTodo)
 
 	assert.NotNil(t, entityTodo)
-	// Should default to pending for invalid status
-	assert.Equal(t, entity.StatusPending, entityTodo.Status)
+	// Statuses are validated against the workflow by the usecase, not rewritten here
+	assert.Equal(t, entity.TodoStatus("in-review"), entityTodo.Status)
+	assert.Equal(t, uint(2), entityTodo.WorkflowID)
 }
 
 func TestModelToEntity_NilInput(t *testing.T) {
@@ -231,4 +189,50 @@ func TestModelsToEntities_EmptySlice(t *testing.T) {
 	entities := ModelsToEntities(models)
 	assert.NotNil(t, entities)
 	assert.Len(t, entities, 0)
-}
\ No newline at end of file
+}
+func TestPriorityConversion(t *testing.T) {
+	entityTodo := &entity.Todo{
+		ID:       1,
+		Title:    "測試標題",
+		Status:   entity.StatusPending,
+		Priority: entity.PriorityHigh,
+	}
+
+	modelTodo := EntityToModel(entityTodo)
+	assert.Equal(t, 3, modelTodo.Priority)
+	assert.Equal(t, entity.PriorityHigh, ModelToEntity(modelTodo).Priority)
+
+	// Unknown levels fall back to none
+	modelTodo.Priority = 42
+	assert.Equal(t, entity.PriorityNone, ModelToEntity(modelTodo).Priority)
+}
+
+func TestRecurrenceConversion(t *testing.T) {
+	recurrence, err := entity.ParseRecurrence("FREQ=WEEKLY;BYDAY=MO,FR", "Europe/Berlin")
+	assert.NoError(t, err)
+	seriesID := uint(3)
+	entityTodo := &entity.Todo{
+		ID:         5,
+		Title:      "值班交接",
+		Status:     entity.StatusPending,
+		Recurrence: recurrence,
+		SeriesID:   &seriesID,
+		Occurrence: 2,
+	}
+
+	modelTodo := EntityToModel(entityTodo)
+	assert.Equal(t, "FREQ=WEEKLY;BYDAY=MO,FR", *modelTodo.Recurrence)
+	assert.Equal(t, "Europe/Berlin", modelTodo.RecurrenceTimezone)
+	assert.Equal(t, &seriesID, modelTodo.SeriesID)
+	assert.Equal(t, 2, modelTodo.Occurrence)
+
+	converted := ModelToEntity(modelTodo)
+	assert.Equal(t, recurrence, converted.Recurrence)
+	assert.Equal(t, &seriesID, converted.SeriesID)
+	assert.Equal(t, 2, converted.Occurrence)
+
+	// A rule that no longer parses is dropped
+	invalid := "FREQ=HOURLY"
+	modelTodo.Recurrence = &invalid
+	assert.Nil(t, ModelToEntity(modelTodo).Recurrence)
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// User represents the GORM model for users table
+type User struct {
+	ID           uint      `gorm:"primarykey"`
+	Email        string    `gorm:"type:varchar(255);not null;uniqueIndex;comment:登入信箱，小寫" json:"email"`
+	PasswordHash string    `gorm:"type:varchar(100);not null;comment:bcrypt密碼雜湊" json:"password_hash"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name for GORM
+func (User) TableName() string {
+	return "users"
+}
+
+// UserEntityToModel converts domain entity to GORM model
+func UserEntityToModel(entityUser *entity.User) *User {
+	if entityUser == nil {
+		return nil
+	}
+
+	return &User{
+		ID:           entityUser.ID,
+		Email:        entityUser.Email,
+		PasswordHash: entityUser.PasswordHash,
+		CreatedAt:    entityUser.CreatedAt,
+		UpdatedAt:    entityUser.UpdatedAt,
+	}
+}
+
+// UserModelToEntity converts GORM model to domain entity
+func UserModelToEntity(modelUser *User) *entity.User {
+	if modelUser == nil {
+		return nil
+	}
+
+	return &entity.User{
+		ID:           modelUser.ID,
+		Email:        modelUser.Email,
+		PasswordHash: modelUser.PasswordHash,
+		CreatedAt:    modelUser.CreatedAt,
+		UpdatedAt:    modelUser.UpdatedAt,
+	}
+}
@@ -0,0 +1,112 @@
+package model
+
+import (
+	"time"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// Workflow represents the GORM model for workflows table
+// Workflows are hard deleted and only when no todo uses them
+type Workflow struct {
+	ID          uint             `gorm:"primarykey"`
+	WorkspaceID uint             `gorm:"not null;default:0;uniqueIndex:idx_workflows_workspace_name,priority:1;comment:所屬工作區ID，0為工作區功能上線前建立" json:"workspace_id"`
+	Name        string           `gorm:"type:varchar(200);not null;uniqueIndex:idx_workflows_workspace_name,priority:2;comment:工作流程名稱，最多50個字符，同一工作區內不可重複" json:"name"`
+	IsDefault   bool             `gorm:"not null;default:false;comment:是否為工作區的預設工作流程;index" json:"is_default"`
+	Statuses    []WorkflowStatus `gorm:"foreignKey:WorkflowID" json:"statuses"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Workflow) TableName() string {
+	return "workflows"
+}
+
+// ObsoleteIndexes lists the indexes dropped on migration, names were unique across workspaces
+func (Workflow) ObsoleteIndexes() []string {
+	return []string{"idx_workflows_name"}
+}
+
+// WorkflowStatus represents the GORM model for workflow_statuses table
+type WorkflowStatus struct {
+	ID         uint   `gorm:"primarykey"`
+	WorkflowID uint   `gorm:"not null;uniqueIndex:idx_workflow_statuses_workflow_name,priority:1;comment:所屬工作流程ID" json:"workflow_id"`
+	Name       string `gorm:"type:varchar(30);not null;uniqueIndex:idx_workflow_statuses_workflow_name,priority:2;comment:狀態名稱" json:"name"`
+	Category   string `gorm:"type:varchar(20);not null;comment:狀態分類 todo/in_progress/done" json:"category"`
+	Position   int    `gorm:"not null;default:0;comment:排序位置，從0開始" json:"position"`
+}
+
+// TableName specifies the table name for GORM
+func (WorkflowStatus) TableName() string {
+	return "workflow_statuses"
+}
+
+// WorkflowEntityToModel converts domain entity to GORM model
+func WorkflowEntityToModel(entityWorkflow *entity.Workflow) *Workflow {
+	if entityWorkflow == nil {
+		return nil
+	}
+
+	model := &Workflow{
+		ID:          entityWorkflow.ID,
+		WorkspaceID: entityWorkflow.WorkspaceID,
+		Name:        entityWorkflow.Name,
+		IsDefault:   entityWorkflow.IsDefault,
+		Statuses:    make([]WorkflowStatus, len(entityWorkflow.Statuses)),
+		CreatedAt:   entityWorkflow.CreatedAt,
+		UpdatedAt:   entityWorkflow.UpdatedAt,
+	}
+	for i, status := range entityWorkflow.Statuses {
+		model.Statuses[i] = WorkflowStatus{
+			ID:         status.ID,
+			WorkflowID: entityWorkflow.ID,
+			Name:       string(status.Name),
+			Category:   string(status.Category),
+			Position:   status.Position,
+		}
+	}
+
+	return model
+}
+
+// WorkflowModelToEntity converts GORM model to domain entity
+func WorkflowModelToEntity(modelWorkflow *Workflow) *entity.Workflow {
+	if modelWorkflow == nil {
+		return nil
+	}
+
+	entityWorkflow := &entity.Workflow{
+		ID:          modelWorkflow.ID,
+		Name:        modelWorkflow.Name,
+		IsDefault:   modelWorkflow.IsDefault,
+		Statuses:    make([]entity.WorkflowStatus, len(modelWorkflow.Statuses)),
+		WorkspaceID: modelWorkflow.WorkspaceID,
+		CreatedAt:   modelWorkflow.CreatedAt,
+		UpdatedAt:   modelWorkflow.UpdatedAt,
+	}
+	for i, status := range modelWorkflow.Statuses {
+		entityWorkflow.Statuses[i] = entity.WorkflowStatus{
+			ID:         status.ID,
+			WorkflowID: status.WorkflowID,
+			Name:       entity.TodoStatus(status.Name),
+			Category:   entity.StatusCategory(status.Category),
+			Position:   status.Position,
+		}
+	}
+
+	return entityWorkflow
+}
+
+// WorkflowModelsToEntities converts slice of GORM models to slice of domain entities
+func WorkflowModelsToEntities(modelWorkflows []*Workflow) []*entity.Workflow {
+	if modelWorkflows == nil {
+		return nil
+	}
+
+	entities := make([]*entity.Workflow, len(modelWorkflows))
+	for i, model := range modelWorkflows {
+		entities[i] = WorkflowModelToEntity(model)
+	}
+	return entities
+}
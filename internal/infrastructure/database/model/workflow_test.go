@@ -0,0 +1,42 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+func TestWorkflow_TableName(t *testing.T) {
+	assert.Equal(t, "workflows", Workflow{}.TableName())
+	assert.Equal(t, "workflow_statuses", WorkflowStatus{}.TableName())
+}
+
+func TestWorkflow_Conversions(t *testing.T) {
+	now := time.Now().UTC()
+	workflow := &entity.Workflow{
+		ID:   2,
+		Name: "review",
+		Statuses: []entity.WorkflowStatus{
+			{ID: 4, WorkflowID: 2, Name: "open", Category: entity.CategoryTodo, Position: 0},
+			{ID: 5, WorkflowID: 2, Name: "in-review", Category: entity.CategoryInProgress, Position: 1},
+			{ID: 6, WorkflowID: 2, Name: "merged", Category: entity.CategoryDone, Position: 2},
+		},
+		WorkspaceID: 5,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	modelWorkflow := WorkflowEntityToModel(workflow)
+	assert.Equal(t, "review", modelWorkflow.Name)
+	assert.Equal(t, uint(5), modelWorkflow.WorkspaceID)
+	assert.Equal(t, WorkflowStatus{ID: 5, WorkflowID: 2, Name: "in-review", Category: "in_progress", Position: 1}, modelWorkflow.Statuses[1])
+	assert.Equal(t, workflow, WorkflowModelToEntity(modelWorkflow))
+
+	assert.Nil(t, WorkflowEntityToModel(nil))
+	assert.Nil(t, WorkflowModelToEntity(nil))
+	assert.Nil(t, WorkflowModelsToEntities(nil))
+	assert.Equal(t, []*entity.Workflow{workflow}, WorkflowModelsToEntities([]*Workflow{modelWorkflow}))
+}
@@ -0,0 +1,97 @@
+package model
+
+import (
+	"time"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+)
+
+// Workspace represents the GORM model for workspaces table
+type Workspace struct {
+	ID              uint      `gorm:"primarykey"`
+	Name            string    `gorm:"type:varchar(200);not null;comment:工作區名稱，最多50個字符" json:"name"`
+	PersonalOwnerID *uint     `gorm:"uniqueIndex;comment:個人工作區所屬的使用者ID，每位使用者最多一個，其他工作區為NULL" json:"personal_owner_id"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name for GORM
+func (Workspace) TableName() string {
+	return "workspaces"
+}
+
+// WorkspaceMember represents the GORM model for workspace_members table,
+// a row means the user may work in the workspace
+type WorkspaceMember struct {
+	WorkspaceID uint      `gorm:"primaryKey;autoIncrement:false;comment:工作區ID" json:"workspace_id"`
+	UserID      uint      `gorm:"primaryKey;autoIncrement:false;index;comment:使用者ID" json:"user_id"`
+	Role        string    `gorm:"type:varchar(20);not null;default:member;comment:成員角色 owner/admin/member/viewer" json:"role"`
+	User        *User     `gorm:"foreignKey:UserID" json:"user,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for GORM
+func (WorkspaceMember) TableName() string {
+	return "workspace_members"
+}
+
+// WorkspaceEntityToModel converts domain entity to GORM model
+func WorkspaceEntityToModel(entityWorkspace *entity.Workspace) *Workspace {
+	if entityWorkspace == nil {
+		return nil
+	}
+
+	return &Workspace{
+		ID:        entityWorkspace.ID,
+		Name:      entityWorkspace.Name,
+		CreatedAt: entityWorkspace.CreatedAt,
+		UpdatedAt: entityWorkspace.UpdatedAt,
+	}
+}
+
+// WorkspaceModelToEntity converts GORM model to domain entity
+func WorkspaceModelToEntity(modelWorkspace *Workspace) *entity.Workspace {
+	if modelWorkspace == nil {
+		return nil
+	}
+
+	return &entity.Workspace{
+		ID:        modelWorkspace.ID,
+		Name:      modelWorkspace.Name,
+		CreatedAt: modelWorkspace.CreatedAt,
+		UpdatedAt: modelWorkspace.UpdatedAt,
+	}
+}
+
+// WorkspaceMemberEntityToModel converts domain entity to GORM model
+func WorkspaceMemberEntityToModel(entityMember *entity.WorkspaceMember) *WorkspaceMember {
+	if entityMember == nil {
+		return nil
+	}
+
+	return &WorkspaceMember{
+		WorkspaceID: entityMember.WorkspaceID,
+		UserID:      entityMember.UserID,
+		Role:        string(entityMember.Role),
+		CreatedAt:   entityMember.CreatedAt,
+	}
+}
+
+// WorkspaceMemberModelToEntity converts GORM model to domain entity, the email is set when the user is loaded
+func WorkspaceMemberModelToEntity(modelMember *WorkspaceMember) *entity.WorkspaceMember {
+	if modelMember == nil {
+		return nil
+	}
+
+	member := &entity.WorkspaceMember{
+		WorkspaceID: modelMember.WorkspaceID,
+		UserID:      modelMember.UserID,
+		Role:        entity.WorkspaceRole(modelMember.Role),
+		CreatedAt:   modelMember.CreatedAt,
+	}
+	if modelMember.User != nil {
+		member.Email = modelMember.User.Email
+	}
+
+	return member
+}
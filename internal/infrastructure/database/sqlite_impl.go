@@ -52,7 +52,7 @@ func (d *SQLiteDBImpl) Migrate(models ...interface{}) error {
 	}
 
 	for _, model := range models {
-		if err := d.db.AutoMigrate(model); err != nil {
+		if err := migrateModel(d.db, model); err != nil {
 			return fmt.Errorf("failed to migrate model %T: %w", model, err)
 		}
 	}
@@ -0,0 +1,101 @@
+package job
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+	"itmrchow/go-todolist-service/internal/infrastructure/config"
+)
+
+var _ Job = &IdempotencyCleanupJob{}
+
+// IdempotencyCleanupJob periodically deletes idempotency keys whose retention has passed.
+type IdempotencyCleanupJob struct {
+	logger        zerolog.Logger
+	idempotencyUc usecase.IdempotencyUseCase
+	config        *config.IdempotencyConfig
+	now           func() time.Time
+	wg            sync.WaitGroup
+}
+
+// NewIdempotencyCleanupJob creates a new idempotency cleanup job.
+func NewIdempotencyCleanupJob(
+	logger zerolog.Logger,
+	idempotencyUc usecase.IdempotencyUseCase,
+	config *config.IdempotencyConfig,
+) *IdempotencyCleanupJob {
+	return &IdempotencyCleanupJob{
+		logger:        logger.With().Str("module", "idempotency_cleanup_job").Logger(),
+		idempotencyUc: idempotencyUc,
+		config:        config,
+		now:           time.Now,
+	}
+}
+
+// Start runs the cleanup immediately and then on every interval until ctx is cancelled.
+func (j *IdempotencyCleanupJob) Start(ctx context.Context) {
+	if j.config.PurgeInterval <= 0 || j.config.BatchSize <= 0 {
+		j.logger.Info().Msg("idempotency cleanup job disabled")
+		return
+	}
+
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+
+		ticker := time.NewTicker(j.config.PurgeInterval)
+		defer ticker.Stop()
+
+		for {
+			j.RunOnce(ctx)
+
+			select {
+			case <-ctx.Done():
+				j.logger.Info().Msg("idempotency cleanup job stopped")
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Wait blocks until the background goroutine has exited.
+func (j *IdempotencyCleanupJob) Wait() {
+	j.wg.Wait()
+}
+
+// RunOnce deletes expired idempotency keys in batches and returns the total number of deleted keys.
+func (j *IdempotencyCleanupJob) RunOnce(ctx context.Context) int64 {
+	before := j.now().UTC()
+
+	var total int64
+	for ctx.Err() == nil {
+		purged, err := j.idempotencyUc.PurgeExpired(ctx, before, j.config.BatchSize)
+		if err != nil {
+			j.logger.Error().Err(err).Int64("purged", total).Msg("idempotency key cleanup failed")
+			return total
+		}
+
+		total += purged
+		if purged > 0 {
+			j.logger.Debug().Int64("batch", purged).Msg("purged expired idempotency key batch")
+		}
+		// A short batch means no expired key is left
+		if purged < int64(j.config.BatchSize) {
+			break
+		}
+	}
+
+	if total > 0 {
+		j.logger.Info().
+			Int64("purged", total).
+			Time("expired_before", before).
+			Msg("purged expired idempotency keys")
+	}
+
+	return total
+}
@@ -0,0 +1,108 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+	"itmrchow/go-todolist-service/internal/infrastructure/config"
+)
+
+func TestIdempotencyCleanupJob_RunOnce(t *testing.T) {
+	now := time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		setupMock   func(mockUc *usecase.MockIdempotencyUseCase)
+		expectTotal int64
+	}{
+		{
+			name: "nothing_to_purge",
+			setupMock: func(mockUc *usecase.MockIdempotencyUseCase) {
+				mockUc.EXPECT().
+					PurgeExpired(gomock.Any(), now, 2).
+					Return(int64(0), nil).
+					Times(1)
+			},
+			expectTotal: 0,
+		},
+		{
+			name: "purge_until_short_batch",
+			setupMock: func(mockUc *usecase.MockIdempotencyUseCase) {
+				gomock.InOrder(
+					mockUc.EXPECT().PurgeExpired(gomock.Any(), now, 2).Return(int64(2), nil),
+					mockUc.EXPECT().PurgeExpired(gomock.Any(), now, 2).Return(int64(1), nil),
+				)
+			},
+			expectTotal: 3,
+		},
+		{
+			name: "stop_on_error",
+			setupMock: func(mockUc *usecase.MockIdempotencyUseCase) {
+				gomock.InOrder(
+					mockUc.EXPECT().PurgeExpired(gomock.Any(), now, 2).Return(int64(2), nil),
+					mockUc.EXPECT().PurgeExpired(gomock.Any(), now, 2).Return(int64(0), errors.New("internal fail")),
+				)
+			},
+			expectTotal: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockUc := usecase.NewMockIdempotencyUseCase(ctrl)
+			tt.setupMock(mockUc)
+
+			job := NewIdempotencyCleanupJob(zerolog.New(os.Stdout), mockUc, &config.IdempotencyConfig{
+				KeyTTL:        24 * time.Hour,
+				PurgeInterval: time.Hour,
+				BatchSize:     2,
+			})
+			job.now = func() time.Time { return now }
+
+			total := job.RunOnce(context.Background())
+
+			assert.Equal(t, tt.expectTotal, total)
+		})
+	}
+}
+
+func TestIdempotencyCleanupJob_StopsOnContextCancel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUc := usecase.NewMockIdempotencyUseCase(ctrl)
+	mockUc.EXPECT().
+		PurgeExpired(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(int64(0), nil).
+		AnyTimes()
+
+	job := NewIdempotencyCleanupJob(zerolog.New(os.Stdout), mockUc, &config.IdempotencyConfig{
+		KeyTTL:        24 * time.Hour,
+		PurgeInterval: 10 * time.Millisecond,
+		BatchSize:     100,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	job.Start(ctx)
+	cancel()
+
+	// Wait returns once the goroutine observed the cancellation
+	done := make(chan struct{})
+	go func() {
+		job.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("idempotency cleanup job did not stop after context cancel")
+	}
+}
@@ -0,0 +1,11 @@
+package job
+
+import "context"
+
+// Job defines the interface for background jobs started from main.
+type Job interface {
+	// Start runs the job in the background until ctx is cancelled.
+	Start(ctx context.Context)
+	// Wait blocks until the background goroutine has exited.
+	Wait()
+}
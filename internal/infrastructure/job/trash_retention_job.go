@@ -0,0 +1,106 @@
+package job
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"itmrchow/go-todolist-service/internal/domain/usecase"
+	"itmrchow/go-todolist-service/internal/infrastructure/config"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+var _ Job = &TrashRetentionJob{}
+
+// TrashRetentionJob periodically hard deletes todos that stayed in the trash
+// longer than the configured retention.
+type TrashRetentionJob struct {
+	logger zerolog.Logger
+	todoUc usecase.TodoUseCase
+	config *config.TrashRetentionConfig
+	now    func() time.Time
+	wg     sync.WaitGroup
+}
+
+// NewTrashRetentionJob creates a new trash retention job.
+func NewTrashRetentionJob(
+	logger zerolog.Logger,
+	todoUc usecase.TodoUseCase,
+	config *config.TrashRetentionConfig,
+) *TrashRetentionJob {
+	return &TrashRetentionJob{
+		logger: logger.With().Str("module", "trash_retention_job").Logger(),
+		todoUc: todoUc,
+		config: config,
+		now:    time.Now,
+	}
+}
+
+// Start runs the purge immediately and then on every interval until ctx is cancelled.
+func (j *TrashRetentionJob) Start(ctx context.Context) {
+	if j.config.RetentionDays <= 0 || j.config.PurgeInterval <= 0 || j.config.BatchSize <= 0 {
+		j.logger.Info().Msg("trash retention job disabled")
+		return
+	}
+
+	j.wg.Add(1)
+	go func() {
+		defer j.wg.Done()
+
+		ticker := time.NewTicker(j.config.PurgeInterval)
+		defer ticker.Stop()
+
+		for {
+			j.RunOnce(ctx)
+
+			select {
+			case <-ctx.Done():
+				j.logger.Info().Msg("trash retention job stopped")
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Wait blocks until the background goroutine has exited.
+func (j *TrashRetentionJob) Wait() {
+	j.wg.Wait()
+}
+
+// RunOnce purges expired trash in batches and returns the total number of purged todos.
+// Each batch is a separate statement so the table is never locked for long.
+func (j *TrashRetentionJob) RunOnce(ctx context.Context) int64 {
+	// The trash of every workspace expires, the job acts for the system instead of a tenant
+	ctx = actor.WithSystem(ctx)
+	before := j.now().UTC().AddDate(0, 0, -j.config.RetentionDays)
+
+	var total int64
+	for ctx.Err() == nil {
+		purged, err := j.todoUc.PurgeExpiredTrash(ctx, before, j.config.BatchSize)
+		if err != nil {
+			j.logger.Error().Err(err).Int64("purged", total).Msg("trash retention purge failed")
+			return total
+		}
+
+		total += purged
+		if purged > 0 {
+			j.logger.Debug().Int64("batch", purged).Msg("purged expired trash batch")
+		}
+		// A short batch means nothing older than the cutoff is left
+		if purged < int64(j.config.BatchSize) {
+			break
+		}
+	}
+
+	if total > 0 {
+		j.logger.Info().
+			Int64("purged", total).
+			Time("deleted_before", before).
+			Msg("purged expired trash")
+	}
+
+	return total
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+)
+
+var _ repository.APIKeyRepository = &APIKeyRepositoryImpl{}
+
+// APIKeyRepositoryImpl implements the APIKeyRepository interface using GORM
+type APIKeyRepositoryImpl struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+// NewAPIKeyRepository creates a new APIKeyRepository instance
+func NewAPIKeyRepository(logger zerolog.Logger, db *gorm.DB) repository.APIKeyRepository {
+	return &APIKeyRepositoryImpl{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new API key and returns the created key with assigned ID
+func (r *APIKeyRepositoryImpl) Create(ctx context.Context, apiKey *entity.APIKey) (*entity.APIKey, error) {
+	if apiKey == nil {
+		return nil, errors.New("api key cannot be nil")
+	}
+
+	apiKeyModel := model.APIKeyEntityToModel(apiKey)
+	if err := r.db.WithContext(ctx).Create(apiKeyModel).Error; err != nil {
+		return nil, fmt.Errorf("failed to create api key: %w", err)
+	}
+
+	return model.APIKeyModelToEntity(apiKeyModel), nil
+}
+
+// GetByID retrieves an API key by its ID, revoked keys included
+// Returns nil if API key is not found
+func (r *APIKeyRepositoryImpl) GetByID(ctx context.Context, id uint) (*entity.APIKey, error) {
+	var apiKeyModel model.APIKey
+
+	err := r.db.WithContext(ctx).First(&apiKeyModel, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil for not found, not an error
+		}
+		return nil, fmt.Errorf("failed to get api key by id %d: %w", id, err)
+	}
+
+	return model.APIKeyModelToEntity(&apiKeyModel), nil
+}
+
+// GetByPrefix retrieves an API key by its visible prefix, revoked keys included
+// Returns nil if API key is not found
+func (r *APIKeyRepositoryImpl) GetByPrefix(ctx context.Context, prefix string) (*entity.APIKey, error) {
+	var apiKeyModel model.APIKey
+
+	err := r.db.WithContext(ctx).Where("prefix = ?", prefix).First(&apiKeyModel).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil for not found, not an error
+		}
+		return nil, fmt.Errorf("failed to get api key by prefix: %w", err)
+	}
+
+	return model.APIKeyModelToEntity(&apiKeyModel), nil
+}
+
+// ListByUser retrieves the API keys of a user, newest first, revoked keys included
+func (r *APIKeyRepositoryImpl) ListByUser(ctx context.Context, userID uint) ([]*entity.APIKey, error) {
+	var apiKeyModels []model.APIKey
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("id DESC").
+		Find(&apiKeyModels).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api keys of user %d: %w", userID, err)
+	}
+
+	apiKeys := make([]*entity.APIKey, len(apiKeyModels))
+	for i := range apiKeyModels {
+		apiKeys[i] = model.APIKeyModelToEntity(&apiKeyModels[i])
+	}
+
+	return apiKeys, nil
+}
+
+// Revoke sets the revoked time of a key that is not revoked yet and returns the number of affected rows
+func (r *APIKeyRepositoryImpl) Revoke(ctx context.Context, id uint, revokedAt time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).Model(&model.APIKey{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", revokedAt)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to revoke api key: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+// TouchLastUsed sets the last used time of a key
+func (r *APIKeyRepositoryImpl) TouchLastUsed(ctx context.Context, id uint, usedAt time.Time) error {
+	err := r.db.WithContext(ctx).Model(&model.APIKey{}).
+		Where("id = ?", id).
+		Update("last_used_at", usedAt).Error
+	if err != nil {
+		return fmt.Errorf("failed to touch api key: %w", err)
+	}
+
+	return nil
+}
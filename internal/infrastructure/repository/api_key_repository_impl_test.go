@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/config"
+	"itmrchow/go-todolist-service/internal/infrastructure/database"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+)
+
+type APIKeyRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo repository.APIKeyRepository
+	ctx  context.Context
+}
+
+// SetupSuite 在整個測試 suite 開始前執行一次
+func (suite *APIKeyRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	sqlLiteDB := &database.SQLiteDBImpl{}
+	db, err := sqlLiteDB.Connect(ctx, &config.DatabaseConfig{})
+	suite.Require().NoError(err)
+
+	err = sqlLiteDB.Migrate(&model.APIKey{})
+	suite.Require().NoError(err)
+
+	suite.db = db
+	suite.ctx = ctx
+
+	suite.repo = NewAPIKeyRepository(zerolog.New(os.Stdout), suite.db)
+}
+
+// TearDownSuite 在整個測試 suite 結束後執行一次
+func (suite *APIKeyRepositoryTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		sqlDB, err := suite.db.DB()
+		if err == nil {
+			sqlDB.Close()
+		}
+	}
+}
+
+// TearDownTest 每個測試後清理資料
+func (suite *APIKeyRepositoryTestSuite) TearDownTest() {
+	if suite.db != nil {
+		suite.db.Exec("DELETE FROM api_keys")
+		suite.db.Exec("DELETE FROM sqlite_sequence WHERE name = 'api_keys'")
+	}
+}
+
+// createAPIKey creates an API key of the user and returns it with the key itself
+func (suite *APIKeyRepositoryTestSuite) createAPIKey(userID uint, name string) (*entity.APIKey, string) {
+	apiKey, key, err := entity.NewAPIKey(userID, name, []entity.APIKeyScope{entity.ScopeTodosRead, entity.ScopeTodosWrite})
+	suite.Require().NoError(err)
+
+	created, err := suite.repo.Create(suite.ctx, apiKey)
+	suite.Require().NoError(err)
+	return created, key
+}
+
+func (suite *APIKeyRepositoryTestSuite) TestCreateAndGet() {
+	created, key := suite.createAPIKey(1, "ci bot")
+	suite.Equal(uint(1), created.ID)
+
+	got, err := suite.repo.GetByID(suite.ctx, created.ID)
+	suite.NoError(err)
+	suite.Equal("ci bot", got.Name)
+	suite.Equal([]entity.APIKeyScope{entity.ScopeTodosRead, entity.ScopeTodosWrite}, got.Scopes)
+	suite.True(got.Matches(key))
+
+	prefix, _ := entity.ParseAPIKeyPrefix(key)
+	got, err = suite.repo.GetByPrefix(suite.ctx, prefix)
+	suite.NoError(err)
+	suite.Equal(created.ID, got.ID)
+
+	got, err = suite.repo.GetByPrefix(suite.ctx, "tdk_000000000000")
+	suite.NoError(err)
+	suite.Nil(got)
+
+	got, err = suite.repo.GetByID(suite.ctx, 99)
+	suite.NoError(err)
+	suite.Nil(got)
+
+	_, err = suite.repo.Create(suite.ctx, nil)
+	suite.EqualError(err, "api key cannot be nil")
+}
+
+func (suite *APIKeyRepositoryTestSuite) TestListByUser() {
+	first, _ := suite.createAPIKey(1, "ci bot")
+	second, _ := suite.createAPIKey(1, "backup script")
+	suite.createAPIKey(2, "someone else")
+
+	apiKeys, err := suite.repo.ListByUser(suite.ctx, 1)
+
+	suite.NoError(err)
+	suite.Require().Len(apiKeys, 2)
+	suite.Equal(second.ID, apiKeys[0].ID)
+	suite.Equal(first.ID, apiKeys[1].ID)
+}
+
+func (suite *APIKeyRepositoryTestSuite) TestRevokeAndTouch() {
+	created, _ := suite.createAPIKey(1, "ci bot")
+	now := time.Now().UTC().Truncate(time.Second)
+
+	suite.NoError(suite.repo.TouchLastUsed(suite.ctx, created.ID, now))
+
+	rows, err := suite.repo.Revoke(suite.ctx, created.ID, now)
+	suite.NoError(err)
+	suite.Equal(int64(1), rows)
+
+	// a revoked key keeps its first revoke time
+	rows, err = suite.repo.Revoke(suite.ctx, created.ID, now.Add(time.Hour))
+	suite.NoError(err)
+	suite.Equal(int64(0), rows)
+
+	got, err := suite.repo.GetByID(suite.ctx, created.ID)
+	suite.NoError(err)
+	suite.True(got.IsRevoked())
+	suite.True(now.Equal(*got.RevokedAt))
+	suite.True(now.Equal(*got.LastUsedAt))
+}
+
+func TestAPIKeyRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(APIKeyRepositoryTestSuite))
+}
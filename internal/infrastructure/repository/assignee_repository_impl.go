@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+)
+
+var _ repository.AssigneeRepository = &AssigneeRepositoryImpl{}
+
+// AssigneeRepositoryImpl implements the AssigneeRepository interface using GORM
+type AssigneeRepositoryImpl struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+// NewAssigneeRepository creates a new AssigneeRepository instance
+func NewAssigneeRepository(logger zerolog.Logger, db *gorm.DB) repository.AssigneeRepository {
+	return &AssigneeRepositoryImpl{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create assigns a user to a todo and bumps the version of the todo
+func (r *AssigneeRepositoryImpl) Create(ctx context.Context, assignment *entity.Assignment) error {
+	if assignment == nil {
+		return errors.New("assignment cannot be nil")
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(model.AssignmentEntityToModel(assignment)).Error; err != nil {
+			return err
+		}
+		return bumpTodoVersions(tx, []uint{assignment.TodoID})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create assignment: %w", err)
+	}
+
+	return nil
+}
+
+// Exists checks if the user is already assigned to the todo
+func (r *AssigneeRepositoryImpl) Exists(ctx context.Context, todoID uint, userID uint) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.TodoAssignee{}).
+		Where("todo_id = ? AND user_id = ?", todoID, userID).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check assignment: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// Delete unassigns a user from a todo, bumps the version of the todo and returns the number of affected rows
+func (r *AssigneeRepositoryImpl) Delete(ctx context.Context, todoID uint, userID uint) (int64, error) {
+	var rowsAffected int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("todo_id = ? AND user_id = ?", todoID, userID).Delete(&model.TodoAssignee{})
+		if result.Error != nil {
+			return result.Error
+		}
+		rowsAffected = result.RowsAffected
+		if rowsAffected == 0 {
+			return nil
+		}
+		return bumpTodoVersions(tx, []uint{todoID})
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete assignment: %w", err)
+	}
+
+	return rowsAffected, nil
+}
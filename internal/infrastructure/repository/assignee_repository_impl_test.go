@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/config"
+	"itmrchow/go-todolist-service/internal/infrastructure/database"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+type AssigneeRepositoryTestSuite struct {
+	suite.Suite
+	db       *gorm.DB
+	repo     repository.AssigneeRepository
+	todoRepo repository.TodoRepository
+	ctx      context.Context
+	workflow *entity.Workflow // default workflow, seeded once
+}
+
+// SetupSuite 在整個測試 suite 開始前執行一次
+func (suite *AssigneeRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	sqlLiteDB := &database.SQLiteDBImpl{}
+	db, err := sqlLiteDB.Connect(ctx, &config.DatabaseConfig{})
+	suite.Require().NoError(err)
+
+	err = sqlLiteDB.Migrate(&model.Todo{}, &model.Tag{}, &model.TodoTag{}, &model.ChecklistItem{}, &model.StatusChange{}, &model.TodoDependency{}, &model.TodoAssignee{}, &model.Comment{},
+		&model.Workflow{}, &model.WorkflowStatus{})
+	suite.Require().NoError(err)
+
+	suite.db = db
+	suite.ctx = actor.WithSystem(ctx)
+
+	suite.repo = NewAssigneeRepository(zerolog.New(os.Stdout), suite.db)
+	suite.todoRepo = NewTodoRepository(zerolog.New(os.Stdout), suite.db)
+	suite.workflow, err = NewWorkflowRepository(zerolog.New(os.Stdout), suite.db).EnsureDefault(ctx, entity.DefaultWorkflow())
+	suite.Require().NoError(err)
+}
+
+// TearDownSuite 在整個測試 suite 結束後執行一次
+func (suite *AssigneeRepositoryTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		sqlDB, err := suite.db.DB()
+		if err == nil {
+			sqlDB.Close()
+		}
+	}
+}
+
+// TearDownTest 每個測試後清理資料
+func (suite *AssigneeRepositoryTestSuite) TearDownTest() {
+	if suite.db != nil {
+		suite.db.Exec("DELETE FROM todos")
+		suite.db.Exec("DELETE FROM todo_assignees")
+		suite.db.Exec("DELETE FROM sqlite_sequence WHERE name = 'todos'")
+	}
+}
+
+// createTodo creates a todo of the default workflow
+func (suite *AssigneeRepositoryTestSuite) createTodo(title string) *entity.Todo {
+	todo, err := entity.NewTodo(title, nil, nil, nil)
+	suite.Require().NoError(err)
+	suite.Require().NoError(todo.SetWorkflow(suite.workflow, time.Now()))
+
+	created, err := suite.todoRepo.Create(suite.ctx, todo)
+	suite.Require().NoError(err)
+	return created
+}
+
+func (suite *AssigneeRepositoryTestSuite) TestCreateExistsDelete() {
+	todo := suite.createTodo("寫文件")
+
+	assignment, err := entity.NewAssignment(todo.ID, 7)
+	suite.Require().NoError(err)
+	suite.NoError(suite.repo.Create(suite.ctx, assignment))
+	suite.Error(suite.repo.Create(suite.ctx, assignment))
+
+	exists, err := suite.repo.Exists(suite.ctx, todo.ID, 7)
+	suite.NoError(err)
+	suite.True(exists)
+
+	exists, err = suite.repo.Exists(suite.ctx, todo.ID, 8)
+	suite.NoError(err)
+	suite.False(exists)
+
+	// the todo lists its assignees
+	got, err := suite.todoRepo.GetByID(suite.ctx, todo.ID)
+	suite.Require().NoError(err)
+	suite.Equal([]uint{7}, got.AssigneeIDs)
+	suite.Equal(todo.Version+1, got.Version)
+
+	rowsAffected, err := suite.repo.Delete(suite.ctx, todo.ID, 7)
+	suite.NoError(err)
+	suite.Equal(int64(1), rowsAffected)
+
+	rowsAffected, err = suite.repo.Delete(suite.ctx, todo.ID, 7)
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected)
+
+	// only the delete that removed the assignee bumps the version
+	got, err = suite.todoRepo.GetByID(suite.ctx, todo.ID)
+	suite.Require().NoError(err)
+	suite.Empty(got.AssigneeIDs)
+	suite.Equal(todo.Version+2, got.Version)
+}
+
+func TestAssigneeRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(AssigneeRepositoryTestSuite))
+}
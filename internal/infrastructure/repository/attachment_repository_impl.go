@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+)
+
+var _ repository.AttachmentRepository = &AttachmentRepositoryImpl{}
+
+// AttachmentRepositoryImpl implements the AttachmentRepository interface using GORM
+type AttachmentRepositoryImpl struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+// NewAttachmentRepository creates a new AttachmentRepository instance
+func NewAttachmentRepository(logger zerolog.Logger, db *gorm.DB) repository.AttachmentRepository {
+	return &AttachmentRepositoryImpl{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new attachment and returns the created attachment with assigned ID
+func (r *AttachmentRepositoryImpl) Create(ctx context.Context, attachment *entity.Attachment) (*entity.Attachment, error) {
+	if attachment == nil {
+		return nil, errors.New("attachment cannot be nil")
+	}
+
+	attachmentModel := model.AttachmentEntityToModel(attachment)
+	if err := r.db.WithContext(ctx).Create(attachmentModel).Error; err != nil {
+		return nil, fmt.Errorf("failed to create attachment: %w", err)
+	}
+
+	return model.AttachmentModelToEntity(attachmentModel), nil
+}
+
+// GetByID retrieves an attachment by its ID
+// Returns nil if attachment is not found
+func (r *AttachmentRepositoryImpl) GetByID(ctx context.Context, id uint) (*entity.Attachment, error) {
+	var attachmentModel model.Attachment
+
+	err := r.db.WithContext(ctx).First(&attachmentModel, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil for not found, not an error
+		}
+		return nil, fmt.Errorf("failed to get attachment by id %d: %w", id, err)
+	}
+
+	return model.AttachmentModelToEntity(&attachmentModel), nil
+}
+
+// ListByTodo retrieves the attachments of a todo ordered by ID
+func (r *AttachmentRepositoryImpl) ListByTodo(ctx context.Context, todoID uint) ([]*entity.Attachment, error) {
+	var attachmentModels []*model.Attachment
+
+	if err := r.db.WithContext(ctx).
+		Where("todo_id = ?", todoID).
+		Order("id ASC").
+		Find(&attachmentModels).Error; err != nil {
+		return nil, fmt.Errorf("failed to list attachments: %w", err)
+	}
+
+	return model.AttachmentModelsToEntities(attachmentModels), nil
+}
+
+// Delete deletes an attachment and returns the number of affected rows
+func (r *AttachmentRepositoryImpl) Delete(ctx context.Context, id uint) (int64, error) {
+	result := r.db.WithContext(ctx).Delete(&model.Attachment{}, id)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete attachment: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+// ListOrphans retrieves up to limit attachments whose todo has been purged, ordered by ID
+// Soft deleted todos still have a row, so their attachments are kept
+func (r *AttachmentRepositoryImpl) ListOrphans(ctx context.Context, limit int) ([]*entity.Attachment, error) {
+	var attachmentModels []*model.Attachment
+
+	if err := r.db.WithContext(ctx).
+		Where("NOT EXISTS (SELECT 1 FROM todos WHERE todos.id = todo_attachments.todo_id)").
+		Order("id ASC").
+		Limit(limit).
+		Find(&attachmentModels).Error; err != nil {
+		return nil, fmt.Errorf("failed to list orphan attachments: %w", err)
+	}
+
+	return model.AttachmentModelsToEntities(attachmentModels), nil
+}
@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/config"
+	"itmrchow/go-todolist-service/internal/infrastructure/database"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+type AttachmentRepositoryTestSuite struct {
+	suite.Suite
+	db       *gorm.DB
+	repo     repository.AttachmentRepository
+	todoRepo repository.TodoRepository
+	ctx      context.Context
+}
+
+// SetupSuite 在整個測試 suite 開始前執行一次
+func (suite *AttachmentRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	sqlLiteDB := &database.SQLiteDBImpl{}
+	db, err := sqlLiteDB.Connect(ctx, &config.DatabaseConfig{})
+	suite.Require().NoError(err)
+
+	err = sqlLiteDB.Migrate(&model.Todo{}, &model.Tag{}, &model.TodoTag{}, &model.ChecklistItem{}, &model.StatusChange{}, &model.TodoDependency{}, &model.TodoAssignee{}, &model.Comment{}, &model.Attachment{})
+	suite.Require().NoError(err)
+
+	suite.db = db
+	suite.ctx = actor.WithSystem(ctx)
+
+	suite.repo = NewAttachmentRepository(zerolog.New(os.Stdout), suite.db)
+	suite.todoRepo = NewTodoRepository(zerolog.New(os.Stdout), suite.db)
+}
+
+// TearDownSuite 在整個測試 suite 結束後執行一次
+func (suite *AttachmentRepositoryTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		sqlDB, err := suite.db.DB()
+		if err == nil {
+			sqlDB.Close()
+		}
+	}
+}
+
+// TearDownTest 每個測試後清理資料
+func (suite *AttachmentRepositoryTestSuite) TearDownTest() {
+	if suite.db != nil {
+		suite.db.Exec("DELETE FROM todos")
+		suite.db.Exec("DELETE FROM todo_attachments")
+		suite.db.Exec("DELETE FROM sqlite_sequence WHERE name IN ('todos', 'todo_attachments')")
+	}
+}
+
+// createAttachments adds attachments with the given names to the todo, in order
+func (suite *AttachmentRepositoryTestSuite) createAttachments(todoID uint, names ...string) []*entity.Attachment {
+	attachments := make([]*entity.Attachment, len(names))
+	for i, name := range names {
+		attachment, err := entity.NewAttachment(todoID, name, "alice")
+		suite.Require().NoError(err)
+		attachment.Size = 5
+		attachment.ContentType = "text/plain"
+		attachment.SHA256 = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+		attachment.StorageKey = fmt.Sprintf("todos/%d/%s", todoID, name)
+		attachments[i], err = suite.repo.Create(suite.ctx, attachment)
+		suite.Require().NoError(err)
+	}
+	return attachments
+}
+
+func (suite *AttachmentRepositoryTestSuite) TestCreateAndGetByID() {
+	created := suite.createAttachments(1, "report.txt")[0]
+	suite.Equal(uint(1), created.ID)
+
+	got, err := suite.repo.GetByID(suite.ctx, created.ID)
+	suite.NoError(err)
+	suite.Equal("report.txt", got.Name)
+	suite.Equal(int64(5), got.Size)
+	suite.Equal("text/plain", got.ContentType)
+	suite.Equal("todos/1/report.txt", got.StorageKey)
+	suite.Equal("alice", got.Uploader)
+
+	got, err = suite.repo.GetByID(suite.ctx, 99)
+	suite.NoError(err)
+	suite.Nil(got)
+
+	_, err = suite.repo.Create(suite.ctx, nil)
+	suite.EqualError(err, "attachment cannot be nil")
+}
+
+func (suite *AttachmentRepositoryTestSuite) TestListByTodoAndDelete() {
+	created := suite.createAttachments(1, "a.txt", "b.txt")
+	suite.createAttachments(2, "c.txt")
+
+	got, err := suite.repo.ListByTodo(suite.ctx, 1)
+	suite.NoError(err)
+	suite.Require().Len(got, 2)
+	suite.Equal("a.txt", got[0].Name)
+	suite.Equal("b.txt", got[1].Name)
+
+	rowsAffected, err := suite.repo.Delete(suite.ctx, created[0].ID)
+	suite.NoError(err)
+	suite.Equal(int64(1), rowsAffected)
+
+	got, _ = suite.repo.ListByTodo(suite.ctx, 1)
+	suite.Require().Len(got, 1)
+	suite.Equal("b.txt", got[0].Name)
+
+	rowsAffected, err = suite.repo.Delete(suite.ctx, created[0].ID)
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected)
+}
+
+func (suite *AttachmentRepositoryTestSuite) TestListOrphans() {
+	kept, _ := entity.NewTodo("保留", nil, nil, nil)
+	createdKept, _ := suite.todoRepo.Create(suite.ctx, kept)
+	trashed, _ := entity.NewTodo("在垃圾桶", nil, nil, nil)
+	createdTrashed, _ := suite.todoRepo.Create(suite.ctx, trashed)
+	purged, _ := entity.NewTodo("已清除", nil, nil, nil)
+	createdPurged, _ := suite.todoRepo.Create(suite.ctx, purged)
+
+	suite.createAttachments(createdKept.ID, "kept.txt")
+	suite.createAttachments(createdTrashed.ID, "trashed.txt")
+	suite.createAttachments(createdPurged.ID, "a.txt", "b.txt")
+
+	suite.todoRepo.Delete(suite.ctx, createdTrashed.ID)
+	suite.todoRepo.Delete(suite.ctx, createdPurged.ID)
+	_, err := suite.todoRepo.HardDelete(suite.ctx, createdPurged.ID)
+	suite.Require().NoError(err)
+
+	// purging the todo keeps the attachment rows until their blobs are cleaned up
+	orphans, err := suite.repo.ListOrphans(suite.ctx, 10)
+	suite.NoError(err)
+	suite.Require().Len(orphans, 2)
+	suite.Equal("a.txt", orphans[0].Name)
+	suite.Equal("b.txt", orphans[1].Name)
+
+	orphans, err = suite.repo.ListOrphans(suite.ctx, 1)
+	suite.NoError(err)
+	suite.Require().Len(orphans, 1)
+	suite.Equal("a.txt", orphans[0].Name)
+}
+
+func TestAttachmentRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(AttachmentRepositoryTestSuite))
+}
@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+)
+
+var _ repository.ChecklistRepository = &ChecklistRepositoryImpl{}
+
+// ChecklistRepositoryImpl implements the ChecklistRepository interface using GORM
+type ChecklistRepositoryImpl struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+// NewChecklistRepository creates a new ChecklistRepository instance
+func NewChecklistRepository(logger zerolog.Logger, db *gorm.DB) repository.ChecklistRepository {
+	return &ChecklistRepositoryImpl{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create appends a new item to the end of its todo's checklist, bumps the version of the todo
+// and returns the created item with assigned ID and position
+func (r *ChecklistRepositoryImpl) Create(ctx context.Context, item *entity.ChecklistItem) (*entity.ChecklistItem, error) {
+	if item == nil {
+		return nil, errors.New("checklist item cannot be nil")
+	}
+
+	itemModel := model.ChecklistItemEntityToModel(item)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var position int
+		if err := tx.Model(&model.ChecklistItem{}).
+			Where("todo_id = ?", item.TodoID).
+			Select("COALESCE(MAX(position) + 1, 0)").
+			Scan(&position).Error; err != nil {
+			return err
+		}
+
+		itemModel.Position = position
+		if err := tx.Create(itemModel).Error; err != nil {
+			return err
+		}
+		return bumpTodoVersions(tx, []uint{item.TodoID})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create checklist item: %w", err)
+	}
+
+	return model.ChecklistItemModelToEntity(itemModel), nil
+}
+
+// GetByID retrieves a checklist item by its ID
+// Returns nil if item is not found
+func (r *ChecklistRepositoryImpl) GetByID(ctx context.Context, id uint) (*entity.ChecklistItem, error) {
+	var itemModel model.ChecklistItem
+
+	err := r.db.WithContext(ctx).First(&itemModel, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil for not found, not an error
+		}
+		return nil, fmt.Errorf("failed to get checklist item by id %d: %w", id, err)
+	}
+
+	return model.ChecklistItemModelToEntity(&itemModel), nil
+}
+
+// ListByTodo retrieves the checklist items of a todo ordered by position
+func (r *ChecklistRepositoryImpl) ListByTodo(ctx context.Context, todoID uint) ([]*entity.ChecklistItem, error) {
+	var itemModels []*model.ChecklistItem
+	if err := r.db.WithContext(ctx).
+		Where("todo_id = ?", todoID).
+		Scopes(orderChecklistByPosition).
+		Find(&itemModels).Error; err != nil {
+		return nil, fmt.Errorf("failed to list checklist items: %w", err)
+	}
+
+	return model.ChecklistItemModelsToEntities(itemModels), nil
+}
+
+// Update updates the text and done flag of an item, bumps the version of its todo
+// and returns the number of affected rows
+func (r *ChecklistRepositoryImpl) Update(ctx context.Context, item *entity.ChecklistItem) (int64, error) {
+	if item == nil {
+		return 0, errors.New("checklist item cannot be nil")
+	}
+
+	if item.ID == 0 {
+		return 0, errors.New("checklist item ID cannot be 0")
+	}
+
+	var rowsAffected int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Select the columns so unchecking (false) is written instead of skipped
+		result := tx.Model(&model.ChecklistItem{}).
+			Where("id = ?", item.ID).
+			Select("text", "done", "updated_at").
+			Updates(model.ChecklistItemEntityToModel(item))
+		if result.Error != nil {
+			return result.Error
+		}
+		rowsAffected = result.RowsAffected
+		if rowsAffected == 0 {
+			return nil
+		}
+		return bumpTodoVersions(tx, itemTodo(tx, item.ID))
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to update checklist item: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// Delete permanently removes an item, bumps the version of its todo and returns the number of affected rows
+func (r *ChecklistRepositoryImpl) Delete(ctx context.Context, id uint) (int64, error) {
+	var rowsAffected int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// the todo is looked up while the item still exists
+		if err := bumpTodoVersions(tx, itemTodo(tx, id)); err != nil {
+			return err
+		}
+		result := tx.Delete(&model.ChecklistItem{}, id)
+		rowsAffected = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete checklist item: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// Reorder sets the positions of a todo's items to their index in itemIDs and bumps the version of the todo
+func (r *ChecklistRepositoryImpl) Reorder(ctx context.Context, todoID uint, itemIDs []uint) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for position, id := range itemIDs {
+			if err := tx.Model(&model.ChecklistItem{}).
+				Where("id = ? AND todo_id = ?", id, todoID).
+				UpdateColumn("position", position).Error; err != nil {
+				return err
+			}
+		}
+		return bumpTodoVersions(tx, []uint{todoID})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reorder checklist items: %w", err)
+	}
+
+	return nil
+}
+
+// itemTodo selects the todo ID of a checklist item
+func itemTodo(tx *gorm.DB, id uint) *gorm.DB {
+	return tx.Model(&model.ChecklistItem{}).Select("todo_id").Where("id = ?", id)
+}
@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/config"
+	"itmrchow/go-todolist-service/internal/infrastructure/database"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+type ChecklistRepositoryTestSuite struct {
+	suite.Suite
+	db       *gorm.DB
+	repo     repository.ChecklistRepository
+	todoRepo repository.TodoRepository
+	ctx      context.Context
+}
+
+// SetupSuite 在整個測試 suite 開始前執行一次
+func (suite *ChecklistRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	sqlLiteDB := &database.SQLiteDBImpl{}
+	db, err := sqlLiteDB.Connect(ctx, &config.DatabaseConfig{})
+	suite.Require().NoError(err)
+
+	err = sqlLiteDB.Migrate(&model.Todo{}, &model.Tag{}, &model.TodoTag{}, &model.ChecklistItem{}, &model.TodoDependency{}, &model.TodoAssignee{}, &model.Comment{})
+	suite.Require().NoError(err)
+
+	suite.db = db
+	suite.ctx = actor.WithSystem(ctx)
+
+	suite.repo = NewChecklistRepository(zerolog.New(os.Stdout), suite.db)
+	suite.todoRepo = NewTodoRepository(zerolog.New(os.Stdout), suite.db)
+}
+
+// TearDownSuite 在整個測試 suite 結束後執行一次
+func (suite *ChecklistRepositoryTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		sqlDB, err := suite.db.DB()
+		if err == nil {
+			sqlDB.Close()
+		}
+	}
+}
+
+// TearDownTest 每個測試後清理資料
+func (suite *ChecklistRepositoryTestSuite) TearDownTest() {
+	if suite.db != nil {
+		suite.db.Exec("DELETE FROM todos")
+		suite.db.Exec("DELETE FROM checklist_items")
+		suite.db.Exec("DELETE FROM sqlite_sequence WHERE name IN ('todos', 'checklist_items')")
+	}
+}
+
+// createTodoWithItems creates a todo with one checklist item per text
+func (suite *ChecklistRepositoryTestSuite) createTodoWithItems(texts ...string) (uint, []*entity.ChecklistItem) {
+	todo, err := entity.NewTodo("有清單", nil, nil, nil)
+	suite.Require().NoError(err)
+	createdTodo, err := suite.todoRepo.Create(suite.ctx, todo)
+	suite.Require().NoError(err)
+
+	items := make([]*entity.ChecklistItem, len(texts))
+	for i, text := range texts {
+		item, err := entity.NewChecklistItem(createdTodo.ID, text)
+		suite.Require().NoError(err)
+		items[i], err = suite.repo.Create(suite.ctx, item)
+		suite.Require().NoError(err)
+	}
+	return createdTodo.ID, items
+}
+
+func (suite *ChecklistRepositoryTestSuite) TestCreate_AppendsToEnd() {
+	todoID, items := suite.createTodoWithItems("第一步", "第二步", "第三步")
+	otherTodoID, otherItems := suite.createTodoWithItems("別的清單")
+
+	suite.NotEqual(todoID, otherTodoID)
+	suite.Equal([]int{0, 1, 2}, []int{items[0].Position, items[1].Position, items[2].Position})
+	suite.Equal(0, otherItems[0].Position) // Positions are per todo
+}
+
+func (suite *ChecklistRepositoryTestSuite) TestUpdate_CanUncheck() {
+	_, items := suite.createTodoWithItems("第一步")
+	item := items[0]
+
+	item.SetDone(true)
+	suite.Require().NoError(item.SetText("改過的第一步"))
+	rowsAffected, err := suite.repo.Update(suite.ctx, item)
+	suite.NoError(err)
+	suite.Equal(int64(1), rowsAffected)
+
+	item.SetDone(false)
+	_, err = suite.repo.Update(suite.ctx, item)
+	suite.NoError(err)
+
+	got, err := suite.repo.GetByID(suite.ctx, item.ID)
+	suite.NoError(err)
+	suite.False(got.Done)
+	suite.Equal("改過的第一步", got.Text)
+
+	// one bump for the item created, one per update
+	todo, err := suite.todoRepo.GetByID(suite.ctx, item.TodoID)
+	suite.NoError(err)
+	suite.Equal(uint(4), todo.Version)
+}
+
+func (suite *ChecklistRepositoryTestSuite) TestReorder() {
+	todoID, items := suite.createTodoWithItems("第一步", "第二步", "第三步")
+
+	err := suite.repo.Reorder(suite.ctx, todoID, []uint{items[2].ID, items[0].ID, items[1].ID})
+	suite.NoError(err)
+
+	got, err := suite.repo.ListByTodo(suite.ctx, todoID)
+	suite.NoError(err)
+	suite.Require().Len(got, 3)
+	suite.Equal([]string{"第三步", "第一步", "第二步"}, []string{got[0].Text, got[1].Text, got[2].Text})
+
+	// The todo preloads its checklist in the same order
+	todo, err := suite.todoRepo.GetByID(suite.ctx, todoID)
+	suite.NoError(err)
+	suite.Equal(got[0].ID, todo.Checklist[0].ID)
+	suite.Equal(uint(5), todo.Version)
+}
+
+func (suite *ChecklistRepositoryTestSuite) TestReorder_IgnoresItemsOfOtherTodos() {
+	todoID, items := suite.createTodoWithItems("第一步")
+	_, otherItems := suite.createTodoWithItems("別的清單")
+
+	err := suite.repo.Reorder(suite.ctx, todoID, []uint{otherItems[0].ID, items[0].ID})
+	suite.NoError(err)
+
+	got, _ := suite.repo.GetByID(suite.ctx, otherItems[0].ID)
+	suite.Equal(0, got.Position)
+}
+
+func (suite *ChecklistRepositoryTestSuite) TestDelete() {
+	todoID, items := suite.createTodoWithItems("第一步", "第二步")
+
+	rowsAffected, err := suite.repo.Delete(suite.ctx, items[0].ID)
+	suite.NoError(err)
+	suite.Equal(int64(1), rowsAffected)
+
+	got, err := suite.repo.ListByTodo(suite.ctx, todoID)
+	suite.NoError(err)
+	suite.Require().Len(got, 1)
+	suite.Equal(items[1].ID, got[0].ID)
+
+	missing, err := suite.repo.GetByID(suite.ctx, items[0].ID)
+	suite.NoError(err)
+	suite.Nil(missing)
+
+	todo, err := suite.todoRepo.GetByID(suite.ctx, todoID)
+	suite.NoError(err)
+	suite.Equal(uint(4), todo.Version)
+}
+
+func TestChecklistRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ChecklistRepositoryTestSuite))
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+)
+
+var _ repository.CommentRepository = &CommentRepositoryImpl{}
+
+// CommentRepositoryImpl implements the CommentRepository interface using GORM
+type CommentRepositoryImpl struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+// NewCommentRepository creates a new CommentRepository instance
+func NewCommentRepository(logger zerolog.Logger, db *gorm.DB) repository.CommentRepository {
+	return &CommentRepositoryImpl{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new comment and returns the created comment with assigned ID
+func (r *CommentRepositoryImpl) Create(ctx context.Context, comment *entity.Comment) (*entity.Comment, error) {
+	if comment == nil {
+		return nil, errors.New("comment cannot be nil")
+	}
+
+	commentModel := model.CommentEntityToModel(comment)
+	if err := r.db.WithContext(ctx).Create(commentModel).Error; err != nil {
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	return model.CommentModelToEntity(commentModel), nil
+}
+
+// GetByID retrieves a comment by its ID
+// Returns nil if comment is not found or soft deleted
+func (r *CommentRepositoryImpl) GetByID(ctx context.Context, id uint) (*entity.Comment, error) {
+	var commentModel model.Comment
+
+	err := r.db.WithContext(ctx).First(&commentModel, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil for not found, not an error
+		}
+		return nil, fmt.Errorf("failed to get comment by id %d: %w", id, err)
+	}
+
+	return model.CommentModelToEntity(&commentModel), nil
+}
+
+// ListByTodo retrieves the comments of a todo with pagination, deleted ones are left out
+func (r *CommentRepositoryImpl) ListByTodo(ctx context.Context, todoID uint, pagination *repository.Pagination[entity.Comment]) error {
+	var commentModels []*model.Comment
+
+	query := r.db.WithContext(ctx).Where("todo_id = ?", todoID)
+	if err := FindPage(query, pagination, &commentModels); err != nil {
+		return fmt.Errorf("failed to list comments: %w", err)
+	}
+
+	pagination.Rows = model.CommentModelsToEntities(commentModels)
+
+	return nil
+}
+
+// Update updates the body of a comment and returns the number of affected rows
+func (r *CommentRepositoryImpl) Update(ctx context.Context, comment *entity.Comment) (int64, error) {
+	if comment == nil {
+		return 0, errors.New("comment cannot be nil")
+	}
+
+	if comment.ID == 0 {
+		return 0, errors.New("comment ID cannot be 0")
+	}
+
+	result := r.db.WithContext(ctx).Model(&model.Comment{}).
+		Where("id = ?", comment.ID).
+		Updates(map[string]interface{}{
+			"body":       comment.Body,
+			"edited_at":  comment.EditedAt,
+			"updated_at": comment.UpdatedAt,
+		})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to update comment: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+// Delete soft deletes a comment and returns the number of affected rows
+func (r *CommentRepositoryImpl) Delete(ctx context.Context, id uint) (int64, error) {
+	result := r.db.WithContext(ctx).Delete(&model.Comment{}, id)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete comment: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/config"
+	"itmrchow/go-todolist-service/internal/infrastructure/database"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+type CommentRepositoryTestSuite struct {
+	suite.Suite
+	db       *gorm.DB
+	repo     repository.CommentRepository
+	todoRepo repository.TodoRepository
+	ctx      context.Context
+}
+
+// SetupSuite 在整個測試 suite 開始前執行一次
+func (suite *CommentRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	sqlLiteDB := &database.SQLiteDBImpl{}
+	db, err := sqlLiteDB.Connect(ctx, &config.DatabaseConfig{})
+	suite.Require().NoError(err)
+
+	err = sqlLiteDB.Migrate(&model.Todo{}, &model.Tag{}, &model.TodoTag{}, &model.ChecklistItem{}, &model.StatusChange{}, &model.TodoDependency{}, &model.TodoAssignee{}, &model.Comment{})
+	suite.Require().NoError(err)
+
+	suite.db = db
+	suite.ctx = actor.WithSystem(ctx)
+
+	suite.repo = NewCommentRepository(zerolog.New(os.Stdout), suite.db)
+	suite.todoRepo = NewTodoRepository(zerolog.New(os.Stdout), suite.db)
+}
+
+// TearDownSuite 在整個測試 suite 結束後執行一次
+func (suite *CommentRepositoryTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		sqlDB, err := suite.db.DB()
+		if err == nil {
+			sqlDB.Close()
+		}
+	}
+}
+
+// TearDownTest 每個測試後清理資料
+func (suite *CommentRepositoryTestSuite) TearDownTest() {
+	if suite.db != nil {
+		suite.db.Exec("DELETE FROM todos")
+		suite.db.Exec("DELETE FROM todo_comments")
+		suite.db.Exec("DELETE FROM sqlite_sequence WHERE name IN ('todos', 'todo_comments')")
+	}
+}
+
+// createComments adds comments with the given bodies to the todo, in order
+func (suite *CommentRepositoryTestSuite) createComments(todoID uint, bodies ...string) []*entity.Comment {
+	comments := make([]*entity.Comment, len(bodies))
+	for i, body := range bodies {
+		comment, err := entity.NewComment(todoID, "alice", body)
+		suite.Require().NoError(err)
+		comment.AuthorID = 7
+		comments[i], err = suite.repo.Create(suite.ctx, comment)
+		suite.Require().NoError(err)
+	}
+	return comments
+}
+
+func (suite *CommentRepositoryTestSuite) TestCreateAndGetByID() {
+	created := suite.createComments(1, "明天再確認")[0]
+	suite.Equal(uint(1), created.ID)
+
+	got, err := suite.repo.GetByID(suite.ctx, created.ID)
+	suite.NoError(err)
+	suite.Equal("alice", got.Author)
+	suite.Equal(uint(7), got.AuthorID)
+	suite.Equal("明天再確認", got.Body)
+	suite.Nil(got.EditedAt)
+
+	got, err = suite.repo.GetByID(suite.ctx, 99)
+	suite.NoError(err)
+	suite.Nil(got)
+
+	_, err = suite.repo.Create(suite.ctx, nil)
+	suite.EqualError(err, "comment cannot be nil")
+}
+
+func (suite *CommentRepositoryTestSuite) TestListByTodo_PagesOldestFirst() {
+	suite.createComments(1, "第一則", "第二則", "第三則")
+	suite.createComments(2, "別的todo")
+
+	pagination := &repository.Pagination[entity.Comment]{
+		Limit: 2,
+		Page:  1,
+		Sorts: []repository.SortOption{{Field: "id", Direction: repository.SortAsc}},
+	}
+	err := suite.repo.ListByTodo(suite.ctx, 1, pagination)
+
+	suite.NoError(err)
+	suite.Equal(int64(3), pagination.TotalRows)
+	suite.Equal(2, pagination.TotalPages)
+	suite.Require().Len(pagination.Rows, 2)
+	suite.Equal("第一則", pagination.Rows[0].Body)
+	suite.Equal("第二則", pagination.Rows[1].Body)
+
+	pagination.Page = 2
+	suite.NoError(suite.repo.ListByTodo(suite.ctx, 1, pagination))
+	suite.Require().Len(pagination.Rows, 1)
+	suite.Equal("第三則", pagination.Rows[0].Body)
+}
+
+func (suite *CommentRepositoryTestSuite) TestUpdateAndDelete() {
+	created := suite.createComments(1, "明天再確認")[0]
+
+	suite.Require().NoError(created.Edit("今天確認了"))
+	rowsAffected, err := suite.repo.Update(suite.ctx, created)
+	suite.NoError(err)
+	suite.Equal(int64(1), rowsAffected)
+
+	got, _ := suite.repo.GetByID(suite.ctx, created.ID)
+	suite.Equal("今天確認了", got.Body)
+	suite.NotNil(got.EditedAt)
+
+	rowsAffected, err = suite.repo.Delete(suite.ctx, created.ID)
+	suite.NoError(err)
+	suite.Equal(int64(1), rowsAffected)
+
+	got, _ = suite.repo.GetByID(suite.ctx, created.ID)
+	suite.Nil(got)
+
+	pagination := &repository.Pagination[entity.Comment]{}
+	suite.NoError(suite.repo.ListByTodo(suite.ctx, 1, pagination))
+	suite.Empty(pagination.Rows)
+
+	rowsAffected, err = suite.repo.Delete(suite.ctx, created.ID)
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected)
+}
+
+func (suite *CommentRepositoryTestSuite) TestTodoCommentCount() {
+	first, _ := entity.NewTodo("有留言", nil, nil, nil)
+	createdFirst, _ := suite.todoRepo.Create(suite.ctx, first)
+	second, _ := entity.NewTodo("沒有留言", nil, nil, nil)
+	createdSecond, _ := suite.todoRepo.Create(suite.ctx, second)
+	comments := suite.createComments(createdFirst.ID, "第一則", "第二則", "第三則")
+	suite.repo.Delete(suite.ctx, comments[2].ID)
+
+	pagination := &repository.Pagination[entity.Todo]{Sorts: []repository.SortOption{{Field: "id", Direction: repository.SortAsc}}}
+	suite.NoError(suite.todoRepo.List(suite.ctx, repository.TodoQueryParams{}, pagination))
+	suite.Require().Len(pagination.Rows, 2)
+	suite.Equal(2, pagination.Rows[0].CommentCount)
+	suite.Equal(0, pagination.Rows[1].CommentCount)
+
+	got, _ := suite.todoRepo.GetByID(suite.ctx, createdFirst.ID)
+	suite.Equal(2, got.CommentCount)
+
+	// purging the todo removes its comments, deleted ones included
+	suite.todoRepo.Delete(suite.ctx, createdFirst.ID)
+	_, err := suite.todoRepo.HardDelete(suite.ctx, createdFirst.ID)
+	suite.NoError(err)
+
+	var count int64
+	suite.db.Unscoped().Model(&model.Comment{}).Count(&count)
+	suite.Equal(int64(0), count)
+
+	got, _ = suite.todoRepo.GetByID(suite.ctx, createdSecond.ID)
+	suite.Equal(0, got.CommentCount)
+}
+
+func TestCommentRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(CommentRepositoryTestSuite))
+}
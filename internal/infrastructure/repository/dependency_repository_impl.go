@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+)
+
+var _ repository.DependencyRepository = &DependencyRepositoryImpl{}
+
+// DependencyRepositoryImpl implements the DependencyRepository interface using GORM
+type DependencyRepositoryImpl struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+// NewDependencyRepository creates a new DependencyRepository instance
+func NewDependencyRepository(logger zerolog.Logger, db *gorm.DB) repository.DependencyRepository {
+	return &DependencyRepositoryImpl{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create stores a dependency link between two todos and bumps the version of the blocked todo
+func (r *DependencyRepositoryImpl) Create(ctx context.Context, dep *entity.Dependency) error {
+	if dep == nil {
+		return errors.New("dependency cannot be nil")
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(model.DependencyEntityToModel(dep)).Error; err != nil {
+			return err
+		}
+		return bumpTodoVersions(tx, []uint{dep.TodoID})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create dependency: %w", err)
+	}
+
+	return nil
+}
+
+// Exists checks if the todo is already blocked by the blocker
+func (r *DependencyRepositoryImpl) Exists(ctx context.Context, todoID uint, blockerID uint) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.TodoDependency{}).
+		Where("todo_id = ? AND blocker_id = ?", todoID, blockerID).
+		Count(&count).Error; err != nil {
+		return false, fmt.Errorf("failed to check dependency: %w", err)
+	}
+
+	return count > 0, nil
+}
+
+// Delete removes a dependency link, bumps the version of the blocked todo and returns the number of affected rows
+func (r *DependencyRepositoryImpl) Delete(ctx context.Context, todoID uint, blockerID uint) (int64, error) {
+	var rowsAffected int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("todo_id = ? AND blocker_id = ?", todoID, blockerID).Delete(&model.TodoDependency{})
+		if result.Error != nil {
+			return result.Error
+		}
+		rowsAffected = result.RowsAffected
+		if rowsAffected == 0 {
+			return nil
+		}
+		return bumpTodoVersions(tx, []uint{todoID})
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete dependency: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// ListByTodos retrieves the links of the given blocked todos in one query
+func (r *DependencyRepositoryImpl) ListByTodos(ctx context.Context, todoIDs []uint) ([]*entity.Dependency, error) {
+	if len(todoIDs) == 0 {
+		return nil, nil
+	}
+
+	var depModels []*model.TodoDependency
+	if err := r.db.WithContext(ctx).
+		Where("todo_id IN ?", todoIDs).
+		Order("todo_id ASC, blocker_id ASC").
+		Find(&depModels).Error; err != nil {
+		return nil, fmt.Errorf("failed to list dependencies: %w", err)
+	}
+
+	return model.DependencyModelsToEntities(depModels), nil
+}
+
+// CountUnfinishedBlockers counts the blockers of a todo that are not in a done status,
+// blockers in the trash are ignored
+func (r *DependencyRepositoryImpl) CountUnfinishedBlockers(ctx context.Context, todoID uint) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.Todo{}).
+		Joins("JOIN todo_dependencies ON todo_dependencies.blocker_id = todos.id").
+		Where("todo_dependencies.todo_id = ?", todoID).
+		Where("NOT EXISTS ("+statusCategorySubquery+" AND ws.category = ?)", string(entity.CategoryDone)).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count unfinished blockers: %w", err)
+	}
+
+	return count, nil
+}
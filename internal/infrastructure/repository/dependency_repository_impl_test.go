@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/config"
+	"itmrchow/go-todolist-service/internal/infrastructure/database"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+type DependencyRepositoryTestSuite struct {
+	suite.Suite
+	db       *gorm.DB
+	repo     repository.DependencyRepository
+	todoRepo repository.TodoRepository
+	ctx      context.Context
+	workflow *entity.Workflow // default workflow, seeded once
+}
+
+// SetupSuite 在整個測試 suite 開始前執行一次
+func (suite *DependencyRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	sqlLiteDB := &database.SQLiteDBImpl{}
+	db, err := sqlLiteDB.Connect(ctx, &config.DatabaseConfig{})
+	suite.Require().NoError(err)
+
+	err = sqlLiteDB.Migrate(&model.Todo{}, &model.Tag{}, &model.TodoTag{}, &model.ChecklistItem{}, &model.StatusChange{}, &model.TodoDependency{}, &model.TodoAssignee{}, &model.Comment{},
+		&model.Workflow{}, &model.WorkflowStatus{})
+	suite.Require().NoError(err)
+
+	suite.db = db
+	suite.ctx = actor.WithSystem(ctx)
+
+	suite.repo = NewDependencyRepository(zerolog.New(os.Stdout), suite.db)
+	suite.todoRepo = NewTodoRepository(zerolog.New(os.Stdout), suite.db)
+	suite.workflow, err = NewWorkflowRepository(zerolog.New(os.Stdout), suite.db).EnsureDefault(ctx, entity.DefaultWorkflow())
+	suite.Require().NoError(err)
+}
+
+// TearDownSuite 在整個測試 suite 結束後執行一次
+func (suite *DependencyRepositoryTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		sqlDB, err := suite.db.DB()
+		if err == nil {
+			sqlDB.Close()
+		}
+	}
+}
+
+// TearDownTest 每個測試後清理資料
+func (suite *DependencyRepositoryTestSuite) TearDownTest() {
+	if suite.db != nil {
+		suite.db.Exec("DELETE FROM todos")
+		suite.db.Exec("DELETE FROM todo_dependencies")
+		suite.db.Exec("DELETE FROM sqlite_sequence WHERE name = 'todos'")
+	}
+}
+
+// createTodo creates a todo of the default workflow in the given status
+func (suite *DependencyRepositoryTestSuite) createTodo(title string, status entity.TodoStatus) *entity.Todo {
+	todo, err := entity.NewTodo(title, nil, nil, nil)
+	suite.Require().NoError(err)
+	suite.Require().NoError(todo.SetWorkflow(suite.workflow, time.Now()))
+	todo.Status = status
+
+	created, err := suite.todoRepo.Create(suite.ctx, todo)
+	suite.Require().NoError(err)
+	return created
+}
+
+func (suite *DependencyRepositoryTestSuite) TestCreateExistsDelete() {
+	blocked := suite.createTodo("上線", entity.StatusPending)
+	blocker := suite.createTodo("測試", entity.StatusPending)
+	dep, _ := entity.NewDependency(blocked.ID, blocker.ID)
+
+	suite.NoError(suite.repo.Create(suite.ctx, dep))
+	suite.EqualError(suite.repo.Create(suite.ctx, nil), "dependency cannot be nil")
+
+	exists, err := suite.repo.Exists(suite.ctx, blocked.ID, blocker.ID)
+	suite.NoError(err)
+	suite.True(exists)
+
+	// the link is directed
+	exists, err = suite.repo.Exists(suite.ctx, blocker.ID, blocked.ID)
+	suite.NoError(err)
+	suite.False(exists)
+
+	// only the blocked todo changes
+	got, _ := suite.todoRepo.GetByID(suite.ctx, blocked.ID)
+	suite.Equal([]uint{blocker.ID}, got.BlockedBy)
+	suite.Equal(blocked.Version+1, got.Version)
+	got, _ = suite.todoRepo.GetByID(suite.ctx, blocker.ID)
+	suite.Equal(blocker.Version, got.Version)
+
+	rowsAffected, err := suite.repo.Delete(suite.ctx, blocked.ID, blocker.ID)
+	suite.NoError(err)
+	suite.Equal(int64(1), rowsAffected)
+
+	rowsAffected, err = suite.repo.Delete(suite.ctx, blocked.ID, blocker.ID)
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected)
+
+	got, _ = suite.todoRepo.GetByID(suite.ctx, blocked.ID)
+	suite.Equal(blocked.Version+2, got.Version)
+}
+
+func (suite *DependencyRepositoryTestSuite) TestListByTodos() {
+	a := suite.createTodo("A", entity.StatusPending)
+	b := suite.createTodo("B", entity.StatusPending)
+	c := suite.createTodo("C", entity.StatusPending)
+	for _, pair := range [][2]uint{{a.ID, b.ID}, {b.ID, c.ID}, {a.ID, c.ID}} {
+		dep, _ := entity.NewDependency(pair[0], pair[1])
+		suite.Require().NoError(suite.repo.Create(suite.ctx, dep))
+	}
+
+	deps, err := suite.repo.ListByTodos(suite.ctx, []uint{a.ID, c.ID})
+	suite.NoError(err)
+	suite.Require().Len(deps, 2)
+	suite.Equal(b.ID, deps[0].BlockerID)
+	suite.Equal(c.ID, deps[1].BlockerID)
+
+	deps, err = suite.repo.ListByTodos(suite.ctx, nil)
+	suite.NoError(err)
+	suite.Empty(deps)
+}
+
+func (suite *DependencyRepositoryTestSuite) TestCountUnfinishedBlockers() {
+	blocked := suite.createTodo("上線", entity.StatusPending)
+	open := suite.createTodo("寫文件", entity.StatusDoing)
+	done := suite.createTodo("測試", entity.StatusDone)
+	trashed := suite.createTodo("舊需求", entity.StatusPending)
+	for _, blocker := range []*entity.Todo{open, done, trashed} {
+		dep, _ := entity.NewDependency(blocked.ID, blocker.ID)
+		suite.Require().NoError(suite.repo.Create(suite.ctx, dep))
+	}
+	suite.todoRepo.Delete(suite.ctx, trashed.ID)
+
+	count, err := suite.repo.CountUnfinishedBlockers(suite.ctx, blocked.ID)
+	suite.NoError(err)
+	suite.Equal(int64(1), count)
+
+	count, err = suite.repo.CountUnfinishedBlockers(suite.ctx, open.ID)
+	suite.NoError(err)
+	suite.Equal(int64(0), count)
+}
+
+func (suite *DependencyRepositoryTestSuite) TestPurge_RemovesLinksInBothDirections() {
+	a := suite.createTodo("A", entity.StatusPending)
+	b := suite.createTodo("B", entity.StatusPending)
+	c := suite.createTodo("C", entity.StatusPending)
+	for _, pair := range [][2]uint{{a.ID, b.ID}, {b.ID, c.ID}} {
+		dep, _ := entity.NewDependency(pair[0], pair[1])
+		suite.Require().NoError(suite.repo.Create(suite.ctx, dep))
+	}
+
+	suite.todoRepo.Delete(suite.ctx, b.ID)
+	_, err := suite.todoRepo.HardDelete(suite.ctx, b.ID)
+	suite.NoError(err)
+
+	var links int64
+	suite.db.Model(&model.TodoDependency{}).Count(&links)
+	suite.Equal(int64(0), links)
+}
+
+func TestDependencyRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(DependencyRepositoryTestSuite))
+}
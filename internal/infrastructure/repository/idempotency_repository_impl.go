@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+)
+
+var _ repository.IdempotencyRepository = &IdempotencyRepositoryImpl{}
+
+// IdempotencyRepositoryImpl implements the IdempotencyRepository interface using GORM
+type IdempotencyRepositoryImpl struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+// NewIdempotencyRepository creates a new IdempotencyRepository instance
+func NewIdempotencyRepository(logger zerolog.Logger, db *gorm.DB) repository.IdempotencyRepository {
+	return &IdempotencyRepositoryImpl{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create stores a new record unless the user already holds its key,
+// returns false when the key is taken and the record was not stored
+func (r *IdempotencyRepositoryImpl) Create(ctx context.Context, record *entity.IdempotencyRecord) (bool, error) {
+	if record == nil {
+		return false, errors.New("idempotency record cannot be nil")
+	}
+
+	// The unique index on (user_id, key) decides which of two concurrent requests runs
+	recordModel := model.IdempotencyRecordEntityToModel(record)
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(recordModel)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to create idempotency record: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	record.ID = recordModel.ID
+	record.CreatedAt = recordModel.CreatedAt
+	return true, nil
+}
+
+// Get retrieves the record of a key sent by a user, expired records included
+// Returns nil if the record is not found
+func (r *IdempotencyRepositoryImpl) Get(ctx context.Context, userID uint, key string) (*entity.IdempotencyRecord, error) {
+	var recordModel model.IdempotencyRecord
+
+	// key is a reserved word in MySQL, a map condition lets GORM quote the column
+	err := r.db.WithContext(ctx).
+		Where(map[string]interface{}{"user_id": userID, "key": key}).
+		First(&recordModel).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil for not found, not an error
+		}
+		return nil, fmt.Errorf("failed to get idempotency record: %w", err)
+	}
+
+	return model.IdempotencyRecordModelToEntity(&recordModel), nil
+}
+
+// Update stores the response of a record and returns the number of affected rows
+func (r *IdempotencyRepositoryImpl) Update(ctx context.Context, record *entity.IdempotencyRecord) (int64, error) {
+	if record == nil {
+		return 0, errors.New("idempotency record cannot be nil")
+	}
+
+	recordModel := model.IdempotencyRecordEntityToModel(record)
+	result := r.db.WithContext(ctx).Model(&model.IdempotencyRecord{}).
+		Where("id = ?", record.ID).
+		Select("status_code", "headers", "body").
+		Updates(recordModel)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to update idempotency record: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+// Delete removes a record and returns the number of affected rows
+func (r *IdempotencyRepositoryImpl) Delete(ctx context.Context, id uint) (int64, error) {
+	result := r.db.WithContext(ctx).Delete(&model.IdempotencyRecord{}, id)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete idempotency record: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+// DeleteExpired removes at most limit records expired at the given time
+// and returns the number of affected rows
+func (r *IdempotencyRepositoryImpl) DeleteExpired(ctx context.Context, before time.Time, limit int) (int64, error) {
+	if limit <= 0 {
+		return 0, errors.New("limit must be greater than 0")
+	}
+
+	// Select the batch first so the DELETE only locks the chosen primary keys
+	var ids []uint
+	if err := r.db.WithContext(ctx).Model(&model.IdempotencyRecord{}).
+		Where("expires_at <= ?", before).
+		Order("expires_at asc").
+		Limit(limit).
+		Pluck("id", &ids).Error; err != nil {
+		return 0, fmt.Errorf("failed to select expired idempotency records: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	result := r.db.WithContext(ctx).Delete(&model.IdempotencyRecord{}, ids)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete expired idempotency records: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/config"
+	"itmrchow/go-todolist-service/internal/infrastructure/database"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+)
+
+type IdempotencyRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo repository.IdempotencyRepository
+	ctx  context.Context
+}
+
+// SetupSuite 在整個測試 suite 開始前執行一次
+func (suite *IdempotencyRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	sqlLiteDB := &database.SQLiteDBImpl{}
+	db, err := sqlLiteDB.Connect(ctx, &config.DatabaseConfig{})
+	suite.Require().NoError(err)
+
+	err = sqlLiteDB.Migrate(&model.IdempotencyRecord{})
+	suite.Require().NoError(err)
+
+	suite.db = db
+	suite.ctx = ctx
+
+	suite.repo = NewIdempotencyRepository(zerolog.New(os.Stdout), suite.db)
+}
+
+// TearDownSuite 在整個測試 suite 結束後執行一次
+func (suite *IdempotencyRepositoryTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		sqlDB, err := suite.db.DB()
+		if err == nil {
+			sqlDB.Close()
+		}
+	}
+}
+
+// TearDownTest 每個測試後清理資料
+func (suite *IdempotencyRepositoryTestSuite) TearDownTest() {
+	if suite.db != nil {
+		suite.db.Exec("DELETE FROM idempotency_keys")
+		suite.db.Exec("DELETE FROM sqlite_sequence WHERE name = 'idempotency_keys'")
+	}
+}
+
+func TestIdempotencyRepositorySuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyRepositoryTestSuite))
+}
+
+func (suite *IdempotencyRepositoryTestSuite) newRecord(userID uint, key string, now time.Time) *entity.IdempotencyRecord {
+	record, err := entity.NewIdempotencyRecord(userID, key, "hash-"+key, now, time.Hour)
+	suite.Require().NoError(err)
+	return record
+}
+
+func (suite *IdempotencyRepositoryTestSuite) TestCreate() {
+	now := time.Now().UTC()
+
+	created, err := suite.repo.Create(suite.ctx, suite.newRecord(1, "k", now))
+	suite.NoError(err)
+	suite.True(created)
+
+	// The same key of the same user is taken
+	created, err = suite.repo.Create(suite.ctx, suite.newRecord(1, "k", now))
+	suite.NoError(err)
+	suite.False(created)
+
+	// Keys are scoped to their user
+	created, err = suite.repo.Create(suite.ctx, suite.newRecord(2, "k", now))
+	suite.NoError(err)
+	suite.True(created)
+
+	_, err = suite.repo.Create(suite.ctx, nil)
+	suite.EqualError(err, "idempotency record cannot be nil")
+}
+
+func (suite *IdempotencyRepositoryTestSuite) TestGetAndUpdate() {
+	record := suite.newRecord(1, "k", time.Now().UTC())
+	created, err := suite.repo.Create(suite.ctx, record)
+	suite.Require().NoError(err)
+	suite.Require().True(created)
+
+	found, err := suite.repo.Get(suite.ctx, 1, "k")
+	suite.NoError(err)
+	suite.Equal(record.ID, found.ID)
+	suite.False(found.Completed())
+
+	suite.Require().NoError(found.Complete(201, map[string]string{"Location": "/api/v2/todos/3"}, []byte(`{"id":3}`)))
+	rows, err := suite.repo.Update(suite.ctx, found)
+	suite.NoError(err)
+	suite.Equal(int64(1), rows)
+
+	found, err = suite.repo.Get(suite.ctx, 1, "k")
+	suite.NoError(err)
+	suite.Equal(201, found.StatusCode)
+	suite.Equal(map[string]string{"Location": "/api/v2/todos/3"}, found.Headers)
+	suite.Equal([]byte(`{"id":3}`), found.Body)
+	suite.Equal("hash-k", found.RequestHash)
+
+	missing, err := suite.repo.Get(suite.ctx, 2, "k")
+	suite.NoError(err)
+	suite.Nil(missing)
+}
+
+func (suite *IdempotencyRepositoryTestSuite) TestDelete() {
+	record := suite.newRecord(1, "k", time.Now().UTC())
+	_, err := suite.repo.Create(suite.ctx, record)
+	suite.Require().NoError(err)
+
+	rows, err := suite.repo.Delete(suite.ctx, record.ID)
+	suite.NoError(err)
+	suite.Equal(int64(1), rows)
+
+	// The key can be used again once released
+	created, err := suite.repo.Create(suite.ctx, suite.newRecord(1, "k", time.Now().UTC()))
+	suite.NoError(err)
+	suite.True(created)
+
+	rows, err = suite.repo.Delete(suite.ctx, record.ID)
+	suite.NoError(err)
+	suite.Equal(int64(0), rows)
+}
+
+func (suite *IdempotencyRepositoryTestSuite) TestDeleteExpired() {
+	now := time.Now().UTC()
+	for i, key := range []string{"a", "b", "c"} {
+		_, err := suite.repo.Create(suite.ctx, suite.newRecord(1, key, now.Add(-time.Duration(3-i)*time.Hour)))
+		suite.Require().NoError(err)
+	}
+	_, err := suite.repo.Create(suite.ctx, suite.newRecord(1, "fresh", now))
+	suite.Require().NoError(err)
+
+	rows, err := suite.repo.DeleteExpired(suite.ctx, now, 2)
+	suite.NoError(err)
+	suite.Equal(int64(2), rows)
+
+	// The oldest records go first
+	found, err := suite.repo.Get(suite.ctx, 1, "a")
+	suite.NoError(err)
+	suite.Nil(found)
+	found, err = suite.repo.Get(suite.ctx, 1, "c")
+	suite.NoError(err)
+	suite.NotNil(found)
+
+	rows, err = suite.repo.DeleteExpired(suite.ctx, now, 2)
+	suite.NoError(err)
+	suite.Equal(int64(1), rows)
+
+	found, err = suite.repo.Get(suite.ctx, 1, "fresh")
+	suite.NoError(err)
+	suite.NotNil(found)
+
+	_, err = suite.repo.DeleteExpired(suite.ctx, now, 0)
+	suite.EqualError(err, "limit must be greater than 0")
+}
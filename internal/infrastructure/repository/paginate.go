@@ -1,24 +1,304 @@
 package repository
 
 import (
+	"context"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
 	"math"
+	"reflect"
+	"strings"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
 
 	"itmrchow/go-todolist-service/internal/domain/repository"
 )
 
 func Paginate[T any](value interface{}, p *repository.Pagination[T], db *gorm.DB) func(db *gorm.DB) *gorm.DB {
-	// query count
-	var totalRows int64
-	db.Model(value).Count(&totalRows)
-	p.TotalRows = totalRows
+	if !p.SkipCount {
+		// query count
+		var totalRows int64
+		db.Model(value).Count(&totalRows)
+		p.TotalRows = totalRows
 
-	// total page
-	totalPages := int(math.Ceil(float64(totalRows) / float64(p.Limit)))
-	p.TotalPages = totalPages
+		// total page
+		totalPages := int(math.Ceil(float64(totalRows) / float64(p.GetLimit())))
+		p.TotalPages = totalPages
+	}
 
 	return func(db *gorm.DB) *gorm.DB {
-		return db.Offset(p.GetOffset()).Limit(p.GetLimit()).Order(p.GetSort())
+		return db.Offset(p.GetOffset()).Limit(p.GetLimit()).Order(OrderBy(p.GetSorts()))
+	}
+}
+
+// FindPage loads one page of M rows into dest and fills the pagination metadata
+// Offset mode delegates to Paginate, cursor mode seeks past the row encoded in p.Cursor
+// so it neither needs OFFSET nor skips/duplicates rows when data changes between pages
+func FindPage[T any, M any](db *gorm.DB, p *repository.Pagination[T], dest *[]*M) error {
+	if !p.UseCursor {
+		return db.Scopes(Paginate(new(M), p, db)).Find(dest).Error
+	}
+
+	sorts := p.GetSorts()
+
+	// Resolve the sort columns to model fields so cursor values keep their Go types
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(new(M)); err != nil {
+		return err
+	}
+	fields := make([]*schema.Field, len(sorts))
+	for i, sort := range sorts {
+		fields[i] = stmt.Schema.LookUpField(sort.Field)
+		if fields[i] == nil {
+			return fmt.Errorf("unknown sort field %q", sort.Field)
+		}
+	}
+
+	if !p.SkipCount {
+		var totalRows int64
+		if err := db.Session(&gorm.Session{}).Model(new(M)).Count(&totalRows).Error; err != nil {
+			return err
+		}
+		p.TotalRows = totalRows
+		p.TotalPages = int(math.Ceil(float64(totalRows) / float64(p.GetLimit())))
+	}
+
+	query := db.Session(&gorm.Session{})
+	backward := false
+	if p.Cursor != "" {
+		token, err := decodeCursor(p.Cursor, sorts, fields)
+		if err != nil {
+			return err
+		}
+		backward = token.Backward
+		query = query.Where(keysetCondition(sorts, token.values, backward))
+	}
+
+	// Fetch one extra row to know whether there is another page in the walking direction
+	if err := query.Order(orderBy(sorts, backward)).Limit(p.GetLimit() + 1).Find(dest).Error; err != nil {
+		return err
+	}
+
+	rows := *dest
+	hasMore := len(rows) > p.GetLimit()
+	if hasMore {
+		rows = rows[:p.GetLimit()]
+	}
+	if backward {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+	}
+	*dest = rows
+
+	p.NextCursor, p.PrevCursor = "", ""
+	if len(rows) == 0 {
+		return nil
+	}
+
+	// Walking forward there is a previous page whenever we started from a cursor and vice versa
+	var err error
+	if (!backward && hasMore) || (backward && p.Cursor != "") {
+		if p.NextCursor, err = encodeCursor(sorts, fields, rows[len(rows)-1], false); err != nil {
+			return err
+		}
+	}
+	if (backward && hasMore) || (!backward && p.Cursor != "") {
+		if p.PrevCursor, err = encodeCursor(sorts, fields, rows[0], true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OrderBy builds an ORDER BY clause from sort options
+// Column names are written as quoted identifiers so they can never inject SQL
+func OrderBy(sorts []repository.SortOption) clause.OrderBy {
+	return orderBy(sorts, false)
+}
+
+// orderBy builds an ORDER BY clause, reverse flips every key including the NULL placement
+func orderBy(sorts []repository.SortOption, reverse bool) clause.OrderBy {
+	parts := make([]string, 0, len(sorts)*2)
+	vars := make([]interface{}, 0, len(sorts)*2)
+
+	for _, sort := range sorts {
+		column := clause.Column{Name: sort.Field}
+
+		// NULLs sort as smallest in MySQL and SQLite, push them last explicitly
+		if sort.NullsLast {
+			if reverse {
+				parts = append(parts, "? IS NULL DESC")
+			} else {
+				parts = append(parts, "? IS NULL")
+			}
+			vars = append(vars, column)
+		}
+
+		if (sort.Direction == repository.SortDesc) != reverse {
+			parts = append(parts, "? DESC")
+		} else {
+			parts = append(parts, "? ASC")
+		}
+		vars = append(vars, column)
+	}
+
+	return clause.OrderBy{
+		Expression: clause.Expr{
+			SQL:                strings.Join(parts, ", "),
+			Vars:               vars,
+			WithoutParentheses: true,
+		},
+	}
+}
+
+// keysetCondition matches rows strictly after (or before when backward) the cursor row:
+// (k1 > v1) OR (k1 = v1 AND k2 > v2) OR ... where ">" follows each key's direction
+func keysetCondition(sorts []repository.SortOption, values []interface{}, backward bool) clause.Expr {
+	var (
+		ors  []string
+		vars []interface{}
+	)
+
+	for i := range sorts {
+		var ands []string
+		var andVars []interface{}
+
+		// all previous keys equal
+		for j := 0; j < i; j++ {
+			column := clause.Column{Name: sorts[j].Field}
+			if values[j] == nil {
+				ands = append(ands, "? IS NULL")
+				andVars = append(andVars, column)
+			} else {
+				ands = append(ands, "? = ?")
+				andVars = append(andVars, column, values[j])
+			}
+		}
+
+		// this key beyond the cursor value
+		column := clause.Column{Name: sorts[i].Field}
+		operator := "<"
+		if (sorts[i].Direction == repository.SortAsc) != backward {
+			operator = ">"
+		}
+		switch {
+		case values[i] == nil && (!sorts[i].NullsLast || !backward):
+			// nothing sorts beyond a trailing NULL, ties are left to the next key
+			continue
+		case values[i] == nil:
+			ands = append(ands, "? IS NOT NULL")
+			andVars = append(andVars, column)
+		case sorts[i].NullsLast && !backward:
+			ands = append(ands, "(? "+operator+" ? OR ? IS NULL)")
+			andVars = append(andVars, column, values[i], column)
+		default:
+			ands = append(ands, "? "+operator+" ?")
+			andVars = append(andVars, column, values[i])
+		}
+
+		ors = append(ors, "("+strings.Join(ands, " AND ")+")")
+		vars = append(vars, andVars...)
+	}
+
+	if len(ors) == 0 {
+		return clause.Expr{SQL: "1 = 0"}
+	}
+
+	return clause.Expr{SQL: "(" + strings.Join(ors, " OR ") + ")", Vars: vars}
+}
+
+// cursorToken is the decoded form of an opaque pagination cursor
+type cursorToken struct {
+	Sort     string            `json:"s"`           // sort signature the cursor was issued for
+	Values   []json.RawMessage `json:"v"`           // sort key values of the boundary row
+	Backward bool              `json:"b,omitempty"` // walk towards the previous page
+
+	values []interface{}
+}
+
+// sortSignature identifies a sort so cursors cannot be replayed against another ordering
+func sortSignature(sorts []repository.SortOption) string {
+	keys := make([]string, len(sorts))
+	for i, sort := range sorts {
+		keys[i] = sort.Field + ":" + string(sort.Direction)
+	}
+	return strings.Join(keys, ",")
+}
+
+// encodeCursor builds an opaque cursor from the sort key values of row
+func encodeCursor[M any](sorts []repository.SortOption, fields []*schema.Field, row *M, backward bool) (string, error) {
+	token := cursorToken{
+		Sort:     sortSignature(sorts),
+		Values:   make([]json.RawMessage, len(fields)),
+		Backward: backward,
+	}
+
+	rv := reflect.ValueOf(row).Elem()
+	for i, field := range fields {
+		value, _ := field.ValueOf(context.Background(), rv)
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return "", fmt.Errorf("failed to encode cursor: %w", err)
+		}
+		token.Values[i] = raw
 	}
+
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeCursor parses an opaque cursor and converts its values back to the field types
+func decodeCursor(cursor string, sorts []repository.SortOption, fields []*schema.Field) (*cursorToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, repository.ErrInvalidCursor
+	}
+
+	var token cursorToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, repository.ErrInvalidCursor
+	}
+	if token.Sort != sortSignature(sorts) || len(token.Values) != len(fields) {
+		return nil, fmt.Errorf("%w: cursor does not match the requested sort", repository.ErrInvalidCursor)
+	}
+
+	token.values = make([]interface{}, len(fields))
+	for i, field := range fields {
+		value := reflect.New(field.FieldType)
+		if err := json.Unmarshal(token.Values[i], value.Interface()); err != nil {
+			return nil, repository.ErrInvalidCursor
+		}
+		token.values[i] = sqlValue(value.Elem().Interface())
+	}
+
+	return &token, nil
+}
+
+// sqlValue unwraps pointers and driver.Valuer types, returning nil for NULL values
+func sqlValue(value interface{}) interface{} {
+	if valuer, ok := value.(driver.Valuer); ok {
+		v, err := valuer.Value()
+		if err == nil {
+			return v
+		}
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil
+		}
+		return rv.Elem().Interface()
+	}
+
+	return value
 }
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+)
+
+var _ repository.ProjectRepository = &ProjectRepositoryImpl{}
+
+// ProjectRepositoryImpl implements the ProjectRepository interface using GORM
+type ProjectRepositoryImpl struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+// NewProjectRepository creates a new ProjectRepository instance
+func NewProjectRepository(logger zerolog.Logger, db *gorm.DB) repository.ProjectRepository {
+	return &ProjectRepositoryImpl{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new project and returns the created project with assigned ID
+func (r *ProjectRepositoryImpl) Create(ctx context.Context, project *entity.Project) (*entity.Project, error) {
+	if project == nil {
+		return nil, errors.New("project cannot be nil")
+	}
+
+	projectModel := model.ProjectEntityToModel(project)
+	if err := r.db.WithContext(ctx).Create(projectModel).Error; err != nil {
+		return nil, fmt.Errorf("failed to create project: %w", err)
+	}
+
+	return model.ProjectModelToEntity(projectModel), nil
+}
+
+// GetByID retrieves a project by its ID
+// Returns nil if project is not found
+func (r *ProjectRepositoryImpl) GetByID(ctx context.Context, id uint) (*entity.Project, error) {
+	var projectModel model.Project
+
+	err := r.db.WithContext(ctx).Scopes(inWorkspace(ctx, "projects")).First(&projectModel, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil for not found, not an error
+		}
+		return nil, fmt.Errorf("failed to get project by id %d: %w", id, err)
+	}
+
+	return model.ProjectModelToEntity(&projectModel), nil
+}
+
+// GetByName retrieves a project by its exact name
+// Returns nil if project is not found
+func (r *ProjectRepositoryImpl) GetByName(ctx context.Context, name string) (*entity.Project, error) {
+	var projectModel model.Project
+
+	err := r.db.WithContext(ctx).Scopes(inWorkspace(ctx, "projects")).Where("name = ?", name).First(&projectModel).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil for not found, not an error
+		}
+		return nil, fmt.Errorf("failed to get project by name: %w", err)
+	}
+
+	return model.ProjectModelToEntity(&projectModel), nil
+}
+
+// List retrieves all projects ordered by name
+func (r *ProjectRepositoryImpl) List(ctx context.Context) ([]*entity.Project, error) {
+	var projectModels []*model.Project
+	if err := r.db.WithContext(ctx).Scopes(inWorkspace(ctx, "projects")).Order("name ASC").Find(&projectModels).Error; err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	return model.ProjectModelsToEntities(projectModels), nil
+}
+
+// CountByWorkflow counts the projects using the workflow
+func (r *ProjectRepositoryImpl) CountByWorkflow(ctx context.Context, workflowID uint) (int64, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&model.Project{}).Scopes(inWorkspace(ctx, "projects")).Where("workflow_id = ?", workflowID).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count projects: %w", err)
+	}
+
+	return count, nil
+}
+
+// Update updates the name and description of a project and returns the number of affected rows
+func (r *ProjectRepositoryImpl) Update(ctx context.Context, project *entity.Project) (int64, error) {
+	if project == nil {
+		return 0, errors.New("project cannot be nil")
+	}
+
+	if project.ID == 0 {
+		return 0, errors.New("project ID cannot be 0")
+	}
+
+	result := r.db.WithContext(ctx).Model(&model.Project{}).
+		Scopes(inWorkspace(ctx, "projects")).
+		Where("id = ?", project.ID).
+		Select("name", "description", "updated_at").
+		Updates(model.ProjectEntityToModel(project))
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to update project: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+// Delete permanently removes a project and returns the number of affected rows,
+// cascade soft deletes its todos, then every remaining todo of the project,
+// trashed ones included, is detached so a restored todo comes back without project.
+// The todos are only touched once the project was found in the workspace of ctx
+func (r *ProjectRepositoryImpl) Delete(ctx context.Context, id uint, cascade bool) (int64, error) {
+	var rowsAffected int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Scopes(inWorkspace(ctx, "projects")).Delete(&model.Project{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		rowsAffected = result.RowsAffected
+		if rowsAffected == 0 {
+			return nil
+		}
+
+		if cascade {
+			if err := tx.Model(&model.Todo{}).
+				Scopes(inTenant(ctx)).
+				Where("project_id = ?", id).
+				UpdateColumns(softDeleteColumns(tx)).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Unscoped().Model(&model.Todo{}).
+			Scopes(inTenant(ctx)).
+			Where("project_id = ?", id).
+			UpdateColumns(map[string]interface{}{
+				"project_id": nil,
+				"version":    gorm.Expr("version + 1"),
+			}).Error
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete project: %w", err)
+	}
+
+	return rowsAffected, nil
+}
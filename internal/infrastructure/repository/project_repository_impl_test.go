@@ -0,0 +1,270 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/config"
+	"itmrchow/go-todolist-service/internal/infrastructure/database"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+type ProjectRepositoryTestSuite struct {
+	suite.Suite
+	db       *gorm.DB
+	repo     repository.ProjectRepository
+	todoRepo repository.TodoRepository
+	ctx      context.Context
+}
+
+// SetupSuite 在整個測試 suite 開始前執行一次
+func (suite *ProjectRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	sqlLiteDB := &database.SQLiteDBImpl{}
+	db, err := sqlLiteDB.Connect(ctx, &config.DatabaseConfig{})
+	suite.Require().NoError(err)
+
+	err = sqlLiteDB.Migrate(&model.Todo{}, &model.Tag{}, &model.TodoTag{}, &model.ChecklistItem{}, &model.TodoDependency{}, &model.TodoAssignee{}, &model.Comment{}, &model.Project{})
+	suite.Require().NoError(err)
+
+	suite.db = db
+	suite.ctx = actor.WithSystem(ctx)
+
+	suite.repo = NewProjectRepository(zerolog.New(os.Stdout), suite.db)
+	suite.todoRepo = NewTodoRepository(zerolog.New(os.Stdout), suite.db)
+}
+
+// TearDownSuite 在整個測試 suite 結束後執行一次
+func (suite *ProjectRepositoryTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		sqlDB, err := suite.db.DB()
+		if err == nil {
+			sqlDB.Close()
+		}
+	}
+}
+
+// TearDownTest 每個測試後清理資料
+func (suite *ProjectRepositoryTestSuite) TearDownTest() {
+	if suite.db != nil {
+		suite.db.Exec("DELETE FROM todos")
+		suite.db.Exec("DELETE FROM projects")
+		suite.db.Exec("DELETE FROM sqlite_sequence WHERE name IN ('todos', 'projects')")
+	}
+}
+
+func (suite *ProjectRepositoryTestSuite) create(name string, workflowID uint) *entity.Project {
+	project, err := entity.NewProject(name, nil, workflowID)
+	suite.Require().NoError(err)
+	created, err := suite.repo.Create(suite.ctx, project)
+	suite.Require().NoError(err)
+	return created
+}
+
+func (suite *ProjectRepositoryTestSuite) createTodo(title string, projectID *uint) *entity.Todo {
+	todo, err := entity.NewTodo(title, nil, nil, nil)
+	suite.Require().NoError(err)
+	todo.ProjectID = projectID
+	created, err := suite.todoRepo.Create(suite.ctx, todo)
+	suite.Require().NoError(err)
+	return created
+}
+
+func (suite *ProjectRepositoryTestSuite) TestCreate_GetByIDAndName() {
+	created := suite.create("Q3", 1)
+
+	suite.NotZero(created.ID)
+
+	got, err := suite.repo.GetByID(suite.ctx, created.ID)
+	suite.NoError(err)
+	suite.Equal("Q3", got.Name)
+	suite.Equal(uint(1), got.WorkflowID)
+
+	got, err = suite.repo.GetByName(suite.ctx, "Q3")
+	suite.NoError(err)
+	suite.Equal(created.ID, got.ID)
+
+	got, err = suite.repo.GetByID(suite.ctx, 999)
+	suite.NoError(err)
+	suite.Nil(got)
+
+	got, err = suite.repo.GetByName(suite.ctx, "missing")
+	suite.NoError(err)
+	suite.Nil(got)
+}
+
+func (suite *ProjectRepositoryTestSuite) TestList_OrderedByNameAndCountByWorkflow() {
+	suite.create("home", 1)
+	suite.create("errands", 2)
+	suite.create("work", 2)
+
+	projects, err := suite.repo.List(suite.ctx)
+
+	suite.NoError(err)
+	suite.Require().Len(projects, 3)
+	suite.Equal("errands", projects[0].Name)
+	suite.Equal("work", projects[2].Name)
+
+	count, err := suite.repo.CountByWorkflow(suite.ctx, 2)
+	suite.NoError(err)
+	suite.Equal(int64(2), count)
+}
+
+func (suite *ProjectRepositoryTestSuite) TestUpdate_Success() {
+	created := suite.create("home", 1)
+	description := "家務"
+	suite.Require().NoError(created.Rename("house"))
+	suite.Require().NoError(created.SetDescription(&description))
+
+	rowsAffected, err := suite.repo.Update(suite.ctx, created)
+
+	suite.NoError(err)
+	suite.Equal(int64(1), rowsAffected)
+	got, _ := suite.repo.GetByID(suite.ctx, created.ID)
+	suite.Equal("house", got.Name)
+	suite.Equal(&description, got.Description)
+
+	rowsAffected, err = suite.repo.Update(suite.ctx, &entity.Project{ID: 999, Name: "x"})
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected)
+}
+
+func (suite *ProjectRepositoryTestSuite) TestDelete_DetachesTodos() {
+	project := suite.create("home", 1)
+	trashed := suite.createTodo("已刪除", &project.ID)
+	_, err := suite.todoRepo.Delete(suite.ctx, trashed.ID)
+	suite.Require().NoError(err)
+	other := suite.createTodo("未分組", nil)
+
+	rowsAffected, err := suite.repo.Delete(suite.ctx, project.ID, false)
+
+	suite.NoError(err)
+	suite.Equal(int64(1), rowsAffected)
+	got, _ := suite.todoRepo.GetByIDUnscoped(suite.ctx, trashed.ID)
+	suite.Nil(got.ProjectID)
+	suite.NotNil(got.DeletedAt)
+	suite.Equal(trashed.Version+2, got.Version) // trashed, then detached
+	got, _ = suite.todoRepo.GetByID(suite.ctx, other.ID)
+	suite.NotNil(got)
+	suite.Equal(other.Version, got.Version)
+
+	rowsAffected, err = suite.repo.Delete(suite.ctx, project.ID, false)
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected)
+}
+
+func (suite *ProjectRepositoryTestSuite) TestDelete_CascadeTrashesTodos() {
+	project := suite.create("home", 1)
+	other := suite.create("work", 1)
+	inProject := suite.createTodo("買菜", &project.ID)
+	inOther := suite.createTodo("開會", &other.ID)
+
+	count, err := suite.todoRepo.Count(suite.ctx, repository.TodoQueryParams{ProjectID: &project.ID})
+	suite.Require().NoError(err)
+	suite.Equal(int64(1), count)
+
+	rowsAffected, err := suite.repo.Delete(suite.ctx, project.ID, true)
+
+	suite.NoError(err)
+	suite.Equal(int64(1), rowsAffected)
+	got, _ := suite.todoRepo.GetByID(suite.ctx, inProject.ID)
+	suite.Nil(got)
+	got, _ = suite.todoRepo.GetByIDUnscoped(suite.ctx, inProject.ID)
+	suite.NotNil(got.DeletedAt)
+	suite.Nil(got.ProjectID)
+	suite.Greater(got.Version, inProject.Version)
+	got, _ = suite.todoRepo.GetByID(suite.ctx, inOther.ID)
+	suite.Equal(&other.ID, got.ProjectID)
+	suite.Equal(inOther.Version, got.Version)
+}
+
+func (suite *ProjectRepositoryTestSuite) TestWorkspaceScope() {
+	teamACtx := actor.WithWorkspaceID(suite.ctx, 1)
+	teamBCtx := actor.WithWorkspaceID(suite.ctx, 2)
+
+	// names only need to be unique within a workspace
+	projectA, err := entity.NewProject("home", nil, 1)
+	suite.Require().NoError(err)
+	projectA.WorkspaceID = 1
+	projectA, err = suite.repo.Create(teamACtx, projectA)
+	suite.Require().NoError(err)
+	projectB, err := entity.NewProject("home", nil, 1)
+	suite.Require().NoError(err)
+	projectB.WorkspaceID = 2
+	projectB, err = suite.repo.Create(teamBCtx, projectB)
+	suite.Require().NoError(err)
+
+	todo, _ := entity.NewTodo("B 團隊的 Todo", nil, nil, nil)
+	todo.WorkspaceID = 2
+	todo.ProjectID = &projectB.ID
+	todoB, err := suite.todoRepo.Create(suite.ctx, todo)
+	suite.Require().NoError(err)
+	// a todo of another workspace pointing at the project is never touched by its delete
+	todo, _ = entity.NewTodo("A 團隊的 Todo", nil, nil, nil)
+	todo.WorkspaceID = 1
+	todo.ProjectID = &projectB.ID
+	todoA, err := suite.todoRepo.Create(suite.ctx, todo)
+	suite.Require().NoError(err)
+
+	// a project of another workspace cannot be read, changed or deleted
+	got, err := suite.repo.GetByID(teamACtx, projectB.ID)
+	suite.NoError(err)
+	suite.Nil(got)
+
+	got, err = suite.repo.GetByName(teamACtx, "home")
+	suite.NoError(err)
+	suite.Equal(projectA.ID, got.ID)
+
+	projects, err := suite.repo.List(teamACtx)
+	suite.NoError(err)
+	suite.Require().Len(projects, 1)
+	suite.Equal(projectA.ID, projects[0].ID)
+
+	rowsAffected, err := suite.repo.Update(teamACtx, &entity.Project{ID: projectB.ID, Name: "被改掉"})
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected)
+
+	rowsAffected, err = suite.repo.Delete(teamACtx, projectB.ID, true)
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected)
+	gotTodo, _ := suite.todoRepo.GetByID(suite.ctx, todoB.ID)
+	suite.Equal(&projectB.ID, gotTodo.ProjectID)
+
+	// the cascade only trashes the todos of the workspace deleting the project
+	rowsAffected, err = suite.repo.Delete(teamBCtx, projectB.ID, true)
+	suite.NoError(err)
+	suite.Equal(int64(1), rowsAffected)
+	gotTodo, _ = suite.todoRepo.GetByID(suite.ctx, todoB.ID)
+	suite.Nil(gotTodo)
+	gotTodo, _ = suite.todoRepo.GetByID(suite.ctx, todoA.ID)
+	suite.Require().NotNil(gotTodo)
+	suite.Equal(&projectB.ID, gotTodo.ProjectID)
+
+	// without workspace nothing is visible
+	assertHiddenWithoutWorkspace(&suite.Suite, []workspaceLookup{
+		{"list", func(ctx context.Context) (bool, error) {
+			projects, err := suite.repo.List(ctx)
+			return len(projects) > 0, err
+		}},
+		{"get_by_id", func(ctx context.Context) (bool, error) {
+			project, err := suite.repo.GetByID(ctx, projectA.ID)
+			return project != nil, err
+		}},
+		{"get_by_name", func(ctx context.Context) (bool, error) {
+			project, err := suite.repo.GetByName(ctx, "home")
+			return project != nil, err
+		}},
+	})
+}
+
+func TestProjectRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(ProjectRepositoryTestSuite))
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+)
+
+var _ repository.StatusHistoryRepository = &StatusHistoryRepositoryImpl{}
+
+// StatusHistoryRepositoryImpl implements the StatusHistoryRepository interface using GORM
+type StatusHistoryRepositoryImpl struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+// NewStatusHistoryRepository creates a new StatusHistoryRepository instance
+func NewStatusHistoryRepository(logger zerolog.Logger, db *gorm.DB) repository.StatusHistoryRepository {
+	return &StatusHistoryRepositoryImpl{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create records a status change and returns it with assigned ID
+func (r *StatusHistoryRepositoryImpl) Create(ctx context.Context, change *entity.StatusChange) (*entity.StatusChange, error) {
+	if change == nil {
+		return nil, errors.New("status change cannot be nil")
+	}
+
+	changeModel := model.StatusChangeEntityToModel(change)
+	if err := r.db.WithContext(ctx).Create(changeModel).Error; err != nil {
+		return nil, fmt.Errorf("failed to create status change: %w", err)
+	}
+
+	return model.StatusChangeModelToEntity(changeModel), nil
+}
+
+// ListByTodo retrieves the status changes of a todo, oldest first
+func (r *StatusHistoryRepositoryImpl) ListByTodo(ctx context.Context, todoID uint) ([]*entity.StatusChange, error) {
+	var changeModels []*model.StatusChange
+	if err := r.db.WithContext(ctx).
+		Where("todo_id = ?", todoID).
+		Order("changed_at ASC, id ASC").
+		Find(&changeModels).Error; err != nil {
+		return nil, fmt.Errorf("failed to list status history: %w", err)
+	}
+
+	return model.StatusChangeModelsToEntities(changeModels), nil
+}
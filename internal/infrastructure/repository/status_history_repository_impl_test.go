@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/config"
+	"itmrchow/go-todolist-service/internal/infrastructure/database"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+)
+
+type StatusHistoryRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo repository.StatusHistoryRepository
+	ctx  context.Context
+}
+
+// SetupSuite 在整個測試 suite 開始前執行一次
+func (suite *StatusHistoryRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	sqlLiteDB := &database.SQLiteDBImpl{}
+	db, err := sqlLiteDB.Connect(ctx, &config.DatabaseConfig{})
+	suite.Require().NoError(err)
+
+	err = sqlLiteDB.Migrate(&model.StatusChange{})
+	suite.Require().NoError(err)
+
+	suite.db = db
+	suite.ctx = ctx
+
+	suite.repo = NewStatusHistoryRepository(zerolog.New(os.Stdout), suite.db)
+}
+
+// TearDownSuite 在整個測試 suite 結束後執行一次
+func (suite *StatusHistoryRepositoryTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		sqlDB, err := suite.db.DB()
+		if err == nil {
+			sqlDB.Close()
+		}
+	}
+}
+
+// TearDownTest 每個測試後清理資料
+func (suite *StatusHistoryRepositoryTestSuite) TearDownTest() {
+	if suite.db != nil {
+		suite.db.Exec("DELETE FROM todo_status_history")
+		suite.db.Exec("DELETE FROM sqlite_sequence WHERE name = 'todo_status_history'")
+	}
+}
+
+func (suite *StatusHistoryRepositoryTestSuite) TestCreate() {
+	now := time.Now().UTC()
+
+	created, err := suite.repo.Create(suite.ctx, entity.NewStatusChange(1, entity.StatusPending, entity.StatusDoing, "alice", now))
+
+	suite.NoError(err)
+	suite.Equal(uint(1), created.ID)
+	suite.Equal(entity.StatusPending, created.FromStatus)
+	suite.Equal(entity.StatusDoing, created.ToStatus)
+	suite.Equal("alice", created.Actor)
+
+	_, err = suite.repo.Create(suite.ctx, nil)
+	suite.EqualError(err, "status change cannot be nil")
+}
+
+func (suite *StatusHistoryRepositoryTestSuite) TestListByTodo_OldestFirst() {
+	now := time.Now().UTC()
+	suite.repo.Create(suite.ctx, entity.NewStatusChange(1, entity.StatusDoing, entity.StatusDone, "bob", now))
+	suite.repo.Create(suite.ctx, entity.NewStatusChange(1, entity.StatusPending, entity.StatusDoing, "alice", now.Add(-time.Hour)))
+	suite.repo.Create(suite.ctx, entity.NewStatusChange(2, entity.StatusPending, entity.StatusDone, "", now))
+
+	changes, err := suite.repo.ListByTodo(suite.ctx, 1)
+
+	suite.NoError(err)
+	suite.Require().Len(changes, 2)
+	suite.Equal("alice", changes[0].Actor)
+	suite.Equal(entity.StatusDone, changes[1].ToStatus)
+
+	changes, err = suite.repo.ListByTodo(suite.ctx, 99)
+	suite.NoError(err)
+	suite.Empty(changes)
+}
+
+func TestStatusHistoryRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusHistoryRepositoryTestSuite))
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+)
+
+var _ repository.TagRepository = &TagRepositoryImpl{}
+
+// TagRepositoryImpl implements the TagRepository interface using GORM
+type TagRepositoryImpl struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+// NewTagRepository creates a new TagRepository instance
+func NewTagRepository(logger zerolog.Logger, db *gorm.DB) repository.TagRepository {
+	return &TagRepositoryImpl{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new tag and returns the created tag with assigned ID
+func (r *TagRepositoryImpl) Create(ctx context.Context, tag *entity.Tag) (*entity.Tag, error) {
+	if tag == nil {
+		return nil, errors.New("tag cannot be nil")
+	}
+
+	tagModel := model.TagEntityToModel(tag)
+	if err := r.db.WithContext(ctx).Create(tagModel).Error; err != nil {
+		return nil, fmt.Errorf("failed to create tag: %w", err)
+	}
+
+	return model.TagModelToEntity(tagModel), nil
+}
+
+// GetByID retrieves a tag by its ID
+// Returns nil if tag is not found
+func (r *TagRepositoryImpl) GetByID(ctx context.Context, id uint) (*entity.Tag, error) {
+	var tagModel model.Tag
+
+	err := r.db.WithContext(ctx).Scopes(inWorkspace(ctx, "tags")).First(&tagModel, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil for not found, not an error
+		}
+		return nil, fmt.Errorf("failed to get tag by id %d: %w", id, err)
+	}
+
+	return model.TagModelToEntity(&tagModel), nil
+}
+
+// GetByName retrieves a tag by its exact name
+// Returns nil if tag is not found
+func (r *TagRepositoryImpl) GetByName(ctx context.Context, name string) (*entity.Tag, error) {
+	var tagModel model.Tag
+
+	err := r.db.WithContext(ctx).Scopes(inWorkspace(ctx, "tags")).Where("name = ?", name).First(&tagModel).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil for not found, not an error
+		}
+		return nil, fmt.Errorf("failed to get tag by name: %w", err)
+	}
+
+	return model.TagModelToEntity(&tagModel), nil
+}
+
+// GetByIDs retrieves the tags with the given IDs, unknown IDs and tags of another workspace are skipped
+func (r *TagRepositoryImpl) GetByIDs(ctx context.Context, ids []uint) ([]*entity.Tag, error) {
+	if len(ids) == 0 {
+		return []*entity.Tag{}, nil
+	}
+
+	var tagModels []*model.Tag
+	if err := r.db.WithContext(ctx).Scopes(inWorkspace(ctx, "tags")).Where("id IN ?", ids).Order("name ASC").Find(&tagModels).Error; err != nil {
+		return nil, fmt.Errorf("failed to get tags by ids: %w", err)
+	}
+
+	return model.TagModelsToEntities(tagModels), nil
+}
+
+// List retrieves all tags ordered by name
+func (r *TagRepositoryImpl) List(ctx context.Context) ([]*entity.Tag, error) {
+	var tagModels []*model.Tag
+	if err := r.db.WithContext(ctx).Scopes(inWorkspace(ctx, "tags")).Order("name ASC").Find(&tagModels).Error; err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	return model.TagModelsToEntities(tagModels), nil
+}
+
+// Update updates the name and color of a tag, bumps the version of the todos carrying it
+// and returns the number of affected rows
+func (r *TagRepositoryImpl) Update(ctx context.Context, tag *entity.Tag) (int64, error) {
+	if tag == nil {
+		return 0, errors.New("tag cannot be nil")
+	}
+
+	if tag.ID == 0 {
+		return 0, errors.New("tag ID cannot be 0")
+	}
+
+	var rowsAffected int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Tag{}).
+			Scopes(inWorkspace(ctx, "tags")).
+			Where("id = ?", tag.ID).
+			Select("name", "color", "updated_at").
+			Updates(model.TagEntityToModel(tag))
+		if result.Error != nil {
+			return result.Error
+		}
+		rowsAffected = result.RowsAffected
+		if rowsAffected == 0 {
+			return nil
+		}
+		return bumpTodoVersions(tx, taggedTodos(tx, tag.ID))
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to update tag: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// Delete permanently removes a tag, detaching it from every todo,
+// and returns the number of affected rows
+func (r *TagRepositoryImpl) Delete(ctx context.Context, id uint) (int64, error) {
+	var rowsAffected int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Scopes(inWorkspace(ctx, "tags")).Delete(&model.Tag{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		rowsAffected = result.RowsAffected
+		if rowsAffected == 0 {
+			return nil
+		}
+
+		if err := bumpTodoVersions(tx, taggedTodos(tx, id)); err != nil {
+			return err
+		}
+		return tx.Where("tag_id = ?", id).Delete(&model.TodoTag{}).Error
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// taggedTodos selects the IDs of the todos carrying a tag
+func taggedTodos(tx *gorm.DB, tagID uint) *gorm.DB {
+	return tx.Model(&model.TodoTag{}).Select("todo_id").Where("tag_id = ?", tagID)
+}
@@ -0,0 +1,257 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/config"
+	"itmrchow/go-todolist-service/internal/infrastructure/database"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+type TagRepositoryTestSuite struct {
+	suite.Suite
+	db       *gorm.DB
+	repo     repository.TagRepository
+	todoRepo repository.TodoRepository
+	ctx      context.Context
+}
+
+// SetupSuite 在整個測試 suite 開始前執行一次
+func (suite *TagRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	sqlLiteDB := &database.SQLiteDBImpl{}
+	db, err := sqlLiteDB.Connect(ctx, &config.DatabaseConfig{})
+	suite.Require().NoError(err)
+
+	err = sqlLiteDB.Migrate(&model.Todo{}, &model.Tag{}, &model.TodoTag{}, &model.ChecklistItem{}, &model.TodoDependency{}, &model.TodoAssignee{}, &model.Comment{})
+	suite.Require().NoError(err)
+
+	suite.db = db
+	suite.ctx = actor.WithSystem(ctx)
+
+	suite.repo = NewTagRepository(zerolog.New(os.Stdout), suite.db)
+	suite.todoRepo = NewTodoRepository(zerolog.New(os.Stdout), suite.db)
+}
+
+// TearDownSuite 在整個測試 suite 結束後執行一次
+func (suite *TagRepositoryTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		sqlDB, err := suite.db.DB()
+		if err == nil {
+			sqlDB.Close()
+		}
+	}
+}
+
+// TearDownTest 每個測試後清理資料
+func (suite *TagRepositoryTestSuite) TearDownTest() {
+	if suite.db != nil {
+		suite.db.Exec("DELETE FROM todos")
+		suite.db.Exec("DELETE FROM tags")
+		suite.db.Exec("DELETE FROM todo_tags")
+		suite.db.Exec("DELETE FROM checklist_items")
+		suite.db.Exec("DELETE FROM sqlite_sequence WHERE name IN ('todos', 'tags', 'checklist_items')")
+	}
+}
+
+func (suite *TagRepositoryTestSuite) create(name string) *entity.Tag {
+	tag, err := entity.NewTag(name, "")
+	suite.Require().NoError(err)
+	created, err := suite.repo.Create(suite.ctx, tag)
+	suite.Require().NoError(err)
+	return created
+}
+
+func (suite *TagRepositoryTestSuite) TestCreate_Success() {
+	created := suite.create("work")
+
+	suite.NotZero(created.ID)
+	suite.Equal("work", created.Name)
+	suite.Equal(entity.DefaultTagColor, created.Color)
+}
+
+func (suite *TagRepositoryTestSuite) TestCreate_DuplicateName() {
+	suite.create("work")
+
+	tag, _ := entity.NewTag("work", "")
+	_, err := suite.repo.Create(suite.ctx, tag)
+
+	suite.Error(err) // Unique index backs up the usecase name check
+}
+
+func (suite *TagRepositoryTestSuite) TestGetByIDAndName() {
+	created := suite.create("work")
+
+	byID, err := suite.repo.GetByID(suite.ctx, created.ID)
+	suite.NoError(err)
+	suite.Equal("work", byID.Name)
+
+	byName, err := suite.repo.GetByName(suite.ctx, "work")
+	suite.NoError(err)
+	suite.Equal(created.ID, byName.ID)
+
+	missing, err := suite.repo.GetByID(suite.ctx, 999)
+	suite.NoError(err)
+	suite.Nil(missing)
+
+	missing, err = suite.repo.GetByName(suite.ctx, "home")
+	suite.NoError(err)
+	suite.Nil(missing)
+}
+
+func (suite *TagRepositoryTestSuite) TestGetByIDs_SkipsUnknown() {
+	work := suite.create("work")
+	home := suite.create("home")
+
+	tags, err := suite.repo.GetByIDs(suite.ctx, []uint{work.ID, home.ID, 999})
+
+	suite.NoError(err)
+	suite.Require().Len(tags, 2)
+	suite.Equal("home", tags[0].Name)
+	suite.Equal("work", tags[1].Name)
+
+	tags, err = suite.repo.GetByIDs(suite.ctx, nil)
+	suite.NoError(err)
+	suite.Empty(tags)
+}
+
+func (suite *TagRepositoryTestSuite) TestList_OrderedByName() {
+	suite.create("work")
+	suite.create("home")
+
+	tags, err := suite.repo.List(suite.ctx)
+
+	suite.NoError(err)
+	suite.Require().Len(tags, 2)
+	suite.Equal("home", tags[0].Name)
+	suite.Equal("work", tags[1].Name)
+}
+
+func (suite *TagRepositoryTestSuite) TestUpdate_Success() {
+	created := suite.create("work")
+	todo, _ := entity.NewTodo("有標籤", nil, nil, nil)
+	todo.SetTags([]entity.Tag{*created})
+	createdTodo, err := suite.todoRepo.Create(suite.ctx, todo)
+	suite.Require().NoError(err)
+	suite.Require().NoError(created.Rename("office"))
+	suite.Require().NoError(created.SetColor("#ff0000"))
+
+	rowsAffected, err := suite.repo.Update(suite.ctx, created)
+
+	suite.NoError(err)
+	suite.Equal(int64(1), rowsAffected)
+	got, _ := suite.repo.GetByID(suite.ctx, created.ID)
+	suite.Equal("office", got.Name)
+	suite.Equal("#ff0000", got.Color)
+	// todos embed the tag, so renaming it changes them
+	gotTodo, _ := suite.todoRepo.GetByID(suite.ctx, createdTodo.ID)
+	suite.Equal(createdTodo.Version+1, gotTodo.Version)
+
+	rowsAffected, err = suite.repo.Update(suite.ctx, &entity.Tag{ID: 999, Name: "x", Color: "#000000"})
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected)
+}
+
+func (suite *TagRepositoryTestSuite) TestDelete_DetachesTodos() {
+	work := suite.create("work")
+	home := suite.create("home")
+	todo, _ := entity.NewTodo("有標籤", nil, nil, nil)
+	todo.SetTags([]entity.Tag{*work, *home})
+	createdTodo, err := suite.todoRepo.Create(suite.ctx, todo)
+	suite.Require().NoError(err)
+
+	rowsAffected, err := suite.repo.Delete(suite.ctx, work.ID)
+
+	suite.NoError(err)
+	suite.Equal(int64(1), rowsAffected)
+	got, _ := suite.todoRepo.GetByID(suite.ctx, createdTodo.ID)
+	suite.Equal([]uint{home.ID}, got.TagIDs())
+	suite.Equal(createdTodo.Version+1, got.Version)
+
+	rowsAffected, err = suite.repo.Delete(suite.ctx, work.ID)
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected)
+}
+
+func (suite *TagRepositoryTestSuite) TestWorkspaceScope() {
+	teamACtx := actor.WithWorkspaceID(suite.ctx, 1)
+	teamBCtx := actor.WithWorkspaceID(suite.ctx, 2)
+
+	// names only need to be unique within a workspace
+	tagA, _ := entity.NewTag("work", "")
+	tagA.WorkspaceID = 1
+	tagA, err := suite.repo.Create(teamACtx, tagA)
+	suite.Require().NoError(err)
+	tagB, _ := entity.NewTag("work", "")
+	tagB.WorkspaceID = 2
+	tagB, err = suite.repo.Create(teamBCtx, tagB)
+	suite.Require().NoError(err)
+
+	todo, _ := entity.NewTodo("B 團隊的 Todo", nil, nil, nil)
+	todo.WorkspaceID = 2
+	todo.SetTags([]entity.Tag{*tagB})
+	todoB, err := suite.todoRepo.Create(suite.ctx, todo)
+	suite.Require().NoError(err)
+
+	// a tag of another workspace cannot be read, changed or deleted
+	got, err := suite.repo.GetByID(teamACtx, tagB.ID)
+	suite.NoError(err)
+	suite.Nil(got)
+
+	got, err = suite.repo.GetByName(teamACtx, "work")
+	suite.NoError(err)
+	suite.Equal(tagA.ID, got.ID)
+
+	tags, err := suite.repo.GetByIDs(teamACtx, []uint{tagA.ID, tagB.ID})
+	suite.NoError(err)
+	suite.Require().Len(tags, 1)
+	suite.Equal(tagA.ID, tags[0].ID)
+
+	tags, err = suite.repo.List(teamACtx)
+	suite.NoError(err)
+	suite.Require().Len(tags, 1)
+	suite.Equal(tagA.ID, tags[0].ID)
+
+	rowsAffected, err := suite.repo.Update(teamACtx, &entity.Tag{ID: tagB.ID, Name: "被改掉", Color: "#000000"})
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected)
+
+	rowsAffected, err = suite.repo.Delete(teamACtx, tagB.ID)
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected)
+	gotTodo, _ := suite.todoRepo.GetByID(suite.ctx, todoB.ID)
+	suite.Equal([]uint{tagB.ID}, gotTodo.TagIDs())
+
+	// without workspace nothing is visible
+	assertHiddenWithoutWorkspace(&suite.Suite, []workspaceLookup{
+		{"list", func(ctx context.Context) (bool, error) {
+			tags, err := suite.repo.List(ctx)
+			return len(tags) > 0, err
+		}},
+		{"get_by_id", func(ctx context.Context) (bool, error) {
+			tag, err := suite.repo.GetByID(ctx, tagA.ID)
+			return tag != nil, err
+		}},
+		{"get_by_name", func(ctx context.Context) (bool, error) {
+			tag, err := suite.repo.GetByName(ctx, "work")
+			return tag != nil, err
+		}},
+		{"get_by_ids", func(ctx context.Context) (bool, error) {
+			tags, err := suite.repo.GetByIDs(ctx, []uint{tagA.ID, tagB.ID})
+			return len(tags) > 0, err
+		}},
+	})
+}
+
+func TestTagRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(TagRepositoryTestSuite))
+}
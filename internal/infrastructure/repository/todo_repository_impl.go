@@ -4,13 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/rs/zerolog"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"itmrchow/go-todolist-service/internal/domain/entity"
 	"itmrchow/go-todolist-service/internal/domain/repository"
 	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+	"itmrchow/go-todolist-service/internal/utils/actor"
 )
 
 var _ repository.TodoRepository = &TodoRepositoryImpl{}
@@ -41,8 +44,10 @@ func (r *TodoRepositoryImpl) Create(ctx context.Context, todo *entity.Todo) (*en
 		return nil, errors.New("failed to convert entity to model")
 	}
 
-	// Create in database
-	if err := r.db.WithContext(ctx).Create(todoModel).Error; err != nil {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return createTodo(tx, todoModel, todo.TagIDs())
+	})
+	if err != nil {
 		return nil, fmt.Errorf("failed to create todo: %w", err)
 	}
 
@@ -51,27 +56,65 @@ func (r *TodoRepositoryImpl) Create(ctx context.Context, todo *entity.Todo) (*en
 	return createdEntity, nil
 }
 
+// createTodo inserts a todo with its checklist and assignees, tags are only referenced so
+// the join rows are written here
+func createTodo(tx *gorm.DB, todoModel *model.Todo, tagIDs []uint) error {
+	todoModel.Version = 1
+	if err := tx.Omit(clause.Associations).Create(todoModel).Error; err != nil {
+		return err
+	}
+
+	if len(todoModel.Checklist) > 0 {
+		for i := range todoModel.Checklist {
+			todoModel.Checklist[i].TodoID = todoModel.ID
+		}
+		if err := tx.Create(&todoModel.Checklist).Error; err != nil {
+			return err
+		}
+	}
+
+	if len(todoModel.Assignees) > 0 {
+		for i := range todoModel.Assignees {
+			todoModel.Assignees[i].TodoID = todoModel.ID
+		}
+		if err := tx.Create(&todoModel.Assignees).Error; err != nil {
+			return err
+		}
+	}
+
+	return replaceTodoTags(tx, todoModel.ID, tagIDs)
+}
+
 // GetByID retrieves a todo by its ID
 // Returns nil if todo is not found or is soft deleted
 func (r *TodoRepositoryImpl) GetByID(ctx context.Context, id uint) (*entity.Todo, error) {
 	var todoModel model.Todo
 
 	// Query with soft delete scope (GORM automatically adds WHERE deleted_at IS NULL)
-	err := r.db.WithContext(ctx).First(&todoModel, id).Error
+	err := r.db.WithContext(ctx).Scopes(inTenant(ctx), preloadTodoRelations).First(&todoModel, id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil // Return nil for not found, not an error
 		}
 		return nil, fmt.Errorf("failed to get todo by id %d: %w", id, err)
 	}
+	if err := loadCommentCounts(r.db.WithContext(ctx), []*model.Todo{&todoModel}); err != nil {
+		return nil, fmt.Errorf("failed to get todo by id %d: %w", id, err)
+	}
 
 	// Convert model to entity
 	entity := model.ModelToEntity(&todoModel)
 	return entity, nil
 }
 
-// Update updates an existing todo and returns the number of affected rows
+// Update updates an existing todo at the version it was read and returns the number of affected rows
 func (r *TodoRepositoryImpl) Update(ctx context.Context, todo *entity.Todo) (int64, error) {
+	return r.UpdateWithHistory(ctx, todo, nil, nil)
+}
+
+// UpdateWithHistory updates an existing todo like Update and, in the same transaction, records
+// the status change and creates the next occurrence when they are given
+func (r *TodoRepositoryImpl) UpdateWithHistory(ctx context.Context, todo *entity.Todo, change *entity.StatusChange, next *entity.Todo) (int64, error) {
 	if todo == nil {
 		return 0, errors.New("todo cannot be nil")
 	}
@@ -87,20 +130,74 @@ func (r *TodoRepositoryImpl) Update(ctx context.Context, todo *entity.Todo) (int
 	}
 
 	// Use Updates to only update existing records (not insert new ones)
-	result := r.db.WithContext(ctx).Model(&model.Todo{}).
-		Where("id = ?", todo.ID).
-		Updates(todoModel)
+	// Select the writable columns so nil fields are written as NULL instead of skipped,
+	// the version read by the caller guards against overwriting a concurrent write
+	todoModel.Version = todo.Version + 1
+	var rowsAffected int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Todo{}).
+			Scopes(inTenant(ctx)).
+			Where("id = ? AND version = ?", todo.ID, todo.Version).
+			Select("title", "description", "status", "workflow_id", "project_id", "position", "priority", "due_date", "parent_id",
+				"recurrence", "recurrence_timezone", "series_id", "occurrence", "started_at", "completed_at", "updated_at", "version").
+			Omit(clause.Associations).
+			Updates(todoModel)
+		if result.Error != nil {
+			return result.Error
+		}
+		rowsAffected = result.RowsAffected
+		if rowsAffected == 0 {
+			// tell a stale version apart from a missing todo
+			var count int64
+			if err := tx.Model(&model.Todo{}).Scopes(inTenant(ctx)).Where("id = ?", todo.ID).Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				return repository.ErrVersionConflict
+			}
+			return nil
+		}
 
-	if result.Error != nil {
-		return 0, fmt.Errorf("failed to update todo: %w", result.Error)
+		if err := replaceTodoTags(tx, todo.ID, todo.TagIDs()); err != nil {
+			return err
+		}
+
+		if change != nil {
+			if err := tx.Create(model.StatusChangeEntityToModel(change)).Error; err != nil {
+				return err
+			}
+		}
+
+		if next != nil {
+			nextModel := model.EntityToModel(next)
+			if nextModel == nil {
+				return errors.New("failed to convert entity to model")
+			}
+			if err := createTodo(tx, nextModel, next.TagIDs()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, repository.ErrVersionConflict) {
+		return 0, err
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to update todo: %w", err)
+	}
+	if rowsAffected > 0 {
+		todo.Version = todoModel.Version
 	}
 
-	return result.RowsAffected, nil
+	return rowsAffected, nil
 }
 
-// Delete soft deletes a todo (sets DeletedAt timestamp)
+// Delete soft deletes a todo (sets DeletedAt timestamp) and bumps its version
 func (r *TodoRepositoryImpl) Delete(ctx context.Context, id uint) (int64, error) {
-	result := r.db.WithContext(ctx).Delete(&model.Todo{}, id)
+	result := r.db.WithContext(ctx).Model(&model.Todo{}).
+		Scopes(inTenant(ctx)).
+		Where("id = ?", id).
+		UpdateColumns(softDeleteColumns(r.db))
 	if result.Error != nil {
 		return 0, fmt.Errorf("failed to delete todo: %w", result.Error)
 	}
@@ -108,6 +205,14 @@ func (r *TodoRepositoryImpl) Delete(ctx context.Context, id uint) (int64, error)
 	return result.RowsAffected, nil
 }
 
+// softDeleteColumns moves todos to the trash like a GORM soft delete, the version changes with them
+func softDeleteColumns(db *gorm.DB) map[string]interface{} {
+	return map[string]interface{}{
+		"deleted_at": db.NowFunc(),
+		"version":    gorm.Expr("version + 1"),
+	}
+}
+
 // List retrieves todos with pagination and filtering options
 func (r *TodoRepositoryImpl) List(
 	ctx context.Context,
@@ -116,12 +221,16 @@ func (r *TodoRepositoryImpl) List(
 ) error {
 	var todoModels []*model.Todo
 
-	query := r.db.WithContext(ctx)
-	// Apply filters
-	query = r.applyFilters(query, queryParams)
+	// Tags and checklists of the whole page are loaded with one extra query each instead of one per todo
+	query := r.db.WithContext(ctx).Scopes(preloadTodoRelations)
+	// Apply filters, scoped to the workspace or owner of ctx
+	query = r.applyFilters(ctx, query, queryParams)
 
 	// Execute query
-	if err := query.Scopes(Paginate(model.Todo{}, pagination, query)).Find(&todoModels).Error; err != nil {
+	if err := FindPage(query, pagination, &todoModels); err != nil {
+		return fmt.Errorf("failed to list todos: %w", err)
+	}
+	if err := loadCommentCounts(r.db.WithContext(ctx), todoModels); err != nil {
 		return fmt.Errorf("failed to list todos: %w", err)
 	}
 
@@ -132,12 +241,166 @@ func (r *TodoRepositoryImpl) List(
 	return nil
 }
 
+// GetByIDUnscoped retrieves a todo by its ID including soft deleted ones
+// Returns nil if todo is not found
+func (r *TodoRepositoryImpl) GetByIDUnscoped(ctx context.Context, id uint) (*entity.Todo, error) {
+	var todoModel model.Todo
+
+	err := r.db.WithContext(ctx).Unscoped().Scopes(inTenant(ctx), preloadTodoRelations).First(&todoModel, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil for not found, not an error
+		}
+		return nil, fmt.Errorf("failed to get todo by id %d: %w", id, err)
+	}
+	if err := loadCommentCounts(r.db.WithContext(ctx), []*model.Todo{&todoModel}); err != nil {
+		return nil, fmt.Errorf("failed to get todo by id %d: %w", id, err)
+	}
+
+	return model.ModelToEntity(&todoModel), nil
+}
+
+// ListDeleted retrieves soft deleted todos (the trash) with pagination
+func (r *TodoRepositoryImpl) ListDeleted(
+	ctx context.Context,
+	pagination *repository.Pagination[entity.Todo],
+) error {
+	var todoModels []*model.Todo
+
+	query := r.db.WithContext(ctx).Unscoped().Scopes(inTenant(ctx), preloadTodoRelations).Where("deleted_at IS NOT NULL")
+
+	// Execute query
+	if err := FindPage(query, pagination, &todoModels); err != nil {
+		return fmt.Errorf("failed to list deleted todos: %w", err)
+	}
+	if err := loadCommentCounts(r.db.WithContext(ctx), todoModels); err != nil {
+		return fmt.Errorf("failed to list deleted todos: %w", err)
+	}
+
+	pagination.Rows = model.ModelsToEntities(todoModels)
+
+	return nil
+}
+
+// Restore clears DeletedAt of a soft deleted todo and returns the number of affected rows
+func (r *TodoRepositoryImpl) Restore(ctx context.Context, todo *entity.Todo) (int64, error) {
+	if todo == nil {
+		return 0, errors.New("todo cannot be nil")
+	}
+
+	result := r.db.WithContext(ctx).Unscoped().Model(&model.Todo{}).
+		Scopes(inTenant(ctx)).
+		Where("id = ? AND deleted_at IS NOT NULL", todo.ID).
+		Updates(map[string]interface{}{
+			"deleted_at": todo.DeletedAt,
+			"updated_at": todo.UpdatedAt,
+			"version":    gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to restore todo: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+// HardDelete permanently removes a soft deleted todo with its checklist, detaching its subtasks,
+// and returns the number of affected rows
+func (r *TodoRepositoryImpl) HardDelete(ctx context.Context, id uint) (int64, error) {
+	var rowsAffected int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Unscoped().
+			Scopes(inTenant(ctx)).
+			Where("deleted_at IS NOT NULL").
+			Delete(&model.Todo{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		rowsAffected = result.RowsAffected
+		if rowsAffected == 0 {
+			return nil
+		}
+
+		return deleteTodoRelations(tx, []uint{id})
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to hard delete todo: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// PurgeDeleted permanently removes all soft deleted todos and returns the number of affected rows
+func (r *TodoRepositoryImpl) PurgeDeleted(ctx context.Context) (int64, error) {
+	var rowsAffected int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// MySQL cannot update todos with a subquery on todos, so resolve the IDs first
+		var ids []uint
+		if err := tx.Unscoped().Model(&model.Todo{}).Scopes(inTenant(ctx)).Where("deleted_at IS NOT NULL").Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		if err := deleteTodoRelations(tx, ids); err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().Delete(&model.Todo{}, ids)
+		rowsAffected = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge deleted todos: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// PurgeDeletedBefore permanently removes at most limit todos soft deleted before the given time
+// and returns the number of affected rows
+func (r *TodoRepositoryImpl) PurgeDeletedBefore(ctx context.Context, before time.Time, limit int) (int64, error) {
+	if limit <= 0 {
+		return 0, errors.New("limit must be greater than 0")
+	}
+
+	// Select the batch first so the DELETE only locks the chosen primary keys
+	var ids []uint
+	if err := r.db.WithContext(ctx).Unscoped().Model(&model.Todo{}).
+		Scopes(inTenant(ctx)).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+		Order("deleted_at asc").
+		Limit(limit).
+		Pluck("id", &ids).Error; err != nil {
+		return 0, fmt.Errorf("failed to select expired todos: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	var rowsAffected int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := deleteTodoRelations(tx, ids); err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().Delete(&model.Todo{}, ids)
+		rowsAffected = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge expired todos: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
 // Count returns the total count of todos (excluding soft deleted ones)
 func (r *TodoRepositoryImpl) Count(ctx context.Context, filters repository.TodoQueryParams) (int64, error) {
 	query := r.db.WithContext(ctx).Model(&model.Todo{})
 
 	// Apply filters
-	query = r.applyFilters(query, filters)
+	query = r.applyFilters(ctx, query, filters)
 
 	// Count
 	var count int64
@@ -148,19 +411,261 @@ func (r *TodoRepositoryImpl) Count(ctx context.Context, filters repository.TodoQ
 	return count, nil
 }
 
-// applyFilters applies filtering conditions to the query
-func (r *TodoRepositoryImpl) applyFilters(query *gorm.DB, qP repository.TodoQueryParams) *gorm.DB {
+// AssignWorkspace moves the todos of the owner that belong to no workspace into the workspace
+func (r *TodoRepositoryImpl) AssignWorkspace(ctx context.Context, ownerID uint, workspaceID uint) (int64, error) {
+	result := r.db.WithContext(ctx).Unscoped().Model(&model.Todo{}).
+		Where("owner_id = ? AND workspace_id = 0", ownerID).
+		UpdateColumns(map[string]interface{}{
+			"workspace_id": workspaceID,
+			"version":      gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to assign todos to workspace: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+// LastPosition returns the highest rank key of the todos of the tenant, soft deleted ones included
+func (r *TodoRepositoryImpl) LastPosition(ctx context.Context) (string, error) {
+	var position *string
+	if err := r.db.WithContext(ctx).Unscoped().Model(&model.Todo{}).Scopes(inTenant(ctx)).Select("MAX(position)").Scan(&position).Error; err != nil {
+		return "", fmt.Errorf("failed to get last todo position: %w", err)
+	}
+	if position == nil {
+		return "", nil
+	}
+
+	return *position, nil
+}
+
+// NeighborPosition returns the rank key of the closest todo of the tenant after (or before) the given key
+func (r *TodoRepositoryImpl) NeighborPosition(ctx context.Context, position string, after bool, excludeID uint) (string, error) {
+	query := r.db.WithContext(ctx).Model(&model.Todo{}).
+		Scopes(inTenant(ctx)).
+		Where("id <> ? AND position <> ?", excludeID, "")
+	if after {
+		query = query.Where("position > ?", position).Order("position ASC")
+	} else {
+		query = query.Where("position < ?", position).Order("position DESC")
+	}
+
+	var positions []string
+	if err := query.Limit(1).Pluck("position", &positions).Error; err != nil {
+		return "", fmt.Errorf("failed to get neighbor todo position: %w", err)
+	}
+	if len(positions) == 0 {
+		return "", nil
+	}
+
+	return positions[0], nil
+}
+
+// BackfillPositions gives the todos without rank key one after the last key in ID order
+func (r *TodoRepositoryImpl) BackfillPositions(ctx context.Context) (int64, error) {
+	var updated int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ids []uint
+		if err := tx.Unscoped().Model(&model.Todo{}).Scopes(inTenant(ctx)).Where("position = ?", "").Order("id ASC").Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		var last *string
+		if err := tx.Unscoped().Model(&model.Todo{}).Scopes(inTenant(ctx)).Select("MAX(position)").Scan(&last).Error; err != nil {
+			return err
+		}
+		position := ""
+		if last != nil {
+			position = *last
+		}
+
+		for _, id := range ids {
+			next, err := entity.RankBetween(position, "")
+			if err != nil {
+				return err
+			}
+			if err := tx.Unscoped().Model(&model.Todo{}).Where("id = ?", id).UpdateColumns(map[string]interface{}{
+				"position": next,
+				"version":  gorm.Expr("version + 1"),
+			}).Error; err != nil {
+				return err
+			}
+			position = next
+			updated++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to backfill todo positions: %w", err)
+	}
+
+	return updated, nil
+}
+
+// RebalancePositions replaces the rank keys of the todos with short keys in the same order,
+// todos without rank key are left to BackfillPositions
+func (r *TodoRepositoryImpl) RebalancePositions(ctx context.Context) (int64, error) {
+	var updated int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ids []uint
+		if err := tx.Unscoped().Model(&model.Todo{}).
+			Scopes(inTenant(ctx)).
+			Where("position <> ?", "").
+			Order("position ASC, id ASC").
+			Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+
+		position := ""
+		for _, id := range ids {
+			next, err := entity.RankBetween(position, "")
+			if err != nil {
+				return err
+			}
+			if err := tx.Unscoped().Model(&model.Todo{}).Where("id = ?", id).UpdateColumns(map[string]interface{}{
+				"position": next,
+				"version":  gorm.Expr("version + 1"),
+			}).Error; err != nil {
+				return err
+			}
+			position = next
+			updated++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to rebalance todo positions: %w", err)
+	}
+
+	return updated, nil
+}
+
+// statusCategorySubquery selects the workflow status of a todo, callers append the category condition
+const statusCategorySubquery = "SELECT 1 FROM workflow_statuses ws WHERE ws.workflow_id = todos.workflow_id AND ws.name = todos.status"
+
+// applyFilters applies filtering conditions to the query, limited to the tenant of ctx
+func (r *TodoRepositoryImpl) applyFilters(ctx context.Context, query *gorm.DB, qP repository.TodoQueryParams) *gorm.DB {
+	query = query.Scopes(inTenant(ctx))
+	if qP.WithTrashed {
+		query = query.Unscoped()
+	}
+
 	// Filter by status
 	if qP.Status != nil {
 		query = query.Where("status = ?", string(*qP.Status))
 	}
+	if len(qP.Statuses) > 0 {
+		statuses := make([]string, len(qP.Statuses))
+		for i, status := range qP.Statuses {
+			statuses[i] = string(status)
+		}
+		query = query.Where("status IN ?", statuses)
+	}
+	if len(qP.Categories) > 0 {
+		categories := make([]string, len(qP.Categories))
+		for i, category := range qP.Categories {
+			categories[i] = string(category)
+		}
+		query = query.Where("EXISTS ("+statusCategorySubquery+" AND ws.category IN ?)", categories)
+	}
 
-	// Filter by due date range
-	if qP.DueTo != nil {
-		query = query.Where("due_date < ?", *qP.DueTo)
+	// Filter by workflow
+	if qP.WorkflowID != nil {
+		query = query.Where("workflow_id = ?", *qP.WorkflowID)
+	}
+
+	// Filter by project
+	if qP.ProjectID != nil {
+		query = query.Where("project_id = ?", *qP.ProjectID)
+	}
+
+	// Filter by priority, stored as its level so ranges follow the priority order
+	if len(qP.Priorities) > 0 {
+		levels := make([]int, len(qP.Priorities))
+		for i, priority := range qP.Priorities {
+			levels[i] = priority.Level()
+		}
+		query = query.Where("priority IN ?", levels)
+	}
+	if qP.MinPriority != nil {
+		query = query.Where("priority >= ?", qP.MinPriority.Level())
 	}
+
+	// Filter by created date range (inclusive)
+	if qP.CreatedFrom != nil {
+		query = query.Where("created_at >= ?", *qP.CreatedFrom)
+	}
+	if qP.CreatedTo != nil {
+		query = query.Where("created_at <= ?", *qP.CreatedTo)
+	}
+
+	// Filter by due date range (inclusive)
 	if qP.DueFrom != nil {
-		query = query.Where("due_date > ?", *qP.DueFrom)
+		query = query.Where("due_date >= ?", *qP.DueFrom)
+	}
+	if qP.DueTo != nil {
+		query = query.Where("due_date <= ?", *qP.DueTo)
+	}
+
+	// Filter by last modification
+	if qP.UpdatedSince != nil {
+		query = query.Where("updated_at >= ?", *qP.UpdatedSince)
+	}
+
+	// Filter by due date presence
+	if qP.HasDueDate != nil {
+		if *qP.HasDueDate {
+			query = query.Where("due_date IS NOT NULL")
+		} else {
+			query = query.Where("due_date IS NULL")
+		}
+	}
+
+	// Filter by overdue: due date passed and not done yet
+	if qP.Overdue != nil {
+		now := time.Now().UTC()
+		if *qP.Overdue {
+			query = query.Where("due_date IS NOT NULL AND due_date < ? AND NOT EXISTS ("+statusCategorySubquery+" AND ws.category = ?)",
+				now, string(entity.CategoryDone))
+		} else {
+			query = query.Where("(due_date IS NULL OR due_date >= ? OR EXISTS ("+statusCategorySubquery+" AND ws.category = ?))",
+				now, string(entity.CategoryDone))
+		}
+	}
+
+	// Filter by parent
+	if qP.ParentID != nil {
+		query = query.Where("parent_id = ?", *qP.ParentID)
+	}
+
+	// Filter by recurring series, the first occurrence is the series itself
+	if qP.SeriesID != nil {
+		query = query.Where("(id = ? OR series_id = ?)", *qP.SeriesID, *qP.SeriesID)
+	}
+
+	// Filter by tags through the join table, so no tag rows are loaded for filtering
+	if len(qP.TagsAny) > 0 {
+		query = query.Where("id IN (?)",
+			r.db.Model(&model.TodoTag{}).Select("todo_id").Where("tag_id IN ?", qP.TagsAny))
+	}
+	if len(qP.TagsAll) > 0 {
+		tagIDs := uniqueIDs(qP.TagsAll)
+		query = query.Where("id IN (?)",
+			r.db.Model(&model.TodoTag{}).Select("todo_id").Where("tag_id IN ?", tagIDs).
+				Group("todo_id").Having("COUNT(DISTINCT tag_id) = ?", len(tagIDs)))
+	}
+
+	// Filter by assignees through the join table
+	if qP.AssigneeID != nil {
+		query = query.Where("id IN (?)",
+			r.db.Model(&model.TodoAssignee{}).Select("todo_id").Where("user_id = ?", *qP.AssigneeID))
+	}
+	if qP.Unassigned {
+		query = query.Where("id NOT IN (?)",
+			r.db.Model(&model.TodoAssignee{}).Select("todo_id"))
 	}
 
 	// Search in title and description
@@ -174,3 +679,171 @@ func (r *TodoRepositoryImpl) applyFilters(query *gorm.DB, qP repository.TodoQuer
 
 	return query
 }
+
+// replaceTodoTags makes the given tags the only tags attached to the todo
+func replaceTodoTags(tx *gorm.DB, todoID uint, tagIDs []uint) error {
+	if err := tx.Where("todo_id = ?", todoID).Delete(&model.TodoTag{}).Error; err != nil {
+		return err
+	}
+
+	tagIDs = uniqueIDs(tagIDs)
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	rows := make([]model.TodoTag, len(tagIDs))
+	for i, tagID := range tagIDs {
+		rows[i] = model.TodoTag{TodoID: todoID, TagID: tagID}
+	}
+	return tx.Create(&rows).Error
+}
+
+// deleteTodoRelations removes the rows owned by the given todos and detaches their subtasks,
+// trashed subtasks included, so nothing points at a purged todo
+func deleteTodoRelations(tx *gorm.DB, ids []uint) error {
+	if err := tx.Where("todo_id IN ?", ids).Delete(&model.TodoTag{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("todo_id IN ?", ids).Delete(&model.ChecklistItem{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("todo_id IN ?", ids).Delete(&model.StatusChange{}).Error; err != nil {
+		return err
+	}
+	// todos blocked by a purged todo lose a blocker, their version changes with it
+	blocked := tx.Model(&model.TodoDependency{}).Select("todo_id").Where("blocker_id IN ? AND todo_id NOT IN ?", ids, ids)
+	if err := bumpTodoVersions(tx, blocked); err != nil {
+		return err
+	}
+	if err := tx.Where("todo_id IN ? OR blocker_id IN ?", ids, ids).Delete(&model.TodoDependency{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("todo_id IN ?", ids).Delete(&model.TodoAssignee{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Unscoped().Where("todo_id IN ?", ids).Delete(&model.Comment{}).Error; err != nil {
+		return err
+	}
+	return tx.Unscoped().Model(&model.Todo{}).
+		Where("parent_id IN ?", ids).
+		UpdateColumns(map[string]interface{}{
+			"parent_id": nil,
+			"version":   gorm.Expr("version + 1"),
+		}).Error
+}
+
+// bumpTodoVersions increments the version of the todos matched by ids, a slice of IDs or a subquery
+// selecting them, so a change to what is attached to a todo also changes its ETag
+func bumpTodoVersions(tx *gorm.DB, ids interface{}) error {
+	return tx.Unscoped().Model(&model.Todo{}).
+		Where("id IN (?)", ids).
+		UpdateColumn("version", gorm.Expr("version + 1")).Error
+}
+
+// inTenant limits a query to the todos of the workspace carried by ctx, or outside a workspace
+// to the todos of the signed-in user; only background work marked with actor.WithSystem sees every todo,
+// a ctx carrying no tenant at all matches nothing
+func inTenant(ctx context.Context) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if workspaceID := actor.WorkspaceID(ctx); workspaceID != 0 {
+			return db.Where("todos.workspace_id = ?", workspaceID)
+		}
+		if userID := actor.UserID(ctx); userID != 0 {
+			return db.Where("todos.owner_id = ?", userID)
+		}
+		if actor.IsSystem(ctx) {
+			return db
+		}
+		return db.Where("1 = 0")
+	}
+}
+
+// inWorkspace limits a query on the table of a resource the members of a workspace share, such as projects,
+// to the rows of the workspace carried by ctx; background work marked with actor.WithSystem sees every row,
+// any other ctx matches nothing
+func inWorkspace(ctx context.Context, table string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if workspaceID := actor.WorkspaceID(ctx); workspaceID != 0 {
+			return db.Where(table+".workspace_id = ?", workspaceID)
+		}
+		if actor.IsSystem(ctx) {
+			return db
+		}
+		return db.Where("1 = 0")
+	}
+}
+
+// preloadTodoRelations loads the tags, checklist, blockers and assignees of the queried todos
+func preloadTodoRelations(db *gorm.DB) *gorm.DB {
+	return db.Preload("Tags", orderTagsByName).
+		Preload("Checklist", orderChecklistByPosition).
+		Preload("Blockers", orderBlockersByID).
+		Preload("Assignees", orderAssigneesByCreation)
+}
+
+// orderChecklistByPosition keeps preloaded checklist items in their manual order
+func orderChecklistByPosition(db *gorm.DB) *gorm.DB {
+	return db.Order("position ASC, id ASC")
+}
+
+// loadCommentCounts sets the number of comments, deleted ones excluded, of the given todos
+// with one grouped query instead of one query per todo
+func loadCommentCounts(db *gorm.DB, todos []*model.Todo) error {
+	if len(todos) == 0 {
+		return nil
+	}
+
+	ids := make([]uint, len(todos))
+	for i, todo := range todos {
+		ids[i] = todo.ID
+	}
+
+	var counts []struct {
+		TodoID uint
+		Count  int
+	}
+	if err := db.Model(&model.Comment{}).
+		Select("todo_id, COUNT(*) AS count").
+		Where("todo_id IN ?", ids).
+		Group("todo_id").
+		Scan(&counts).Error; err != nil {
+		return fmt.Errorf("failed to count comments: %w", err)
+	}
+
+	byTodo := make(map[uint]int, len(counts))
+	for _, count := range counts {
+		byTodo[count.TodoID] = count.Count
+	}
+	for _, todo := range todos {
+		todo.CommentCount = byTodo[todo.ID]
+	}
+	return nil
+}
+
+// orderAssigneesByCreation lists preloaded assignees in the order they were assigned
+func orderAssigneesByCreation(db *gorm.DB) *gorm.DB {
+	return db.Order("created_at ASC, user_id ASC")
+}
+
+// orderBlockersByID keeps preloaded blockers in a stable order
+func orderBlockersByID(db *gorm.DB) *gorm.DB {
+	return db.Order("blocker_id ASC")
+}
+
+// orderTagsByName keeps preloaded tags in a stable order
+func orderTagsByName(db *gorm.DB) *gorm.DB {
+	return db.Order("name ASC")
+}
+
+// uniqueIDs returns ids without duplicates, keeping the first occurrence order
+func uniqueIDs(ids []uint) []uint {
+	seen := make(map[uint]bool, len(ids))
+	unique := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,13 +17,15 @@ import (
 	"itmrchow/go-todolist-service/internal/infrastructure/config"
 	"itmrchow/go-todolist-service/internal/infrastructure/database"
 	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+	"itmrchow/go-todolist-service/internal/utils/actor"
 )
 
 type TodoRepositoryTestSuite struct {
 	suite.Suite
-	db   *gorm.DB
-	repo repository.TodoRepository
-	ctx  context.Context
+	db       *gorm.DB
+	repo     repository.TodoRepository
+	ctx      context.Context
+	workflow *entity.Workflow // default workflow, seeded once
 }
 
 // SetupSuite 在整個測試 suite 開始前執行一次
@@ -33,13 +37,17 @@ func (suite *TodoRepositoryTestSuite) SetupSuite() {
 	suite.Require().NoError(err)
 
 	// Auto migrate
-	err = sqlLiteDB.Migrate(&model.Todo{})
+	err = sqlLiteDB.Migrate(&model.Todo{}, &model.Tag{}, &model.TodoTag{}, &model.ChecklistItem{}, &model.StatusChange{}, &model.TodoDependency{}, &model.TodoAssignee{}, &model.Comment{},
+		&model.Workflow{}, &model.WorkflowStatus{})
 	suite.Require().NoError(err)
 
 	suite.db = db
-	suite.ctx = ctx
+	// The suite acts for the system, tenant scoping is covered by TestOwnerScope and TestWorkspaceScope
+	suite.ctx = actor.WithSystem(ctx)
 
 	suite.repo = NewTodoRepository(zerolog.New(os.Stdout), suite.db)
+	suite.workflow, err = NewWorkflowRepository(zerolog.New(os.Stdout), suite.db).EnsureDefault(ctx, entity.DefaultWorkflow())
+	suite.Require().NoError(err)
 }
 
 // TearDownSuite 在整個測試 suite 結束後執行一次
@@ -56,7 +64,14 @@ func (suite *TodoRepositoryTestSuite) TearDownSuite() {
 func (suite *TodoRepositoryTestSuite) TearDownTest() {
 	if suite.db != nil {
 		suite.db.Exec("DELETE FROM todos")
-		suite.db.Exec("DELETE FROM sqlite_sequence WHERE name='todos'")
+		suite.db.Exec("DELETE FROM tags")
+		suite.db.Exec("DELETE FROM todo_tags")
+		suite.db.Exec("DELETE FROM checklist_items")
+		suite.db.Exec("DELETE FROM todo_status_history")
+		suite.db.Exec("DELETE FROM todo_dependencies")
+		suite.db.Exec("DELETE FROM todo_assignees")
+		suite.db.Exec("DELETE FROM todo_comments")
+		suite.db.Exec("DELETE FROM sqlite_sequence WHERE name IN ('todos', 'tags', 'checklist_items', 'todo_status_history')")
 	}
 }
 
@@ -193,6 +208,110 @@ func (suite *TodoRepositoryTestSuite) TestUpdate_Success() {
 	suite.True(updatedTodo.UpdatedAt.After(createdTodo.UpdatedAt))
 }
 
+func (suite *TodoRepositoryTestSuite) TestUpdate_VersionConflict() {
+	todo, err := entity.NewTodo("原始標題", nil, nil, nil)
+	suite.Require().NoError(err)
+	createdTodo, err := suite.repo.Create(suite.ctx, todo)
+	suite.Require().NoError(err)
+	suite.Equal(uint(1), createdTodo.Version)
+
+	// two editors read version 1
+	first, err := suite.repo.GetByID(suite.ctx, createdTodo.ID)
+	suite.Require().NoError(err)
+	second, err := suite.repo.GetByID(suite.ctx, createdTodo.ID)
+	suite.Require().NoError(err)
+
+	first.Title = "第一個編輯"
+	rowsAffected, err := suite.repo.Update(suite.ctx, first)
+	suite.NoError(err)
+	suite.Equal(int64(1), rowsAffected)
+	suite.Equal(uint(2), first.Version)
+
+	// the second editor still holds version 1
+	second.Title = "第二個編輯"
+	rowsAffected, err = suite.repo.Update(suite.ctx, second)
+	suite.ErrorIs(err, repository.ErrVersionConflict)
+	suite.Equal(int64(0), rowsAffected)
+
+	got, err := suite.repo.GetByID(suite.ctx, createdTodo.ID)
+	suite.Require().NoError(err)
+	suite.Equal("第一個編輯", got.Title)
+	suite.Equal(uint(2), got.Version)
+
+	// a missing todo is not a conflict
+	rowsAffected, err = suite.repo.Update(suite.ctx, &entity.Todo{ID: 999, Title: "不存在", Version: 1})
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected)
+}
+
+func (suite *TodoRepositoryTestSuite) TestUpdateWithHistory() {
+	todo, err := entity.NewTodo("發版檢查", nil, nil, nil)
+	suite.Require().NoError(err)
+	createdTodo, err := suite.repo.Create(suite.ctx, todo)
+	suite.Require().NoError(err)
+	now := time.Now().UTC()
+
+	// completing the todo records the change and schedules the next occurrence together
+	createdTodo.Status = entity.StatusDone
+	next, err := entity.NewTodo("發版檢查", nil, nil, nil)
+	suite.Require().NoError(err)
+	change := entity.NewStatusChange(createdTodo.ID, entity.StatusPending, entity.StatusDone, "alice", now)
+	rowsAffected, err := suite.repo.UpdateWithHistory(suite.ctx, createdTodo, change, next)
+	suite.NoError(err)
+	suite.Equal(int64(1), rowsAffected)
+	suite.Equal(uint(2), createdTodo.Version)
+
+	history, err := NewStatusHistoryRepository(zerolog.New(os.Stdout), suite.db).ListByTodo(suite.ctx, createdTodo.ID)
+	suite.NoError(err)
+	suite.Len(history, 1)
+	count, err := suite.repo.Count(suite.ctx, repository.TodoQueryParams{})
+	suite.NoError(err)
+	suite.Equal(int64(2), count)
+
+	// a failing write of the next occurrence rolls the update and the change back
+	createdTodo.Status = entity.StatusPending
+	duplicate := &entity.Todo{ID: createdTodo.ID, Title: "重複的ID", Status: entity.StatusPending, Priority: entity.PriorityNone}
+	change = entity.NewStatusChange(createdTodo.ID, entity.StatusDone, entity.StatusPending, "alice", now)
+	_, err = suite.repo.UpdateWithHistory(suite.ctx, createdTodo, change, duplicate)
+	suite.Error(err)
+
+	got, err := suite.repo.GetByID(suite.ctx, createdTodo.ID)
+	suite.Require().NoError(err)
+	suite.Equal(entity.StatusDone, got.Status)
+	suite.Equal(uint(2), got.Version)
+	history, err = NewStatusHistoryRepository(zerolog.New(os.Stdout), suite.db).ListByTodo(suite.ctx, createdTodo.ID)
+	suite.NoError(err)
+	suite.Len(history, 1)
+}
+
+func (suite *TodoRepositoryTestSuite) TestUpdate_ClearNullableFields() {
+	// Arrange - Create a todo with description and due date
+	description := "測試描述"
+	dueDate := time.Now().Add(time.Hour * 24).UTC()
+	todo, err := entity.NewTodo("測試標題", &description, nil, &dueDate)
+	suite.Require().NoError(err)
+	createdTodo, err := suite.repo.Create(suite.ctx, todo)
+	suite.Require().NoError(err)
+
+	// Clear the nullable fields
+	createdTodo.Description = nil
+	createdTodo.DueDate = nil
+
+	// Act
+	rowsAffected, err := suite.repo.Update(suite.ctx, createdTodo)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(int64(1), rowsAffected)
+
+	updatedTodo, err := suite.repo.GetByID(suite.ctx, createdTodo.ID)
+	suite.NoError(err)
+	suite.NotNil(updatedTodo)
+	suite.Nil(updatedTodo.Description)
+	suite.Nil(updatedTodo.DueDate)
+	suite.Equal(createdTodo.CreatedAt.Unix(), updatedTodo.CreatedAt.Unix())
+}
+
 func (suite *TodoRepositoryTestSuite) TestUpdate_NilInput() {
 	// Act
 	rowsAffected, err := suite.repo.Update(suite.ctx, nil)
@@ -248,15 +367,26 @@ func (suite *TodoRepositoryTestSuite) TestDelete_Success() {
 	suite.Require().NoError(err)
 
 	// Act
-	_, err = suite.repo.Delete(suite.ctx, createdTodo.ID)
+	rowsAffected, err := suite.repo.Delete(suite.ctx, createdTodo.ID)
 
 	// Assert
 	suite.NoError(err)
+	suite.Equal(int64(1), rowsAffected)
 
 	// Verify the todo is soft deleted
 	foundTodo, err := suite.repo.GetByID(suite.ctx, createdTodo.ID)
 	suite.NoError(err)
 	suite.Nil(foundTodo) // Should not be found after soft delete
+
+	deletedTodo, err := suite.repo.GetByIDUnscoped(suite.ctx, createdTodo.ID)
+	suite.NoError(err)
+	suite.NotNil(deletedTodo.DeletedAt)
+	suite.Equal(createdTodo.Version+1, deletedTodo.Version) // a trashed todo changed, cached ETags go stale
+
+	// Deleting it again changes nothing
+	rowsAffected, err = suite.repo.Delete(suite.ctx, createdTodo.ID)
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected)
 }
 
 func (suite *TodoRepositoryTestSuite) TestDelete_NotFound() {
@@ -290,7 +420,7 @@ func (suite *TodoRepositoryTestSuite) TestList_WithFilters() {
 	pagination := &repository.Pagination[entity.Todo]{
 		Limit: 2,
 		Page:  1,
-		Sort:  "created_at DESC",
+		Sorts: []repository.SortOption{{Field: "created_at", Direction: repository.SortDesc}},
 	}
 
 	err := suite.repo.List(suite.ctx, queryParams, pagination)
@@ -303,6 +433,1324 @@ func (suite *TodoRepositoryTestSuite) TestList_WithFilters() {
 	suite.EqualValues(3, pagination.TotalRows)
 }
 
+func (suite *TodoRepositoryTestSuite) TestListDeleted_OnlySoftDeleted() {
+	// Arrange - Create three todos and soft delete two of them
+	todo1, _ := entity.NewTodo("第一個 Todo", nil, nil, nil)
+	todo2, _ := entity.NewTodo("第二個 Todo", nil, nil, nil)
+	todo3, _ := entity.NewTodo("第三個 Todo", nil, nil, nil)
+	created1, _ := suite.repo.Create(suite.ctx, todo1)
+	created2, _ := suite.repo.Create(suite.ctx, todo2)
+	suite.repo.Create(suite.ctx, todo3)
+	suite.repo.Delete(suite.ctx, created1.ID)
+	suite.repo.Delete(suite.ctx, created2.ID)
+
+	pagination := &repository.Pagination[entity.Todo]{
+		Limit: 10,
+		Page:  1,
+		Sorts: []repository.SortOption{{Field: "deleted_at", Direction: repository.SortDesc}},
+	}
+
+	// Act
+	err := suite.repo.ListDeleted(suite.ctx, pagination)
+
+	// Assert
+	suite.NoError(err)
+	suite.Len(pagination.Rows, 2)
+	suite.EqualValues(2, pagination.TotalRows)
+	for _, row := range pagination.Rows {
+		suite.True(row.IsDeleted())
+	}
+}
+
+func (suite *TodoRepositoryTestSuite) TestRestore_Success() {
+	// Arrange - Create and soft delete a todo
+	todo, err := entity.NewTodo("測試標題", nil, nil, nil)
+	suite.Require().NoError(err)
+	createdTodo, err := suite.repo.Create(suite.ctx, todo)
+	suite.Require().NoError(err)
+	_, err = suite.repo.Delete(suite.ctx, createdTodo.ID)
+	suite.Require().NoError(err)
+
+	deletedTodo, err := suite.repo.GetByIDUnscoped(suite.ctx, createdTodo.ID)
+	suite.Require().NoError(err)
+	suite.Require().True(deletedTodo.IsDeleted())
+
+	// Act
+	deletedTodo.Restore()
+	rowsAffected, err := suite.repo.Restore(suite.ctx, deletedTodo)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(int64(1), rowsAffected)
+
+	foundTodo, err := suite.repo.GetByID(suite.ctx, createdTodo.ID)
+	suite.NoError(err)
+	suite.NotNil(foundTodo) // Restored todo is visible again
+	suite.False(foundTodo.IsDeleted())
+	suite.Equal(deletedTodo.Version+1, foundTodo.Version)
+}
+
+func (suite *TodoRepositoryTestSuite) TestRestore_NotDeleted() {
+	// Arrange - Create a todo without deleting it
+	todo, err := entity.NewTodo("測試標題", nil, nil, nil)
+	suite.Require().NoError(err)
+	createdTodo, err := suite.repo.Create(suite.ctx, todo)
+	suite.Require().NoError(err)
+
+	// Act
+	rowsAffected, err := suite.repo.Restore(suite.ctx, createdTodo)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected) // Only trashed todos can be restored
+}
+
+func (suite *TodoRepositoryTestSuite) TestHardDelete_OnlyTrashed() {
+	// Arrange - One active todo and one trashed todo
+	activeTodo, _ := entity.NewTodo("未刪除", nil, nil, nil)
+	trashedTodo, _ := entity.NewTodo("已刪除", nil, nil, nil)
+	createdActive, _ := suite.repo.Create(suite.ctx, activeTodo)
+	createdTrashed, _ := suite.repo.Create(suite.ctx, trashedTodo)
+	suite.repo.Delete(suite.ctx, createdTrashed.ID)
+
+	// Act
+	activeRows, activeErr := suite.repo.HardDelete(suite.ctx, createdActive.ID)
+	trashedRows, trashedErr := suite.repo.HardDelete(suite.ctx, createdTrashed.ID)
+
+	// Assert
+	suite.NoError(activeErr)
+	suite.Equal(int64(0), activeRows) // Active todos must go through the trash first
+	suite.NoError(trashedErr)
+	suite.Equal(int64(1), trashedRows)
+
+	purgedTodo, err := suite.repo.GetByIDUnscoped(suite.ctx, createdTrashed.ID)
+	suite.NoError(err)
+	suite.Nil(purgedTodo) // Row is gone even for unscoped queries
+}
+
+func (suite *TodoRepositoryTestSuite) TestPurgeDeleted() {
+	// Arrange - Two trashed todos and one active todo
+	todo1, _ := entity.NewTodo("第一個 Todo", nil, nil, nil)
+	todo2, _ := entity.NewTodo("第二個 Todo", nil, nil, nil)
+	todo3, _ := entity.NewTodo("第三個 Todo", nil, nil, nil)
+	created1, _ := suite.repo.Create(suite.ctx, todo1)
+	created2, _ := suite.repo.Create(suite.ctx, todo2)
+	created3, _ := suite.repo.Create(suite.ctx, todo3)
+	suite.repo.Delete(suite.ctx, created1.ID)
+	suite.repo.Delete(suite.ctx, created2.ID)
+
+	// Act
+	purgedCount, err := suite.repo.PurgeDeleted(suite.ctx)
+
+	// Assert
+	suite.NoError(err)
+	suite.Equal(int64(2), purgedCount)
+
+	activeTodo, err := suite.repo.GetByID(suite.ctx, created3.ID)
+	suite.NoError(err)
+	suite.NotNil(activeTodo)
+}
+
+func (suite *TodoRepositoryTestSuite) TestPurgeDeletedBefore_RespectsCutoffAndLimit() {
+	// Arrange - Three todos trashed long ago, one trashed recently and one active
+	now := time.Now().UTC()
+	var expiredIDs []uint
+	for i := 0; i < 3; i++ {
+		todo, _ := entity.NewTodo("過期", nil, nil, nil)
+		created, _ := suite.repo.Create(suite.ctx, todo)
+		suite.repo.Delete(suite.ctx, created.ID)
+		expiredIDs = append(expiredIDs, created.ID)
+	}
+	suite.db.Exec("UPDATE todos SET deleted_at = ? WHERE id IN ?", now.AddDate(0, 0, -40), expiredIDs)
+
+	recentTodo, _ := entity.NewTodo("近期刪除", nil, nil, nil)
+	recentCreated, _ := suite.repo.Create(suite.ctx, recentTodo)
+	suite.repo.Delete(suite.ctx, recentCreated.ID)
+
+	activeTodo, _ := entity.NewTodo("未刪除", nil, nil, nil)
+	activeCreated, _ := suite.repo.Create(suite.ctx, activeTodo)
+
+	cutoff := now.AddDate(0, 0, -30)
+
+	// Act - First batch is capped by the limit, second batch takes the rest
+	firstBatch, err := suite.repo.PurgeDeletedBefore(suite.ctx, cutoff, 2)
+	suite.NoError(err)
+	secondBatch, err := suite.repo.PurgeDeletedBefore(suite.ctx, cutoff, 2)
+	suite.NoError(err)
+	thirdBatch, err := suite.repo.PurgeDeletedBefore(suite.ctx, cutoff, 2)
+	suite.NoError(err)
+
+	// Assert
+	suite.Equal(int64(2), firstBatch)
+	suite.Equal(int64(1), secondBatch)
+	suite.Equal(int64(0), thirdBatch)
+
+	recent, err := suite.repo.GetByIDUnscoped(suite.ctx, recentCreated.ID)
+	suite.NoError(err)
+	suite.NotNil(recent) // Still within retention
+	active, err := suite.repo.GetByID(suite.ctx, activeCreated.ID)
+	suite.NoError(err)
+	suite.NotNil(active) // Active todos are never purged
+}
+
+// seedFilterTodos creates todos with controlled timestamps for filter tests
+// and returns their IDs keyed by title
+func (suite *TodoRepositoryTestSuite) seedFilterTodos(base time.Time) map[string]uint {
+	type seed struct {
+		title     string
+		status    entity.TodoStatus
+		dueDate   *time.Time
+		createdAt time.Time
+		updatedAt time.Time
+	}
+
+	overdueDate := base.Add(-48 * time.Hour)
+	futureDate := base.Add(48 * time.Hour)
+	seeds := []seed{
+		{"overdue", entity.StatusPending, &overdueDate, base.Add(-72 * time.Hour), base.Add(-72 * time.Hour)},
+		{"overdue_done", entity.StatusDone, &overdueDate, base.Add(-48 * time.Hour), base.Add(-1 * time.Hour)},
+		{"future", entity.StatusDoing, &futureDate, base.Add(-24 * time.Hour), base.Add(-24 * time.Hour)},
+		{"no_due", entity.StatusPending, nil, base, base},
+	}
+
+	ids := make(map[string]uint)
+	for _, sd := range seeds {
+		todo, err := entity.NewTodo(sd.title, nil, nil, nil)
+		suite.Require().NoError(err)
+		created, err := suite.repo.Create(suite.ctx, todo)
+		suite.Require().NoError(err)
+
+		// Bypass entity validation to control timestamps and past due dates
+		suite.db.Exec("UPDATE todos SET status = ?, workflow_id = ?, due_date = ?, created_at = ?, updated_at = ? WHERE id = ?",
+			string(sd.status), suite.workflow.ID, sd.dueDate, sd.createdAt, sd.updatedAt, created.ID)
+		ids[sd.title] = created.ID
+	}
+
+	return ids
+}
+
+func (suite *TodoRepositoryTestSuite) TestList_FilterConditions() {
+	base := time.Now().UTC().Truncate(time.Second)
+	ids := suite.seedFilterTodos(base)
+
+	createdFrom := base.Add(-48 * time.Hour) // equals "overdue_done" created_at
+	createdTo := base.Add(-24 * time.Hour)   // equals "future" created_at
+	dueFrom := base.Add(-48 * time.Hour)     // equals overdue due dates
+	dueTo := base.Add(48 * time.Hour)        // equals "future" due date
+	updatedSince := base.Add(-1 * time.Hour) // equals "overdue_done" updated_at
+
+	tests := []struct {
+		name        string
+		queryParams repository.TodoQueryParams
+		expected    []string
+	}{
+		{
+			name: "created_range_is_applied_and_inclusive",
+			queryParams: repository.TodoQueryParams{
+				CreatedFrom: &createdFrom,
+				CreatedTo:   &createdTo,
+			},
+			expected: []string{"overdue_done", "future"},
+		},
+		{
+			name: "due_range_is_inclusive",
+			queryParams: repository.TodoQueryParams{
+				DueFrom: &dueFrom,
+				DueTo:   &dueTo,
+			},
+			expected: []string{"overdue", "overdue_done", "future"},
+		},
+		{
+			name: "overdue_excludes_done",
+			queryParams: repository.TodoQueryParams{
+				Overdue: boolPtr(true),
+			},
+			expected: []string{"overdue"},
+		},
+		{
+			name: "not_overdue",
+			queryParams: repository.TodoQueryParams{
+				Overdue: boolPtr(false),
+			},
+			expected: []string{"overdue_done", "future", "no_due"},
+		},
+		{
+			name: "has_due_date",
+			queryParams: repository.TodoQueryParams{
+				HasDueDate: boolPtr(true),
+			},
+			expected: []string{"overdue", "overdue_done", "future"},
+		},
+		{
+			name: "without_due_date",
+			queryParams: repository.TodoQueryParams{
+				HasDueDate: boolPtr(false),
+			},
+			expected: []string{"no_due"},
+		},
+		{
+			name: "multiple_statuses",
+			queryParams: repository.TodoQueryParams{
+				Statuses: []entity.TodoStatus{entity.StatusDoing, entity.StatusDone},
+			},
+			expected: []string{"overdue_done", "future"},
+		},
+		{
+			name: "updated_since_is_inclusive",
+			queryParams: repository.TodoQueryParams{
+				UpdatedSince: &updatedSince,
+			},
+			expected: []string{"overdue_done", "no_due"},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			pagination := &repository.Pagination[entity.Todo]{
+				Limit: 10,
+				Page:  1,
+			}
+
+			err := suite.repo.List(suite.ctx, tt.queryParams, pagination)
+
+			suite.NoError(err)
+			expectedIDs := make([]uint, len(tt.expected))
+			for i, title := range tt.expected {
+				expectedIDs[i] = ids[title]
+			}
+			actualIDs := make([]uint, len(pagination.Rows))
+			for i, row := range pagination.Rows {
+				actualIDs[i] = row.ID
+			}
+			suite.ElementsMatch(expectedIDs, actualIDs)
+			suite.EqualValues(len(tt.expected), pagination.TotalRows)
+		})
+	}
+}
+
+func (suite *TodoRepositoryTestSuite) TestList_Sorting() {
+	base := time.Now().UTC().Truncate(time.Second)
+	ids := suite.seedFilterTodos(base)
+
+	tests := []struct {
+		name     string
+		sorts    []repository.SortOption
+		expected []string
+	}{
+		{
+			name:     "default_is_id_desc",
+			sorts:    nil,
+			expected: []string{"no_due", "future", "overdue_done", "overdue"},
+		},
+		{
+			name: "nulls_last_ascending",
+			sorts: []repository.SortOption{
+				{Field: "due_date", Direction: repository.SortAsc, NullsLast: true},
+			},
+			// equal due dates fall back to the id tiebreaker in the same direction
+			expected: []string{"overdue", "overdue_done", "future", "no_due"},
+		},
+		{
+			name: "nulls_last_descending",
+			sorts: []repository.SortOption{
+				{Field: "due_date", Direction: repository.SortDesc, NullsLast: true},
+			},
+			expected: []string{"future", "overdue_done", "overdue", "no_due"},
+		},
+		{
+			name: "multiple_keys",
+			sorts: []repository.SortOption{
+				{Field: "status", Direction: repository.SortDesc},
+				{Field: "created_at", Direction: repository.SortAsc},
+			},
+			expected: []string{"overdue", "no_due", "overdue_done", "future"},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			pagination := &repository.Pagination[entity.Todo]{
+				Limit: 10,
+				Page:  1,
+				Sorts: tt.sorts,
+			}
+
+			err := suite.repo.List(suite.ctx, repository.TodoQueryParams{}, pagination)
+
+			suite.NoError(err)
+			actual := make([]uint, len(pagination.Rows))
+			for i, row := range pagination.Rows {
+				actual[i] = row.ID
+			}
+			expected := make([]uint, len(tt.expected))
+			for i, title := range tt.expected {
+				expected[i] = ids[title]
+			}
+			suite.Equal(expected, actual)
+		})
+	}
+}
+
+func (suite *TodoRepositoryTestSuite) TestList_SortFieldIsQuoted() {
+	todo, err := entity.NewTodo("Quoted", nil, nil, nil)
+	suite.Require().NoError(err)
+	_, err = suite.repo.Create(suite.ctx, todo)
+	suite.Require().NoError(err)
+
+	// A field that slipped past validation is treated as an identifier, never as SQL
+	pagination := &repository.Pagination[entity.Todo]{
+		Limit: 10,
+		Page:  1,
+		Sorts: []repository.SortOption{{Field: "id; DROP TABLE todos", Direction: repository.SortAsc}},
+	}
+	err = suite.repo.List(suite.ctx, repository.TodoQueryParams{}, pagination)
+
+	suite.Error(err)
+	suite.True(suite.db.Migrator().HasTable("todos"))
+}
+
+func (suite *TodoRepositoryTestSuite) TestList_CursorWalksAllPages() {
+	base := time.Now().UTC().Truncate(time.Second)
+	suite.seedFilterTodos(base)
+	suite.seedFilterTodos(base)
+
+	sortCases := map[string][]repository.SortOption{
+		"default":        nil,
+		"due_date_asc":   {{Field: "due_date", Direction: repository.SortAsc, NullsLast: true}},
+		"due_date_desc":  {{Field: "due_date", Direction: repository.SortDesc, NullsLast: true}},
+		"status_created": {{Field: "status", Direction: repository.SortAsc}, {Field: "created_at", Direction: repository.SortDesc}},
+	}
+
+	for name, sorts := range sortCases {
+		suite.Run(name, func() {
+			// Offset listing of everything is the reference order
+			all := &repository.Pagination[entity.Todo]{Limit: 100, Page: 1, Sorts: sorts}
+			suite.Require().NoError(suite.repo.List(suite.ctx, repository.TodoQueryParams{}, all))
+			expected := make([]uint, len(all.Rows))
+			for i, row := range all.Rows {
+				expected[i] = row.ID
+			}
+
+			// Walk forward
+			var forward []uint
+			var pages []*repository.Pagination[entity.Todo]
+			cursor := ""
+			for {
+				page := &repository.Pagination[entity.Todo]{Limit: 3, Sorts: sorts, UseCursor: true, Cursor: cursor, SkipCount: true}
+				suite.Require().NoError(suite.repo.List(suite.ctx, repository.TodoQueryParams{}, page))
+				for _, row := range page.Rows {
+					forward = append(forward, row.ID)
+				}
+				pages = append(pages, page)
+				if page.NextCursor == "" {
+					break
+				}
+				cursor = page.NextCursor
+			}
+			suite.Equal(expected, forward)
+			suite.Empty(pages[0].PrevCursor)
+			suite.Len(pages, 3)
+
+			// Walk back from the last page
+			var backward []uint
+			cursor = pages[len(pages)-1].PrevCursor
+			for cursor != "" {
+				page := &repository.Pagination[entity.Todo]{Limit: 3, Sorts: sorts, UseCursor: true, Cursor: cursor, SkipCount: true}
+				suite.Require().NoError(suite.repo.List(suite.ctx, repository.TodoQueryParams{}, page))
+				suite.NotEmpty(page.NextCursor)
+				pageIDs := make([]uint, len(page.Rows))
+				for i, row := range page.Rows {
+					pageIDs[i] = row.ID
+				}
+				backward = append(pageIDs, backward...)
+				cursor = page.PrevCursor
+			}
+			suite.Equal(expected[:len(expected)-len(pages[len(pages)-1].Rows)], backward)
+		})
+	}
+}
+
+func (suite *TodoRepositoryTestSuite) TestList_CursorStableWhenRowsAreAdded() {
+	for i := 0; i < 4; i++ {
+		todo, err := entity.NewTodo(fmt.Sprintf("Todo %d", i), nil, nil, nil)
+		suite.Require().NoError(err)
+		_, err = suite.repo.Create(suite.ctx, todo)
+		suite.Require().NoError(err)
+	}
+
+	first := &repository.Pagination[entity.Todo]{Limit: 2, UseCursor: true, SkipCount: true}
+	suite.Require().NoError(suite.repo.List(suite.ctx, repository.TodoQueryParams{}, first))
+	suite.Require().NotEmpty(first.NextCursor)
+
+	// A new todo lands on the first page (id desc), OFFSET would repeat a row on page 2
+	todo, err := entity.NewTodo("New", nil, nil, nil)
+	suite.Require().NoError(err)
+	_, err = suite.repo.Create(suite.ctx, todo)
+	suite.Require().NoError(err)
+
+	second := &repository.Pagination[entity.Todo]{Limit: 2, UseCursor: true, Cursor: first.NextCursor, SkipCount: true}
+	suite.Require().NoError(suite.repo.List(suite.ctx, repository.TodoQueryParams{}, second))
+
+	suite.Len(second.Rows, 2)
+	suite.Less(second.Rows[0].ID, first.Rows[1].ID)
+	suite.Empty(second.NextCursor)
+	suite.Zero(second.TotalRows)
+}
+
+func (suite *TodoRepositoryTestSuite) TestList_CursorCountsWhenAsked() {
+	suite.seedFilterTodos(time.Now().UTC())
+
+	pagination := &repository.Pagination[entity.Todo]{Limit: 3, UseCursor: true}
+	err := suite.repo.List(suite.ctx, repository.TodoQueryParams{}, pagination)
+
+	suite.NoError(err)
+	suite.EqualValues(4, pagination.TotalRows)
+	suite.Equal(2, pagination.TotalPages)
+}
+
+func (suite *TodoRepositoryTestSuite) TestList_InvalidCursor() {
+	suite.seedFilterTodos(time.Now().UTC())
+
+	first := &repository.Pagination[entity.Todo]{Limit: 1, UseCursor: true}
+	suite.Require().NoError(suite.repo.List(suite.ctx, repository.TodoQueryParams{}, first))
+
+	tests := []struct {
+		name   string
+		cursor string
+		sorts  []repository.SortOption
+	}{
+		{name: "malformed", cursor: "not a cursor"},
+		{name: "not_json", cursor: "bm90IGpzb24"},
+		{
+			name:   "issued_for_another_sort",
+			cursor: first.NextCursor,
+			sorts:  []repository.SortOption{{Field: "title", Direction: repository.SortAsc}},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			pagination := &repository.Pagination[entity.Todo]{Limit: 1, UseCursor: true, Cursor: tt.cursor, Sorts: tt.sorts}
+			err := suite.repo.List(suite.ctx, repository.TodoQueryParams{}, pagination)
+
+			suite.ErrorIs(err, repository.ErrInvalidCursor)
+		})
+	}
+}
+
+func (suite *TodoRepositoryTestSuite) TestList_Priority() {
+	ids := make(map[entity.TodoPriority]uint)
+	for _, priority := range []entity.TodoPriority{entity.PriorityMedium, entity.PriorityUrgent, entity.PriorityNone, entity.PriorityHigh} {
+		todo, err := entity.NewTodo(string(priority), nil, nil, nil)
+		suite.Require().NoError(err)
+		suite.Require().NoError(todo.SetPriority(priority))
+		created, err := suite.repo.Create(suite.ctx, todo)
+		suite.Require().NoError(err)
+		ids[priority] = created.ID
+	}
+
+	minPriority := entity.PriorityHigh
+	tests := []struct {
+		name        string
+		queryParams repository.TodoQueryParams
+		sorts       []repository.SortOption
+		expected    []entity.TodoPriority
+	}{
+		{
+			name:        "sort_by_priority_follows_level_not_name",
+			queryParams: repository.TodoQueryParams{},
+			sorts:       []repository.SortOption{{Field: "priority", Direction: repository.SortDesc}},
+			expected:    []entity.TodoPriority{entity.PriorityUrgent, entity.PriorityHigh, entity.PriorityMedium, entity.PriorityNone},
+		},
+		{
+			name:        "filter_by_priorities",
+			queryParams: repository.TodoQueryParams{Priorities: []entity.TodoPriority{entity.PriorityNone, entity.PriorityMedium}},
+			sorts:       []repository.SortOption{{Field: "priority", Direction: repository.SortAsc}},
+			expected:    []entity.TodoPriority{entity.PriorityNone, entity.PriorityMedium},
+		},
+		{
+			name:        "filter_by_min_priority",
+			queryParams: repository.TodoQueryParams{MinPriority: &minPriority},
+			sorts:       []repository.SortOption{{Field: "priority", Direction: repository.SortAsc}},
+			expected:    []entity.TodoPriority{entity.PriorityHigh, entity.PriorityUrgent},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			pagination := &repository.Pagination[entity.Todo]{Limit: 10, Page: 1, Sorts: tt.sorts}
+
+			err := suite.repo.List(suite.ctx, tt.queryParams, pagination)
+
+			suite.NoError(err)
+			actual := make([]entity.TodoPriority, len(pagination.Rows))
+			for i, row := range pagination.Rows {
+				actual[i] = row.Priority
+				suite.Equal(ids[row.Priority], row.ID)
+			}
+			suite.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func (suite *TodoRepositoryTestSuite) TestUpdate_Priority() {
+	todo, err := entity.NewTodo("Priority", nil, nil, nil)
+	suite.Require().NoError(err)
+	created, err := suite.repo.Create(suite.ctx, todo)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(created.SetPriority(entity.PriorityLow))
+	_, err = suite.repo.Update(suite.ctx, created)
+	suite.NoError(err)
+
+	got, err := suite.repo.GetByID(suite.ctx, created.ID)
+	suite.NoError(err)
+	suite.Equal(entity.PriorityLow, got.Priority)
+}
+
+// createTags creates tags with the given names and returns them in the same order
+func (suite *TodoRepositoryTestSuite) createTags(names ...string) []entity.Tag {
+	tagRepo := NewTagRepository(zerolog.New(os.Stdout), suite.db)
+	tags := make([]entity.Tag, len(names))
+	for i, name := range names {
+		tag, err := entity.NewTag(name, "")
+		suite.Require().NoError(err)
+		created, err := tagRepo.Create(suite.ctx, tag)
+		suite.Require().NoError(err)
+		tags[i] = *created
+	}
+	return tags
+}
+
+func (suite *TodoRepositoryTestSuite) TestCreate_WithTags() {
+	tags := suite.createTags("work", "home")
+	todo, err := entity.NewTodo("有標籤", nil, nil, nil)
+	suite.Require().NoError(err)
+	todo.SetTags(tags)
+
+	created, err := suite.repo.Create(suite.ctx, todo)
+	suite.Require().NoError(err)
+
+	got, err := suite.repo.GetByID(suite.ctx, created.ID)
+	suite.NoError(err)
+	suite.Require().Len(got.Tags, 2)
+	suite.Equal("home", got.Tags[0].Name) // Tags are ordered by name
+	suite.Equal("work", got.Tags[1].Name)
+}
+
+func (suite *TodoRepositoryTestSuite) TestUpdate_ReplacesTags() {
+	tags := suite.createTags("work", "home", "urgent")
+	todo, err := entity.NewTodo("有標籤", nil, nil, nil)
+	suite.Require().NoError(err)
+	todo.SetTags(tags[:2])
+	created, err := suite.repo.Create(suite.ctx, todo)
+	suite.Require().NoError(err)
+
+	created.SetTags(tags[2:])
+	_, err = suite.repo.Update(suite.ctx, created)
+	suite.NoError(err)
+
+	got, err := suite.repo.GetByID(suite.ctx, created.ID)
+	suite.NoError(err)
+	suite.Equal([]uint{tags[2].ID}, got.TagIDs())
+
+	got.SetTags(nil)
+	_, err = suite.repo.Update(suite.ctx, got)
+	suite.NoError(err)
+
+	got, err = suite.repo.GetByID(suite.ctx, created.ID)
+	suite.NoError(err)
+	suite.Empty(got.Tags)
+}
+
+func (suite *TodoRepositoryTestSuite) TestList_TagFilters() {
+	tags := suite.createTags("work", "home", "urgent")
+	work, home, urgent := tags[0], tags[1], tags[2]
+
+	ids := make(map[string]uint)
+	for title, todoTags := range map[string][]entity.Tag{
+		"work":        {work},
+		"work_urgent": {work, urgent},
+		"home":        {home},
+		"untagged":    nil,
+	} {
+		todo, err := entity.NewTodo(title, nil, nil, nil)
+		suite.Require().NoError(err)
+		todo.SetTags(todoTags)
+		created, err := suite.repo.Create(suite.ctx, todo)
+		suite.Require().NoError(err)
+		ids[title] = created.ID
+	}
+
+	tests := []struct {
+		name        string
+		queryParams repository.TodoQueryParams
+		expected    []string
+	}{
+		{
+			name:        "tags_any",
+			queryParams: repository.TodoQueryParams{TagsAny: []uint{urgent.ID, home.ID}},
+			expected:    []string{"work_urgent", "home"},
+		},
+		{
+			name:        "tags_all",
+			queryParams: repository.TodoQueryParams{TagsAll: []uint{work.ID, urgent.ID}},
+			expected:    []string{"work_urgent"},
+		},
+		{
+			name:        "tags_all_ignores_duplicate_ids",
+			queryParams: repository.TodoQueryParams{TagsAll: []uint{work.ID, work.ID}},
+			expected:    []string{"work", "work_urgent"},
+		},
+		{
+			name:        "tags_any_and_tags_all_combined",
+			queryParams: repository.TodoQueryParams{TagsAny: []uint{urgent.ID, home.ID}, TagsAll: []uint{work.ID}},
+			expected:    []string{"work_urgent"},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			pagination := &repository.Pagination[entity.Todo]{Limit: 10, Page: 1}
+
+			err := suite.repo.List(suite.ctx, tt.queryParams, pagination)
+
+			suite.NoError(err)
+			expectedIDs := make([]uint, len(tt.expected))
+			for i, title := range tt.expected {
+				expectedIDs[i] = ids[title]
+			}
+			actualIDs := make([]uint, len(pagination.Rows))
+			for i, row := range pagination.Rows {
+				actualIDs[i] = row.ID
+			}
+			suite.ElementsMatch(expectedIDs, actualIDs)
+			suite.Equal(int64(len(tt.expected)), pagination.TotalRows)
+		})
+	}
+}
+
+func (suite *TodoRepositoryTestSuite) TestList_AssigneeFilters() {
+	ids := make(map[string]uint)
+	for title, assignees := range map[string][]uint{
+		"alice":     {7},
+		"alice_bob": {7, 8},
+		"bob":       {8},
+		"nobody":    nil,
+	} {
+		todo, err := entity.NewTodo(title, nil, nil, nil)
+		suite.Require().NoError(err)
+		todo.AssigneeIDs = assignees
+		created, err := suite.repo.Create(suite.ctx, todo)
+		suite.Require().NoError(err)
+		ids[title] = created.ID
+	}
+
+	// assignees written on create are loaded on read, in the order they were assigned
+	got, err := suite.repo.GetByID(suite.ctx, ids["alice_bob"])
+	suite.Require().NoError(err)
+	suite.Equal([]uint{7, 8}, got.AssigneeIDs)
+
+	alice := uint(7)
+	tests := []struct {
+		name        string
+		queryParams repository.TodoQueryParams
+		expected    []string
+	}{
+		{
+			name:        "assignee",
+			queryParams: repository.TodoQueryParams{AssigneeID: &alice},
+			expected:    []string{"alice", "alice_bob"},
+		},
+		{
+			name:        "unassigned",
+			queryParams: repository.TodoQueryParams{Unassigned: true},
+			expected:    []string{"nobody"},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			pagination := &repository.Pagination[entity.Todo]{Limit: 10, Page: 1}
+
+			err := suite.repo.List(suite.ctx, tt.queryParams, pagination)
+
+			suite.NoError(err)
+			actualIDs := make([]uint, len(pagination.Rows))
+			for i, row := range pagination.Rows {
+				actualIDs[i] = row.ID
+			}
+			expectedIDs := make([]uint, len(tt.expected))
+			for i, title := range tt.expected {
+				expectedIDs[i] = ids[title]
+			}
+			suite.ElementsMatch(expectedIDs, actualIDs)
+		})
+	}
+
+	// purging a todo removes its assignees
+	_, err = suite.repo.Delete(suite.ctx, ids["alice"])
+	suite.Require().NoError(err)
+	_, err = suite.repo.HardDelete(suite.ctx, ids["alice"])
+	suite.Require().NoError(err)
+	var count int64
+	suite.db.Model(&model.TodoAssignee{}).Where("todo_id = ?", ids["alice"]).Count(&count)
+	suite.Equal(int64(0), count)
+}
+
+func (suite *TodoRepositoryTestSuite) TestPurgeDeleted_RemovesTagLinks() {
+	tags := suite.createTags("work")
+	todo, err := entity.NewTodo("有標籤", nil, nil, nil)
+	suite.Require().NoError(err)
+	todo.SetTags(tags)
+	created, err := suite.repo.Create(suite.ctx, todo)
+	suite.Require().NoError(err)
+	suite.repo.Delete(suite.ctx, created.ID)
+
+	_, err = suite.repo.PurgeDeleted(suite.ctx)
+	suite.NoError(err)
+
+	var links int64
+	suite.db.Model(&model.TodoTag{}).Count(&links)
+	suite.Equal(int64(0), links)
+}
+
+func (suite *TodoRepositoryTestSuite) TestParent_FilterAndCount() {
+	parent, _ := entity.NewTodo("父任務", nil, nil, nil)
+	createdParent, err := suite.repo.Create(suite.ctx, parent)
+	suite.Require().NoError(err)
+
+	done := entity.StatusDone
+	for _, status := range []*entity.TodoStatus{nil, &done} {
+		child, _ := entity.NewTodo("子任務", nil, status, nil)
+		suite.Require().NoError(child.SetWorkflow(suite.workflow, time.Now()))
+		suite.Require().NoError(child.SetParent(&createdParent.ID))
+		_, err := suite.repo.Create(suite.ctx, child)
+		suite.Require().NoError(err)
+	}
+
+	pagination := &repository.Pagination[entity.Todo]{Limit: 10, Page: 1}
+	err = suite.repo.List(suite.ctx, repository.TodoQueryParams{ParentID: &createdParent.ID}, pagination)
+	suite.NoError(err)
+	suite.Len(pagination.Rows, 2)
+	suite.Equal(&createdParent.ID, pagination.Rows[0].ParentID)
+
+	open, err := suite.repo.Count(suite.ctx, repository.TodoQueryParams{
+		ParentID: &createdParent.ID,
+		Statuses: []entity.TodoStatus{entity.StatusPending, entity.StatusDoing},
+	})
+	suite.NoError(err)
+	suite.Equal(int64(1), open)
+
+	open, err = suite.repo.Count(suite.ctx, repository.TodoQueryParams{
+		ParentID:   &createdParent.ID,
+		Categories: []entity.StatusCategory{entity.CategoryTodo, entity.CategoryInProgress},
+	})
+	suite.NoError(err)
+	suite.Equal(int64(1), open)
+}
+
+func (suite *TodoRepositoryTestSuite) TestUpdate_Parent() {
+	parent, _ := entity.NewTodo("父任務", nil, nil, nil)
+	createdParent, _ := suite.repo.Create(suite.ctx, parent)
+	child, _ := entity.NewTodo("子任務", nil, nil, nil)
+	createdChild, _ := suite.repo.Create(suite.ctx, child)
+
+	suite.Require().NoError(createdChild.SetParent(&createdParent.ID))
+	_, err := suite.repo.Update(suite.ctx, createdChild)
+	suite.NoError(err)
+
+	got, _ := suite.repo.GetByID(suite.ctx, createdChild.ID)
+	suite.Equal(&createdParent.ID, got.ParentID)
+
+	suite.Require().NoError(got.SetParent(nil))
+	_, err = suite.repo.Update(suite.ctx, got)
+	suite.NoError(err)
+
+	got, _ = suite.repo.GetByID(suite.ctx, createdChild.ID)
+	suite.Nil(got.ParentID)
+}
+
+func (suite *TodoRepositoryTestSuite) TestUpdate_ProjectAndWorkflow() {
+	todo, _ := entity.NewTodo("搬家", nil, nil, nil)
+	created, _ := suite.repo.Create(suite.ctx, todo)
+	projectID := uint(4)
+
+	created.ProjectID = &projectID
+	created.WorkflowID = 7
+	_, err := suite.repo.Update(suite.ctx, created)
+	suite.NoError(err)
+
+	got, _ := suite.repo.GetByID(suite.ctx, created.ID)
+	suite.Equal(&projectID, got.ProjectID)
+	suite.Equal(uint(7), got.WorkflowID)
+	count, err := suite.repo.Count(suite.ctx, repository.TodoQueryParams{ProjectID: &projectID})
+	suite.NoError(err)
+	suite.Equal(int64(1), count)
+}
+
+func (suite *TodoRepositoryTestSuite) TestPositions_BackfillNeighborsAndSort() {
+	// todos created before manual ordering have no rank key
+	ids := make([]uint, 3)
+	for i, title := range []string{"一", "二", "三"} {
+		todo, _ := entity.NewTodo(title, nil, nil, nil)
+		created, err := suite.repo.Create(suite.ctx, todo)
+		suite.Require().NoError(err)
+		ids[i] = created.ID
+	}
+	last, err := suite.repo.LastPosition(suite.ctx)
+	suite.NoError(err)
+	suite.Equal("", last)
+
+	updated, err := suite.repo.BackfillPositions(suite.ctx)
+	suite.NoError(err)
+	suite.Equal(int64(3), updated)
+	last, err = suite.repo.LastPosition(suite.ctx)
+	suite.NoError(err)
+	suite.Equal("a2", last)
+
+	// a second run has nothing left to do
+	updated, err = suite.repo.BackfillPositions(suite.ctx)
+	suite.NoError(err)
+	suite.Equal(int64(0), updated)
+
+	next, err := suite.repo.NeighborPosition(suite.ctx, "a0", true, 0)
+	suite.NoError(err)
+	suite.Equal("a1", next)
+	next, err = suite.repo.NeighborPosition(suite.ctx, "a0", true, ids[1])
+	suite.NoError(err)
+	suite.Equal("a2", next)
+	prev, err := suite.repo.NeighborPosition(suite.ctx, "a0", false, 0)
+	suite.NoError(err)
+	suite.Equal("", prev)
+
+	// moving the last todo between the first two only rewrites its own key
+	third, _ := suite.repo.GetByID(suite.ctx, ids[2])
+	third.Position, _ = entity.RankBetween("a0", "a1")
+	_, err = suite.repo.Update(suite.ctx, third)
+	suite.NoError(err)
+
+	pagination := &repository.Pagination[entity.Todo]{
+		Limit: 10,
+		Page:  1,
+		Sorts: []repository.SortOption{{Field: string(repository.TodoSortPosition), Direction: repository.SortAsc}},
+	}
+	suite.NoError(suite.repo.List(suite.ctx, repository.TodoQueryParams{}, pagination))
+	actual := make([]uint, len(pagination.Rows))
+	for i, row := range pagination.Rows {
+		actual[i] = row.ID
+	}
+	suite.Equal([]uint{ids[0], ids[2], ids[1]}, actual)
+}
+
+func (suite *TodoRepositoryTestSuite) TestRebalancePositions() {
+	// keys grown long by inserting into the same slot again and again
+	long := strings.Repeat("i", entity.MaxRankLength)
+	positions := []string{"a0", "a0" + long, "a0" + long + "i", "a1"}
+	ids := make([]uint, len(positions))
+	for i, position := range positions {
+		todo, _ := entity.NewTodo(fmt.Sprintf("任務 %d", i), nil, nil, nil)
+		todo.Position = position
+		created, err := suite.repo.Create(suite.ctx, todo)
+		suite.Require().NoError(err)
+		ids[i] = created.ID
+	}
+	// trashed todos keep their place for a restore
+	_, err := suite.repo.Delete(suite.ctx, ids[3])
+	suite.Require().NoError(err)
+	// todos without rank key are left to the backfill
+	unranked, _ := entity.NewTodo("未排序", nil, nil, nil)
+	createdUnranked, err := suite.repo.Create(suite.ctx, unranked)
+	suite.Require().NoError(err)
+
+	updated, err := suite.repo.RebalancePositions(suite.ctx)
+	suite.NoError(err)
+	suite.Equal(int64(4), updated)
+
+	for i, want := range []string{"a0", "a1", "a2", "a3"} {
+		var row model.Todo
+		suite.Require().NoError(suite.db.Unscoped().First(&row, ids[i]).Error)
+		suite.Equal(want, row.Position)
+	}
+	var first model.Todo
+	suite.Require().NoError(suite.db.First(&first, ids[0]).Error)
+	suite.Equal(uint(2), first.Version)
+	got, _ := suite.repo.GetByID(suite.ctx, createdUnranked.ID)
+	suite.Equal("", got.Position)
+}
+
+func (suite *TodoRepositoryTestSuite) TestHardDelete_DetachesSubtasksAndRemovesChecklist() {
+	parent, _ := entity.NewTodo("父任務", nil, nil, nil)
+	createdParent, _ := suite.repo.Create(suite.ctx, parent)
+	child, _ := entity.NewTodo("子任務", nil, nil, nil)
+	suite.Require().NoError(child.SetParent(&createdParent.ID))
+	createdChild, _ := suite.repo.Create(suite.ctx, child)
+	blocked, _ := entity.NewTodo("被擋住的任務", nil, nil, nil)
+	createdBlocked, _ := suite.repo.Create(suite.ctx, blocked)
+	item, _ := entity.NewChecklistItem(createdParent.ID, "第一步")
+	_, err := NewChecklistRepository(zerolog.New(os.Stdout), suite.db).Create(suite.ctx, item)
+	suite.Require().NoError(err)
+	dep, _ := entity.NewDependency(createdBlocked.ID, createdParent.ID)
+	suite.Require().NoError(NewDependencyRepository(zerolog.New(os.Stdout), suite.db).Create(suite.ctx, dep))
+	createdBlocked, _ = suite.repo.GetByID(suite.ctx, createdBlocked.ID)
+
+	suite.repo.Delete(suite.ctx, createdParent.ID)
+	rowsAffected, err := suite.repo.HardDelete(suite.ctx, createdParent.ID)
+	suite.NoError(err)
+	suite.Equal(int64(1), rowsAffected)
+
+	// detached subtasks and todos that lost a blocker change, so do their versions
+	got, _ := suite.repo.GetByID(suite.ctx, createdChild.ID)
+	suite.Nil(got.ParentID)
+	suite.Equal(createdChild.Version+1, got.Version)
+	got, _ = suite.repo.GetByID(suite.ctx, createdBlocked.ID)
+	suite.Equal(createdBlocked.Version+1, got.Version)
+
+	var items int64
+	suite.db.Model(&model.ChecklistItem{}).Count(&items)
+	suite.Equal(int64(0), items)
+}
+
+func (suite *TodoRepositoryTestSuite) TestUpdate_StatusTimesAndPurgedHistory() {
+	todo, _ := entity.NewTodo("測試標題", nil, nil, nil)
+	created, err := suite.repo.Create(suite.ctx, todo)
+	suite.Require().NoError(err)
+
+	startedAt := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	suite.Require().NoError(created.TransitionTo(entity.StatusDoing, entity.StatusMachine{}, startedAt))
+	_, err = suite.repo.Update(suite.ctx, created)
+	suite.NoError(err)
+
+	got, _ := suite.repo.GetByID(suite.ctx, created.ID)
+	suite.Equal(entity.StatusDoing, got.Status)
+	suite.True(startedAt.Equal(*got.StartedAt))
+	suite.Nil(got.CompletedAt)
+
+	historyRepo := NewStatusHistoryRepository(zerolog.New(os.Stdout), suite.db)
+	_, err = historyRepo.Create(suite.ctx, entity.NewStatusChange(created.ID, entity.StatusPending, entity.StatusDoing, "alice", startedAt))
+	suite.Require().NoError(err)
+
+	suite.repo.Delete(suite.ctx, created.ID)
+	_, err = suite.repo.PurgeDeleted(suite.ctx)
+	suite.NoError(err)
+
+	var changes int64
+	suite.db.Model(&model.StatusChange{}).Count(&changes)
+	suite.Equal(int64(0), changes)
+}
+
 func TestTodoRepositoryTestSuite(t *testing.T) {
 	suite.Run(t, new(TodoRepositoryTestSuite))
 }
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func (suite *TodoRepositoryTestSuite) TestRecurrence_NextOccurrenceAndSeriesFilter() {
+	due := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+	first, _ := entity.NewTodo("值班交接", nil, nil, &due)
+	recurrence, err := entity.ParseRecurrence("FREQ=WEEKLY;BYDAY=MO,FR", "Asia/Taipei")
+	suite.Require().NoError(err)
+	suite.Require().NoError(first.SetRecurrence(recurrence))
+	first.Checklist = []entity.ChecklistItem{{Text: "交接清單", Done: true}}
+	createdFirst, err := suite.repo.Create(suite.ctx, first)
+	suite.Require().NoError(err)
+
+	got, _ := suite.repo.GetByID(suite.ctx, createdFirst.ID)
+	suite.Equal("FREQ=WEEKLY;BYDAY=MO,FR", got.Recurrence.String())
+	suite.Equal("Asia/Taipei", got.Recurrence.Timezone)
+	suite.Equal(1, got.Occurrence)
+	suite.Nil(got.SeriesID)
+
+	// The next occurrence is created with a fresh checklist
+	next := got.NextOccurrence(time.Now(), suite.workflow)
+	suite.Require().NotNil(next)
+	createdNext, err := suite.repo.Create(suite.ctx, next)
+	suite.Require().NoError(err)
+	suite.Require().Len(createdNext.Checklist, 1)
+	suite.Equal(createdNext.ID, createdNext.Checklist[0].TodoID)
+	suite.False(createdNext.Checklist[0].Done)
+
+	// The completed occurrence hands its rule over
+	suite.Require().NoError(got.SetRecurrence(nil))
+	got.Status = entity.StatusDone
+	_, err = suite.repo.Update(suite.ctx, got)
+	suite.NoError(err)
+	got, _ = suite.repo.GetByID(suite.ctx, createdFirst.ID)
+	suite.Nil(got.Recurrence)
+	suite.Equal(1, got.Occurrence)
+
+	unrelated, _ := entity.NewTodo("其他", nil, nil, nil)
+	_, err = suite.repo.Create(suite.ctx, unrelated)
+	suite.Require().NoError(err)
+
+	pagination := &repository.Pagination[entity.Todo]{
+		Limit: 10,
+		Page:  1,
+		Sorts: []repository.SortOption{{Field: "id", Direction: repository.SortAsc}},
+	}
+	err = suite.repo.List(suite.ctx, repository.TodoQueryParams{SeriesID: &createdFirst.ID}, pagination)
+	suite.NoError(err)
+	suite.Require().Len(pagination.Rows, 2)
+	suite.Equal(createdFirst.ID, pagination.Rows[0].ID)
+	suite.Equal(createdNext.ID, pagination.Rows[1].ID)
+	suite.Equal(&createdFirst.ID, pagination.Rows[1].SeriesID)
+	suite.Equal(2, pagination.Rows[1].Occurrence)
+	suite.NotNil(pagination.Rows[1].Recurrence)
+}
+
+func (suite *TodoRepositoryTestSuite) TestList_CustomWorkflowCategories() {
+	workflowRepo := NewWorkflowRepository(zerolog.New(os.Stdout), suite.db)
+	workflow, err := entity.NewWorkflow("release", []entity.WorkflowStatus{
+		{Name: "open", Category: entity.CategoryTodo},
+		{Name: "qa", Category: entity.CategoryInProgress},
+		{Name: "shipped", Category: entity.CategoryDone},
+	})
+	suite.Require().NoError(err)
+	workflow, err = workflowRepo.Create(suite.ctx, workflow)
+	suite.Require().NoError(err)
+	defer func() {
+		_, err := workflowRepo.Delete(suite.ctx, workflow.ID)
+		suite.NoError(err)
+	}()
+
+	dueDate := time.Now().UTC().Add(time.Hour)
+	ids := make(map[entity.TodoStatus]uint)
+	for _, status := range []entity.TodoStatus{"open", "qa", "shipped"} {
+		todo, _ := entity.NewTodo(string(status), nil, &status, &dueDate)
+		suite.Require().NoError(todo.SetWorkflow(workflow, time.Now()))
+		created, err := suite.repo.Create(suite.ctx, todo)
+		suite.Require().NoError(err)
+		ids[status] = created.ID
+	}
+	// both are past due, only qa is overdue since shipped is a done status
+	suite.db.Exec("UPDATE todos SET due_date = ? WHERE id IN ?", time.Now().UTC().Add(-time.Hour), []uint{ids["qa"], ids["shipped"]})
+
+	open, err := suite.repo.Count(suite.ctx, repository.TodoQueryParams{
+		WorkflowID: &workflow.ID,
+		Categories: []entity.StatusCategory{entity.CategoryTodo, entity.CategoryInProgress},
+	})
+	suite.NoError(err)
+	suite.Equal(int64(2), open)
+
+	pagination := &repository.Pagination[entity.Todo]{Limit: 10, Page: 1}
+	err = suite.repo.List(suite.ctx, repository.TodoQueryParams{WorkflowID: &workflow.ID, Overdue: boolPtr(true)}, pagination)
+	suite.NoError(err)
+	suite.Require().Len(pagination.Rows, 1)
+	suite.Equal(ids["qa"], pagination.Rows[0].ID)
+	suite.Equal(workflow.ID, pagination.Rows[0].WorkflowID)
+
+	_, err = suite.repo.Delete(suite.ctx, ids["open"])
+	suite.Require().NoError(err)
+	count, err := suite.repo.Count(suite.ctx, repository.TodoQueryParams{WorkflowID: &workflow.ID})
+	suite.NoError(err)
+	suite.Equal(int64(2), count)
+	count, err = suite.repo.Count(suite.ctx, repository.TodoQueryParams{WorkflowID: &workflow.ID, WithTrashed: true})
+	suite.NoError(err)
+	suite.Equal(int64(3), count)
+}
+
+func (suite *TodoRepositoryTestSuite) TestOwnerScope() {
+	aliceCtx := actor.WithUserID(suite.ctx, 1)
+	bobCtx := actor.WithUserID(suite.ctx, 2)
+
+	aliceTodo, _ := entity.NewTodo("alice 的 Todo", nil, nil, nil)
+	aliceTodo.OwnerID = 1
+	createdAlice, _ := suite.repo.Create(aliceCtx, aliceTodo)
+	bobTodo, _ := entity.NewTodo("bob 的 Todo", nil, nil, nil)
+	bobTodo.OwnerID = 2
+	createdBob, _ := suite.repo.Create(bobCtx, bobTodo)
+
+	// another user's todo cannot be read, changed or deleted
+	got, err := suite.repo.GetByID(aliceCtx, createdBob.ID)
+	suite.NoError(err)
+	suite.Nil(got)
+
+	createdBob.Title = "被改掉"
+	rowsAffected, err := suite.repo.Update(aliceCtx, createdBob)
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected)
+
+	rowsAffected, err = suite.repo.Delete(aliceCtx, createdBob.ID)
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected)
+
+	pagination := &repository.Pagination[entity.Todo]{Sorts: []repository.SortOption{{Field: "id", Direction: repository.SortAsc}}}
+	suite.NoError(suite.repo.List(aliceCtx, repository.TodoQueryParams{}, pagination))
+	suite.Require().Len(pagination.Rows, 1)
+	suite.Equal(createdAlice.ID, pagination.Rows[0].ID)
+	suite.Equal(uint(1), pagination.Rows[0].OwnerID)
+
+	// emptying the trash only purges the todos of the caller
+	suite.repo.Delete(aliceCtx, createdAlice.ID)
+	suite.repo.Delete(bobCtx, createdBob.ID)
+	purgedCount, err := suite.repo.PurgeDeleted(aliceCtx)
+	suite.NoError(err)
+	suite.Equal(int64(1), purgedCount)
+
+	got, err = suite.repo.GetByIDUnscoped(bobCtx, createdBob.ID)
+	suite.NoError(err)
+	suite.Equal("bob 的 Todo", got.Title)
+
+	// background work marked as system, such as the trash retention job, sees every todo
+	got, err = suite.repo.GetByIDUnscoped(suite.ctx, createdBob.ID)
+	suite.NoError(err)
+	suite.NotNil(got)
+}
+
+func (suite *TodoRepositoryTestSuite) TestNoTenantSeesNothing() {
+	noTenantCtx := context.Background()
+	todo, _ := entity.NewTodo("有主人的 Todo", nil, nil, nil)
+	todo.OwnerID = 1
+	todo.WorkspaceID = 1
+	todo.Position = "a0"
+	created, _ := suite.repo.Create(suite.ctx, todo)
+	suite.repo.Delete(suite.ctx, created.ID)
+
+	// a ctx carrying neither user, workspace nor the system marker fails closed
+	got, err := suite.repo.GetByIDUnscoped(noTenantCtx, created.ID)
+	suite.NoError(err)
+	suite.Nil(got)
+
+	count, err := suite.repo.Count(noTenantCtx, repository.TodoQueryParams{WithTrashed: true})
+	suite.NoError(err)
+	suite.Equal(int64(0), count)
+
+	position, err := suite.repo.LastPosition(noTenantCtx)
+	suite.NoError(err)
+	suite.Empty(position)
+
+	purgedCount, err := suite.repo.PurgeDeletedBefore(noTenantCtx, time.Now().Add(time.Hour), 10)
+	suite.NoError(err)
+	suite.Equal(int64(0), purgedCount)
+
+	purgedCount, err = suite.repo.PurgeDeleted(noTenantCtx)
+	suite.NoError(err)
+	suite.Equal(int64(0), purgedCount)
+
+	got, err = suite.repo.GetByIDUnscoped(suite.ctx, created.ID)
+	suite.NoError(err)
+	suite.NotNil(got)
+}
+
+func (suite *TodoRepositoryTestSuite) TestWorkspaceScope() {
+	// alice works in both workspaces, so the workspace alone decides what she sees
+	teamACtx := actor.WithWorkspaceID(actor.WithUserID(suite.ctx, 1), 1)
+	teamBCtx := actor.WithWorkspaceID(actor.WithUserID(suite.ctx, 1), 2)
+	bobTeamACtx := actor.WithWorkspaceID(actor.WithUserID(suite.ctx, 2), 1)
+
+	todoA, _ := entity.NewTodo("A 團隊的 Todo", nil, nil, nil)
+	todoA.OwnerID = 1
+	todoA.WorkspaceID = 1
+	createdA, _ := suite.repo.Create(teamACtx, todoA)
+	todoB, _ := entity.NewTodo("B 團隊的 Todo", nil, nil, nil)
+	todoB.OwnerID = 1
+	todoB.WorkspaceID = 2
+	createdB, _ := suite.repo.Create(teamBCtx, todoB)
+
+	// members of a workspace share its todos
+	got, err := suite.repo.GetByID(bobTeamACtx, createdA.ID)
+	suite.NoError(err)
+	suite.Require().NotNil(got)
+	suite.Equal(uint(1), got.WorkspaceID)
+
+	// a todo of another workspace cannot be read, changed or deleted
+	got, err = suite.repo.GetByID(teamACtx, createdB.ID)
+	suite.NoError(err)
+	suite.Nil(got)
+
+	got, err = suite.repo.GetByIDUnscoped(teamACtx, createdB.ID)
+	suite.NoError(err)
+	suite.Nil(got)
+
+	createdB.Title = "被改掉"
+	rowsAffected, err := suite.repo.Update(teamACtx, createdB)
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected)
+
+	rowsAffected, err = suite.repo.Delete(teamACtx, createdB.ID)
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected)
+
+	got, err = suite.repo.GetByID(teamBCtx, createdB.ID)
+	suite.NoError(err)
+	suite.Equal("B 團隊的 Todo", got.Title)
+
+	// lists and counts only see the workspace, filters cannot widen them
+	pagination := &repository.Pagination[entity.Todo]{Sorts: []repository.SortOption{{Field: "id", Direction: repository.SortAsc}}}
+	suite.NoError(suite.repo.List(teamACtx, repository.TodoQueryParams{WithTrashed: true}, pagination))
+	suite.Require().Len(pagination.Rows, 1)
+	suite.Equal(createdA.ID, pagination.Rows[0].ID)
+
+	count, err := suite.repo.Count(teamACtx, repository.TodoQueryParams{})
+	suite.NoError(err)
+	suite.Equal(int64(1), count)
+
+	count, err = suite.repo.Count(suite.ctx, repository.TodoQueryParams{})
+	suite.NoError(err)
+	suite.Equal(int64(2), count)
+
+	// rank keys are only compared within the workspace
+	suite.Require().NoError(suite.db.Model(&model.Todo{}).Where("id = ?", createdA.ID).UpdateColumn("position", "a5").Error)
+	suite.Require().NoError(suite.db.Model(&model.Todo{}).Where("id = ?", createdB.ID).UpdateColumn("position", "a9").Error)
+	position, err := suite.repo.LastPosition(teamACtx)
+	suite.NoError(err)
+	suite.Equal("a5", position)
+	position, err = suite.repo.NeighborPosition(teamACtx, "a5", true, 0)
+	suite.NoError(err)
+	suite.Empty(position)
+
+	// emptying the trash only purges the todos of the workspace
+	suite.repo.Delete(teamACtx, createdA.ID)
+	suite.repo.Delete(teamBCtx, createdB.ID)
+	purgedCount, err := suite.repo.PurgeDeleted(teamACtx)
+	suite.NoError(err)
+	suite.Equal(int64(1), purgedCount)
+
+	got, err = suite.repo.GetByIDUnscoped(teamBCtx, createdB.ID)
+	suite.NoError(err)
+	suite.NotNil(got)
+}
+
+func (suite *TodoRepositoryTestSuite) TestAssignWorkspace() {
+	legacy, _ := entity.NewTodo("工作區之前的 Todo", nil, nil, nil)
+	legacy.OwnerID = 1
+	createdLegacy, _ := suite.repo.Create(suite.ctx, legacy)
+	placed, _ := entity.NewTodo("已在工作區的 Todo", nil, nil, nil)
+	placed.OwnerID = 1
+	placed.WorkspaceID = 2
+	createdPlaced, _ := suite.repo.Create(suite.ctx, placed)
+	other, _ := entity.NewTodo("別人的 Todo", nil, nil, nil)
+	other.OwnerID = 2
+	createdOther, _ := suite.repo.Create(suite.ctx, other)
+
+	rowsAffected, err := suite.repo.AssignWorkspace(suite.ctx, 1, 3)
+
+	suite.NoError(err)
+	suite.Equal(int64(1), rowsAffected)
+	got, _ := suite.repo.GetByID(suite.ctx, createdLegacy.ID)
+	suite.Equal(uint(3), got.WorkspaceID)
+	got, _ = suite.repo.GetByID(suite.ctx, createdPlaced.ID)
+	suite.Equal(uint(2), got.WorkspaceID)
+	got, _ = suite.repo.GetByID(suite.ctx, createdOther.ID)
+	suite.Equal(uint(0), got.WorkspaceID)
+}
+
+// workspaceLookup reads a workspace scoped resource and reports whether it found any row
+type workspaceLookup struct {
+	name   string
+	lookup func(ctx context.Context) (bool, error)
+}
+
+// assertHiddenWithoutWorkspace runs every lookup for a request without workspace,
+// inWorkspace must hide every row from it
+func assertHiddenWithoutWorkspace(s *suite.Suite, lookups []workspaceLookup) {
+	for _, l := range lookups {
+		s.Run(l.name, func() {
+			found, err := l.lookup(context.Background())
+			s.NoError(err)
+			s.False(found)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+)
+
+var _ repository.UserRepository = &UserRepositoryImpl{}
+
+// UserRepositoryImpl implements the UserRepository interface using GORM
+type UserRepositoryImpl struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+// NewUserRepository creates a new UserRepository instance
+func NewUserRepository(logger zerolog.Logger, db *gorm.DB) repository.UserRepository {
+	return &UserRepositoryImpl{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new user and returns the created user with assigned ID
+func (r *UserRepositoryImpl) Create(ctx context.Context, user *entity.User) (*entity.User, error) {
+	if user == nil {
+		return nil, errors.New("user cannot be nil")
+	}
+
+	userModel := model.UserEntityToModel(user)
+	if err := r.db.WithContext(ctx).Create(userModel).Error; err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+
+	return model.UserModelToEntity(userModel), nil
+}
+
+// GetByID retrieves a user by its ID
+// Returns nil if user is not found
+func (r *UserRepositoryImpl) GetByID(ctx context.Context, id uint) (*entity.User, error) {
+	var userModel model.User
+
+	err := r.db.WithContext(ctx).First(&userModel, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil for not found, not an error
+		}
+		return nil, fmt.Errorf("failed to get user by id %d: %w", id, err)
+	}
+
+	return model.UserModelToEntity(&userModel), nil
+}
+
+// GetByEmail retrieves a user by its normalized email
+// Returns nil if user is not found
+func (r *UserRepositoryImpl) GetByEmail(ctx context.Context, email string) (*entity.User, error) {
+	var userModel model.User
+
+	err := r.db.WithContext(ctx).Where("email = ?", email).First(&userModel).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil for not found, not an error
+		}
+		return nil, fmt.Errorf("failed to get user by email: %w", err)
+	}
+
+	return model.UserModelToEntity(&userModel), nil
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/config"
+	"itmrchow/go-todolist-service/internal/infrastructure/database"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+)
+
+type UserRepositoryTestSuite struct {
+	suite.Suite
+	db   *gorm.DB
+	repo repository.UserRepository
+	ctx  context.Context
+}
+
+// SetupSuite 在整個測試 suite 開始前執行一次
+func (suite *UserRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	sqlLiteDB := &database.SQLiteDBImpl{}
+	db, err := sqlLiteDB.Connect(ctx, &config.DatabaseConfig{})
+	suite.Require().NoError(err)
+
+	err = sqlLiteDB.Migrate(&model.User{})
+	suite.Require().NoError(err)
+
+	suite.db = db
+	suite.ctx = ctx
+
+	suite.repo = NewUserRepository(zerolog.New(os.Stdout), suite.db)
+}
+
+// TearDownSuite 在整個測試 suite 結束後執行一次
+func (suite *UserRepositoryTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		sqlDB, err := suite.db.DB()
+		if err == nil {
+			sqlDB.Close()
+		}
+	}
+}
+
+// TearDownTest 每個測試後清理資料
+func (suite *UserRepositoryTestSuite) TearDownTest() {
+	if suite.db != nil {
+		suite.db.Exec("DELETE FROM users")
+		suite.db.Exec("DELETE FROM sqlite_sequence WHERE name = 'users'")
+	}
+}
+
+func (suite *UserRepositoryTestSuite) TestCreateAndGet() {
+	user, err := entity.NewUser("alice@example.com", "correct horse")
+	suite.Require().NoError(err)
+
+	created, err := suite.repo.Create(suite.ctx, user)
+	suite.Require().NoError(err)
+	suite.Equal(uint(1), created.ID)
+
+	got, err := suite.repo.GetByID(suite.ctx, created.ID)
+	suite.NoError(err)
+	suite.Equal("alice@example.com", got.Email)
+	suite.True(got.CheckPassword("correct horse"))
+
+	got, err = suite.repo.GetByEmail(suite.ctx, "alice@example.com")
+	suite.NoError(err)
+	suite.Equal(created.ID, got.ID)
+
+	got, err = suite.repo.GetByEmail(suite.ctx, "bob@example.com")
+	suite.NoError(err)
+	suite.Nil(got)
+
+	got, err = suite.repo.GetByID(suite.ctx, 99)
+	suite.NoError(err)
+	suite.Nil(got)
+}
+
+func (suite *UserRepositoryTestSuite) TestCreate_DuplicateEmail() {
+	first, _ := entity.NewUser("alice@example.com", "correct horse")
+	_, err := suite.repo.Create(suite.ctx, first)
+	suite.Require().NoError(err)
+
+	second, _ := entity.NewUser("alice@example.com", "battery staple")
+	_, err = suite.repo.Create(suite.ctx, second)
+	suite.Error(err)
+
+	_, err = suite.repo.Create(suite.ctx, nil)
+	suite.EqualError(err, "user cannot be nil")
+}
+
+func TestUserRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(UserRepositoryTestSuite))
+}
@@ -0,0 +1,245 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+var _ repository.WorkflowRepository = &WorkflowRepositoryImpl{}
+
+// WorkflowRepositoryImpl implements the WorkflowRepository interface using GORM
+type WorkflowRepositoryImpl struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+// NewWorkflowRepository creates a new WorkflowRepository instance
+func NewWorkflowRepository(logger zerolog.Logger, db *gorm.DB) repository.WorkflowRepository {
+	return &WorkflowRepositoryImpl{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new workflow with its statuses and returns it with assigned IDs
+func (r *WorkflowRepositoryImpl) Create(ctx context.Context, workflow *entity.Workflow) (*entity.Workflow, error) {
+	if workflow == nil {
+		return nil, errors.New("workflow cannot be nil")
+	}
+
+	workflowModel := model.WorkflowEntityToModel(workflow)
+	if err := r.db.WithContext(ctx).Create(workflowModel).Error; err != nil {
+		return nil, fmt.Errorf("failed to create workflow: %w", err)
+	}
+
+	return model.WorkflowModelToEntity(workflowModel), nil
+}
+
+// GetByID retrieves a workflow with its statuses in order
+// Returns nil if workflow is not found
+func (r *WorkflowRepositoryImpl) GetByID(ctx context.Context, id uint) (*entity.Workflow, error) {
+	return r.first(ctx, "id = ?", id)
+}
+
+// GetByName retrieves a workflow by its exact name
+// Returns nil if workflow is not found
+func (r *WorkflowRepositoryImpl) GetByName(ctx context.Context, name string) (*entity.Workflow, error) {
+	return r.first(ctx, "name = ?", name)
+}
+
+// GetDefault retrieves the default workflow of the workspace
+// Returns nil if no default workflow exists yet
+func (r *WorkflowRepositoryImpl) GetDefault(ctx context.Context) (*entity.Workflow, error) {
+	return r.first(ctx, "is_default = ?", true)
+}
+
+// List retrieves all workflows with their statuses, ordered by name
+func (r *WorkflowRepositoryImpl) List(ctx context.Context) ([]*entity.Workflow, error) {
+	var workflowModels []*model.Workflow
+	if err := r.db.WithContext(ctx).Scopes(inWorkspace(ctx, "workflows")).Preload("Statuses", orderStatusesByPosition).Order("name ASC").Find(&workflowModels).Error; err != nil {
+		return nil, fmt.Errorf("failed to list workflows: %w", err)
+	}
+
+	return model.WorkflowModelsToEntities(workflowModels), nil
+}
+
+// Update saves the name and statuses of a workflow and returns the number of affected rows,
+// statuses without ID are added, missing ones removed and renamed ones are renamed
+// on the todos of the workflow, trashed todos included
+func (r *WorkflowRepositoryImpl) Update(ctx context.Context, workflow *entity.Workflow) (int64, error) {
+	if workflow == nil {
+		return 0, errors.New("workflow cannot be nil")
+	}
+
+	if workflow.ID == 0 {
+		return 0, errors.New("workflow ID cannot be 0")
+	}
+
+	workflowModel := model.WorkflowEntityToModel(workflow)
+	var rowsAffected int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Workflow{}).
+			Scopes(inWorkspace(ctx, "workflows")).
+			Where("id = ?", workflow.ID).
+			Select("name", "updated_at").
+			Updates(workflowModel)
+		if result.Error != nil {
+			return result.Error
+		}
+		rowsAffected = result.RowsAffected
+		if rowsAffected == 0 {
+			return nil
+		}
+
+		var existing []model.WorkflowStatus
+		if err := tx.Where("workflow_id = ?", workflow.ID).Find(&existing).Error; err != nil {
+			return err
+		}
+		oldNames := make(map[uint]string, len(existing))
+		for _, status := range existing {
+			oldNames[status.ID] = status.Name
+		}
+
+		// Remove the dropped statuses first so their names are free for the kept ones
+		keptIDs := []uint{0}
+		for _, status := range workflowModel.Statuses {
+			if _, ok := oldNames[status.ID]; ok {
+				keptIDs = append(keptIDs, status.ID)
+			}
+		}
+		if err := tx.Where("workflow_id = ? AND id NOT IN ?", workflow.ID, keptIDs).Delete(&model.WorkflowStatus{}).Error; err != nil {
+			return err
+		}
+
+		// Kept statuses are saved before new ones so a new status may take a name given up by a rename
+		for _, status := range workflowModel.Statuses {
+			oldName, ok := oldNames[status.ID]
+			if !ok {
+				continue
+			}
+
+			if err := tx.Model(&model.WorkflowStatus{}).
+				Where("id = ?", status.ID).
+				Select("name", "category", "position").
+				Updates(&status).Error; err != nil {
+				return err
+			}
+			if oldName != status.Name {
+				if err := tx.Unscoped().Model(&model.Todo{}).
+					Scopes(inTenant(ctx)).
+					Where("workflow_id = ? AND status = ?", workflow.ID, oldName).
+					UpdateColumns(map[string]interface{}{
+						"status":  status.Name,
+						"version": gorm.Expr("version + 1"),
+					}).Error; err != nil {
+					return err
+				}
+			}
+		}
+		for _, status := range workflowModel.Statuses {
+			if _, ok := oldNames[status.ID]; ok {
+				continue
+			}
+			status.ID = 0
+			if err := tx.Create(&status).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to update workflow: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// Delete permanently removes a workflow with its statuses and returns the number of affected rows
+func (r *WorkflowRepositoryImpl) Delete(ctx context.Context, id uint) (int64, error) {
+	var rowsAffected int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Scopes(inWorkspace(ctx, "workflows")).Delete(&model.Workflow{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		rowsAffected = result.RowsAffected
+		if rowsAffected == 0 {
+			return nil
+		}
+
+		return tx.Where("workflow_id = ?", id).Delete(&model.WorkflowStatus{}).Error
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete workflow: %w", err)
+	}
+
+	return rowsAffected, nil
+}
+
+// EnsureDefault creates the given workflow as the default of the workspace carried by ctx when it has
+// none yet and moves the todos of the workspace without workflow into it, returns the default workflow
+func (r *WorkflowRepositoryImpl) EnsureDefault(ctx context.Context, workflow *entity.Workflow) (*entity.Workflow, error) {
+	if workflow == nil {
+		return nil, errors.New("workflow cannot be nil")
+	}
+
+	workspaceID := actor.WorkspaceID(ctx)
+	var defaultModel model.Workflow
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("workspace_id = ? AND is_default = ?", workspaceID, true).First(&defaultModel).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			defaultModel = *model.WorkflowEntityToModel(workflow)
+			defaultModel.WorkspaceID = workspaceID
+			defaultModel.IsDefault = true
+			err = tx.Create(&defaultModel).Error
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Model(&model.Todo{}).
+			Where("workspace_id = ? AND workflow_id = ?", workspaceID, 0).
+			UpdateColumns(map[string]interface{}{
+				"workflow_id": defaultModel.ID,
+				"version":     gorm.Expr("version + 1"),
+			}).Error; err != nil {
+			return err
+		}
+
+		return tx.Preload("Statuses", orderStatusesByPosition).First(&defaultModel, defaultModel.ID).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to ensure default workflow: %w", err)
+	}
+
+	return model.WorkflowModelToEntity(&defaultModel), nil
+}
+
+// first retrieves the first workflow matching the condition with its statuses
+func (r *WorkflowRepositoryImpl) first(ctx context.Context, query string, args ...interface{}) (*entity.Workflow, error) {
+	var workflowModel model.Workflow
+
+	err := r.db.WithContext(ctx).Scopes(inWorkspace(ctx, "workflows")).Preload("Statuses", orderStatusesByPosition).Where(query, args...).First(&workflowModel).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil for not found, not an error
+		}
+		return nil, fmt.Errorf("failed to get workflow: %w", err)
+	}
+
+	return model.WorkflowModelToEntity(&workflowModel), nil
+}
+
+func orderStatusesByPosition(db *gorm.DB) *gorm.DB {
+	return db.Order("position ASC")
+}
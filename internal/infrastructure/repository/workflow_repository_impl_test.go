@@ -0,0 +1,291 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/config"
+	"itmrchow/go-todolist-service/internal/infrastructure/database"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+type WorkflowRepositoryTestSuite struct {
+	suite.Suite
+	db       *gorm.DB
+	repo     repository.WorkflowRepository
+	todoRepo repository.TodoRepository
+	ctx      context.Context
+}
+
+// SetupSuite 在整個測試 suite 開始前執行一次
+func (suite *WorkflowRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	sqlLiteDB := &database.SQLiteDBImpl{}
+	db, err := sqlLiteDB.Connect(ctx, &config.DatabaseConfig{})
+	suite.Require().NoError(err)
+
+	err = sqlLiteDB.Migrate(&model.Todo{}, &model.Tag{}, &model.TodoTag{}, &model.ChecklistItem{}, &model.TodoDependency{}, &model.TodoAssignee{}, &model.Comment{},
+		&model.Workflow{}, &model.WorkflowStatus{})
+	suite.Require().NoError(err)
+
+	suite.db = db
+	suite.ctx = actor.WithSystem(ctx)
+
+	suite.repo = NewWorkflowRepository(zerolog.New(os.Stdout), suite.db)
+	suite.todoRepo = NewTodoRepository(zerolog.New(os.Stdout), suite.db)
+}
+
+// TearDownSuite 在整個測試 suite 結束後執行一次
+func (suite *WorkflowRepositoryTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		sqlDB, err := suite.db.DB()
+		if err == nil {
+			sqlDB.Close()
+		}
+	}
+}
+
+// TearDownTest 每個測試後清理資料
+func (suite *WorkflowRepositoryTestSuite) TearDownTest() {
+	if suite.db != nil {
+		suite.db.Exec("DELETE FROM todos")
+		suite.db.Exec("DELETE FROM workflows")
+		suite.db.Exec("DELETE FROM workflow_statuses")
+		suite.db.Exec("DELETE FROM sqlite_sequence WHERE name IN ('todos', 'workflows', 'workflow_statuses')")
+	}
+}
+
+func (suite *WorkflowRepositoryTestSuite) create(name string, statuses ...string) *entity.Workflow {
+	workflowStatuses := []entity.WorkflowStatus{{Name: "open", Category: entity.CategoryTodo}}
+	for _, status := range statuses {
+		workflowStatuses = append(workflowStatuses, entity.WorkflowStatus{Name: entity.TodoStatus(status), Category: entity.CategoryInProgress})
+	}
+	workflowStatuses = append(workflowStatuses, entity.WorkflowStatus{Name: "closed", Category: entity.CategoryDone})
+
+	workflow, err := entity.NewWorkflow(name, workflowStatuses)
+	suite.Require().NoError(err)
+	created, err := suite.repo.Create(suite.ctx, workflow)
+	suite.Require().NoError(err)
+	return created
+}
+
+func (suite *WorkflowRepositoryTestSuite) createTodo(workflow *entity.Workflow, status entity.TodoStatus) *entity.Todo {
+	todo, err := entity.NewTodo("任務", nil, &status, nil)
+	suite.Require().NoError(err)
+	suite.Require().NoError(todo.SetWorkflow(workflow, time.Now()))
+	created, err := suite.todoRepo.Create(suite.ctx, todo)
+	suite.Require().NoError(err)
+	return created
+}
+
+func TestWorkflowRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(WorkflowRepositoryTestSuite))
+}
+
+func (suite *WorkflowRepositoryTestSuite) TestCreate_AndGet() {
+	created := suite.create("review", "in-review")
+	suite.NotZero(created.ID)
+	suite.Len(created.Statuses, 3)
+	for _, status := range created.Statuses {
+		suite.NotZero(status.ID)
+		suite.Equal(created.ID, status.WorkflowID)
+	}
+
+	got, err := suite.repo.GetByID(suite.ctx, created.ID)
+	suite.NoError(err)
+	suite.Equal("review", got.Name)
+	suite.Equal([]entity.TodoStatus{"open", "in-review", "closed"}, statusNames(got))
+
+	byName, err := suite.repo.GetByName(suite.ctx, "review")
+	suite.NoError(err)
+	suite.Equal(created.ID, byName.ID)
+
+	missing, err := suite.repo.GetByID(suite.ctx, 999)
+	suite.NoError(err)
+	suite.Nil(missing)
+}
+
+func (suite *WorkflowRepositoryTestSuite) TestList_OrderedByName() {
+	suite.create("support")
+	suite.create("engineering", "coding", "review")
+
+	workflows, err := suite.repo.List(suite.ctx)
+	suite.NoError(err)
+	suite.Require().Len(workflows, 2)
+	suite.Equal("engineering", workflows[0].Name)
+	suite.Equal([]entity.TodoStatus{"open", "coding", "review", "closed"}, statusNames(workflows[0]))
+	suite.Equal("support", workflows[1].Name)
+}
+
+func (suite *WorkflowRepositoryTestSuite) TestUpdate_AddRemoveRenameAndReorder() {
+	workflow := suite.create("review", "coding", "in-review")
+	todo := suite.createTodo(workflow, "in-review")
+	trashed := suite.createTodo(workflow, "in-review")
+	_, err := suite.todoRepo.Delete(suite.ctx, trashed.ID)
+	suite.Require().NoError(err)
+
+	// drop coding, rename in-review to review, add qa and take the dropped name for a new status
+	open, inReview, closed := workflow.Statuses[0], workflow.Statuses[2], workflow.Statuses[3]
+	inReview.Name = "review"
+	suite.Require().NoError(workflow.Update("code review", []entity.WorkflowStatus{
+		open,
+		{Name: "coding", Category: entity.CategoryInProgress},
+		inReview,
+		{Name: "qa", Category: entity.CategoryInProgress},
+		closed,
+	}))
+
+	rowsAffected, err := suite.repo.Update(suite.ctx, workflow)
+	suite.NoError(err)
+	suite.Equal(int64(1), rowsAffected)
+
+	got, err := suite.repo.GetByID(suite.ctx, workflow.ID)
+	suite.NoError(err)
+	suite.Equal("code review", got.Name)
+	suite.Equal([]entity.TodoStatus{"open", "coding", "review", "qa", "closed"}, statusNames(got))
+	suite.Equal(inReview.ID, got.Statuses[2].ID)
+	suite.Equal(2, got.Statuses[2].Position)
+
+	// todos follow the rename, trashed ones included
+	var statuses []string
+	suite.db.Unscoped().Model(&model.Todo{}).Where("id IN ?", []uint{todo.ID, trashed.ID}).Pluck("status", &statuses)
+	suite.Equal([]string{"review", "review"}, statuses)
+	// and their versions change with the status
+	var versions []uint
+	suite.db.Unscoped().Model(&model.Todo{}).Where("id IN ?", []uint{todo.ID, trashed.ID}).Order("id").Pluck("version", &versions)
+	suite.Equal([]uint{todo.Version + 1, trashed.Version + 2}, versions)
+}
+
+func (suite *WorkflowRepositoryTestSuite) TestUpdate_NotFound() {
+	workflow, err := entity.NewWorkflow("ghost", entity.DefaultWorkflow().Statuses)
+	suite.Require().NoError(err)
+	workflow.ID = 999
+
+	rowsAffected, err := suite.repo.Update(suite.ctx, workflow)
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected)
+}
+
+func (suite *WorkflowRepositoryTestSuite) TestDelete_RemovesStatuses() {
+	workflow := suite.create("review", "in-review")
+
+	rowsAffected, err := suite.repo.Delete(suite.ctx, workflow.ID)
+	suite.NoError(err)
+	suite.Equal(int64(1), rowsAffected)
+
+	var count int64
+	suite.db.Model(&model.WorkflowStatus{}).Where("workflow_id = ?", workflow.ID).Count(&count)
+	suite.Equal(int64(0), count)
+
+	rowsAffected, err = suite.repo.Delete(suite.ctx, workflow.ID)
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected)
+}
+
+func (suite *WorkflowRepositoryTestSuite) TestEnsureDefault_CreatesOnceAndAdoptsTodos() {
+	legacy, err := entity.NewTodo("舊任務", nil, nil, nil)
+	suite.Require().NoError(err)
+	legacy, err = suite.todoRepo.Create(suite.ctx, legacy)
+	suite.Require().NoError(err)
+
+	first, err := suite.repo.EnsureDefault(suite.ctx, entity.DefaultWorkflow())
+	suite.NoError(err)
+	suite.True(first.IsDefault)
+	suite.Equal([]entity.TodoStatus{entity.StatusPending, entity.StatusDoing, entity.StatusDone}, statusNames(first))
+
+	second, err := suite.repo.EnsureDefault(suite.ctx, entity.DefaultWorkflow())
+	suite.NoError(err)
+	suite.Equal(first.ID, second.ID)
+
+	got, err := suite.repo.GetDefault(suite.ctx)
+	suite.NoError(err)
+	suite.Equal(first.ID, got.ID)
+
+	adopted, err := suite.todoRepo.GetByID(suite.ctx, legacy.ID)
+	suite.NoError(err)
+	suite.Equal(first.ID, adopted.WorkflowID)
+	suite.Equal(legacy.Version+1, adopted.Version)
+}
+
+func (suite *WorkflowRepositoryTestSuite) TestWorkspaceScope() {
+	teamACtx := actor.WithWorkspaceID(suite.ctx, 1)
+	teamBCtx := actor.WithWorkspaceID(suite.ctx, 2)
+
+	legacy, err := entity.NewTodo("B 團隊的舊任務", nil, nil, nil)
+	suite.Require().NoError(err)
+	legacy.WorkspaceID = 2
+	legacy, err = suite.todoRepo.Create(suite.ctx, legacy)
+	suite.Require().NoError(err)
+
+	// every workspace gets its own default, only its own todos are adopted
+	defaultA, err := suite.repo.EnsureDefault(teamACtx, entity.DefaultWorkflow())
+	suite.Require().NoError(err)
+	suite.Equal(uint(1), defaultA.WorkspaceID)
+	adopted, _ := suite.todoRepo.GetByID(suite.ctx, legacy.ID)
+	suite.Equal(uint(0), adopted.WorkflowID)
+
+	defaultB, err := suite.repo.EnsureDefault(teamBCtx, entity.DefaultWorkflow())
+	suite.Require().NoError(err)
+	suite.NotEqual(defaultA.ID, defaultB.ID)
+	suite.Equal(uint(2), defaultB.WorkspaceID)
+	adopted, _ = suite.todoRepo.GetByID(suite.ctx, legacy.ID)
+	suite.Equal(defaultB.ID, adopted.WorkflowID)
+
+	got, err := suite.repo.GetDefault(teamACtx)
+	suite.NoError(err)
+	suite.Equal(defaultA.ID, got.ID)
+
+	// a workflow of another workspace cannot be read, changed or deleted
+	got, err = suite.repo.GetByID(teamACtx, defaultB.ID)
+	suite.NoError(err)
+	suite.Nil(got)
+
+	workflows, err := suite.repo.List(teamACtx)
+	suite.NoError(err)
+	suite.Require().Len(workflows, 1)
+	suite.Equal(defaultA.ID, workflows[0].ID)
+
+	rowsAffected, err := suite.repo.Update(teamACtx, defaultB)
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected)
+
+	rowsAffected, err = suite.repo.Delete(teamACtx, defaultB.ID)
+	suite.NoError(err)
+	suite.Equal(int64(0), rowsAffected)
+	got, _ = suite.repo.GetByID(teamBCtx, defaultB.ID)
+	suite.Require().NotNil(got)
+	suite.Len(got.Statuses, 3)
+
+	// without workspace nothing is visible
+	assertHiddenWithoutWorkspace(&suite.Suite, []workspaceLookup{
+		{"list", func(ctx context.Context) (bool, error) {
+			workflows, err := suite.repo.List(ctx)
+			return len(workflows) > 0, err
+		}},
+		{"get_by_id", func(ctx context.Context) (bool, error) {
+			workflow, err := suite.repo.GetByID(ctx, defaultA.ID)
+			return workflow != nil, err
+		}},
+		{"get_default", func(ctx context.Context) (bool, error) {
+			workflow, err := suite.repo.GetDefault(ctx)
+			return workflow != nil, err
+		}},
+	})
+}
+
+func statusNames(workflow *entity.Workflow) []entity.TodoStatus {
+	names := make([]entity.TodoStatus, len(workflow.Statuses))
+	for i, status := range workflow.Statuses {
+		names[i] = status.Name
+	}
+	return names
+}
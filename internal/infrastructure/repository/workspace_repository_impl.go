@@ -0,0 +1,425 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+)
+
+var _ repository.WorkspaceRepository = &WorkspaceRepositoryImpl{}
+
+// WorkspaceRepositoryImpl implements the WorkspaceRepository interface using GORM
+type WorkspaceRepositoryImpl struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+// NewWorkspaceRepository creates a new WorkspaceRepository instance
+func NewWorkspaceRepository(logger zerolog.Logger, db *gorm.DB) repository.WorkspaceRepository {
+	return &WorkspaceRepositoryImpl{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new workspace with the creator as its first member and owner
+func (r *WorkspaceRepositoryImpl) Create(ctx context.Context, workspace *entity.Workspace, creatorID uint) (*entity.Workspace, error) {
+	if workspace == nil {
+		return nil, errors.New("workspace cannot be nil")
+	}
+
+	workspaceModel := model.WorkspaceEntityToModel(workspace)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(workspaceModel).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&model.WorkspaceMember{
+			WorkspaceID: workspaceModel.ID,
+			UserID:      creatorID,
+			Role:        string(entity.RoleOwner),
+			CreatedAt:   workspaceModel.CreatedAt,
+		}).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create workspace: %w", err)
+	}
+
+	return model.WorkspaceModelToEntity(workspaceModel), nil
+}
+
+// CreatePersonal creates the personal workspace of a user like Create unless the user already has one,
+// returns the personal workspace of the user and false when it already existed
+func (r *WorkspaceRepositoryImpl) CreatePersonal(ctx context.Context, workspace *entity.Workspace, ownerID uint) (*entity.Workspace, bool, error) {
+	if workspace == nil {
+		return nil, false, errors.New("workspace cannot be nil")
+	}
+
+	workspaceModel := model.WorkspaceEntityToModel(workspace)
+	workspaceModel.PersonalOwnerID = &ownerID
+	created := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The unique index on personal_owner_id decides which of two concurrent requests creates it
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(workspaceModel)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return tx.Where("personal_owner_id = ?", ownerID).First(workspaceModel).Error
+		}
+
+		created = true
+		return tx.Create(&model.WorkspaceMember{
+			WorkspaceID: workspaceModel.ID,
+			UserID:      ownerID,
+			Role:        string(entity.RoleOwner),
+			CreatedAt:   workspaceModel.CreatedAt,
+		}).Error
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create personal workspace: %w", err)
+	}
+
+	return model.WorkspaceModelToEntity(workspaceModel), created, nil
+}
+
+// GetByID retrieves a workspace by its ID
+// Returns nil if workspace is not found
+func (r *WorkspaceRepositoryImpl) GetByID(ctx context.Context, id uint) (*entity.Workspace, error) {
+	var workspaceModel model.Workspace
+
+	err := r.db.WithContext(ctx).First(&workspaceModel, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil for not found, not an error
+		}
+		return nil, fmt.Errorf("failed to get workspace by id %d: %w", id, err)
+	}
+
+	return model.WorkspaceModelToEntity(&workspaceModel), nil
+}
+
+// ListByMember retrieves the workspaces the user is a member of, oldest first
+func (r *WorkspaceRepositoryImpl) ListByMember(ctx context.Context, userID uint) ([]*entity.Workspace, error) {
+	var workspaceModels []model.Workspace
+
+	err := r.db.WithContext(ctx).
+		Where("EXISTS (SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = workspaces.id AND wm.user_id = ?)", userID).
+		Order("id ASC").
+		Find(&workspaceModels).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspaces of user %d: %w", userID, err)
+	}
+
+	workspaces := make([]*entity.Workspace, len(workspaceModels))
+	for i := range workspaceModels {
+		workspaces[i] = model.WorkspaceModelToEntity(&workspaceModels[i])
+	}
+
+	return workspaces, nil
+}
+
+// GetMember retrieves the membership of the user in the workspace
+// Returns nil if the user is not a member
+func (r *WorkspaceRepositoryImpl) GetMember(ctx context.Context, workspaceID uint, userID uint) (*entity.WorkspaceMember, error) {
+	var memberModel model.WorkspaceMember
+
+	err := r.db.WithContext(ctx).
+		Where("workspace_id = ? AND user_id = ?", workspaceID, userID).
+		First(&memberModel).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil // Return nil for not found, not an error
+		}
+		return nil, fmt.Errorf("failed to get member of workspace %d: %w", workspaceID, err)
+	}
+
+	return model.WorkspaceMemberModelToEntity(&memberModel), nil
+}
+
+// ListMembers retrieves the members of a workspace with their email, oldest first
+func (r *WorkspaceRepositoryImpl) ListMembers(ctx context.Context, workspaceID uint) ([]*entity.WorkspaceMember, error) {
+	var memberModels []model.WorkspaceMember
+
+	err := r.db.WithContext(ctx).
+		Preload("User").
+		Where("workspace_id = ?", workspaceID).
+		Order("created_at ASC, user_id ASC").
+		Find(&memberModels).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list members of workspace %d: %w", workspaceID, err)
+	}
+
+	members := make([]*entity.WorkspaceMember, len(memberModels))
+	for i := range memberModels {
+		members[i] = model.WorkspaceMemberModelToEntity(&memberModels[i])
+	}
+
+	return members, nil
+}
+
+// AddMember adds a user to a workspace with the role of the member
+func (r *WorkspaceRepositoryImpl) AddMember(ctx context.Context, member *entity.WorkspaceMember) error {
+	if member == nil {
+		return errors.New("workspace member cannot be nil")
+	}
+
+	if err := r.db.WithContext(ctx).Create(model.WorkspaceMemberEntityToModel(member)).Error; err != nil {
+		return fmt.Errorf("failed to add workspace member: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateMemberRole changes the role of a member and returns the number of memberships changed
+func (r *WorkspaceRepositoryImpl) UpdateMemberRole(ctx context.Context, workspaceID uint, userID uint, role entity.WorkspaceRole) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Model(&model.WorkspaceMember{}).
+		Where("workspace_id = ? AND user_id = ?", workspaceID, userID).
+		Update("role", string(role))
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to update role of member %d in workspace %d: %w", userID, workspaceID, result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+// RemoveMember removes a user from a workspace and returns the number of memberships removed
+func (r *WorkspaceRepositoryImpl) RemoveMember(ctx context.Context, workspaceID uint, userID uint) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("workspace_id = ? AND user_id = ?", workspaceID, userID).
+		Delete(&model.WorkspaceMember{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to remove member %d from workspace %d: %w", userID, workspaceID, result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+// CountOwners counts the members of a workspace with the owner role
+func (r *WorkspaceRepositoryImpl) CountOwners(ctx context.Context, workspaceID uint) (int64, error) {
+	var count int64
+
+	err := r.db.WithContext(ctx).
+		Model(&model.WorkspaceMember{}).
+		Where("workspace_id = ? AND role = ?", workspaceID, string(entity.RoleOwner)).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to count owners of workspace %d: %w", workspaceID, err)
+	}
+
+	return count, nil
+}
+
+// EnsureOwners makes the oldest member the owner of every workspace without one.
+// The oldest members are picked in Go, MySQL cannot update a table it selects from
+func (r *WorkspaceRepositoryImpl) EnsureOwners(ctx context.Context) (int64, error) {
+	var workspaceIDs []uint
+
+	err := r.db.WithContext(ctx).
+		Model(&model.Workspace{}).
+		Where("NOT EXISTS (SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = workspaces.id AND wm.role = ?)", string(entity.RoleOwner)).
+		Where("EXISTS (SELECT 1 FROM workspace_members wm WHERE wm.workspace_id = workspaces.id)").
+		Pluck("id", &workspaceIDs).Error
+	if err != nil {
+		return 0, fmt.Errorf("failed to find workspaces without owner: %w", err)
+	}
+
+	var fixed int64
+	for _, workspaceID := range workspaceIDs {
+		var oldest model.WorkspaceMember
+		err := r.db.WithContext(ctx).
+			Where("workspace_id = ?", workspaceID).
+			Order("created_at ASC, user_id ASC").
+			First(&oldest).Error
+		if err != nil {
+			return fixed, fmt.Errorf("failed to find oldest member of workspace %d: %w", workspaceID, err)
+		}
+
+		if _, err := r.UpdateMemberRole(ctx, workspaceID, oldest.UserID, entity.RoleOwner); err != nil {
+			return fixed, err
+		}
+		fixed++
+	}
+
+	return fixed, nil
+}
+
+// ListIDs retrieves the IDs of every workspace, oldest first
+func (r *WorkspaceRepositoryImpl) ListIDs(ctx context.Context) ([]uint, error) {
+	var ids []uint
+	if err := r.db.WithContext(ctx).Model(&model.Workspace{}).Order("id ASC").Pluck("id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("failed to list workspace ids: %w", err)
+	}
+
+	return ids, nil
+}
+
+// AdoptLegacyResources gives the workspace its own copy of the workflows, projects and tags created
+// before workspaces that its todos use and moves the todos onto the copies. A resource of the workspace
+// with the same name is taken as the copy, so running it again does not copy twice
+func (r *WorkspaceRepositoryImpl) AdoptLegacyResources(ctx context.Context, workspaceID uint) (int64, error) {
+	if workspaceID == 0 {
+		return 0, errors.New("workspace ID cannot be 0")
+	}
+
+	var created int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// workspaceTodos selects a column of every todo of the workspace, trashed ones included
+		workspaceTodos := func(column string) *gorm.DB {
+			return tx.Unscoped().Model(&model.Todo{}).Select(column).Where("workspace_id = ?", workspaceID)
+		}
+
+		// Workflows go first, copied projects point at the copied workflow
+		var workflows []model.Workflow
+		if err := tx.Preload("Statuses", orderStatusesByPosition).
+			Where("workspace_id = 0").
+			Where("(id IN (?) OR id IN (?))",
+				workspaceTodos("workflow_id"),
+				tx.Model(&model.Project{}).Select("workflow_id").Where("workspace_id = 0 AND id IN (?)", workspaceTodos("project_id"))).
+			Order("id ASC").
+			Find(&workflows).Error; err != nil {
+			return err
+		}
+		var defaults int64
+		if err := tx.Model(&model.Workflow{}).Where("workspace_id = ? AND is_default = ?", workspaceID, true).Count(&defaults).Error; err != nil {
+			return err
+		}
+		workflowIDs := make(map[uint]uint, len(workflows))
+		for _, legacy := range workflows {
+			var adopted model.Workflow
+			found, err := firstByName(tx, &adopted, workspaceID, legacy.Name)
+			if err != nil {
+				return err
+			}
+			if !found {
+				adopted = model.Workflow{
+					WorkspaceID: workspaceID,
+					Name:        legacy.Name,
+					IsDefault:   legacy.IsDefault && defaults == 0,
+					Statuses:    make([]model.WorkflowStatus, len(legacy.Statuses)),
+					CreatedAt:   legacy.CreatedAt,
+					UpdatedAt:   legacy.UpdatedAt,
+				}
+				for i, status := range legacy.Statuses {
+					adopted.Statuses[i] = model.WorkflowStatus{Name: status.Name, Category: status.Category, Position: status.Position}
+				}
+				if err := tx.Create(&adopted).Error; err != nil {
+					return err
+				}
+				if adopted.IsDefault {
+					defaults++
+				}
+				created++
+			}
+			workflowIDs[legacy.ID] = adopted.ID
+
+			if err := tx.Unscoped().Model(&model.Todo{}).
+				Where("workspace_id = ? AND workflow_id = ?", workspaceID, legacy.ID).
+				UpdateColumns(map[string]interface{}{
+					"workflow_id": adopted.ID,
+					"version":     gorm.Expr("version + 1"),
+				}).Error; err != nil {
+				return err
+			}
+		}
+
+		var projects []model.Project
+		if err := tx.Where("workspace_id = 0 AND id IN (?)", workspaceTodos("project_id")).Order("id ASC").Find(&projects).Error; err != nil {
+			return err
+		}
+		for _, legacy := range projects {
+			var adopted model.Project
+			found, err := firstByName(tx, &adopted, workspaceID, legacy.Name)
+			if err != nil {
+				return err
+			}
+			if !found {
+				adopted = legacy
+				adopted.ID = 0
+				adopted.WorkspaceID = workspaceID
+				if workflowID, ok := workflowIDs[legacy.WorkflowID]; ok {
+					adopted.WorkflowID = workflowID
+				}
+				if err := tx.Create(&adopted).Error; err != nil {
+					return err
+				}
+				created++
+			}
+
+			if err := tx.Unscoped().Model(&model.Todo{}).
+				Where("workspace_id = ? AND project_id = ?", workspaceID, legacy.ID).
+				UpdateColumns(map[string]interface{}{
+					"project_id": adopted.ID,
+					"version":    gorm.Expr("version + 1"),
+				}).Error; err != nil {
+				return err
+			}
+		}
+
+		var tags []model.Tag
+		if err := tx.Where("workspace_id = 0 AND id IN (?)",
+			tx.Model(&model.TodoTag{}).Select("tag_id").Where("todo_id IN (?)", workspaceTodos("id"))).
+			Order("id ASC").
+			Find(&tags).Error; err != nil {
+			return err
+		}
+		for _, legacy := range tags {
+			var adopted model.Tag
+			found, err := firstByName(tx, &adopted, workspaceID, legacy.Name)
+			if err != nil {
+				return err
+			}
+			if !found {
+				adopted = legacy
+				adopted.ID = 0
+				adopted.WorkspaceID = workspaceID
+				if err := tx.Create(&adopted).Error; err != nil {
+					return err
+				}
+				created++
+			}
+
+			// The tagged todos change too, bump them before their links move
+			if err := tx.Unscoped().Model(&model.Todo{}).
+				Where("workspace_id = ? AND id IN (?)", workspaceID,
+					tx.Model(&model.TodoTag{}).Select("todo_id").Where("tag_id = ?", legacy.ID)).
+				UpdateColumn("version", gorm.Expr("version + 1")).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(&model.TodoTag{}).
+				Where("tag_id = ? AND todo_id IN (?)", legacy.ID, workspaceTodos("id")).
+				UpdateColumn("tag_id", adopted.ID).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to adopt legacy resources of workspace %d: %w", workspaceID, err)
+	}
+
+	return created, nil
+}
+
+// firstByName loads the row of the workspace with the name into dest, reports false when there is none
+func firstByName(tx *gorm.DB, dest interface{}, workspaceID uint, name string) (bool, error) {
+	err := tx.Where("workspace_id = ? AND name = ?", workspaceID, name).First(dest).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,326 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/repository"
+	"itmrchow/go-todolist-service/internal/infrastructure/config"
+	"itmrchow/go-todolist-service/internal/infrastructure/database"
+	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+	"itmrchow/go-todolist-service/internal/utils/actor"
+)
+
+type WorkspaceRepositoryTestSuite struct {
+	suite.Suite
+	db    *gorm.DB
+	repo  repository.WorkspaceRepository
+	users repository.UserRepository
+	ctx   context.Context
+}
+
+// SetupSuite 在整個測試 suite 開始前執行一次
+func (suite *WorkspaceRepositoryTestSuite) SetupSuite() {
+	ctx := context.Background()
+	sqlLiteDB := &database.SQLiteDBImpl{}
+	db, err := sqlLiteDB.Connect(ctx, &config.DatabaseConfig{})
+	suite.Require().NoError(err)
+
+	err = sqlLiteDB.Migrate(&model.User{}, &model.Workspace{}, &model.WorkspaceMember{},
+		&model.Todo{}, &model.Tag{}, &model.TodoTag{}, &model.ChecklistItem{}, &model.TodoDependency{}, &model.TodoAssignee{}, &model.Comment{},
+		&model.Project{}, &model.Workflow{}, &model.WorkflowStatus{})
+	suite.Require().NoError(err)
+
+	suite.db = db
+	suite.ctx = ctx
+
+	suite.repo = NewWorkspaceRepository(zerolog.New(os.Stdout), suite.db)
+	suite.users = NewUserRepository(zerolog.New(os.Stdout), suite.db)
+}
+
+// TearDownSuite 在整個測試 suite 結束後執行一次
+func (suite *WorkspaceRepositoryTestSuite) TearDownSuite() {
+	if suite.db != nil {
+		sqlDB, err := suite.db.DB()
+		if err == nil {
+			sqlDB.Close()
+		}
+	}
+}
+
+// TearDownTest 每個測試後清理資料
+func (suite *WorkspaceRepositoryTestSuite) TearDownTest() {
+	if suite.db != nil {
+		suite.db.Exec("DELETE FROM workspace_members")
+		suite.db.Exec("DELETE FROM workspaces")
+		suite.db.Exec("DELETE FROM users")
+		suite.db.Exec("DELETE FROM todos")
+		suite.db.Exec("DELETE FROM todo_tags")
+		suite.db.Exec("DELETE FROM tags")
+		suite.db.Exec("DELETE FROM projects")
+		suite.db.Exec("DELETE FROM workflows")
+		suite.db.Exec("DELETE FROM workflow_statuses")
+		suite.db.Exec("DELETE FROM sqlite_sequence WHERE name IN ('workspaces', 'users', 'todos', 'tags', 'projects', 'workflows', 'workflow_statuses')")
+	}
+}
+
+// createUser creates a user with the email and returns its ID
+func (suite *WorkspaceRepositoryTestSuite) createUser(email string) uint {
+	user, err := entity.NewUser(email, "correct horse")
+	suite.Require().NoError(err)
+	created, err := suite.users.Create(suite.ctx, user)
+	suite.Require().NoError(err)
+	return created.ID
+}
+
+func (suite *WorkspaceRepositoryTestSuite) TestCreateAndMembers() {
+	alice := suite.createUser("alice@example.com")
+	bob := suite.createUser("bob@example.com")
+
+	workspace, _ := entity.NewWorkspace("平台團隊")
+	created, err := suite.repo.Create(suite.ctx, workspace, alice)
+	suite.Require().NoError(err)
+	suite.Equal(uint(1), created.ID)
+
+	got, err := suite.repo.GetByID(suite.ctx, created.ID)
+	suite.NoError(err)
+	suite.Equal("平台團隊", got.Name)
+
+	// the creator is the first member and owns the workspace
+	member, err := suite.repo.GetMember(suite.ctx, created.ID, alice)
+	suite.NoError(err)
+	suite.Require().NotNil(member)
+	suite.Equal(entity.RoleOwner, member.Role)
+
+	member, err = suite.repo.GetMember(suite.ctx, created.ID, bob)
+	suite.NoError(err)
+	suite.Nil(member)
+
+	newMember, _ := entity.NewWorkspaceMember(created.ID, bob, entity.RoleViewer)
+	suite.NoError(suite.repo.AddMember(suite.ctx, newMember))
+	suite.Error(suite.repo.AddMember(suite.ctx, newMember))
+
+	members, err := suite.repo.ListMembers(suite.ctx, created.ID)
+	suite.NoError(err)
+	suite.Require().Len(members, 2)
+	suite.Equal("alice@example.com", members[0].Email)
+	suite.Equal("bob@example.com", members[1].Email)
+	suite.Equal(entity.RoleViewer, members[1].Role)
+}
+
+func (suite *WorkspaceRepositoryTestSuite) TestCreatePersonal_OncePerUser() {
+	alice := suite.createUser("alice@example.com")
+	bob := suite.createUser("bob@example.com")
+
+	workspace, _ := entity.NewWorkspace("Personal")
+	created, isNew, err := suite.repo.CreatePersonal(suite.ctx, workspace, alice)
+	suite.Require().NoError(err)
+	suite.True(isNew)
+
+	// a second request gets the workspace of the first one instead of another copy
+	again, _ := entity.NewWorkspace("Personal")
+	existing, isNew, err := suite.repo.CreatePersonal(suite.ctx, again, alice)
+	suite.Require().NoError(err)
+	suite.False(isNew)
+	suite.Equal(created.ID, existing.ID)
+
+	workspaces, err := suite.repo.ListByMember(suite.ctx, alice)
+	suite.NoError(err)
+	suite.Len(workspaces, 1)
+	member, err := suite.repo.GetMember(suite.ctx, created.ID, alice)
+	suite.NoError(err)
+	suite.Require().NotNil(member)
+	suite.Equal(entity.RoleOwner, member.Role)
+
+	// each user has their own, and shared workspaces are not personal
+	other, _ := entity.NewWorkspace("Personal")
+	_, isNew, err = suite.repo.CreatePersonal(suite.ctx, other, bob)
+	suite.NoError(err)
+	suite.True(isNew)
+	team, _ := entity.NewWorkspace("平台團隊")
+	_, err = suite.repo.Create(suite.ctx, team, alice)
+	suite.NoError(err)
+}
+
+func (suite *WorkspaceRepositoryTestSuite) TestMemberRoles() {
+	alice := suite.createUser("alice@example.com")
+	bob := suite.createUser("bob@example.com")
+
+	workspace, _ := entity.NewWorkspace("平台團隊")
+	created, _ := suite.repo.Create(suite.ctx, workspace, alice)
+	newMember, _ := entity.NewWorkspaceMember(created.ID, bob, entity.RoleMember)
+	suite.Require().NoError(suite.repo.AddMember(suite.ctx, newMember))
+
+	updated, err := suite.repo.UpdateMemberRole(suite.ctx, created.ID, bob, entity.RoleOwner)
+	suite.NoError(err)
+	suite.Equal(int64(1), updated)
+
+	owners, err := suite.repo.CountOwners(suite.ctx, created.ID)
+	suite.NoError(err)
+	suite.Equal(int64(2), owners)
+
+	updated, err = suite.repo.UpdateMemberRole(suite.ctx, created.ID, 99, entity.RoleAdmin)
+	suite.NoError(err)
+	suite.Equal(int64(0), updated)
+
+	removed, err := suite.repo.RemoveMember(suite.ctx, created.ID, alice)
+	suite.NoError(err)
+	suite.Equal(int64(1), removed)
+
+	removed, err = suite.repo.RemoveMember(suite.ctx, created.ID, alice)
+	suite.NoError(err)
+	suite.Equal(int64(0), removed)
+
+	owners, err = suite.repo.CountOwners(suite.ctx, created.ID)
+	suite.NoError(err)
+	suite.Equal(int64(1), owners)
+}
+
+func (suite *WorkspaceRepositoryTestSuite) TestEnsureOwners() {
+	alice := suite.createUser("alice@example.com")
+	bob := suite.createUser("bob@example.com")
+
+	// a workspace from before roles, every member has the default role
+	workspace, _ := entity.NewWorkspace("平台團隊")
+	created, _ := suite.repo.Create(suite.ctx, workspace, alice)
+	newMember, _ := entity.NewWorkspaceMember(created.ID, bob, entity.RoleMember)
+	suite.Require().NoError(suite.repo.AddMember(suite.ctx, newMember))
+	suite.Require().NoError(suite.db.Exec("UPDATE workspace_members SET role = 'member'").Error)
+
+	owned, _ := entity.NewWorkspace("bob 的工作區")
+	suite.repo.Create(suite.ctx, owned, bob)
+
+	fixed, err := suite.repo.EnsureOwners(suite.ctx)
+	suite.NoError(err)
+	suite.Equal(int64(1), fixed)
+
+	member, err := suite.repo.GetMember(suite.ctx, created.ID, alice)
+	suite.NoError(err)
+	suite.Equal(entity.RoleOwner, member.Role)
+
+	member, err = suite.repo.GetMember(suite.ctx, created.ID, bob)
+	suite.NoError(err)
+	suite.Equal(entity.RoleMember, member.Role)
+
+	fixed, err = suite.repo.EnsureOwners(suite.ctx)
+	suite.NoError(err)
+	suite.Equal(int64(0), fixed)
+}
+
+func (suite *WorkspaceRepositoryTestSuite) TestListByMember() {
+	alice := suite.createUser("alice@example.com")
+	bob := suite.createUser("bob@example.com")
+
+	first, _ := entity.NewWorkspace("個人")
+	createdFirst, _ := suite.repo.Create(suite.ctx, first, alice)
+	second, _ := entity.NewWorkspace("平台團隊")
+	createdSecond, _ := suite.repo.Create(suite.ctx, second, alice)
+	other, _ := entity.NewWorkspace("bob 的工作區")
+	suite.repo.Create(suite.ctx, other, bob)
+
+	workspaces, err := suite.repo.ListByMember(suite.ctx, alice)
+
+	suite.NoError(err)
+	suite.Require().Len(workspaces, 2)
+	suite.Equal(createdFirst.ID, workspaces[0].ID)
+	suite.Equal(createdSecond.ID, workspaces[1].ID)
+
+	got, err := suite.repo.GetByID(suite.ctx, 99)
+	suite.NoError(err)
+	suite.Nil(got)
+}
+
+func (suite *WorkspaceRepositoryTestSuite) TestListIDs() {
+	alice := suite.createUser("alice@example.com")
+	for _, name := range []string{"平台團隊", "行銷團隊"} {
+		workspace, _ := entity.NewWorkspace(name)
+		_, err := suite.repo.Create(suite.ctx, workspace, alice)
+		suite.Require().NoError(err)
+	}
+
+	ids, err := suite.repo.ListIDs(suite.ctx)
+
+	suite.NoError(err)
+	suite.Equal([]uint{1, 2}, ids)
+}
+
+func (suite *WorkspaceRepositoryTestSuite) TestAdoptLegacyResources() {
+	// resources created before workspaces, the project uses the workflow
+	legacyWorkflow := model.Workflow{Name: "review", IsDefault: true, Statuses: []model.WorkflowStatus{
+		{Name: "open", Category: string(entity.CategoryTodo), Position: 0},
+		{Name: "closed", Category: string(entity.CategoryDone), Position: 1},
+	}}
+	suite.Require().NoError(suite.db.Create(&legacyWorkflow).Error)
+	legacyProject := model.Project{Name: "home", WorkflowID: legacyWorkflow.ID}
+	suite.Require().NoError(suite.db.Create(&legacyProject).Error)
+	legacyTag := model.Tag{Name: "work", Color: "#ff0000"}
+	suite.Require().NoError(suite.db.Create(&legacyTag).Error)
+	unusedTag := model.Tag{Name: "unused", Color: "#ff0000"}
+	suite.Require().NoError(suite.db.Create(&unusedTag).Error)
+
+	todoRepo := NewTodoRepository(zerolog.New(os.Stdout), suite.db)
+	systemCtx := actor.WithSystem(suite.ctx)
+	createTodo := func(workspaceID uint) *entity.Todo {
+		todo, err := entity.NewTodo("舊任務", nil, nil, nil)
+		suite.Require().NoError(err)
+		todo.WorkspaceID = workspaceID
+		todo.WorkflowID = legacyWorkflow.ID
+		todo.ProjectID = &legacyProject.ID
+		todo.SetTags([]entity.Tag{{ID: legacyTag.ID, Name: legacyTag.Name}})
+		created, err := todoRepo.Create(systemCtx, todo)
+		suite.Require().NoError(err)
+		return created
+	}
+	todoA := createTodo(1)
+	todoB := createTodo(2)
+
+	created, err := suite.repo.AdoptLegacyResources(suite.ctx, 1)
+	suite.Require().NoError(err)
+	suite.Equal(int64(3), created)
+
+	var workflow model.Workflow
+	suite.Require().NoError(suite.db.Preload("Statuses").Where("workspace_id = 1").First(&workflow).Error)
+	suite.Equal("review", workflow.Name)
+	suite.True(workflow.IsDefault)
+	suite.Len(workflow.Statuses, 2)
+	var project model.Project
+	suite.Require().NoError(suite.db.Where("workspace_id = 1").First(&project).Error)
+	suite.Equal(workflow.ID, project.WorkflowID)
+	var tag model.Tag
+	suite.Require().NoError(suite.db.Where("workspace_id = 1").First(&tag).Error)
+	suite.Equal("#ff0000", tag.Color)
+	var count int64
+	suite.db.Model(&model.Tag{}).Where("workspace_id = 1 AND name = ?", "unused").Count(&count)
+	suite.Equal(int64(0), count)
+
+	// the todos of the workspace move onto the copies, the others keep the legacy rows
+	got, err := todoRepo.GetByID(systemCtx, todoA.ID)
+	suite.Require().NoError(err)
+	suite.Equal(workflow.ID, got.WorkflowID)
+	suite.Equal(&project.ID, got.ProjectID)
+	suite.Equal([]uint{tag.ID}, got.TagIDs())
+	suite.Greater(got.Version, todoA.Version)
+	got, err = todoRepo.GetByID(systemCtx, todoB.ID)
+	suite.Require().NoError(err)
+	suite.Equal(legacyWorkflow.ID, got.WorkflowID)
+	suite.Equal(&legacyProject.ID, got.ProjectID)
+	suite.Equal([]uint{legacyTag.ID}, got.TagIDs())
+
+	// nothing is copied twice
+	created, err = suite.repo.AdoptLegacyResources(suite.ctx, 1)
+	suite.NoError(err)
+	suite.Equal(int64(0), created)
+
+	_, err = suite.repo.AdoptLegacyResources(suite.ctx, 0)
+	suite.Error(err)
+}
+
+func TestWorkspaceRepositoryTestSuite(t *testing.T) {
+	suite.Run(t, new(WorkspaceRepositoryTestSuite))
+}
@@ -5,57 +5,236 @@ import (
 
 	"itmrchow/go-todolist-service/internal/delivery/http/handler"
 	v1 "itmrchow/go-todolist-service/internal/delivery/http/handler/v1"
+	v2 "itmrchow/go-todolist-service/internal/delivery/http/handler/v2"
 	"itmrchow/go-todolist-service/internal/delivery/http/middleware"
+	"itmrchow/go-todolist-service/internal/domain/entity"
+	"itmrchow/go-todolist-service/internal/domain/usecase"
 )
 
 var _ Router = &RouterImpl{}
 
 // RouterImpl implements the Router interface.
 type RouterImpl struct {
-	healthHandler *handler.HealthHandler
-	todoV1Handler v1.TodoHandler
+	healthHandler         *handler.HealthHandler
+	todoV1Handler         v1.TodoHandler
+	todoV2Handler         v2.TodoHandler
+	tagV2Handler          v2.TagHandler
+	checklistV2Handler    v2.ChecklistHandler
+	workflowV2Handler     v2.WorkflowHandler
+	projectV1Handler      v1.ProjectHandler
+	dependencyV2Handler   v2.DependencyHandler
+	assigneeV2Handler     v2.AssigneeHandler
+	commentV2Handler      v2.CommentHandler
+	attachmentV2Handler   v2.AttachmentHandler
+	authV2Handler         v2.AuthHandler
+	apiKeyV2Handler       v2.APIKeyHandler
+	workspaceV2Handler    v2.WorkspaceHandler
+	authMiddleware        gin.HandlerFunc
+	workspaceMiddleware   gin.HandlerFunc
+	idempotencyMiddleware gin.HandlerFunc
+	allowedOrigins        []string
 }
 
 // NewRouter creates a new router instance.
 func NewRouter(
 	healthHandler *handler.HealthHandler,
 	todoV1Handler v1.TodoHandler,
+	todoV2Handler v2.TodoHandler,
+	tagV2Handler v2.TagHandler,
+	checklistV2Handler v2.ChecklistHandler,
+	workflowV2Handler v2.WorkflowHandler,
+	projectV1Handler v1.ProjectHandler,
+	dependencyV2Handler v2.DependencyHandler,
+	assigneeV2Handler v2.AssigneeHandler,
+	commentV2Handler v2.CommentHandler,
+	attachmentV2Handler v2.AttachmentHandler,
+	authV2Handler v2.AuthHandler,
+	apiKeyV2Handler v2.APIKeyHandler,
+	workspaceV2Handler v2.WorkspaceHandler,
+	authMiddleware gin.HandlerFunc,
+	workspaceMiddleware gin.HandlerFunc,
+	idempotencyMiddleware gin.HandlerFunc,
+	allowedOrigins []string,
 ) *RouterImpl {
 	return &RouterImpl{
-		healthHandler: healthHandler,
-		todoV1Handler: todoV1Handler,
+		healthHandler:         healthHandler,
+		todoV1Handler:         todoV1Handler,
+		todoV2Handler:         todoV2Handler,
+		tagV2Handler:          tagV2Handler,
+		checklistV2Handler:    checklistV2Handler,
+		workflowV2Handler:     workflowV2Handler,
+		projectV1Handler:      projectV1Handler,
+		dependencyV2Handler:   dependencyV2Handler,
+		assigneeV2Handler:     assigneeV2Handler,
+		commentV2Handler:      commentV2Handler,
+		attachmentV2Handler:   attachmentV2Handler,
+		authV2Handler:         authV2Handler,
+		apiKeyV2Handler:       apiKeyV2Handler,
+		workspaceV2Handler:    workspaceV2Handler,
+		authMiddleware:        authMiddleware,
+		workspaceMiddleware:   workspaceMiddleware,
+		idempotencyMiddleware: idempotencyMiddleware,
+		allowedOrigins:        allowedOrigins,
 	}
 }
 
 // SetupRoutes configures and returns the Gin engine with all routes.
 func (r *RouterImpl) SetupRoutes() *gin.Engine {
 	engine := gin.Default()
+	engine.ContextWithFallback = true // handlers pass *gin.Context to usecases, expose the request context values
 
 	// 註冊全域中間件
-	engine.Use(middleware.CORS())
+	engine.Use(middleware.CORS(r.allowedOrigins))
 	engine.Use(middleware.ErrorHandler())
+	engine.Use(middleware.Actor())
 
 	// 註冊基礎路由
 	engine.GET("/health", r.healthHandler.Health)
 	engine.GET("/version", r.healthHandler.Version)
 
-	// 設定 v1 API 路由群組
-	v1Group := engine.Group("/api/v1")
+	// 設定帳號路由群組，註冊與登入不需要 token
+	authGroup := engine.Group("/api/v2/auth")
+	r.RegisterAuthRoutes(authGroup)
+
+	// 設定 API 金鑰路由群組，只接受登入 token，API 金鑰不能管理金鑰
+	apiKeyGroup := engine.Group("/api/v2/api-keys", r.authMiddleware, middleware.RequireSession())
+	r.RegisterAPIKeyRoutes(apiKeyGroup)
+
+	// 設定工作區路由群組，只接受登入 token，不限定在某個工作區內，成員管理的權限由 usecase 依角色判斷
+	workspaceGroup := engine.Group("/api/v2/workspaces", r.authMiddleware, middleware.RequireSession())
+	r.RegisterWorkspaceRoutes(workspaceGroup)
+
+	// 設定 v1 API 路由群組，需要登入或 API 金鑰，各路由自行檢查金鑰權限範圍
+	// todo 只在 X-Workspace-ID 指定的工作區內查詢與異動，各路由依成員角色檢查權限
+	v1Group := engine.Group("/api/v1", r.authMiddleware, r.workspaceMiddleware)
 	r.RegisterV1Routes(v1Group)
 
+	// 設定 v2 API 路由群組 (resource-oriented)，需要登入或 API 金鑰，讀取需要 todos:read，其餘需要 todos:write
+	// todo 只在 X-Workspace-ID 指定的工作區內查詢與異動，viewer 只能讀取，其餘需要 member 以上，工作流程與標籤的異動需要 admin 以上
+	// 異動請求帶有 Idempotency-Key 時，重送會回傳第一次的回應
+	v2Group := engine.Group("/api/v2",
+		r.authMiddleware,
+		middleware.RequireScopeByMethod(entity.ScopeTodosRead, entity.ScopeTodosWrite),
+		r.workspaceMiddleware,
+		middleware.AuthorizeByMethod(usecase.ActionViewTodos, usecase.ActionEditTodos),
+		r.idempotencyMiddleware,
+	)
+	r.RegisterV2Routes(v2Group)
+
 	return engine
 }
 
 // RegisterV1Routes registers all v1 API routes.
 func (r *RouterImpl) RegisterV1Routes(routerGroup *gin.RouterGroup) {
+	// v1 的查詢也用 POST，無法依 HTTP method 判斷權限範圍與角色，Idempotency-Key 也只套用在異動路由
+	read := []gin.HandlerFunc{middleware.RequireScope(entity.ScopeTodosRead), middleware.Authorize(usecase.ActionViewTodos)}
+	write := []gin.HandlerFunc{middleware.RequireScope(entity.ScopeTodosWrite), middleware.Authorize(usecase.ActionEditTodos), r.idempotencyMiddleware}
+	purge := []gin.HandlerFunc{middleware.RequireScope(entity.ScopeTodosWrite), middleware.Authorize(usecase.ActionPurgeTodos), r.idempotencyMiddleware}
+	deleteProject := []gin.HandlerFunc{middleware.RequireScope(entity.ScopeTodosWrite), middleware.Authorize(usecase.ActionDeleteProject), r.idempotencyMiddleware}
+
+	routerGroup.POST("/create-todo", append(write, r.todoV1Handler.CreateTodo)...) // 新增todo
+	routerGroup.POST("/find-todo", append(read, r.todoV1Handler.FindTodo)...)      // 查詢todo
+	routerGroup.GET("/todos/:id", append(read, r.todoV1Handler.GetTodo)...)        // 取得單筆todo
+	routerGroup.POST("/update-todo", append(write, r.todoV1Handler.UpdateTodo)...) // 更新todo
+	routerGroup.POST("/delete-todo", append(write, r.todoV1Handler.DeleteTodo)...) // 更新todo，member 只能刪除自己建立的
+	routerGroup.POST("/move-todo", append(write, r.todoV1Handler.MoveTodo)...)     // 手動排序/拖曳todo
+
+	// 垃圾桶 (soft deleted todos)
+	routerGroup.POST("/find-trash", append(read, r.todoV1Handler.FindTrash)...)      // 查詢已刪除todo
+	routerGroup.POST("/restore-todo", append(write, r.todoV1Handler.RestoreTodo)...) // 還原todo，member 只能還原自己建立的
+	routerGroup.POST("/purge-todo", append(purge, r.todoV1Handler.PurgeTodo)...)     // 永久刪除todo，需要 admin 以上
+	routerGroup.POST("/empty-trash", append(purge, r.todoV1Handler.EmptyTrash)...)   // 清空垃圾桶，需要 admin 以上
 
-	routerGroup.POST("/create-todo", r.todoV1Handler.CreateTodo) // 新增todo
-	routerGroup.POST("/find-todo", r.todoV1Handler.FindTodo)     // 查詢todo
-	routerGroup.POST("/update-todo", r.todoV1Handler.UpdateTodo) // 更新todo
-	routerGroup.POST("/delete-todo", r.todoV1Handler.DeleteTodo) // 更新todo
+	// 專案 (todo 分組)
+	routerGroup.POST("/create-project", append(write, r.projectV1Handler.CreateProject)...)         // 新增專案
+	routerGroup.POST("/find-project", append(read, r.projectV1Handler.FindProject)...)              // 查詢專案
+	routerGroup.GET("/projects/:id", append(read, r.projectV1Handler.GetProject)...)                // 取得單筆專案
+	routerGroup.POST("/update-project", append(write, r.projectV1Handler.UpdateProject)...)         // 更新專案
+	routerGroup.POST("/delete-project", append(deleteProject, r.projectV1Handler.DeleteProject)...) // 刪除專案，cascade 時將其 todo 移至垃圾桶，需要 admin 以上
 
 	// 目前 v1 路由群組為空，未來將在此新增業務邏輯路由
 	// 例如：
 	// routerGroup.GET("/todos", todoHandler.GetTodos)
 
 }
+
+// RegisterAuthRoutes registers the account routes, which are reachable without token.
+func (r *RouterImpl) RegisterAuthRoutes(routerGroup *gin.RouterGroup) {
+	routerGroup.POST("/register", r.authV2Handler.Register) // 註冊帳號
+	routerGroup.POST("/login", r.authV2Handler.Login)       // 登入，取得 token
+	routerGroup.POST("/refresh", r.authV2Handler.Refresh)   // 以 refresh token 換發 token
+}
+
+// RegisterAPIKeyRoutes registers the API key management routes of the signed-in user.
+func (r *RouterImpl) RegisterAPIKeyRoutes(routerGroup *gin.RouterGroup) {
+	routerGroup.GET("", r.apiKeyV2Handler.ListAPIKeys)         // 查詢 API 金鑰
+	routerGroup.POST("", r.apiKeyV2Handler.CreateAPIKey)       // 建立 API 金鑰，金鑰只顯示這一次
+	routerGroup.DELETE("/:id", r.apiKeyV2Handler.RevokeAPIKey) // 撤銷 API 金鑰
+}
+
+// RegisterWorkspaceRoutes registers the workspace routes of the signed-in user.
+func (r *RouterImpl) RegisterWorkspaceRoutes(routerGroup *gin.RouterGroup) {
+	routerGroup.GET("", r.workspaceV2Handler.ListWorkspaces)                          // 查詢工作區
+	routerGroup.POST("", r.workspaceV2Handler.CreateWorkspace)                        // 建立工作區，建立者為 owner
+	routerGroup.GET("/:id/members", r.workspaceV2Handler.ListMembers)                 // 查詢成員與角色
+	routerGroup.POST("/:id/members", r.workspaceV2Handler.AddMember)                  // 邀請成員，需要 admin 以上
+	routerGroup.PATCH("/:id/members/:user_id", r.workspaceV2Handler.ChangeMemberRole) // 變更成員角色，只有 owner 能授予或移除 owner
+	routerGroup.DELETE("/:id/members/:user_id", r.workspaceV2Handler.RemoveMember)    // 移除成員，成員可移除自己離開工作區
+}
+
+// RegisterV2Routes registers all v2 API routes.
+func (r *RouterImpl) RegisterV2Routes(routerGroup *gin.RouterGroup) {
+	// 群組已依 HTTP method 檢查 member 以上，工作流程與標籤是整個工作區共用的設定，異動另外需要 admin 以上
+	configure := middleware.Authorize(usecase.ActionConfigureWorkspace)
+
+	todos := routerGroup.Group("/todos")
+	todos.GET("", r.todoV2Handler.ListTodos)                      // 查詢todo
+	todos.POST("", r.todoV2Handler.CreateTodo)                    // 新增todo
+	todos.GET("/:id", r.todoV2Handler.GetTodo)                    // 取得單筆todo
+	todos.PUT("/:id", r.todoV2Handler.ReplaceTodo)                // 整筆取代todo
+	todos.PATCH("/:id", r.todoV2Handler.PatchTodo)                // 部分更新todo
+	todos.DELETE("/:id", r.todoV2Handler.DeleteTodo)              // 刪除todo
+	todos.POST("/:id/transition", r.todoV2Handler.TransitionTodo) // 變更狀態
+	todos.GET("/:id/history", r.todoV2Handler.ListStatusHistory)  // 狀態變更紀錄
+	todos.POST("/:id/move", r.todoV2Handler.MoveTodo)             // 手動排序/拖曳todo
+
+	checklist := todos.Group("/:id/checklist")
+	checklist.GET("", r.checklistV2Handler.ListChecklist)                   // 查詢檢查清單
+	checklist.POST("", r.checklistV2Handler.AddChecklistItem)               // 新增檢查項目
+	checklist.PUT("/order", r.checklistV2Handler.ReorderChecklist)          // 重新排序
+	checklist.PATCH("/:item_id", r.checklistV2Handler.PatchChecklistItem)   // 編輯/勾選檢查項目
+	checklist.DELETE("/:item_id", r.checklistV2Handler.DeleteChecklistItem) // 刪除檢查項目
+
+	dependencies := todos.Group("/:id/dependencies")
+	dependencies.POST("", r.dependencyV2Handler.AddDependency)                  // 新增前置todo
+	dependencies.DELETE("/:blocker_id", r.dependencyV2Handler.RemoveDependency) // 移除前置todo
+
+	assignees := todos.Group("/:id/assignees")
+	assignees.POST("", r.assigneeV2Handler.AssignTodo)              // 指派負責人
+	assignees.DELETE("/:user_id", r.assigneeV2Handler.UnassignTodo) // 取消指派
+
+	comments := todos.Group("/:id/comments")
+	comments.GET("", r.commentV2Handler.ListComments)                 // 查詢留言
+	comments.POST("", r.commentV2Handler.CreateComment)               // 新增留言
+	comments.PATCH("/:comment_id", r.commentV2Handler.PatchComment)   // 編輯留言
+	comments.DELETE("/:comment_id", r.commentV2Handler.DeleteComment) // 刪除留言
+
+	attachments := todos.Group("/:id/attachments")
+	attachments.GET("", r.attachmentV2Handler.ListAttachments)                    // 查詢附件
+	attachments.POST("", r.attachmentV2Handler.UploadAttachment)                  // 上傳附件
+	attachments.GET("/:attachment_id", r.attachmentV2Handler.DownloadAttachment)  // 下載附件
+	attachments.DELETE("/:attachment_id", r.attachmentV2Handler.DeleteAttachment) // 刪除附件
+
+	tags := routerGroup.Group("/tags")
+	tags.GET("", r.tagV2Handler.ListTags)                    // 查詢標籤
+	tags.POST("", configure, r.tagV2Handler.CreateTag)       // 新增標籤，需要 admin 以上
+	tags.PATCH("/:id", configure, r.tagV2Handler.PatchTag)   // 重新命名/變更顏色，需要 admin 以上
+	tags.DELETE("/:id", configure, r.tagV2Handler.DeleteTag) // 刪除標籤，需要 admin 以上
+
+	workflows := routerGroup.Group("/workflows")
+	workflows.GET("", r.workflowV2Handler.ListWorkflows)                    // 查詢工作流程
+	workflows.POST("", configure, r.workflowV2Handler.CreateWorkflow)       // 新增工作流程，需要 admin 以上
+	workflows.GET("/:id", r.workflowV2Handler.GetWorkflow)                  // 取得單筆工作流程
+	workflows.PUT("/:id", configure, r.workflowV2Handler.ReplaceWorkflow)   // 取代名稱與狀態，需要 admin 以上
+	workflows.DELETE("/:id", configure, r.workflowV2Handler.DeleteWorkflow) // 刪除工作流程，需要 admin 以上
+}
@@ -6,4 +6,8 @@ import "github.com/gin-gonic/gin"
 type Router interface {
 	SetupRoutes() *gin.Engine
 	RegisterV1Routes(routerGroup *gin.RouterGroup)
+	RegisterV2Routes(routerGroup *gin.RouterGroup)
+	RegisterAuthRoutes(routerGroup *gin.RouterGroup)
+	RegisterAPIKeyRoutes(routerGroup *gin.RouterGroup)
+	RegisterWorkspaceRoutes(routerGroup *gin.RouterGroup)
 }
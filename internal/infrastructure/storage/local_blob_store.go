@@ -0,0 +1,115 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"itmrchow/go-todolist-service/internal/domain/repository"
+)
+
+var _ repository.BlobStore = &LocalBlobStore{}
+
+// LocalBlobStore implements the BlobStore interface on the local filesystem
+// Every key maps to a file under the root directory
+type LocalBlobStore struct {
+	root string
+}
+
+// NewLocalBlobStore creates a new LocalBlobStore, the root directory is created if missing
+func NewLocalBlobStore(root string) (*LocalBlobStore, error) {
+	if strings.TrimSpace(root) == "" {
+		return nil, errors.New("blob store root cannot be empty")
+	}
+
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create blob store root: %w", err)
+	}
+
+	return &LocalBlobStore{root: root}, nil
+}
+
+// Put stores the content read from r under key, replacing any existing blob
+// The content is written to a temporary file first so readers never see a partial blob
+func (s *LocalBlobStore) Put(ctx context.Context, key string, r io.Reader) error {
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return fmt.Errorf("failed to create blob directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(filePath), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create blob: %w", err)
+	}
+	defer os.Remove(tmp.Name()) // no-op once renamed
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), filePath); err != nil {
+		return fmt.Errorf("failed to store blob: %w", err)
+	}
+
+	return nil
+}
+
+// Open opens the blob stored under key, the caller must close it
+func (s *LocalBlobStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(filePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, repository.ErrBlobNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open blob: %w", err)
+	}
+
+	return file, nil
+}
+
+// Delete removes the blob stored under key, deleting a missing blob is not an error
+func (s *LocalBlobStore) Delete(ctx context.Context, key string) error {
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(filePath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete blob: %w", err)
+	}
+
+	return nil
+}
+
+// filePath resolves a key to a file under the root, keys escaping the root are rejected
+func (s *LocalBlobStore) filePath(key string) (string, error) {
+	if key == "" || path.IsAbs(key) || strings.Contains(key, `\`) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return "", fmt.Errorf("invalid blob key %q", key)
+		}
+	}
+
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
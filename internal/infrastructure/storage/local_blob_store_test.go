@@ -0,0 +1,65 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"itmrchow/go-todolist-service/internal/domain/repository"
+)
+
+func Test_local_blob_store_put_open_delete(t *testing.T) {
+	ctx := context.Background()
+	root := filepath.Join(t.TempDir(), "attachments")
+	store, err := NewLocalBlobStore(root)
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(ctx, "todos/1/abc", strings.NewReader("hello")))
+
+	reader, err := store.Open(ctx, "todos/1/abc")
+	require.NoError(t, err)
+	content, err := io.ReadAll(reader)
+	reader.Close()
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(content))
+
+	// putting the same key replaces the content and leaves no temporary file behind
+	require.NoError(t, store.Put(ctx, "todos/1/abc", strings.NewReader("world")))
+	entries, err := os.ReadDir(filepath.Join(root, "todos", "1"))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	require.NoError(t, store.Delete(ctx, "todos/1/abc"))
+	_, err = store.Open(ctx, "todos/1/abc")
+	assert.ErrorIs(t, err, repository.ErrBlobNotFound)
+
+	// deleting a missing blob is not an error
+	assert.NoError(t, store.Delete(ctx, "todos/1/abc"))
+}
+
+func Test_local_blob_store_invalid_keys(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "/etc/passwd", "../escape", "todos/../../escape", "todos//1", `todos\1`} {
+		t.Run(key, func(t *testing.T) {
+			assert.Error(t, store.Put(ctx, key, strings.NewReader("x")))
+			_, err := store.Open(ctx, key)
+			assert.Error(t, err)
+			assert.NotErrorIs(t, err, repository.ErrBlobNotFound)
+			assert.Error(t, store.Delete(ctx, key))
+		})
+	}
+}
+
+func Test_local_blob_store_empty_root(t *testing.T) {
+	_, err := NewLocalBlobStore(" ")
+	assert.EqualError(t, err, "blob store root cannot be empty")
+}
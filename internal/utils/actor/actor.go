@@ -0,0 +1,71 @@
+// Package actor carries who performs a request through the context, for audit records
+// and for scoping data to the signed-in user and their workspace
+package actor
+
+import "context"
+
+type contextKey struct{}
+
+type userIDKey struct{}
+
+type workspaceIDKey struct{}
+
+type workspaceRoleKey struct{}
+
+type systemKey struct{}
+
+// WithName returns a copy of ctx carrying the name of the actor
+func WithName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, contextKey{}, name)
+}
+
+// Name returns the actor carried by ctx, empty when unknown
+func Name(ctx context.Context) string {
+	name, _ := ctx.Value(contextKey{}).(string)
+	return name
+}
+
+// WithUserID returns a copy of ctx carrying the ID of the signed-in user
+func WithUserID(ctx context.Context, id uint) context.Context {
+	return context.WithValue(ctx, userIDKey{}, id)
+}
+
+// UserID returns the signed-in user carried by ctx, 0 for requests without user such as background jobs
+func UserID(ctx context.Context) uint {
+	id, _ := ctx.Value(userIDKey{}).(uint)
+	return id
+}
+
+// WithWorkspaceID returns a copy of ctx carrying the workspace the request works in
+func WithWorkspaceID(ctx context.Context, id uint) context.Context {
+	return context.WithValue(ctx, workspaceIDKey{}, id)
+}
+
+// WorkspaceID returns the workspace carried by ctx, 0 outside a workspace such as background jobs
+func WorkspaceID(ctx context.Context) uint {
+	id, _ := ctx.Value(workspaceIDKey{}).(uint)
+	return id
+}
+
+// WithWorkspaceRole returns a copy of ctx carrying the role of the signed-in user in the workspace
+func WithWorkspaceRole(ctx context.Context, role string) context.Context {
+	return context.WithValue(ctx, workspaceRoleKey{}, role)
+}
+
+// WorkspaceRole returns the workspace role carried by ctx, empty outside a workspace such as background jobs
+func WorkspaceRole(ctx context.Context) string {
+	role, _ := ctx.Value(workspaceRoleKey{}).(string)
+	return role
+}
+
+// WithSystem returns a copy of ctx marking trusted background work that acts on the data of every tenant,
+// such as the trash retention job; requests without user, workspace or this marker see no data
+func WithSystem(ctx context.Context) context.Context {
+	return context.WithValue(ctx, systemKey{}, true)
+}
+
+// IsSystem reports whether ctx was marked by WithSystem
+func IsSystem(ctx context.Context) bool {
+	system, _ := ctx.Value(systemKey{}).(bool)
+	return system
+}
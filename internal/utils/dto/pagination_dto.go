@@ -2,16 +2,22 @@ package dto
 
 // PaginationReq represents pagination and sorting information
 type PaginationReq struct {
-	Page      int    `json:"page" binding:"min=1"`
-	PageSize  int    `json:"page_size" binding:"min=1,max=100"`
-	SortBy    string `json:"sort_by"`
-	SortOrder string `json:"sort_order" binding:"oneof=asc desc"`
+	Page         int    `json:"page" binding:"omitempty,min=1"`
+	PageSize     int    `json:"page_size" binding:"min=1,max=100"`
+	SortBy       string `json:"sort_by"`                                      // comma separated whitelisted fields, e.g. "status,due_date"
+	SortOrder    string `json:"sort_order"`                                   // comma separated asc/desc, one value or one per sort_by field
+	Mode         string `json:"mode" binding:"omitempty,oneof=offset cursor"` // cursor enables keyset pagination, page is ignored
+	Cursor       string `json:"cursor"`                                       // next_cursor/prev_cursor of a previous response
+	IncludeTotal bool   `json:"include_total"`                                // cursor mode only, totals are not counted by default
 }
 
 // PaginationResp represents pagination information in the response
+// TotalCount and TotalPages are omitted when they were not counted
 type PaginationResp struct {
-	Page       int `json:"page"`
-	PageSize   int `json:"page_size"`
-	TotalCount int `json:"total_count"`
-	TotalPages int `json:"total_pages"`
+	Page       int    `json:"page"`
+	PageSize   int    `json:"page_size"`
+	TotalCount *int   `json:"total_count,omitempty"`
+	TotalPages *int   `json:"total_pages,omitempty"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
 }
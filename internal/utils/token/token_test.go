@@ -0,0 +1,60 @@
+package token
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var secret = []byte("0123456789abcdef0123456789abcdef")
+
+func Test_token_sign_and_verify(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	claims := Claims{Subject: "7", Type: TypeAccess, IssuedAt: now.Unix(), ExpiresAt: now.Add(time.Minute).Unix()}
+
+	signed, err := Sign(claims, secret)
+	require.NoError(t, err)
+	assert.Equal(t, "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9", strings.Split(signed, ".")[0])
+
+	got, err := Verify(signed, secret, now)
+	require.NoError(t, err)
+	assert.Equal(t, claims, *got)
+
+	_, err = Verify(signed, secret, now.Add(time.Minute))
+	assert.ErrorIs(t, err, ErrExpiredToken)
+}
+
+func Test_token_verify_rejects_tampered_tokens(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	signed, err := Sign(Claims{Subject: "7", Type: TypeAccess, ExpiresAt: now.Add(time.Minute).Unix()}, secret)
+	require.NoError(t, err)
+	parts := strings.Split(signed, ".")
+
+	forgedPayload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"1","typ":"access","exp":9999999999}`))
+	noneHeader := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+
+	tests := map[string]string{
+		"wrong_secret":    mustSign(t, Claims{Subject: "7", ExpiresAt: now.Add(time.Minute).Unix()}, []byte("another secret")),
+		"forged_payload":  parts[0] + "." + forgedPayload + "." + parts[2],
+		"alg_none":        noneHeader + "." + parts[1] + ".",
+		"missing_segment": parts[0] + "." + parts[1],
+		"garbage":         "not a token",
+	}
+
+	for name, tokenString := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Verify(tokenString, secret, now)
+			assert.ErrorIs(t, err, ErrInvalidToken)
+		})
+	}
+}
+
+func mustSign(t *testing.T, claims Claims, key []byte) string {
+	signed, err := Sign(claims, key)
+	require.NoError(t, err)
+	return signed
+}
@@ -7,19 +7,25 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	_ "time/tzdata" // recurrence timezones must resolve even on hosts without a zoneinfo database
 
 	"github.com/rs/zerolog/log"
 
 	"itmrchow/go-todolist-service/internal/delivery/http/handler"
 	v1 "itmrchow/go-todolist-service/internal/delivery/http/handler/v1"
+	v2 "itmrchow/go-todolist-service/internal/delivery/http/handler/v2"
+	"itmrchow/go-todolist-service/internal/delivery/http/middleware"
 	"itmrchow/go-todolist-service/internal/domain/usecase"
 	"itmrchow/go-todolist-service/internal/infrastructure/config"
 	"itmrchow/go-todolist-service/internal/infrastructure/database"
 	"itmrchow/go-todolist-service/internal/infrastructure/database/model"
+	"itmrchow/go-todolist-service/internal/infrastructure/job"
 	"itmrchow/go-todolist-service/internal/infrastructure/logger"
 	"itmrchow/go-todolist-service/internal/infrastructure/repository"
 	"itmrchow/go-todolist-service/internal/infrastructure/router"
 	"itmrchow/go-todolist-service/internal/infrastructure/server"
+	"itmrchow/go-todolist-service/internal/infrastructure/storage"
+	"itmrchow/go-todolist-service/internal/utils/actor"
 )
 
 func main() {
@@ -53,7 +59,7 @@ func main() {
 	}
 
 	// Run database migrations
-	migrationErr := db.Migrate(&model.Todo{})
+	migrationErr := db.Migrate(&model.Todo{}, &model.Tag{}, &model.TodoTag{}, &model.ChecklistItem{}, &model.StatusChange{}, &model.Workflow{}, &model.WorkflowStatus{}, &model.Project{}, &model.TodoDependency{}, &model.TodoAssignee{}, &model.Comment{}, &model.Attachment{}, &model.User{}, &model.APIKey{}, &model.Workspace{}, &model.WorkspaceMember{}, &model.IdempotencyRecord{})
 	if migrationErr != nil {
 		log.Fatal().Err(migrationErr).Str("module", "database").Msg("database migration error")
 	}
@@ -61,18 +67,131 @@ func main() {
 
 	// Repository
 	todoRepo := repository.NewTodoRepository(logger, gormDb)
+	tagRepo := repository.NewTagRepository(logger, gormDb)
+	checklistRepo := repository.NewChecklistRepository(logger, gormDb)
+	historyRepo := repository.NewStatusHistoryRepository(logger, gormDb)
+	workflowRepo := repository.NewWorkflowRepository(logger, gormDb)
+	projectRepo := repository.NewProjectRepository(logger, gormDb)
+	dependencyRepo := repository.NewDependencyRepository(logger, gormDb)
+	assigneeRepo := repository.NewAssigneeRepository(logger, gormDb)
+	commentRepo := repository.NewCommentRepository(logger, gormDb)
+	attachmentRepo := repository.NewAttachmentRepository(logger, gormDb)
+	userRepo := repository.NewUserRepository(logger, gormDb)
+	apiKeyRepo := repository.NewAPIKeyRepository(logger, gormDb)
+	idempotencyRepo := repository.NewIdempotencyRepository(logger, gormDb)
+	workspaceRepo := repository.NewWorkspaceRepository(logger, gormDb)
+
+	// Blob store - 附件檔案存放於本機目錄
+	attachmentConfig := config.GetAttachmentConfig()
+	blobStore, blobStoreErr := storage.NewLocalBlobStore(attachmentConfig.Dir)
+	if blobStoreErr != nil {
+		log.Fatal().Err(blobStoreErr).Str("module", "storage").Msg("blob store init error")
+	}
+
+	// Auth - 簽章密鑰未設定或過短時拒絕啟動，密鑰只能由環境變數提供
+	authConfig := config.GetAuthConfig()
+	if len(authConfig.JWTSecret) < 32 {
+		log.Fatal().Str("module", "auth").Msg("AUTH_JWT_SECRET must be at least 32 characters")
+	}
+
+	// Idempotency - 金鑰必須有保留時間，否則帶有 Idempotency-Key 的請求都會失敗
+	idempotencyConfig := config.GetIdempotencyConfig()
+	if idempotencyConfig.KeyTTL <= 0 {
+		log.Fatal().Str("module", "idempotency").Msg("IDEMPOTENCY_KEY_TTL must be positive")
+	}
+	if idempotencyConfig.MaxBodySize <= 0 {
+		log.Fatal().Str("module", "idempotency").Msg("IDEMPOTENCY_MAX_BODY_SIZE must be positive")
+	}
 
 	// Usecase
-	todoUc := usecase.NewTodoUseCaseImpl(todoRepo)
+	todoConfig := config.GetTodoConfig()
+	todoUc := usecase.NewTodoUseCaseImpl(todoRepo, tagRepo, historyRepo, workflowRepo, projectRepo, dependencyRepo, attachmentRepo, blobStore, usecase.TodoOptions{
+		RequireSubtasksDone: todoConfig.RequireSubtasksDone,
+		AllowReopen:         todoConfig.AllowReopen,
+	})
+	tagUc := usecase.NewTagUseCaseImpl(tagRepo)
+	checklistUc := usecase.NewChecklistUseCaseImpl(todoRepo, checklistRepo)
+	workflowUc := usecase.NewWorkflowUseCaseImpl(workflowRepo, todoRepo, projectRepo)
+	projectUc := usecase.NewProjectUseCaseImpl(projectRepo, workflowRepo, todoRepo)
+	dependencyUc := usecase.NewDependencyUseCaseImpl(todoRepo, dependencyRepo)
+	assigneeUc := usecase.NewAssigneeUseCaseImpl(todoRepo, assigneeRepo, workspaceRepo, userRepo, historyRepo)
+	commentUc := usecase.NewCommentUseCaseImpl(todoRepo, commentRepo)
+	authUc := usecase.NewAuthUseCaseImpl(userRepo, usecase.AuthOptions{
+		Secret:          []byte(authConfig.JWTSecret),
+		AccessTokenTTL:  authConfig.AccessTokenTTL,
+		RefreshTokenTTL: authConfig.RefreshTokenTTL,
+	})
+	apiKeyUc := usecase.NewAPIKeyUseCaseImpl(apiKeyRepo, userRepo)
+	idempotencyUc := usecase.NewIdempotencyUseCaseImpl(idempotencyRepo, usecase.IdempotencyOptions{
+		KeyTTL: idempotencyConfig.KeyTTL,
+	})
+	workspaceUc := usecase.NewWorkspaceUseCaseImpl(workspaceRepo, userRepo, todoRepo, workflowRepo)
+	attachmentUc := usecase.NewAttachmentUseCaseImpl(todoRepo, attachmentRepo, blobStore, usecase.AttachmentOptions{
+		MaxSize:      attachmentConfig.MaxSize,
+		AllowedTypes: attachmentConfig.AllowedTypes,
+	})
+
+	// 啟動時的資料修補跨所有工作區執行
+	systemCtx := actor.WithSystem(ctx)
+
+	// 為每個工作區複製工作區功能上線前建立的專案、標籤與工作流程，建立預設工作流程，
+	// 並將尚未指定工作流程的todo歸入預設工作流程
+	if err := workspaceUc.EnsureResources(systemCtx); err != nil {
+		log.Fatal().Err(err).Str("module", "workspace").Msg("workspace resources init error")
+	}
+
+	// 為手動排序上線前建立的todo補上排序鍵
+	if err := todoUc.EnsurePositions(systemCtx); err != nil {
+		log.Fatal().Err(err).Str("module", "todo").Msg("todo position init error")
+	}
+
+	// 為角色上線前建立的工作區指定 owner，由最早加入的成員擔任
+	if err := workspaceUc.EnsureOwners(systemCtx); err != nil {
+		log.Fatal().Err(err).Str("module", "workspace").Msg("workspace owner init error")
+	}
+
+	// Background jobs - 監聽根 context，cancel 時自動停止
+	trashRetentionJob := job.NewTrashRetentionJob(logger, todoUc, config.GetTrashRetentionConfig())
+	trashRetentionJob.Start(ctx)
+	idempotencyCleanupJob := job.NewIdempotencyCleanupJob(logger, idempotencyUc, idempotencyConfig)
+	idempotencyCleanupJob.Start(ctx)
 
 	// Router handlers
 	healthHandler := handler.NewHealthHandler()
 	todoV1Handler := v1.NewTodoHandlerImpl(logger, todoUc) // 假設有一個 TodoUseCase
+	todoV2Handler := v2.NewTodoHandlerImpl(logger, todoUc)
+	tagV2Handler := v2.NewTagHandlerImpl(logger, tagUc)
+	checklistV2Handler := v2.NewChecklistHandlerImpl(logger, checklistUc)
+	workflowV2Handler := v2.NewWorkflowHandlerImpl(logger, workflowUc)
+	projectV1Handler := v1.NewProjectHandlerImpl(logger, projectUc)
+	dependencyV2Handler := v2.NewDependencyHandlerImpl(logger, dependencyUc)
+	assigneeV2Handler := v2.NewAssigneeHandlerImpl(logger, assigneeUc)
+	commentV2Handler := v2.NewCommentHandlerImpl(logger, commentUc)
+	attachmentV2Handler := v2.NewAttachmentHandlerImpl(logger, attachmentUc, attachmentConfig.MaxSize)
+	authV2Handler := v2.NewAuthHandlerImpl(logger, authUc)
+	apiKeyV2Handler := v2.NewAPIKeyHandlerImpl(logger, apiKeyUc)
+	workspaceV2Handler := v2.NewWorkspaceHandlerImpl(logger, workspaceUc)
 
 	// Router
 	appRouter := router.NewRouter(
 		healthHandler,
 		todoV1Handler,
+		todoV2Handler,
+		tagV2Handler,
+		checklistV2Handler,
+		workflowV2Handler,
+		projectV1Handler,
+		dependencyV2Handler,
+		assigneeV2Handler,
+		commentV2Handler,
+		attachmentV2Handler,
+		authV2Handler,
+		apiKeyV2Handler,
+		workspaceV2Handler,
+		middleware.Auth(authUc, apiKeyUc),
+		middleware.Workspace(workspaceUc),
+		middleware.Idempotency(logger, idempotencyUc, idempotencyConfig.MaxBodySize),
+		config.GetAPIServerConfig().AllowedOrigins,
 	)
 	engine := appRouter.SetupRoutes()
 
@@ -94,11 +213,20 @@ func main() {
 	log.Info().Str("module", "server").Msg("Shutting down server...")
 
 	// 呼叫 cancel()，通知所有模組開始關閉
+	cancel()
+
+	// 等待背景工作結束，避免在資料庫關閉後仍在清理
+	trashRetentionJob.Wait()
+	idempotencyCleanupJob.Wait()
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer shutdownCancel()
+
 	var closeErr error
 	if closeErr = db.Close(); closeErr != nil {
 		log.Error().Err(closeErr).Str("module", "close").Msg("database close error")
 	}
-	if closeErr = httpServer.Stop(ctx); closeErr != nil {
+	if closeErr = httpServer.Stop(shutdownCtx); closeErr != nil {
 		log.Error().Err(closeErr).Str("module", "close").Msg("http server close error")
 	}
 